@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=acpolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// AccessControlPolicy is an Inherited Attached Policy. It provides a way to allow or deny access
+// to Gateways, Listeners and Routes based on the IP address of the client.
+type AccessControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessControlPolicy.
+	Spec AccessControlPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessControlPolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessControlPolicyList contains a list of AccessControlPolicies.
+type AccessControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessControlPolicy `json:"items"`
+}
+
+// AccessControlPolicySpec defines the desired state of the AccessControlPolicy.
+type AccessControlPolicySpec struct {
+	// AccessControl defines the Access Control settings.
+	//
+	// +optional
+	AccessControl *AccessControl `json:"accessControl,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute, GRPCRoute, TCPRoute
+	//
+	// SectionName can be set on Gateway targets to apply the policy only to the Listener with the matching name,
+	// and on HTTPRoute and GRPCRoute targets to apply the policy only to the rule with the matching name.
+	// TLS listeners can't be targeted, since their connections are routed by SNI after access is checked.
+	//
+	// A policy that targets a Route or a rule replaces the policy of the Gateway or Listener for the
+	// traffic of that Route or rule. HTTP policies that use the Geo mode are the exception: they are
+	// enforced in addition to the policies of the Gateway, Listener and Route.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, GRPCRoute, or TCPRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute' || t.kind == 'TCPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind, Name and SectionName combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name && (has(t1.sectionName) ? has(t2.sectionName) && t1.sectionName == t2.sectionName : !has(t2.sectionName))))"
+	// +kubebuilder:validation:XValidation:message="SectionName can only be set for Gateway, HTTPRoute or GRPCRoute kinds",rule="self.all(t, !has(t.sectionName) || t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="Cannot target an object and a section of the same object in targetRefs",rule="self.all(t1, self.all(t2, t1.kind == t2.kind && t1.name == t2.name ? has(t1.sectionName) == has(t2.sectionName) : true))"
+	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute, GRPCRoute or TCPRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute' || t.kind == 'TCPRoute'))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs"`
+}
+
+// AccessControl contains settings for Access Control.
+//
+// The client address is the address of the connection, or the address that is extracted from the request
+// when RewriteClientIP is configured in the NginxProxy resource. RewriteClientIP applies to HTTP traffic, and to
+// the traffic of TLS listeners in the ProxyProtocol mode.
+type AccessControl struct {
+	// Mode is the mode that is used to evaluate the rules.
+	// Directives evaluates the rules in order, and the first rule that matches the client address
+	// is applied.
+	// Geo evaluates the rules with a geo map, where the rule with the most specific address range
+	// that matches the client address is applied. Geo is more efficient for large numbers of address
+	// ranges. Geo only applies to HTTP traffic; TCP traffic is always evaluated with Directives.
+	// Default is Directives.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_access_module.html
+	// Directive: https://nginx.org/en/docs/http/ngx_http_geo_module.html
+	//
+	// +optional
+	Mode *AccessControlMode `json:"mode,omitempty"`
+
+	// DefaultAction is the action that is applied to clients that do not match any rule.
+	// If not specified, clients that do not match any rule are allowed.
+	//
+	// +optional
+	DefaultAction *AccessControlAction `json:"defaultAction,omitempty"`
+
+	// Rules contains the list of access control rules.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Rules []AccessControlRule `json:"rules,omitempty"`
+}
+
+// AccessControlRule contains settings for an AccessControl Rule.
+type AccessControlRule struct {
+	// Action is the action that is applied to the clients with an address in one of the CIDRs.
+	Action AccessControlAction `json:"action"`
+
+	// CIDRs is the list of IPv4 or IPv6 CIDR ranges the rule applies to.
+	// A single IP address matches only that address.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_access_module.html#allow
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	CIDRs []string `json:"cidrs"`
+}
+
+// AccessControlAction is the action that is applied to a client.
+//
+// +kubebuilder:validation:Enum=Allow;Deny
+type AccessControlAction string
+
+const (
+	// AccessControlActionAllow allows access to the client.
+	AccessControlActionAllow AccessControlAction = "Allow"
+
+	// AccessControlActionDeny denies access to the client.
+	// Denied HTTP requests are rejected with a 403 status code, and denied TCP connections are closed.
+	AccessControlActionDeny AccessControlAction = "Deny"
+)
+
+// AccessControlMode is the mode that is used to evaluate the access control rules.
+//
+// +kubebuilder:validation:Enum=Directives;Geo
+type AccessControlMode string
+
+const (
+	// AccessControlModeDirectives evaluates the rules in order with the allow and deny directives.
+	AccessControlModeDirectives AccessControlMode = "Directives"
+
+	// AccessControlModeGeo evaluates the rules with a geo map.
+	AccessControlModeGeo AccessControlMode = "Geo"
+)
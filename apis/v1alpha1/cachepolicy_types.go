@@ -0,0 +1,217 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=cachepolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// CachePolicy is a Direct Attached Policy. It provides a way to cache the responses of the upstream
+// applications (backends) of HTTPRoutes in NGINX.
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CachePolicy.
+	Spec CachePolicySpec `json:"spec"`
+
+	// Status defines the state of the CachePolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies.
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicySpec defines the desired state of the CachePolicy.
+type CachePolicySpec struct {
+	// Cache defines the Cache settings.
+	//
+	// +optional
+	Cache *Cache `json:"cache,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	//
+	// SectionName can be set to apply the policy only to the rule with the matching name.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be HTTPRoute",rule="self.all(t, t.kind == 'HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group == 'gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind, Name and SectionName combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name && (has(t1.sectionName) ? has(t2.sectionName) && t1.sectionName == t2.sectionName : !has(t2.sectionName))))"
+	// +kubebuilder:validation:XValidation:message="Cannot target a Route and a named rule of the same Route in targetRefs",rule="self.all(t1, self.all(t2, t1.kind == t2.kind && t1.name == t2.name ? has(t1.sectionName) == has(t2.sectionName) : true))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs"`
+}
+
+// Cache contains settings for caching the responses of the proxied server.
+// Each CachePolicy has its own cache, which is stored on an emptyDir volume of the NGINX Pod.
+type Cache struct {
+	// ZoneSize is the size of the shared memory zone that stores the keys and the metadata of the cached
+	// responses. One megabyte can store about 8 thousand keys.
+	// Default is 10m.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// MaxSize is the maximum size of the cached responses. When the size is exceeded,
+	// the least recently used responses are removed.
+	// If not specified, the cache can use all of the space of the volume.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+
+	// Key defines the key of the cached responses, which can contain NGINX variables.
+	// Default is $scheme$proxy_host$request_uri.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_key
+	//
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// Valid sets the caching durations of the responses with the given status codes.
+	// If not specified, only the responses with caching headers, such as Cache-Control or Expires, are cached.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_valid
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Valid []CacheValid `json:"valid,omitempty"`
+
+	// Bypass is the list of NGINX variables, such as $cookie_nocache or $http_pragma, that make NGINX bypass
+	// the cache and take the response from the proxied server when at least one of them is not empty
+	// and not equal to "0".
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_bypass
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Bypass []string `json:"bypass,omitempty"`
+
+	// UseStale configures serving stale cached responses when the proxied server cannot be used.
+	//
+	// +optional
+	UseStale *CacheUseStale `json:"useStale,omitempty"`
+
+	// Lock configures the cache lock. If set, only one request at a time populates a new cache element,
+	// and the other requests for the same element wait for the response to appear in the cache.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock
+	//
+	// +optional
+	Lock *CacheLock `json:"lock,omitempty"`
+}
+
+// CacheValid defines the caching duration of the responses with the given status codes.
+type CacheValid struct {
+	// Codes is the list of status codes. The value "any" matches all status codes.
+	// If not specified, the duration applies to the 200, 301 and 302 status codes.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Codes []CacheStatusCode `json:"codes,omitempty"`
+
+	// Duration is the time the responses are cached for.
+	Duration Duration `json:"duration"`
+}
+
+// CacheStatusCode is a response status code, or "any" to match all status codes.
+//
+// +kubebuilder:validation:Pattern=`^(any|[1-5][0-9]{2})$`
+type CacheStatusCode string
+
+// CacheUseStale defines the settings for serving stale cached responses.
+//
+// +kubebuilder:validation:XValidation:message="BackgroundUpdate requires the updating condition",rule="!has(self.backgroundUpdate) || !self.backgroundUpdate || self.conditions.exists(c, c == 'updating')"
+//
+//nolint:lll
+type CacheUseStale struct {
+	// Conditions is the list of the conditions in which a stale cached response is served.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_use_stale
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=11
+	// +listType=set
+	Conditions []CacheUseStaleCondition `json:"conditions"`
+
+	// BackgroundUpdate enables a background subrequest to update an expired cache element,
+	// while a stale cached response is returned to the client. Requires the updating condition.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_background_update
+	//
+	// +optional
+	BackgroundUpdate *bool `json:"backgroundUpdate,omitempty"`
+}
+
+// CacheUseStaleCondition is a condition in which a stale cached response is served.
+//
+// +kubebuilder:validation:Enum=error;timeout;invalid_header;updating;http_500;http_502;http_503;http_504;http_403;http_404;http_429
+type CacheUseStaleCondition string
+
+const (
+	// CacheUseStaleError serves a stale response when a connection to the proxied server cannot be established.
+	CacheUseStaleError CacheUseStaleCondition = "error"
+	// CacheUseStaleTimeout serves a stale response when the proxied server times out.
+	CacheUseStaleTimeout CacheUseStaleCondition = "timeout"
+	// CacheUseStaleInvalidHeader serves a stale response when the proxied server returns an invalid response.
+	CacheUseStaleInvalidHeader CacheUseStaleCondition = "invalid_header"
+	// CacheUseStaleUpdating serves a stale response while the cache element is being updated.
+	CacheUseStaleUpdating CacheUseStaleCondition = "updating"
+	// CacheUseStaleHTTP500 serves a stale response when the proxied server returns a 500 status code.
+	CacheUseStaleHTTP500 CacheUseStaleCondition = "http_500"
+	// CacheUseStaleHTTP502 serves a stale response when the proxied server returns a 502 status code.
+	CacheUseStaleHTTP502 CacheUseStaleCondition = "http_502"
+	// CacheUseStaleHTTP503 serves a stale response when the proxied server returns a 503 status code.
+	CacheUseStaleHTTP503 CacheUseStaleCondition = "http_503"
+	// CacheUseStaleHTTP504 serves a stale response when the proxied server returns a 504 status code.
+	CacheUseStaleHTTP504 CacheUseStaleCondition = "http_504"
+	// CacheUseStaleHTTP403 serves a stale response when the proxied server returns a 403 status code.
+	CacheUseStaleHTTP403 CacheUseStaleCondition = "http_403"
+	// CacheUseStaleHTTP404 serves a stale response when the proxied server returns a 404 status code.
+	CacheUseStaleHTTP404 CacheUseStaleCondition = "http_404"
+	// CacheUseStaleHTTP429 serves a stale response when the proxied server returns a 429 status code.
+	CacheUseStaleHTTP429 CacheUseStaleCondition = "http_429"
+)
+
+// CacheLock defines the settings for the cache lock.
+type CacheLock struct {
+	// Timeout is the time a request waits for the cache element to be populated. When the time expires,
+	// the request is passed to the proxied server, but the response is not cached.
+	// Default is 5s.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// Age is the time after which another request may be passed to the proxied server when the
+	// request that populates the cache element has not completed.
+	// Default is 5s.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock_age
+	//
+	// +optional
+	Age *Duration `json:"age,omitempty"`
+}
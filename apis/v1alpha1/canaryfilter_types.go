@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=canaryfilter
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CanaryFilter overrides the weighted backend selection of a route rule based on request headers or cookies.
+// It is referenced by HTTPRoute and GRPCRoute filters using ExtensionRef.
+//
+// Requests that match an override are sent to the backend of the override, bypassing the weights of the
+// backendRefs of the rule. All other requests are distributed according to the weights of the backendRefs.
+// This allows progressive delivery tools to pin specific clients, such as testers or beta users, to a canary
+// backend while shifting the rest of the traffic gradually.
+type CanaryFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CanaryFilter.
+	Spec CanaryFilterSpec `json:"spec"`
+
+	// Status defines the state of the CanaryFilter.
+	Status CanaryFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CanaryFilterList contains a list of CanaryFilters.
+type CanaryFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CanaryFilter `json:"items"`
+}
+
+// CanaryFilterSpec defines the desired state of the CanaryFilter.
+type CanaryFilterSpec struct {
+	// Overrides is a list of backend overrides.
+	// The overrides are evaluated in order, and the first override that matches the request
+	// selects the backend.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Overrides []CanaryOverride `json:"overrides"`
+}
+
+// CanaryOverride sends the requests that match a header or cookie value to a specific backend.
+//
+// +kubebuilder:validation:XValidation:message="header name must only contain alphanumeric characters or '-'",rule="self.type != 'Header' || self.name.matches('^[A-Za-z0-9-]+$')"
+// +kubebuilder:validation:XValidation:message="cookie name must only contain alphanumeric characters or '_'",rule="self.type != 'Cookie' || self.name.matches('^[A-Za-z0-9_]+$')"
+//
+//nolint:lll
+type CanaryOverride struct {
+	// Type is the type of the request attribute to match.
+	Type CanaryOverrideType `json:"type"`
+
+	// Name is the name of the header or cookie.
+	// Header names are case-insensitive.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+$`
+	Name string `json:"name"`
+
+	// Value is the value of the header or cookie. The value must match exactly.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.:/=+-]+$`
+	Value string `json:"value"`
+
+	// BackendRef references the backend of the route rule that receives the matching requests.
+	// The backend must be one of the backendRefs of the rule that references this filter.
+	// If it is not, or if the backend is invalid, the matching requests receive a 500 response.
+	BackendRef CanaryBackendRef `json:"backendRef"`
+}
+
+// CanaryOverrideType is the type of the request attribute matched by a CanaryOverride.
+//
+// +kubebuilder:validation:Enum=Header;Cookie
+type CanaryOverrideType string
+
+const (
+	// CanaryOverrideTypeHeader matches the value of a request header.
+	CanaryOverrideTypeHeader CanaryOverrideType = "Header"
+
+	// CanaryOverrideTypeCookie matches the value of a request cookie.
+	CanaryOverrideTypeCookie CanaryOverrideType = "Cookie"
+)
+
+// CanaryBackendRef references a backendRef of a route rule.
+type CanaryBackendRef struct {
+	// Name is the name of the Service referenced by the backendRef.
+	Name v1.ObjectName `json:"name"`
+
+	// Port is the port of the backendRef. If not specified, the first backendRef of the rule
+	// that references the Service is used.
+	//
+	// +optional
+	Port *v1.PortNumber `json:"port,omitempty"`
+}
+
+// CanaryFilterStatus defines the state of CanaryFilter.
+type CanaryFilterStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the CanaryFilter
+	// and the status of the CanaryFilter with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// CanaryFilterConditionType is a type of condition associated with CanaryFilter.
+type CanaryFilterConditionType string
+
+// CanaryFilterConditionReason is a reason for a CanaryFilter condition type.
+type CanaryFilterConditionReason string
+
+const (
+	// CanaryFilterConditionTypeAccepted indicates that the CanaryFilter is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	CanaryFilterConditionTypeAccepted CanaryFilterConditionType = "Accepted"
+
+	// CanaryFilterConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	CanaryFilterConditionReasonAccepted CanaryFilterConditionReason = "Accepted"
+
+	// CanaryFilterConditionReasonInvalid is used with the Accepted condition type when
+	// CanaryFilter is invalid.
+	CanaryFilterConditionReasonInvalid CanaryFilterConditionReason = "Invalid"
+)
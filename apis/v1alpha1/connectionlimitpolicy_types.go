@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=clpolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ConnectionLimitPolicy is an Inherited Attached Policy. It provides a way to limit the number of concurrent
+// connections per key, such as the client IP address, for HTTP and TCP traffic in NGINX.
+type ConnectionLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ConnectionLimitPolicy.
+	Spec ConnectionLimitPolicySpec `json:"spec"`
+
+	// Status defines the state of the ConnectionLimitPolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionLimitPolicyList contains a list of ConnectionLimitPolicies.
+type ConnectionLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectionLimitPolicy `json:"items"`
+}
+
+// ConnectionLimitPolicySpec defines the desired state of the ConnectionLimitPolicy.
+type ConnectionLimitPolicySpec struct {
+	// ConnectionLimit defines the Connection Limit settings.
+	//
+	// +optional
+	ConnectionLimit *ConnectionLimit `json:"connectionLimit,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	//
+	// Support: Gateway, HTTPRoute, TCPRoute
+	//
+	// A policy that targets a Gateway applies to the HTTP servers and the TCP, TLS and UDP servers of the Gateway.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or TCPRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'TCPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute or TCPRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'TCPRoute'))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ConnectionLimit contains settings for Connection Limiting.
+type ConnectionLimit struct {
+	// DryRun enables the dry run mode. In this mode, the number of connections is not limited, but the number of
+	// excessive connections is accounted as usual in the shared memory zone.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_dry_run
+	//
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// LogLevel sets the desired logging level for cases when the server limits the number of connections.
+	// Allowed values are info, notice, warn or error.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_log_level
+	//
+	// +optional
+	LogLevel *ConnectionLimitLogLevel `json:"logLevel,omitempty"`
+
+	// RejectCode sets the status code to return in response to rejected requests. Must fall into the range 400-599.
+	// Only applies to HTTP traffic; excessive TCP connections are closed.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_status
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+
+	// Rules contains the list of connection limit rules.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Rules []ConnectionLimitRule `json:"rules,omitempty"`
+}
+
+// ConnectionLimitRule contains settings for a ConnectionLimit Rule.
+type ConnectionLimitRule struct {
+	// ZoneSize is the size of the shared memory zone.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_zone
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// Key represents the key to which the connection limit is applied. The key can contain text, variables,
+	// and their combination. Connections with an empty key are not limited.
+	// Default is $binary_remote_addr.
+	// A key that uses variables only available to HTTP traffic, such as $server_name, is not supported
+	// for TCP, TLS and UDP traffic: the policy is not applied to TCPRoutes, or to Gateways with such listeners.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_zone
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	Key *string `json:"key,omitempty"`
+
+	// Connections is the maximum number of concurrent connections allowed per key.
+	// In HTTP/2 and HTTP/3, each concurrent request is counted as a separate connection.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn
+	//
+	// +kubebuilder:validation:Minimum=1
+	Connections int32 `json:"connections"`
+}
+
+// ConnectionLimitLogLevel defines the log level for cases when the server limits the number of connections.
+//
+// +kubebuilder:validation:Enum=info;notice;warn;error
+type ConnectionLimitLogLevel string
+
+const (
+	// ConnectionLimitLogLevelInfo is the info level connection limit logs.
+	ConnectionLimitLogLevelInfo ConnectionLimitLogLevel = "info"
+
+	// ConnectionLimitLogLevelNotice is the notice level connection limit logs.
+	ConnectionLimitLogLevelNotice ConnectionLimitLogLevel = "notice"
+
+	// ConnectionLimitLogLevelWarn is the warn level connection limit logs.
+	ConnectionLimitLogLevelWarn ConnectionLimitLogLevel = "warn"
+
+	// ConnectionLimitLogLevelError is the error level connection limit logs.
+	ConnectionLimitLogLevelError ConnectionLimitLogLevel = "error"
+)
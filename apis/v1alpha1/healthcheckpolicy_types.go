@@ -0,0 +1,133 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=hcpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// HealthCheckPolicy is a Direct Attached Policy. It provides a way to configure active health checks
+// for the upstream applications. Active health checks are only supported by NGINX Plus.
+type HealthCheckPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the HealthCheckPolicy.
+	Spec HealthCheckPolicySpec `json:"spec"`
+
+	// Status defines the state of the HealthCheckPolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckPolicyList contains a list of HealthCheckPolicies.
+type HealthCheckPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HealthCheckPolicy `json:"items"`
+}
+
+// HealthCheckPolicySpec defines the desired state of the HealthCheckPolicy.
+type HealthCheckPolicySpec struct {
+	// Path is the URI that is requested for the health check.
+	// Default: "/".
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s{};"'\\]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Interval is the time between two consecutive health checks.
+	// Default: 5s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Fails is the number of consecutive failed health checks after which an upstream server
+	// is considered unhealthy.
+	// Default: 1.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Fails *int32 `json:"fails,omitempty"`
+
+	// Passes is the number of consecutive passed health checks after which an upstream server
+	// is considered healthy.
+	// Default: 1.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Passes *int32 `json:"passes,omitempty"`
+
+	// Port is the port that is used when connecting to an upstream server to perform a health check.
+	// By default, the port of the upstream server is used.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Match defines the conditions that a response must satisfy for the health check to pass.
+	// By default, a response passes the health check if its status code is 2xx or 3xx.
+	// Match is ignored for gRPC upstreams.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#match
+	//
+	// +optional
+	Match *HealthCheckMatch `json:"match,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Service
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries in the HealthCheckPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be: Service",rule="self.all(t, t.kind=='Service')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be core",rule="self.exists(t, t.group=='') || self.exists(t, t.group=='core')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// HealthCheckMatch defines the conditions that a health check response must satisfy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of status or body must be specified",rule="has(self.status) || has(self.body)"
+//
+//nolint:lll
+type HealthCheckMatch struct {
+	// Status is the expected status code of the response. It can be a single code, a range of codes,
+	// or a space-separated list of codes and ranges. A code or range can be negated with a leading `!`.
+	// Examples: "200", "200-399", "! 500", "200 204 301".
+	//
+	// +optional
+	Status *HealthCheckStatusMatch `json:"status,omitempty"`
+
+	// Body is a regular expression that the response body must match.
+	// Only the first 256 bytes of the response body are checked.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Body *string `json:"body,omitempty"`
+}
+
+// HealthCheckStatusMatch defines the expected status code(s) of a health check response.
+//
+// +kubebuilder:validation:Pattern=`^(! )?[1-5][0-9]{2}(-[1-5][0-9]{2})?( [1-5][0-9]{2}(-[1-5][0-9]{2})?)*$`
+type HealthCheckStatusMatch string
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=mirrorfilter
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// MirrorFilter configures how requests are mirrored by the RequestMirror filters of a route rule.
+// It is referenced by HTTPRoute and GRPCRoute filters using ExtensionRef, and applies to all RequestMirror
+// filters of the rule that references it. It has no effect on rules without RequestMirror filters.
+//
+// Responses of mirrored requests are always ignored. The settings of the MirrorFilter limit how much a slow
+// or unavailable mirror backend, or a large request body, can affect the mirrored traffic.
+type MirrorFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the MirrorFilter.
+	Spec MirrorFilterSpec `json:"spec"`
+
+	// Status defines the state of the MirrorFilter.
+	Status MirrorFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MirrorFilterList contains a list of MirrorFilters.
+type MirrorFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MirrorFilter `json:"items"`
+}
+
+// MirrorFilterSpec defines the desired state of the MirrorFilter.
+//
+// +kubebuilder:validation:XValidation:message="at least one field must be set",rule="has(self.timeout) || has(self.maxBodySize) || has(self.stripAuthHeaders) || has(self.stripCookies)"
+//
+//nolint:lll
+type MirrorFilterSpec struct {
+	// Timeout is the timeout for establishing a connection with the mirror backend, and for reading
+	// and sending data to the mirror backend.
+	// Default: the NGINX defaults.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// MaxBodySize is the maximum size of the body of a mirrored request.
+	// Requests with a Content-Length larger than MaxBodySize are not mirrored.
+	// Requests without a Content-Length, such as requests with a chunked body, are not mirrored either.
+	// Not supported for GRPCRoutes, since gRPC requests don't have a Content-Length.
+	//
+	// +optional
+	MaxBodySize *Size `json:"maxBodySize,omitempty"`
+
+	// StripAuthHeaders removes the Authorization and Proxy-Authorization headers
+	// from the mirrored requests, so that credentials are not sent to the mirror backend.
+	//
+	// +optional
+	StripAuthHeaders *bool `json:"stripAuthHeaders,omitempty"`
+
+	// StripCookies removes the Cookie header from the mirrored requests, so that session cookies
+	// are not sent to the mirror backend.
+	//
+	// +optional
+	StripCookies *bool `json:"stripCookies,omitempty"`
+}
+
+// MirrorFilterStatus defines the state of MirrorFilter.
+type MirrorFilterStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the MirrorFilter
+	// and the status of the MirrorFilter with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// MirrorFilterConditionType is a type of condition associated with MirrorFilter.
+type MirrorFilterConditionType string
+
+// MirrorFilterConditionReason is a reason for a MirrorFilter condition type.
+type MirrorFilterConditionReason string
+
+const (
+	// MirrorFilterConditionTypeAccepted indicates that the MirrorFilter is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	MirrorFilterConditionTypeAccepted MirrorFilterConditionType = "Accepted"
+
+	// MirrorFilterConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	MirrorFilterConditionReasonAccepted MirrorFilterConditionReason = "Accepted"
+
+	// MirrorFilterConditionReasonInvalid is used with the Accepted condition type when
+	// MirrorFilter is invalid.
+	MirrorFilterConditionReasonInvalid MirrorFilterConditionReason = "Invalid"
+)
@@ -21,6 +21,10 @@ func (p *ClientSettingsPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
 }
 
 func (p *ProxySettingsPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return withoutSectionNames(p.Spec.TargetRefs)
+}
+
+func (p *ProxySettingsPolicy) GetTargetRefsWithSectionName() []gatewayv1.LocalPolicyTargetReferenceWithSectionName {
 	return p.Spec.TargetRefs
 }
 
@@ -44,6 +48,18 @@ func (p *UpstreamSettingsPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus)
 	p.Status = status
 }
 
+func (p *HealthCheckPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return p.Spec.TargetRefs
+}
+
+func (p *HealthCheckPolicy) GetPolicyStatus() gatewayv1.PolicyStatus {
+	return p.Status
+}
+
+func (p *HealthCheckPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
+	p.Status = status
+}
+
 func (p *SnippetsPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
 	return p.Spec.TargetRefs
 }
@@ -57,6 +73,10 @@ func (p *SnippetsPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
 }
 
 func (p *RateLimitPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return withoutSectionNames(p.Spec.TargetRefs)
+}
+
+func (p *RateLimitPolicy) GetTargetRefsWithSectionName() []gatewayv1.LocalPolicyTargetReferenceWithSectionName {
 	return p.Spec.TargetRefs
 }
 
@@ -68,6 +88,50 @@ func (p *RateLimitPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
 	p.Status = status
 }
 
+func (p *ConnectionLimitPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return p.Spec.TargetRefs
+}
+
+func (p *ConnectionLimitPolicy) GetPolicyStatus() gatewayv1.PolicyStatus {
+	return p.Status
+}
+
+func (p *ConnectionLimitPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *AccessControlPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return withoutSectionNames(p.Spec.TargetRefs)
+}
+
+func (p *AccessControlPolicy) GetTargetRefsWithSectionName() []gatewayv1.LocalPolicyTargetReferenceWithSectionName {
+	return p.Spec.TargetRefs
+}
+
+func (p *AccessControlPolicy) GetPolicyStatus() gatewayv1.PolicyStatus {
+	return p.Status
+}
+
+func (p *AccessControlPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *CachePolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
+	return withoutSectionNames(p.Spec.TargetRefs)
+}
+
+func (p *CachePolicy) GetTargetRefsWithSectionName() []gatewayv1.LocalPolicyTargetReferenceWithSectionName {
+	return p.Spec.TargetRefs
+}
+
+func (p *CachePolicy) GetPolicyStatus() gatewayv1.PolicyStatus {
+	return p.Status
+}
+
+func (p *CachePolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
+	p.Status = status
+}
+
 func (p *WAFPolicy) GetTargetRefs() []gatewayv1.LocalPolicyTargetReference {
 	return p.Spec.TargetRefs
 }
@@ -79,3 +143,14 @@ func (p *WAFPolicy) GetPolicyStatus() gatewayv1.PolicyStatus {
 func (p *WAFPolicy) SetPolicyStatus(status gatewayv1.PolicyStatus) {
 	p.Status = status
 }
+
+func withoutSectionNames(
+	refs []gatewayv1.LocalPolicyTargetReferenceWithSectionName,
+) []gatewayv1.LocalPolicyTargetReference {
+	localRefs := make([]gatewayv1.LocalPolicyTargetReference, 0, len(refs))
+	for _, ref := range refs {
+		localRefs = append(localRefs, ref.LocalPolicyTargetReference)
+	}
+
+	return localRefs
+}
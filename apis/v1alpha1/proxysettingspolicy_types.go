@@ -51,14 +51,19 @@ type ProxySettingsPolicySpec struct {
 	// Objects must be in the same namespace as the policy.
 	// Support: Gateway, HTTPRoute, GRPCRoute
 	//
+	// SectionName can be set on HTTPRoute and GRPCRoute targets to apply the policy
+	// only to the rule with the matching name.
+	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
 	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group == 'gateway.networking.k8s.io')"
-	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name))"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind, Name and SectionName combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name && (has(t1.sectionName) ? has(t2.sectionName) && t1.sectionName == t2.sectionName : !has(t2.sectionName))))"
+	// +kubebuilder:validation:XValidation:message="SectionName can only be set for HTTPRoute or GRPCRoute kinds",rule="self.all(t, !has(t.sectionName) || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="Cannot target a Route and a named rule of the same Route in targetRefs",rule="self.all(t1, self.all(t2, t1.kind == t2.kind && t1.name == t2.name ? has(t1.sectionName) == has(t2.sectionName) : true))"
 	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute'))"
 	//nolint:lll
-	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+	TargetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs"`
 }
 
 // ProxyBuffering contains the settings for proxy buffering.
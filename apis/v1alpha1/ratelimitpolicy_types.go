@@ -13,7 +13,8 @@ import (
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
 
-// RateLimitPolicy is an Inherited Attached Policy. It provides a way to set local rate limiting rules in NGINX.
+// RateLimitPolicy is an Inherited Attached Policy. It provides a way to set local and global rate limiting rules
+// in NGINX.
 type RateLimitPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -46,23 +47,43 @@ type RateLimitPolicySpec struct {
 	//
 	// Support: Gateway, HTTPRoute, GRPCRoute
 	//
+	// SectionName can be set on HTTPRoute and GRPCRoute targets to apply the policy
+	// only to the rule with the matching name. The rule must not have several matches for the same path
+	// or matches on headers, query parameters or the method, and no other rule may match the same path on the
+	// same host.
+	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
 	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
-	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind, Name and SectionName combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name && (has(t1.sectionName) ? has(t2.sectionName) && t1.sectionName == t2.sectionName : !has(t2.sectionName))))"
+	// +kubebuilder:validation:XValidation:message="SectionName can only be set for HTTPRoute or GRPCRoute kinds",rule="self.all(t, !has(t.sectionName) || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="Cannot target a Route and a named rule of the same Route in targetRefs",rule="self.all(t1, self.all(t2, t1.kind == t2.kind && t1.name == t2.name ? has(t1.sectionName) == has(t2.sectionName) : true))"
 	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute'))"
 	//nolint:lll
-	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+	TargetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs"`
 }
 
 // RateLimit contains settings for Rate Limiting.
 type RateLimit struct {
 	// Local defines the local rate limit rules for this policy.
+	// Each NGINX replica enforces the local rules on its own.
 	//
 	// +optional
 	Local *LocalRateLimit `json:"local,omitempty"`
 
+	// Global defines the global rate limit rules for this policy.
+	// The state of the global rules is synchronized between all NGINX replicas of a Gateway,
+	// so the rate is enforced across the replicas instead of per replica.
+	// The replicas authenticate each other with mutual TLS using the NGINX Agent TLS certificate,
+	// which must be valid for `zone-sync.<cluster domain>`.
+	// Global rate limiting requires NGINX Plus.
+	//
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_zone_sync_module.html
+	//
+	// +optional
+	Global *GlobalRateLimit `json:"global,omitempty"`
+
 	// DryRun enables the dry run mode. In this mode, the rate limit is not actually applied, but the number of excessive
 	// requests is accounted as usual in the shared memory zone.
 	//
@@ -97,6 +118,14 @@ type LocalRateLimit struct {
 	Rules []RateLimitRule `json:"rules,omitempty"`
 }
 
+// GlobalRateLimit contains the global rate limit rules.
+type GlobalRateLimit struct {
+	// Rules contains the list of rate limit rules.
+	//
+	// +optional
+	Rules []RateLimitRule `json:"rules,omitempty"`
+}
+
 // RateLimitRule contains settings for a RateLimit Rule.
 //
 // +kubebuilder:validation:XValidation:message="NoDelay cannot be true when Delay is also set",rule="!(has(self.noDelay) && has(self.delay) && self.noDelay == true)"
@@ -148,10 +177,145 @@ type RateLimitRule struct {
 	//
 	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_zone
 	//
-	// +kubebuilder:validation:Pattern=`^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	// +kubebuilder:validation:Pattern=`^(?:[^ \t\r\n;{}#$"'\\]+|\$\w+)+$`
 	Key string `json:"key"`
+
+	// Conditions restricts the rule to the requests that match all the conditions.
+	// Requests that do not match are not limited by this rule.
+	// If not specified, the rule applies to every request.
+	//
+	// +optional
+	Conditions *RateLimitConditions `json:"conditions,omitempty"`
+}
+
+// RateLimitConditions defines the conditions a request must match for a RateLimitRule to apply.
+// A request matches when it matches all the specified conditions.
+//
+// +kubebuilder:validation:XValidation:message="at least one condition must be specified",rule="has(self.methods) || has(self.headers) || has(self.path) || has(self.jwtClaim)"
+//
+//nolint:lll
+type RateLimitConditions struct {
+	// Path matches the request path.
+	//
+	// +optional
+	Path *RateLimitPathMatch `json:"path,omitempty"`
+
+	// JWTClaim matches the value of a claim of the JWT of the request.
+	// The JWT must be validated for the request, for example with an AuthenticationFilter.
+	// Matching a JWT claim requires NGINX Plus.
+	//
+	// +optional
+	JWTClaim *RateLimitJWTClaimMatch `json:"jwtClaim,omitempty"`
+
+	// Methods matches the HTTP method of the request. The request matches if its method is one of the Methods.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=9
+	// +listType=set
+	Methods []RateLimitHTTPMethod `json:"methods,omitempty"`
+
+	// Headers matches the request headers. The request matches if it matches all the Headers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	Headers []RateLimitHeaderMatch `json:"headers,omitempty"`
+}
+
+// RateLimitHTTPMethod is an HTTP method.
+//
+// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;DELETE;CONNECT;OPTIONS;TRACE;PATCH
+type RateLimitHTTPMethod string
+
+// RateLimitHeaderMatch matches a request header.
+type RateLimitHeaderMatch struct {
+	// Type specifies how to match against the value of the header.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *RateLimitMatchType `json:"type,omitempty"`
+
+	// Name is the name of the header. Header names are case-insensitive.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+$`
+	Name string `json:"name"`
+
+	// Value is the value of the header to match.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+}
+
+// RateLimitPathMatch matches the request path.
+type RateLimitPathMatch struct {
+	// Type specifies how to match against the path.
+	//
+	// +optional
+	// +kubebuilder:default=PathPrefix
+	Type *RateLimitPathMatchType `json:"type,omitempty"`
+
+	// Value is the value of the path to match.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Value string `json:"value"`
+}
+
+// RateLimitJWTClaimMatch matches the value of a JWT claim.
+type RateLimitJWTClaimMatch struct {
+	// Type specifies how to match against the value of the claim.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *RateLimitMatchType `json:"type,omitempty"`
+
+	// Name is the name of a top-level claim.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]+$`
+	Name string `json:"name"`
+
+	// Value is the value of the claim to match.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
 }
 
+// RateLimitMatchType specifies the semantics of how a value is matched.
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression
+type RateLimitMatchType string
+
+const (
+	// RateLimitMatchTypeExact matches the value exactly.
+	RateLimitMatchTypeExact RateLimitMatchType = "Exact"
+
+	// RateLimitMatchTypeRegularExpression matches the value against a PCRE regular expression.
+	RateLimitMatchTypeRegularExpression RateLimitMatchType = "RegularExpression"
+)
+
+// RateLimitPathMatchType specifies the semantics of how the request path is matched.
+//
+// +kubebuilder:validation:Enum=Exact;PathPrefix;RegularExpression
+type RateLimitPathMatchType string
+
+const (
+	// RateLimitPathMatchTypeExact matches the path exactly.
+	RateLimitPathMatchTypeExact RateLimitPathMatchType = "Exact"
+
+	// RateLimitPathMatchTypePathPrefix matches the paths that start with the value.
+	RateLimitPathMatchTypePathPrefix RateLimitPathMatchType = "PathPrefix"
+
+	// RateLimitPathMatchTypeRegularExpression matches the path against a PCRE regular expression.
+	RateLimitPathMatchTypeRegularExpression RateLimitPathMatchType = "RegularExpression"
+)
+
 // Rate is a string value representing a rate. Rate can be specified in r/s or r/m.
 //
 // +kubebuilder:validation:Pattern=`^\d+r/[sm]$`
@@ -36,6 +36,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NginxGatewayList{},
 		&AuthenticationFilter{},
 		&AuthenticationFilterList{},
+		&AccessControlPolicy{},
+		&AccessControlPolicyList{},
+		&CachePolicy{},
+		&CachePolicyList{},
+		&CanaryFilter{},
+		&CanaryFilterList{},
+		&MirrorFilter{},
+		&MirrorFilterList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
 		&ProxySettingsPolicy{},
@@ -48,10 +56,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SnippetsPolicyList{},
 		&RateLimitPolicy{},
 		&RateLimitPolicyList{},
+		&ConnectionLimitPolicy{},
+		&ConnectionLimitPolicyList{},
 		&WAFPolicy{},
 		&WAFPolicyList{},
 		&ExternalLoadBalancer{},
 		&ExternalLoadBalancerList{},
+		&HealthCheckPolicy{},
+		&HealthCheckPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
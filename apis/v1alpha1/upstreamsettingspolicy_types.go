@@ -50,6 +50,7 @@ type UpstreamSettingsPolicySpec struct {
 	ZoneSize *Size `json:"zoneSize,omitempty"`
 
 	// KeepAlive defines the keep-alive settings.
+	// KeepAlive settings are not applied to L4/stream upstreams.
 	//
 	// +optional
 	KeepAlive *UpstreamKeepAlive `json:"keepAlive,omitempty"`
@@ -57,12 +58,16 @@ type UpstreamSettingsPolicySpec struct {
 	// LoadBalancingMethod specifies the load balancing algorithm to be used for the upstream.
 	// If not specified, NGINX Gateway Fabric defaults to `random two least_conn`,
 	// which differs from the standard NGINX default `round-robin`.
+	// For L4/stream upstreams (TCPRoute, TLSRoute, UDPRoute), `ip_hash` is applied as `hash $remote_addr`
+	// and the `header` variants of `least_time` measure the time to the first byte.
 	//
 	// +optional
 	LoadBalancingMethod *LoadBalancingType `json:"loadBalancingMethod,omitempty"`
 
 	// HashMethodKey defines the key used for hash-based load balancing methods.
 	// This field is required when `LoadBalancingMethod` is set to `hash` or `hash consistent`.
+	// For L4/stream upstreams, a key that uses variables only available to HTTP traffic,
+	// such as `$request_uri`, is not supported, and the policy is not applied to their Gateways.
 	//
 	// +optional
 	HashMethodKey *HashMethodKey `json:"hashMethodKey,omitempty"`
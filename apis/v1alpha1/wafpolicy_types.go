@@ -16,7 +16,8 @@ import (
 // WAFPolicy is an Inherited Attached Policy. It provides a way to configure F5 WAF for NGINX
 // for Gateways and Routes by referencing compiled WAF policy bundles. Bundles can be fetched directly from an
 // HTTP/HTTPS URL (type: HTTP), from an NGINX Instance Manager instance (type: NIM), from an F5 NGINX One
-// Console instance (type: N1C), or from a Policy Lifecycle Manager's S3-compatible storage (type: PLM).
+// Console instance (type: N1C), from an OCI registry (type: OCI), or from a Policy Lifecycle Manager's
+// S3-compatible storage (type: PLM).
 type WAFPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -41,10 +42,15 @@ type WAFPolicyList struct {
 //
 // +kubebuilder:validation:XValidation:message="policySource must not be set when type is PLM",rule="!(self.type == 'PLM' && has(self.policySource))"
 // +kubebuilder:validation:XValidation:message="policyRef must not be set when type is not PLM",rule="!(self.type != 'PLM' && has(self.policyRef))"
-// +kubebuilder:validation:XValidation:message="type must match the configured policy source",rule="self.type == 'PLM' || (has(self.policySource) && ((self.type == 'HTTP' && has(self.policySource.httpSource)) || (self.type == 'NIM' && has(self.policySource.nimSource)) || (self.type == 'N1C' && has(self.policySource.n1cSource))))"
+// +kubebuilder:validation:XValidation:message="type must match the configured policy source",rule="self.type == 'PLM' || (has(self.policySource) && ((self.type == 'HTTP' && has(self.policySource.httpSource)) || (self.type == 'NIM' && has(self.policySource.nimSource)) || (self.type == 'N1C' && has(self.policySource.n1cSource)) || (self.type == 'OCI' && has(self.policySource.ociSource))))"
 // +kubebuilder:validation:XValidation:message="policyRef.apPolicyRef is required when type is PLM",rule="self.type != 'PLM' || (has(self.policyRef) && has(self.policyRef.apPolicyRef))"
 // +kubebuilder:validation:XValidation:message="policySource.validation.verifyChecksum is only supported for type HTTP",rule="!has(self.policySource) || !(self.type != 'HTTP' && has(self.policySource.validation) && has(self.policySource.validation.verifyChecksum) && self.policySource.validation.verifyChecksum)"
+// +kubebuilder:validation:XValidation:message="policySource.validation.signature.url is required when type is not HTTP",rule="!has(self.policySource) || self.type == 'HTTP' || !has(self.policySource.validation) || !has(self.policySource.validation.signature) || has(self.policySource.validation.signature.url)"
 // +kubebuilder:validation:XValidation:message="securityLogs[*].logRef.apLogConfRef is only allowed when type is PLM",rule="self.type == 'PLM' || !has(self.securityLogs) || self.securityLogs.all(sl, !has(sl.logRef) || !has(sl.logRef.apLogConfRef))"
+// +kubebuilder:validation:XValidation:message="routeSecurityLogs[*].securityLogs[*].logRef.apLogConfRef is only allowed when type is PLM",rule="self.type == 'PLM' || !has(self.routeSecurityLogs) || self.routeSecurityLogs.all(r, r.securityLogs.all(sl, !has(sl.logRef) || !has(sl.logRef.apLogConfRef)))"
+// +kubebuilder:validation:XValidation:message="routeSecurityLogs is not supported when targetRefs are GRPCRoutes",rule="!has(self.routeSecurityLogs) || self.targetRefs.all(t, t.kind != 'GRPCRoute')"
+// +kubebuilder:validation:XValidation:message="routeSecurityLogs[*].name must match the name of a targetRef when targetRefs are HTTPRoutes",rule="!has(self.routeSecurityLogs) || self.targetRefs.all(t, t.kind != 'HTTPRoute') || self.routeSecurityLogs.all(r, self.targetRefs.exists(t, t.name == r.name))"
+// +kubebuilder:validation:XValidation:message="routeSecurityLogs[*].namespace is only allowed when targetRefs are Gateways",rule="!has(self.routeSecurityLogs) || self.targetRefs.all(t, t.kind == 'Gateway') || self.routeSecurityLogs.all(r, !has(r.namespace))"
 //
 //nolint:lll
 type WAFPolicySpec struct {
@@ -64,12 +70,13 @@ type WAFPolicySpec struct {
 
 	// Type identifies the source type for the policy bundle.
 	// HTTP fetches directly from a URL; NIM uses the NGINX Instance Manager bundles API;
-	// N1C uses the F5 NGINX One Console security policies API; PLM references an APPolicy
-	// CRD managed by the Policy Lifecycle Manager.
+	// N1C uses the F5 NGINX One Console security policies API; OCI pulls a bundle published as an
+	// OCI artifact from a container registry; PLM references an APPolicy CRD managed by the
+	// Policy Lifecycle Manager.
 	Type PolicySourceType `json:"type"`
 
 	// PolicySource holds all non-CRD bundle fetch configuration.
-	// Used for HTTP, NIM, and N1C policy types.
+	// Used for HTTP, NIM, N1C, and OCI policy types.
 	// Must not be set when type is PLM.
 	// +optional
 	PolicySource *PolicySource `json:"policySource,omitempty"`
@@ -84,11 +91,21 @@ type WAFPolicySpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=32
 	SecurityLogs []WAFSecurityLog `json:"securityLogs,omitempty"`
+
+	// RouteSecurityLogs overrides securityLogs for the traffic of individual HTTPRoutes.
+	// When the policy targets a Gateway, the HTTPRoutes attached to the Gateway can be overridden.
+	// When the policy targets HTTPRoutes, only the targeted HTTPRoutes can be overridden.
+	// If several entries match an HTTPRoute, the first one is used.
+	// Paths that are shared by several HTTPRoutes always use securityLogs.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	RouteSecurityLogs []WAFRouteSecurityLogs `json:"routeSecurityLogs,omitempty"`
 }
 
 // PolicySourceType identifies the source type for a WAF bundle.
 //
-// +kubebuilder:validation:Enum=HTTP;NIM;N1C;PLM
+// +kubebuilder:validation:Enum=HTTP;NIM;N1C;OCI;PLM
 type PolicySourceType string
 
 const (
@@ -104,6 +121,13 @@ const (
 	// "Authorization: APIToken <token>".
 	PolicySourceTypeN1C PolicySourceType = "N1C"
 
+	// PolicySourceTypeOCI pulls a compiled bundle published as an OCI artifact from a container registry.
+	// Requires policySource.ociSource.reference. Registry credentials are read from the Secret referenced
+	// by policySource.auth: "username" and "password" are used for the registry token exchange, and
+	// "token" is sent as a pre-issued registry Bearer token.
+	// The manifest digest is used as the bundle checksum.
+	PolicySourceTypeOCI PolicySourceType = "OCI"
+
 	// PolicySourceTypePLM references an APPolicy CRD managed by the Policy Lifecycle Manager (PLM).
 	// Bundles are fetched from PLM's S3-compatible storage (SeaweedFS).
 	// Cluster-wide S3 connection parameters are configured via CLI flags (--plm-storage-*).
@@ -112,7 +136,7 @@ const (
 
 // PolicySource holds all non-CRD configuration for fetching a WAF policy bundle.
 //
-// +kubebuilder:validation:XValidation:message="exactly one of httpSource, nimSource, or n1cSource must be set",rule="[has(self.httpSource), has(self.nimSource), has(self.n1cSource)].filter(x, x).size() == 1"
+// +kubebuilder:validation:XValidation:message="exactly one of httpSource, nimSource, n1cSource, or ociSource must be set",rule="[has(self.httpSource), has(self.nimSource), has(self.n1cSource), has(self.ociSource)].filter(x, x).size() == 1"
 //
 //nolint:lll
 type PolicySource struct {
@@ -134,6 +158,12 @@ type PolicySource struct {
 	// +optional
 	N1CSource *N1CBundleSource `json:"n1cSource,omitempty"`
 
+	// OCISource configures bundle fetching from an OCI registry.
+	// Required when type is OCI; must not be set for other types.
+	//
+	// +optional
+	OCISource *OCIBundleSource `json:"ociSource,omitempty"`
+
 	// Auth configures authentication credentials for fetching the bundle.
 	//
 	// +optional
@@ -197,6 +227,8 @@ type BundleValidation struct {
 	// If set, the downloaded bundle must match this checksum or it will be rejected.
 	// For N1C sources, the checksum reported by the N1C API is verified automatically;
 	// set this field only if you want to enforce an additional, independently known value.
+	// For OCI sources, this is compared against the SHA-256 of the bundle layer, while the
+	// manifest digest is reported as the bundle checksum.
 	//
 	// +optional
 	// +kubebuilder:validation:MinLength=64
@@ -214,8 +246,66 @@ type BundleValidation struct {
 	//
 	// +optional
 	VerifyChecksum bool `json:"verifyChecksum,omitempty"`
+
+	// Signature configures verification of a detached signature of the bundle.
+	// Unlike the checksum, a signature proves that the bundle was published by the holder of the signing key.
+	// A bundle that fails signature verification is never deployed.
+	//
+	// +optional
+	Signature *BundleSignature `json:"signature,omitempty"`
 }
 
+// BundleSignature configures verification of a detached bundle signature.
+// The signature is verified over the raw bundle bytes. ECDSA and RSA (PKCS #1 v1.5 or PSS) signatures
+// must be computed over the SHA-256 digest of the bundle; Ed25519 signatures over the bundle itself.
+// The signature file may contain the raw signature or the signature encoded in base64.
+type BundleSignature struct {
+	// URL is the URL of the detached signature file.
+	// Defaults to the sidecar file at <url>.sig when the bundle is fetched from an HTTP source.
+	// Required for all other source types.
+	// Credentials configured for the bundle are only sent when the signature is served from the same host.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2083
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL *string `json:"url,omitempty"`
+
+	// KeyRef references a Secret or ConfigMap, in the same namespace as the WAFPolicy, containing the
+	// PEM-encoded public key or certificate chain (key: "signing.pem") used to verify the signature.
+	// When a certificate chain is provided, the first certificate holds the verification key and must
+	// chain up to the last certificate in the chain.
+	KeyRef SigningKeyReference `json:"keyRef"`
+}
+
+// SigningKeyReference references a Secret or ConfigMap holding a signature verification key.
+type SigningKeyReference struct {
+	// Kind is the kind of the referenced object.
+	//
+	// +optional
+	// +kubebuilder:default=Secret
+	Kind SigningKeyKind `json:"kind,omitempty"`
+
+	// Name is the name of the referenced object.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// SigningKeyKind is the kind of object holding a signature verification key.
+//
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type SigningKeyKind string
+
+const (
+	// SigningKeyKindSecret references a Secret.
+	SigningKeyKindSecret SigningKeyKind = "Secret"
+
+	// SigningKeyKindConfigMap references a ConfigMap.
+	SigningKeyKindConfigMap SigningKeyKind = "ConfigMap"
+)
+
 // BundlePolling configures automatic re-fetching of a bundle.
 type BundlePolling struct {
 	// Interval is the period between poll cycles.
@@ -224,6 +314,12 @@ type BundlePolling struct {
 	// +optional
 	Interval *metav1.Duration `json:"interval,omitempty"`
 
+	// Rollout configures how a changed bundle is deployed to the Gateways targeted by the policy.
+	// Defaults to deploying the bundle to all Gateways at once.
+	//
+	// +optional
+	Rollout *BundleRollout `json:"rollout,omitempty"`
+
 	// Enabled activates periodic re-fetching of the bundle.
 	// When true, NGF fetches the bundle on each interval and deploys it only if
 	// its checksum differs from the last successfully fetched version.
@@ -232,6 +328,27 @@ type BundlePolling struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+// BundleRollout configures the rollout of a changed bundle detected by polling.
+type BundleRollout struct {
+	// Strategy is the rollout strategy.
+	Strategy BundleRolloutStrategy `json:"strategy"`
+}
+
+// BundleRolloutStrategy is the strategy used to deploy a changed bundle to the Gateways targeted by a WAFPolicy.
+//
+// +kubebuilder:validation:Enum=AllAtOnce;Canary
+type BundleRolloutStrategy string
+
+const (
+	// BundleRolloutStrategyAllAtOnce deploys the bundle to all Gateways at the same time.
+	BundleRolloutStrategyAllAtOnce BundleRolloutStrategy = "AllAtOnce"
+
+	// BundleRolloutStrategyCanary deploys the bundle to a single Gateway first, and to the remaining
+	// Gateways only after it has been applied successfully. If the bundle fails to apply on any Gateway,
+	// every Gateway that received it is reverted to the last bundle that was applied successfully.
+	BundleRolloutStrategyCanary BundleRolloutStrategy = "Canary"
+)
+
 // HTTPBundleSource configures direct bundle fetching from an HTTP/HTTPS URL.
 type HTTPBundleSource struct {
 	// URL is the full URL of the compiled policy bundle (.tgz),
@@ -243,6 +360,22 @@ type HTTPBundleSource struct {
 	URL string `json:"url"`
 }
 
+// OCIBundleSource configures bundle fetching from an OCI registry.
+// The referenced artifact must contain the compiled policy bundle (.tgz) as its only layer,
+// or as the layer annotated with an "org.opencontainers.image.title" ending in ".tgz".
+type OCIBundleSource struct {
+	// Reference is the reference of the OCI artifact, including the registry host and either a tag
+	// or a digest, e.g. "registry.example.com/security/waf-policy:v1.2.0" or
+	// "registry.example.com/security/waf-policy@sha256:<digest>".
+	// Registries are always accessed over HTTPS.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?/[a-z0-9]+((\.|_|__|-+|/)[a-z0-9]+)*(:[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}|@sha256:[a-f0-9]{64})$`
+	//nolint:lll
+	Reference string `json:"reference"`
+}
+
 // NIMBundleSource configures bundle fetching from NGINX Instance Manager (NIM).
 // Exactly one of policyName or policyUID must be set.
 //
@@ -417,8 +550,8 @@ type APLogConfReference struct {
 type BundleAuth struct {
 	// SecretRef references a Kubernetes Secret in the same namespace as the WAFPolicy.
 	// The Secret may contain:
-	//   - "username" and "password" fields for HTTP Basic Authentication
-	//   - "token" field for Bearer Token Authentication (NIM) or APIToken Authentication (N1C)
+	//   - "username" and "password" fields for HTTP Basic Authentication (OCI: registry login)
+	//   - "token" field for Bearer Token Authentication (NIM, OCI) or APIToken Authentication (N1C)
 	SecretRef LocalObjectReference `json:"secretRef"`
 }
 
@@ -467,13 +600,35 @@ type WAFSecurityLog struct {
 	LogRef *LogRef `json:"logRef,omitempty"`
 
 	// Destination defines where security logs are sent.
+	// The format of the logs is defined by the log profile. For example, to write JSON logs to stderr,
+	// use a log profile bundle with a JSON format together with the stderr destination.
 	Destination SecurityLogDestination `json:"destination"`
 }
 
+// WAFRouteSecurityLogs defines the security logging configuration for the traffic of an HTTPRoute.
+type WAFRouteSecurityLogs struct {
+	// Namespace is the namespace of the HTTPRoute.
+	// Defaults to the namespace of the WAFPolicy. Can only be set when the policy targets Gateways.
+	//
+	// +optional
+	Namespace *gatewayv1.Namespace `json:"namespace,omitempty"`
+
+	// SecurityLogs defines the security logging configurations of the HTTPRoute.
+	// They replace securityLogs for the HTTPRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	SecurityLogs []WAFSecurityLog `json:"securityLogs"`
+
+	// Name is the name of the HTTPRoute.
+	Name gatewayv1.ObjectName `json:"name"`
+}
+
 // LogSource holds all non-CRD configuration for fetching a WAF log profile bundle.
 // Exactly one of DefaultProfile, HTTPSource, NIMSource, or N1CSource must be set.
 //
 // +kubebuilder:validation:XValidation:message="exactly one of defaultProfile, httpSource, nimSource, or n1cSource must be set",rule="[has(self.defaultProfile), has(self.httpSource), has(self.nimSource), has(self.n1cSource)].filter(x, x).size() == 1"
+// +kubebuilder:validation:XValidation:message="validation.signature.url is required when httpSource is not set",rule="has(self.httpSource) || !has(self.validation) || !has(self.validation.signature) || has(self.validation.signature.url)"
 //
 //nolint:lll
 type LogSource struct {
@@ -585,7 +740,7 @@ type SecurityLogDestination struct {
 
 // SecurityLogDestinationType defines the supported security log destination types.
 //
-// +kubebuilder:validation:Enum=stderr;file;syslog
+// +kubebuilder:validation:Enum=stderr;file;syslog;otlp
 type SecurityLogDestinationType string
 
 const (
@@ -595,6 +750,9 @@ const (
 	SecurityLogDestinationTypeFile SecurityLogDestinationType = "file"
 	// SecurityLogDestinationTypeSyslog sends logs to a syslog server via TCP.
 	SecurityLogDestinationTypeSyslog SecurityLogDestinationType = "syslog"
+	// SecurityLogDestinationTypeOTLP sends logs to the NGINX agent, which exports them as OpenTelemetry logs
+	// to the OTLP endpoint configured by spec.telemetry.metricsExporter of the NginxProxy.
+	SecurityLogDestinationTypeOTLP SecurityLogDestinationType = "otlp"
 )
 
 // SecurityLogFile defines the file destination configuration for security logs.
@@ -51,6 +51,144 @@ func (in *APPolicyReference) DeepCopy() *APPolicyReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControl) DeepCopyInto(out *AccessControl) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(AccessControlMode)
+		**out = **in
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(AccessControlAction)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AccessControlRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControl.
+func (in *AccessControl) DeepCopy() *AccessControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicy) DeepCopyInto(out *AccessControlPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicy.
+func (in *AccessControlPolicy) DeepCopy() *AccessControlPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicyList) DeepCopyInto(out *AccessControlPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessControlPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicyList.
+func (in *AccessControlPolicyList) DeepCopy() *AccessControlPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicySpec) DeepCopyInto(out *AccessControlPolicySpec) {
+	*out = *in
+	if in.AccessControl != nil {
+		in, out := &in.AccessControl, &out.AccessControl
+		*out = new(AccessControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicySpec.
+func (in *AccessControlPolicySpec) DeepCopy() *AccessControlPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlRule) DeepCopyInto(out *AccessControlRule) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlRule.
+func (in *AccessControlRule) DeepCopy() *AccessControlRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationFilter) DeepCopyInto(out *AuthenticationFilter) {
 	*out = *in
@@ -195,68 +333,461 @@ func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	out.SecretRef = in.SecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuth.
-func (in *BasicAuth) DeepCopy() *BasicAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuth.
+func (in *BasicAuth) DeepCopy() *BasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleAuth) DeepCopyInto(out *BundleAuth) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleAuth.
+func (in *BundleAuth) DeepCopy() *BundleAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundlePolling) DeepCopyInto(out *BundlePolling) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(BundleRollout)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundlePolling.
+func (in *BundlePolling) DeepCopy() *BundlePolling {
+	if in == nil {
+		return nil
+	}
+	out := new(BundlePolling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleRollout) DeepCopyInto(out *BundleRollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleRollout.
+func (in *BundleRollout) DeepCopy() *BundleRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleSignature) DeepCopyInto(out *BundleSignature) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	out.KeyRef = in.KeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleSignature.
+func (in *BundleSignature) DeepCopy() *BundleSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleValidation) DeepCopyInto(out *BundleValidation) {
+	*out = *in
+	if in.ExpectedChecksum != nil {
+		in, out := &in.ExpectedChecksum, &out.ExpectedChecksum
+		*out = new(string)
+		**out = **in
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(BundleSignature)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleValidation.
+func (in *BundleValidation) DeepCopy() *BundleValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = make([]CacheValid, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UseStale != nil {
+		in, out := &in.UseStale, &out.UseStale
+		*out = new(CacheUseStale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(CacheLock)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheLock) DeepCopyInto(out *CacheLock) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Age != nil {
+		in, out := &in.Age, &out.Age
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheLock.
+func (in *CacheLock) DeepCopy() *CacheLock {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicy.
+func (in *CachePolicy) DeepCopy() *CachePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicyList) DeepCopyInto(out *CachePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CachePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyList.
+func (in *CachePolicyList) DeepCopy() *CachePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
+	*out = *in
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicySpec.
+func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheUseStale) DeepCopyInto(out *CacheUseStale) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CacheUseStaleCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackgroundUpdate != nil {
+		in, out := &in.BackgroundUpdate, &out.BackgroundUpdate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheUseStale.
+func (in *CacheUseStale) DeepCopy() *CacheUseStale {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheUseStale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheValid) DeepCopyInto(out *CacheValid) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]CacheStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheValid.
+func (in *CacheValid) DeepCopy() *CacheValid {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheValid)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryBackendRef) DeepCopyInto(out *CanaryBackendRef) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(v1.PortNumber)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryBackendRef.
+func (in *CanaryBackendRef) DeepCopy() *CanaryBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryFilter) DeepCopyInto(out *CanaryFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryFilter.
+func (in *CanaryFilter) DeepCopy() *CanaryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanaryFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryFilterList) DeepCopyInto(out *CanaryFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CanaryFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryFilterList.
+func (in *CanaryFilterList) DeepCopy() *CanaryFilterList {
 	if in == nil {
 		return nil
 	}
-	out := new(BasicAuth)
+	out := new(CanaryFilterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanaryFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BundleAuth) DeepCopyInto(out *BundleAuth) {
+func (in *CanaryFilterSpec) DeepCopyInto(out *CanaryFilterSpec) {
 	*out = *in
-	out.SecretRef = in.SecretRef
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]CanaryOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleAuth.
-func (in *BundleAuth) DeepCopy() *BundleAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryFilterSpec.
+func (in *CanaryFilterSpec) DeepCopy() *CanaryFilterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BundleAuth)
+	out := new(CanaryFilterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BundlePolling) DeepCopyInto(out *BundlePolling) {
+func (in *CanaryFilterStatus) DeepCopyInto(out *CanaryFilterStatus) {
 	*out = *in
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundlePolling.
-func (in *BundlePolling) DeepCopy() *BundlePolling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryFilterStatus.
+func (in *CanaryFilterStatus) DeepCopy() *CanaryFilterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BundlePolling)
+	out := new(CanaryFilterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BundleValidation) DeepCopyInto(out *BundleValidation) {
+func (in *CanaryOverride) DeepCopyInto(out *CanaryOverride) {
 	*out = *in
-	if in.ExpectedChecksum != nil {
-		in, out := &in.ExpectedChecksum, &out.ExpectedChecksum
-		*out = new(string)
-		**out = **in
-	}
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleValidation.
-func (in *BundleValidation) DeepCopy() *BundleValidation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryOverride.
+func (in *CanaryOverride) DeepCopy() *CanaryOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(BundleValidation)
+	out := new(CanaryOverride)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -456,6 +987,152 @@ func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimit) DeepCopyInto(out *ConnectionLimit) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(ConnectionLimitLogLevel)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ConnectionLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimit.
+func (in *ConnectionLimit) DeepCopy() *ConnectionLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicy) DeepCopyInto(out *ConnectionLimitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicy.
+func (in *ConnectionLimitPolicy) DeepCopy() *ConnectionLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionLimitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicyList) DeepCopyInto(out *ConnectionLimitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectionLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicyList.
+func (in *ConnectionLimitPolicyList) DeepCopy() *ConnectionLimitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionLimitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicySpec) DeepCopyInto(out *ConnectionLimitPolicySpec) {
+	*out = *in
+	if in.ConnectionLimit != nil {
+		in, out := &in.ConnectionLimit, &out.ConnectionLimit
+		*out = new(ConnectionLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicySpec.
+func (in *ConnectionLimitPolicySpec) DeepCopy() *ConnectionLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitRule) DeepCopyInto(out *ConnectionLimitRule) {
+	*out = *in
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitRule.
+func (in *ConnectionLimitRule) DeepCopy() *ConnectionLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
 	*out = *in
@@ -776,27 +1453,183 @@ func (in *GatewayLinkTLS) DeepCopyInto(out *GatewayLinkTLS) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLinkTLS.
-func (in *GatewayLinkTLS) DeepCopy() *GatewayLinkTLS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLinkTLS.
+func (in *GatewayLinkTLS) DeepCopy() *GatewayLinkTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayLinkTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimit.
+func (in *GlobalRateLimit) DeepCopy() *GlobalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBundleSource) DeepCopyInto(out *HTTPBundleSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBundleSource.
+func (in *HTTPBundleSource) DeepCopy() *HTTPBundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckMatch) DeepCopyInto(out *HealthCheckMatch) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(HealthCheckStatusMatch)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckMatch.
+func (in *HealthCheckMatch) DeepCopy() *HealthCheckMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPolicy) DeepCopyInto(out *HealthCheckPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPolicy.
+func (in *HealthCheckPolicy) DeepCopy() *HealthCheckPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheckPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPolicyList) DeepCopyInto(out *HealthCheckPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HealthCheckPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPolicyList.
+func (in *HealthCheckPolicyList) DeepCopy() *HealthCheckPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayLinkTLS)
+	out := new(HealthCheckPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheckPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPBundleSource) DeepCopyInto(out *HTTPBundleSource) {
+func (in *HealthCheckPolicySpec) DeepCopyInto(out *HealthCheckPolicySpec) {
 	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Fails != nil {
+		in, out := &in.Fails, &out.Fails
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Passes != nil {
+		in, out := &in.Passes, &out.Passes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(HealthCheckMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBundleSource.
-func (in *HTTPBundleSource) DeepCopy() *HTTPBundleSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPolicySpec.
+func (in *HealthCheckPolicySpec) DeepCopy() *HealthCheckPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPBundleSource)
+	out := new(HealthCheckPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1019,6 +1852,122 @@ func (in *Logging) DeepCopy() *Logging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorFilter) DeepCopyInto(out *MirrorFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorFilter.
+func (in *MirrorFilter) DeepCopy() *MirrorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MirrorFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorFilterList) DeepCopyInto(out *MirrorFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MirrorFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorFilterList.
+func (in *MirrorFilterList) DeepCopy() *MirrorFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MirrorFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorFilterSpec) DeepCopyInto(out *MirrorFilterSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.StripAuthHeaders != nil {
+		in, out := &in.StripAuthHeaders, &out.StripAuthHeaders
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StripCookies != nil {
+		in, out := &in.StripCookies, &out.StripCookies
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorFilterSpec.
+func (in *MirrorFilterSpec) DeepCopy() *MirrorFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorFilterStatus) DeepCopyInto(out *MirrorFilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorFilterStatus.
+func (in *MirrorFilterStatus) DeepCopy() *MirrorFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *N1CBundleSource) DeepCopyInto(out *N1CBundleSource) {
 	*out = *in
@@ -1215,6 +2164,21 @@ func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIBundleSource) DeepCopyInto(out *OCIBundleSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIBundleSource.
+func (in *OCIBundleSource) DeepCopy() *OCIBundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIBundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCAuth) DeepCopyInto(out *OIDCAuth) {
 	*out = *in
@@ -1376,6 +2340,11 @@ func (in *PolicySource) DeepCopyInto(out *PolicySource) {
 		*out = new(N1CBundleSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCISource != nil {
+		in, out := &in.OCISource, &out.OCISource
+		*out = new(OCIBundleSource)
+		**out = **in
+	}
 	if in.Auth != nil {
 		in, out := &in.Auth, &out.Auth
 		*out = new(BundleAuth)
@@ -1542,8 +2511,10 @@ func (in *ProxySettingsPolicySpec) DeepCopyInto(out *ProxySettingsPolicySpec) {
 	}
 	if in.TargetRefs != nil {
 		in, out := &in.TargetRefs, &out.TargetRefs
-		*out = make([]v1.LocalPolicyTargetReference, len(*in))
-		copy(*out, *in)
+		*out = make([]v1.LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1595,6 +2566,11 @@ func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 		*out = new(LocalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DryRun != nil {
 		in, out := &in.DryRun, &out.DryRun
 		*out = new(bool)
@@ -1622,6 +2598,103 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitConditions) DeepCopyInto(out *RateLimitConditions) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(RateLimitPathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(RateLimitJWTClaimMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]RateLimitHTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]RateLimitHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitConditions.
+func (in *RateLimitConditions) DeepCopy() *RateLimitConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitHeaderMatch) DeepCopyInto(out *RateLimitHeaderMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RateLimitMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitHeaderMatch.
+func (in *RateLimitHeaderMatch) DeepCopy() *RateLimitHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitJWTClaimMatch) DeepCopyInto(out *RateLimitJWTClaimMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RateLimitMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitJWTClaimMatch.
+func (in *RateLimitJWTClaimMatch) DeepCopy() *RateLimitJWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitJWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPathMatch) DeepCopyInto(out *RateLimitPathMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RateLimitPathMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPathMatch.
+func (in *RateLimitPathMatch) DeepCopy() *RateLimitPathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 	*out = *in
@@ -1691,8 +2764,10 @@ func (in *RateLimitPolicySpec) DeepCopyInto(out *RateLimitPolicySpec) {
 	}
 	if in.TargetRefs != nil {
 		in, out := &in.TargetRefs, &out.TargetRefs
-		*out = make([]v1.LocalPolicyTargetReference, len(*in))
-		copy(*out, *in)
+		*out = make([]v1.LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1729,6 +2804,11 @@ func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = new(RateLimitConditions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRule.
@@ -1823,6 +2903,21 @@ func (in *SecurityLogSyslog) DeepCopy() *SecurityLogSyslog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SigningKeyReference) DeepCopyInto(out *SigningKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SigningKeyReference.
+func (in *SigningKeyReference) DeepCopy() *SigningKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SigningKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Snippet) DeepCopyInto(out *Snippet) {
 	*out = *in
@@ -2261,6 +3356,13 @@ func (in *WAFPolicySpec) DeepCopyInto(out *WAFPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RouteSecurityLogs != nil {
+		in, out := &in.RouteSecurityLogs, &out.RouteSecurityLogs
+		*out = make([]WAFRouteSecurityLogs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFPolicySpec.
@@ -2273,6 +3375,33 @@ func (in *WAFPolicySpec) DeepCopy() *WAFPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRouteSecurityLogs) DeepCopyInto(out *WAFRouteSecurityLogs) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(v1.Namespace)
+		**out = **in
+	}
+	if in.SecurityLogs != nil {
+		in, out := &in.SecurityLogs, &out.SecurityLogs
+		*out = make([]WAFSecurityLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRouteSecurityLogs.
+func (in *WAFRouteSecurityLogs) DeepCopy() *WAFRouteSecurityLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRouteSecurityLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WAFSecurityLog) DeepCopyInto(out *WAFSecurityLog) {
 	*out = *in
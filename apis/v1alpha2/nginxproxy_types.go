@@ -235,6 +235,16 @@ type Telemetry struct {
 	// +optional
 	Exporter *TelemetryExporter `json:"exporter,omitempty"`
 
+	// MetricsExporter specifies the export of the NGINX and host metrics, collected by the NGINX agent,
+	// to an OTLP/gRPC endpoint. It can be used together with, or instead of, the Prometheus metrics
+	// configured by spec.metrics. When WAF is enabled, the security logs of WAFPolicies with the otlp
+	// destination are exported to the same endpoint. Changing this value results in a re-roll of the
+	// NGINX deployment. If a referenced Secret is invalid, the metrics are not exported and the Gateway
+	// reports the error in its ResolvedRefs condition.
+	//
+	// +optional
+	MetricsExporter *MetricsExporter `json:"metricsExporter,omitempty"`
+
 	// ServiceName is the "service.name" attribute of the OpenTelemetry resource.
 	// Default is 'ngf:gateway-namespace:gateway-name'. If a value is provided by the user,
 	// then the default becomes a prefix to that value.
@@ -264,6 +274,10 @@ const (
 )
 
 // TelemetryExporter specifies OpenTelemetry export parameters.
+//
+// +kubebuilder:validation:XValidation:message="tls.clientCertificateRef and tls.serverName are not supported by the tracing exporter",rule="!has(self.tls) || (!has(self.tls.clientCertificateRef) && !has(self.tls.serverName))"
+//
+//nolint:lll
 type TelemetryExporter struct {
 	// Interval is the maximum interval between two exports.
 	// Default: https://nginx.org/en/docs/ngx_otel_module.html#otel_exporter
@@ -285,13 +299,106 @@ type TelemetryExporter struct {
 	// +kubebuilder:validation:Minimum=0
 	BatchCount *int32 `json:"batchCount,omitempty"`
 
+	// TLS enables TLS for the connection to the endpoint.
+	// TLS is also enabled when the endpoint uses the https scheme.
+	// The CA certificate sets the trusted_certificate parameter of the otel_exporter NGINX directive:
+	// https://nginx.org/en/docs/ngx_otel_module.html#otel_exporter
+	// Changes to the Secret are applied automatically. ClientCertificateRef and ServerName are not supported.
+	//
+	// +optional
+	TLS *ExporterTLS `json:"tls,omitempty"`
+
 	// Endpoint is the address of OTLP/gRPC endpoint that will accept telemetry data.
-	// Format: alphanumeric hostname with optional http scheme and optional port.
+	// Format: alphanumeric hostname with optional http or https scheme and optional port.
 	//
 	//nolint:lll
 	// +optional
-	// +kubebuilder:validation:Pattern=`^(?:http?:\/\/)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*(?::\d{1,5})?$`
+	// +kubebuilder:validation:Pattern=`^(?:https?:\/\/)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*(?::\d{1,5})?$`
 	Endpoint *string `json:"endpoint,omitempty"`
+
+	// Headers are the headers that are added to each export request, such as the credentials
+	// of the endpoint.
+	// Sets the header parameter of the otel_exporter NGINX directive:
+	// https://nginx.org/en/docs/ngx_otel_module.html#otel_exporter
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []ExporterHeader `json:"headers,omitempty"`
+}
+
+// MetricsExporter specifies OpenTelemetry metrics export parameters.
+type MetricsExporter struct {
+	// BatchTimeout is the maximum time that the collected metrics are batched before they are exported.
+	// It doesn't change how often the NGINX agent collects the metrics.
+	// If not specified, the metrics are exported as soon as they are collected.
+	//
+	// +optional
+	BatchTimeout *v1alpha1.Duration `json:"batchTimeout,omitempty"`
+
+	// TLS configures TLS for the connection to the endpoint.
+	// If not specified, the connection is not encrypted.
+	//
+	// +optional
+	TLS *ExporterTLS `json:"tls,omitempty"`
+
+	// Endpoint is the address of the OTLP/gRPC endpoint that will accept the metrics.
+	// Format: alphanumeric hostname with port.
+	//
+	//nolint:lll
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*:\d{1,5}$`
+	Endpoint string `json:"endpoint"`
+
+	// Headers are the headers that are added to each export request, such as the credentials
+	// of the endpoint.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []ExporterHeader `json:"headers,omitempty"`
+}
+
+// ExporterHeader is a header that is added to the export requests of an OpenTelemetry exporter.
+// The value of the header is read from a Secret, so that credentials are not stored in the NginxProxy.
+type ExporterHeader struct {
+	// SecretRef references an Opaque Secret that contains the value of the header in the "header-value" key.
+	// The Secret must be in the namespace of the Gateway. The value must not contain double quotes,
+	// backslashes or line breaks.
+	SecretRef v1alpha1.LocalObjectReference `json:"secretRef"`
+
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Name string `json:"name"`
+}
+
+// ExporterTLS configures TLS for the connection of an OpenTelemetry exporter to its endpoint.
+// The referenced Secrets must be in the namespace of the Gateway.
+type ExporterTLS struct {
+	// CACertificateRef references a Secret that contains the CA certificate, in the "ca.crt" key,
+	// which is used to verify the certificate of the endpoint.
+	// If not specified, the system CA certificates are used.
+	//
+	// +optional
+	CACertificateRef *v1alpha1.LocalObjectReference `json:"caCertificateRef,omitempty"`
+
+	// ClientCertificateRef references a Secret of type kubernetes.io/tls that contains the client
+	// certificate and key, which are presented to the endpoint.
+	//
+	// +optional
+	ClientCertificateRef *v1alpha1.LocalObjectReference `json:"clientCertificateRef,omitempty"`
+
+	// ServerName is the name that is used to verify the certificate of the endpoint.
+	// If not specified, the hostname of the endpoint is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`
+	ServerName *string `json:"serverName,omitempty"`
 }
 
 // Metrics defines the configuration for Prometheus scraping metrics.
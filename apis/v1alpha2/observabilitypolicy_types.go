@@ -45,6 +45,12 @@ type ObservabilityPolicySpec struct {
 	// +optional
 	Tracing *Tracing `json:"tracing,omitempty"`
 
+	// AccessLog configures the access logs of the requests to the targeted Routes.
+	// It overrides the access log settings of the NginxProxy for these Routes.
+	//
+	// +optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+
 	// TargetRefs identifies the API object(s) to apply the policy to.
 	// Objects must be in the same namespace as the policy.
 	// Support: HTTPRoute, GRPCRoute.
@@ -105,6 +111,75 @@ type Tracing struct {
 	SpanAttributes []ngfAPIv1alpha1.SpanAttribute `json:"spanAttributes,omitempty"`
 }
 
+// AccessLog configures the access logs of the requests to a Route.
+//
+// +kubebuilder:validation:XValidation:message="format and formatName cannot both be specified",rule="!(has(self.format) && has(self.formatName))"
+// +kubebuilder:validation:XValidation:message="escape can only be specified with format",rule="!has(self.escape) || has(self.format)"
+// +kubebuilder:validation:XValidation:message="disable cannot be specified with other fields",rule="!has(self.disable) || !self.disable || (!has(self.format) && !has(self.formatName) && !has(self.escape) && !has(self.condition) && !has(self.sampleRatio))"
+//
+//nolint:lll
+type AccessLog struct {
+	// Disable turns off the access logs of the Route, for example, for noisy health check routes.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// FormatName is the name of a predefined log format.
+	// If neither FormatName nor Format is specified, the format of the NginxProxy is used, which is
+	// the NGINX 'combined' format by default.
+	//
+	// +optional
+	FormatName *AccessLogFormatName `json:"formatName,omitempty"`
+
+	// Format specifies a custom log format string.
+	// Single quotes and line breaks are not allowed because the format is
+	// rendered inside a single-quoted NGINX log_format directive.
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#log_format
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^[^'\x0A\x0D]*$`
+	Format *string `json:"format,omitempty"`
+
+	// Escape specifies how to escape characters in variables of the custom log format.
+	// Possible values are: default, json, none.
+	// If not specified, 'default' escaping is used.
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#log_format
+	//
+	// +optional
+	Escape *NginxAccessLogEscapeType `json:"escape,omitempty"`
+
+	// Condition is an NGINX variable, such as $loggable. A request is only logged when the value of
+	// the variable is not empty and not equal to "0".
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#access_log
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=256
+	Condition *string `json:"condition,omitempty"`
+
+	// SampleRatio is the percentage of the requests that are logged. Integer from 1 to 100.
+	// By default, all requests are logged.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SampleRatio *int32 `json:"sampleRatio,omitempty"`
+}
+
+// AccessLogFormatName is the name of a predefined log format.
+//
+// +kubebuilder:validation:Enum=combined;json
+type AccessLogFormatName string
+
+const (
+	// AccessLogFormatCombined is the NGINX 'combined' log format.
+	AccessLogFormatCombined AccessLogFormatName = "combined"
+
+	// AccessLogFormatJSON is the JSON log format that is used when the NginxProxy configures JSON logging.
+	AccessLogFormatJSON AccessLogFormatName = "json"
+)
+
 // TraceStrategy defines the tracing strategy.
 //
 // +kubebuilder:validation:Enum=ratio;parent
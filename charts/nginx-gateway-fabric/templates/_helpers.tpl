@@ -244,9 +244,15 @@ Create namespaced RBAC rules.
   - clientsettingspolicies
   - observabilitypolicies
   - upstreamsettingspolicies
+  - healthcheckpolicies
   - authenticationfilters
+  - canaryfilters
+  - mirrorfilters
   - proxysettingspolicies
   - ratelimitpolicies
+  - connectionlimitpolicies
+  - accesscontrolpolicies
+  - cachepolicies
   - wafpolicies
   {{- if .Values.nginxGateway.externalLoadBalancer.enable }}
   - externalloadbalancers
@@ -267,9 +273,15 @@ Create namespaced RBAC rules.
   - clientsettingspolicies/status
   - observabilitypolicies/status
   - upstreamsettingspolicies/status
+  - healthcheckpolicies/status
   - authenticationfilters/status
+  - canaryfilters/status
+  - mirrorfilters/status
   - proxysettingspolicies/status
   - ratelimitpolicies/status
+  - connectionlimitpolicies/status
+  - accesscontrolpolicies/status
+  - cachepolicies/status
   - wafpolicies/status
   {{- if .Values.nginxGateway.externalLoadBalancer.enable }}
   - externalloadbalancers/status
@@ -363,6 +375,14 @@ Create cluster RBAC rules.
   - tokenreviews
   verbs:
   - create
+  {{- if .Values.nginxGateway.debug.enable }}
+- apiGroups:
+  - authorization.k8s.io
+  resources:
+  - subjectaccessreviews
+  verbs:
+  - create
+  {{- end }}
 - apiGroups:
   - apiextensions.k8s.io
   resources:
@@ -415,3 +435,29 @@ Create cluster RBAC rules.
   - get
   {{- end }}
 {{- end }}
+
+{{/*
+Checks whether the control plane can run more than one replica.
+*/}}
+{{- define "nginx-gateway.multipleReplicas" -}}
+{{- if .Values.nginxGateway.autoscaling.enable }}
+{{- if gt (int .Values.nginxGateway.autoscaling.maxReplicas) 1 }}true{{ end }}
+{{- else if gt (int .Values.nginxGateway.replicas) 1 }}true
+{{- end }}
+{{- end }}
+
+{{/*
+Fails if the WAF bundle cache uses a ReadWriteOnce PersistentVolumeClaim while the control plane can run more than
+one replica, since replicas on different nodes can't mount the claim. The claim is looked up in the cluster, so the
+check is skipped when the chart is rendered without a cluster connection.
+*/}}
+{{- define "nginx-gateway.validateWAFBundleCache" -}}
+{{- $cache := .Values.nginxGateway.wafBundleCache }}
+{{- if and $cache.enable $cache.persistentVolumeClaimName (include "nginx-gateway.multipleReplicas" .) }}
+{{- $claim := lookup "v1" "PersistentVolumeClaim" .Release.Namespace $cache.persistentVolumeClaimName }}
+{{- $accessModes := dig "spec" "accessModes" (list) $claim }}
+{{- if or (has "ReadWriteOnce" $accessModes) (has "ReadWriteOncePod" $accessModes) }}
+{{- fail (printf "nginxGateway.wafBundleCache.persistentVolumeClaimName %q is ReadWriteOnce and can't be shared by multiple replicas; use a ReadWriteMany claim or a single replica" $cache.persistentVolumeClaimName) }}
+{{- end }}
+{{- end }}
+{{- end }}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
@@ -21,10 +22,15 @@ import (
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/file"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
+// resolvConfPath is the path of the resolver configuration of this Pod.
+const resolvConfPath = "/etc/resolv.conf"
+
 // These flags are shared by multiple commands.
 const (
 	domain                = "gateway.nginx.org"
@@ -97,6 +103,8 @@ func createControllerCommand() *cobra.Command {
 		metricsPortFlag                     = "metrics-port"
 		healthDisableFlag                   = "health-disable"
 		healthPortFlag                      = "health-port"
+		debugEnableFlag                     = "debug-enable"
+		debugPortFlag                       = "debug-port"
 		leaderElectionDisableFlag           = "leader-election-disable"
 		leaderElectionLockNameFlag          = "leader-election-lock-name"
 		productTelemetryDisableFlag         = "product-telemetry-disable"
@@ -116,6 +124,8 @@ func createControllerCommand() *cobra.Command {
 		watchNamespacesFlag                 = "watch-namespaces"
 		serverTLSDomainFlag                 = "server-tls-domain"
 		externalLoadBalancerFlag            = "external-load-balancer"
+		wafBundleCacheDirFlag               = "waf-bundle-cache-dir"
+		clusterDomainFlag                   = "cluster-domain"
 	)
 
 	// flag values
@@ -164,6 +174,11 @@ func createControllerCommand() *cobra.Command {
 			validator: validatePort,
 			value:     8081,
 		}
+		enableDebug     bool
+		debugListenPort = intValidatingValue{
+			validator: validatePort,
+			value:     8082,
+		}
 
 		disableLeaderElection  bool
 		leaderElectionLockName = stringValidatingValue{
@@ -196,6 +211,15 @@ func createControllerCommand() *cobra.Command {
 			validator: validateResourceName,
 			value:     "svc",
 		}
+
+		wafBundleCacheDir = stringValidatingValue{
+			validator: validateAbsolutePath,
+		}
+
+		clusterDomain = stringValidatingValue{
+			validator: validateQualifiedName,
+			value:     defaultDomain,
+		}
 	)
 
 	plmParams := plmStorageParams{
@@ -254,7 +278,12 @@ func createControllerCommand() *cobra.Command {
 			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if enableDebug {
+				ports = append(ports, debugListenPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
@@ -312,6 +341,10 @@ func createControllerCommand() *cobra.Command {
 					Port:    metricsListenPort.value,
 					Secure:  metricsSecure,
 				},
+				DebugConfig: config.DebugConfig{
+					Enabled: enableDebug,
+					Port:    debugListenPort.value,
+				},
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
 					LockName: leaderElectionLockName.String(),
@@ -349,6 +382,8 @@ func createControllerCommand() *cobra.Command {
 				ServerTLSDomain:             serverTLSDomain.value,
 				PLMStorageConfig:            plmStorageConfig,
 				ExternalLoadBalancer:        externalLoadBalancer,
+				WAFBundleCacheDir:           wafBundleCacheDir.value,
+				ClusterDNS:                  buildClusterDNSConfig(clusterDomain.value, resolvConfPath),
 			}
 
 			if err := controller.StartManager(conf); err != nil {
@@ -456,6 +491,21 @@ func createControllerCommand() *cobra.Command {
 		"Set the port where the health probe server is exposed. Format: [1024 - 65535]",
 	)
 
+	cmd.Flags().BoolVar(
+		&enableDebug,
+		debugEnableFlag,
+		false,
+		"Enable the debug server, which serves the latest dataplane configuration, nginx files and Pod config"+
+			" statuses of each Gateway. Requests must be authenticated with a bearer token of a user that is"+
+			" allowed to get the /debug/config non-resource URL.",
+	)
+
+	cmd.Flags().Var(
+		&debugListenPort,
+		debugPortFlag,
+		"Set the port where the debug server is exposed. Format: [1024 - 65535]",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableLeaderElection,
 		leaderElectionDisableFlag,
@@ -607,6 +657,13 @@ func createControllerCommand() *cobra.Command {
 		`The domain suffix used in the server TLS certificate SAN and agent config host. Defaults to "svc".`,
 	)
 
+	cmd.Flags().Var(
+		&clusterDomain,
+		clusterDomainFlag,
+		`The DNS domain of your Kubernetes cluster. Used by the NGINX replicas of a Gateway to discover each other `+
+			`for zone synchronization.`,
+	)
+
 	cmd.Flags().Var(
 		&plmParams.URL,
 		plmStorageURLFlag,
@@ -641,6 +698,13 @@ func createControllerCommand() *cobra.Command {
 		"Disable TLS certificate verification when connecting to PLM storage. Not recommended for production.",
 	)
 
+	cmd.Flags().Var(
+		&wafBundleCacheDir,
+		wafBundleCacheDirFlag,
+		"The absolute path of a directory, typically backed by a PersistentVolume, in which fetched WAF bundles "+
+			"are cached so that they survive controller restarts. Only applicable with NGINX Plus.",
+	)
+
 	return cmd
 }
 
@@ -660,6 +724,31 @@ func buildUsageReportConfig(params usageReportParams) (config.UsageReportConfig,
 	}, nil
 }
 
+// buildClusterDNSConfig builds the DNS configuration of the cluster. The DNS servers are read from the
+// resolv.conf of this Pod, since the NGINX Pods use the same cluster DNS.
+func buildClusterDNSConfig(clusterDomain, resolvConf string) config.ClusterDNSConfig {
+	dnsConfig := config.ClusterDNSConfig{
+		Domain: clusterDomain,
+	}
+
+	if contents, err := os.ReadFile(resolvConf); err == nil {
+		for line := range strings.Lines(string(contents)) {
+			fields := strings.Fields(line)
+			if len(fields) < 2 || fields[0] != "nameserver" {
+				continue
+			}
+
+			nameserver := fields[1]
+			if strings.Contains(nameserver, ":") {
+				nameserver = "[" + nameserver + "]"
+			}
+			dnsConfig.Resolvers = append(dnsConfig.Resolvers, nameserver)
+		}
+	}
+
+	return dnsConfig
+}
+
 func buildPLMStorageConfig(params plmStorageParams) *config.PLMStorageConfig {
 	if params.URL.value == "" {
 		return nil
@@ -889,9 +978,11 @@ func createInitializeCommand() *cobra.Command {
 				})
 			}
 
+			generator := ngxConfig.NewGeneratorImpl(plus, nil, config.ClusterDNSConfig{}, logger.WithName("generator"))
+
 			return initialize(initializeConfig{
 				fileManager:   file.NewStdLibOSFileManager(),
-				fileGenerator: ngxConfig.NewGeneratorImpl(plus, nil, logger.WithName("generator")),
+				fileGenerator: generator,
 				logger:        logger,
 				podUID:        podUID,
 				clusterUID:    clusterUID,
@@ -927,6 +1018,112 @@ func createInitializeCommand() *cobra.Command {
 	return cmd
 }
 
+func createRenderCommand() *cobra.Command {
+	// flag names
+	const (
+		fileFlag              = "file"
+		outputDirFlag         = "output-dir"
+		gwAPIExperimentalFlag = "gateway-api-experimental-features"
+		snippetsFlag          = "snippets"
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+			value:     domain + "/nginx-gateway-controller",
+		}
+
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx",
+		}
+
+		files                  []string
+		outputDir              string
+		plus                   bool
+		gwExperimentalFeatures bool
+		snippets               bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render the NGINX configuration for Gateway API and NGINX Gateway Fabric resources from manifests",
+		Long: "Render the NGINX configuration for Gateway API and NGINX Gateway Fabric resources from manifests " +
+			"without a Kubernetes cluster. Services are resolved from the EndpointSlices in the manifests. " +
+			"The NGINX configuration files of every Gateway are written to <output-dir>/<namespace>/<name>, " +
+			"and the conditions that each resource would get are printed.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := ctlrZap.New().WithName("render")
+
+			return controller.Render(cmd.Context(), controller.RenderConfig{
+				Logger:               logger,
+				Out:                  cmd.OutOrStdout(),
+				OutputDir:            outputDir,
+				GatewayCtlrName:      gatewayCtlrName.value,
+				GatewayClassName:     gatewayClassName.value,
+				Files:                files,
+				Plus:                 plus,
+				ExperimentalFeatures: gwExperimentalFeatures,
+				Snippets:             snippets,
+			})
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(
+		&files,
+		fileFlag,
+		"f",
+		nil,
+		"The manifest files, or directories containing manifest files, of the resources to render.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	cmd.Flags().StringVarP(
+		&outputDir,
+		outputDirFlag,
+		"o",
+		"",
+		"The directory to write the rendered NGINX configuration files to.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(outputDirFlag))
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Use NGINX Plus",
+	)
+
+	cmd.Flags().BoolVar(
+		&gwExperimentalFeatures,
+		gwAPIExperimentalFlag,
+		false,
+		"Enable the experimental features of Gateway API.",
+	)
+
+	cmd.Flags().BoolVar(
+		&snippets,
+		snippetsFlag,
+		false,
+		"Enable Snippets feature through SnippetsFilter and SnippetsPolicy APIs.",
+	)
+
+	return cmd
+}
+
 // FIXME(pleshakov): Remove this command once NGF min supported Kubernetes version supports sleep action in
 // preStop hook.
 // See https://github.com/kubernetes/enhancements/tree/4ec371d92dcd4f56a2ab18c8ba20bb85d8d20efe/keps/sig-node/3960-pod-lifecycle-sleep-action
@@ -959,23 +1156,78 @@ func createSleepCommand() *cobra.Command {
 }
 
 func createEndpointPickerCommand() *cobra.Command {
-	var endpointPickerDisableTLS bool
-	endpointPickerTLSSkipVerify := true
+	// flag names
+	const (
+		metricsDisableFlag = "metrics-disable"
+		metricsPortFlag    = "metrics-port"
+	)
+
+	// flag values
+	var (
+		endpointPickerDisableTLS    bool
+		endpointPickerTLSSkipVerify = true
+		disableMetrics              bool
+		metricsListenPort           = intValidatingValue{
+			validator: validatePort,
+			value:     types.GoShimMetricsPort,
+		}
+	)
+
 	cmd := &cobra.Command{
 		Use:   "endpoint-picker",
 		Short: "Shim server for communication between NGINX and the Gateway API Inference Extension Endpoint Picker",
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			logger := ctlrZap.New().WithName("endpoint-picker-shim")
-			handler := createEndpointPickerHandler(
-				realExtProcClientFactory(endpointPickerDisableTLS, endpointPickerTLSSkipVerify),
-				logger,
+
+			var metricsCollector endpointPickerMetricsCollector = collectors.NewEndpointPickerNoopCollector()
+			errCh := make(chan error, 2)
+
+			if !disableMetrics {
+				collector := collectors.NewEndpointPickerCollector(nil)
+				registry := prometheus.NewRegistry()
+				registry.MustRegister(collector)
+				metricsCollector = collector
+
+				go func() {
+					errCh <- fmt.Errorf(
+						"error serving metrics: %w",
+						endpointPickerMetricsServer(metricsListenPort.value, registry),
+					)
+				}()
+			}
+
+			pool := newExtProcClientPool(
+				realExtProcDialer(endpointPickerDisableTLS, endpointPickerTLSSkipVerify),
+				extProcConnIdleTimeout,
+				metricsCollector,
+				logger.WithName("clientPool"),
 			)
-			return endpointPickerServer(handler)
+			go pool.run(cmd.Context())
+
+			handler := createEndpointPickerHandler(pool.getClient, metricsCollector, logger)
+			go func() {
+				errCh <- endpointPickerServer(handler)
+			}()
+
+			return <-errCh
 		},
 	}
 
 	addEPPConnectionFlags(cmd, &endpointPickerDisableTLS, &endpointPickerTLSSkipVerify)
 
+	cmd.Flags().BoolVar(
+		&disableMetrics,
+		metricsDisableFlag,
+		false,
+		"Disable exposing metrics in the Prometheus format.",
+	)
+
+	cmd.Flags().Var(
+		&metricsListenPort,
+		metricsPortFlag,
+		"Set the port where the metrics are exposed. Format: [1024 - 65535]",
+	)
+
 	return cmd
 }
 
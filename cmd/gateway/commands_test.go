@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -144,6 +145,8 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 				"--metrics-secure-serving",
 				"--health-port=8081",
 				"--health-disable",
+				"--debug-enable",
+				"--debug-port=8082",
 				"--leader-election-lock-name=my-lock",
 				"--leader-election-disable=false",
 				"--nginx-plus",
@@ -166,6 +169,8 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 				"--endpoint-picker-disable-tls",
 				"--endpoint-picker-tls-skip-verify",
 				"--watch-namespaces=ns1,ns2",
+				"--waf-bundle-cache-dir=/var/cache/nginx-gateway/waf-bundles",
+				"--cluster-domain=cluster.local",
 			},
 			wantErr: false,
 		},
@@ -288,6 +293,24 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "999" for "--health-disable" flag: strconv.ParseBool:` +
 				` parsing "999": invalid syntax`,
 		},
+		{
+			name: "debug-port is outside of range",
+			args: []string{
+				"--debug-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "debug-enable is not a bool",
+			args: []string{
+				"--debug-enable=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-enable" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
 		{
 			name: "leader-election-lock-name is set to invalid string",
 			args: []string{
@@ -581,6 +604,38 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "my_domain.com" for "--server-tls-domain" flag: invalid format`,
 		},
+		{
+			name: "waf-bundle-cache-dir is set to empty string",
+			args: []string{
+				"--waf-bundle-cache-dir=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--waf-bundle-cache-dir" flag: must be set`,
+		},
+		{
+			name: "waf-bundle-cache-dir is a relative path",
+			args: []string{
+				"--waf-bundle-cache-dir=cache/waf",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "cache/waf" for "--waf-bundle-cache-dir" flag: path must be absolute`,
+		},
+		{
+			name: "cluster-domain is set to empty string",
+			args: []string{
+				"--cluster-domain=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--cluster-domain" flag: must be set`,
+		},
+		{
+			name: "cluster-domain is invalid",
+			args: []string{
+				"--cluster-domain=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--cluster-domain" flag: invalid format`,
+		},
 	}
 
 	// common flags validation is tested separately
@@ -1053,6 +1108,63 @@ func TestUsageReportConfig(t *testing.T) {
 	}
 }
 
+func TestBuildClusterDNSConfig(t *testing.T) {
+	t.Parallel()
+
+	writeResolvConf := func(t *testing.T, contents string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "resolv.conf")
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatalf("failed to write resolv.conf: %v", err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name       string
+		resolvConf string
+		expected   config.ClusterDNSConfig
+	}{
+		{
+			name: "nameservers are read from resolv.conf",
+			resolvConf: "search default.svc.example.local svc.example.local\n" +
+				"nameserver 10.96.0.10\n" +
+				"nameserver fd00::a\n" +
+				"options ndots:5\n",
+			expected: config.ClusterDNSConfig{
+				Domain:    "example.local",
+				Resolvers: []string{"10.96.0.10", "[fd00::a]"},
+			},
+		},
+		{
+			name:       "resolv.conf without nameservers",
+			resolvConf: "options ndots:5\n",
+			expected: config.ClusterDNSConfig{
+				Domain: "example.local",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			path := writeResolvConf(t, test.resolvConf)
+			g.Expect(buildClusterDNSConfig("example.local", path)).To(Equal(test.expected))
+		})
+	}
+
+	t.Run("resolv.conf does not exist", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		g.Expect(buildClusterDNSConfig("cluster.local", filepath.Join(t.TempDir(), "missing"))).To(Equal(
+			config.ClusterDNSConfig{Domain: "cluster.local"},
+		))
+	})
+}
+
 func TestValidatePLMSecretNamespacesWatched(t *testing.T) {
 	t.Parallel()
 
@@ -1173,6 +1285,23 @@ func TestEndpointPickerFlags(t *testing.T) {
 			expectedErrPrefix: `invalid argument "not-a-bool" for "--endpoint-picker-tls-skip-verify" flag:` +
 				` strconv.ParseBool: parsing "not-a-bool": invalid syntax`,
 		},
+		{
+			name: "valid metrics flags",
+			args: []string{
+				"--metrics-disable=true",
+				"--metrics-port=9114",
+			},
+			wantErr: false,
+		},
+		{
+			name: "metrics-port is invalid",
+			args: []string{
+				"--metrics-port=80",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "80" for "--metrics-port" flag: port outside of valid port range` +
+				` [1024 - 65535]: 80`,
+		},
 	}
 
 	for _, test := range tests {
@@ -1183,3 +1312,58 @@ func TestEndpointPickerFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderCommandFlags(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--file=gateway.yaml",
+				"-f=routes.yaml",
+				"--output-dir=out",
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=my-class",
+				"--nginx-plus",
+				"--gateway-api-experimental-features",
+				"--snippets",
+			},
+			wantErr: false,
+		},
+		{
+			name: "file is not set",
+			args: []string{
+				"--output-dir=out",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "file" not set`,
+		},
+		{
+			name: "output-dir is not set",
+			args: []string{
+				"--file=gateway.yaml",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "output-dir" not set`,
+		},
+		{
+			name: "gateway-ctlr-name is invalid",
+			args: []string{
+				"--file=gateway.yaml",
+				"--output-dir=out",
+				"--gateway-ctlr-name=nginx-gateway",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "nginx-gateway" for "--gateway-ctlr-name" flag: invalid format; ` +
+				"must be DOMAIN/PATH",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createRenderCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -8,12 +9,16 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	eppMetadata "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
@@ -21,9 +26,36 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
+const (
+	// extProcConnIdleTimeout is the duration after which an unused pooled gRPC connection is closed.
+	extProcConnIdleTimeout = 5 * time.Minute
+	// extProcConnEvictionInterval is the interval at which idle pooled gRPC connections are evicted.
+	extProcConnEvictionInterval = time.Minute
+)
+
+// Reasons of failed requests to the EndpointPicker, used as a metric label.
+const (
+	errReasonMissingHeaders = "missing_headers"
+	errReasonClient         = "client"
+	errReasonStream         = "stream"
+	errReasonSend           = "send"
+	errReasonReceive        = "receive"
+)
+
 // extProcClientFactory creates a new ExternalProcessorClient and returns a close function.
 type extProcClientFactory func(target string) (extprocv3.ExternalProcessorClient, func() error, error)
 
+// extProcDialer creates a new gRPC connection to the target.
+type extProcDialer func(target string) (*grpc.ClientConn, error)
+
+// endpointPickerMetricsCollector collects metrics for the EndpointPicker shim.
+type endpointPickerMetricsCollector interface {
+	ObserveRequestTime(duration time.Duration)
+	IncrementRequestErrors(reason string)
+	IncrementImmediateResponses(code int)
+	SetPooledConnections(count int)
+}
+
 // endpointPickerServer starts an HTTP server on the given port with the provided handler.
 func endpointPickerServer(handler http.Handler) error {
 	server := &http.Server{
@@ -34,9 +66,23 @@ func endpointPickerServer(handler http.Handler) error {
 	return server.ListenAndServe()
 }
 
-// realExtProcClientFactory returns a factory that creates a new gRPC connection and client per request.
-func realExtProcClientFactory(disableTLS, tlsSkipVerify bool) extProcClientFactory {
-	return func(target string) (extprocv3.ExternalProcessorClient, func() error, error) {
+// endpointPickerMetricsServer starts an HTTP server on the given port that exposes the metrics
+// of the provided registry in the Prometheus format.
+func endpointPickerMetricsServer(port int, registry *prometheus.Registry) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// realExtProcDialer returns a dialer that creates a new gRPC connection to the EndpointPicker.
+func realExtProcDialer(disableTLS, tlsSkipVerify bool) extProcDialer {
+	return func(target string) (*grpc.ClientConn, error) {
 		var opts []grpc.DialOption
 
 		if disableTLS {
@@ -48,17 +94,140 @@ func realExtProcClientFactory(disableTLS, tlsSkipVerify bool) extProcClientFacto
 			opts = append(opts, grpc.WithTransportCredentials(creds))
 		}
 
-		conn, err := grpc.NewClient(target, opts...)
-		if err != nil {
-			return nil, nil, err
+		return grpc.NewClient(target, opts...)
+	}
+}
+
+// pooledExtProcConn is a gRPC connection to an EndpointPicker that is cached by the extProcClientPool.
+type pooledExtProcConn struct {
+	lastUsed time.Time
+	conn     *grpc.ClientConn
+	client   extprocv3.ExternalProcessorClient
+}
+
+// extProcClientPool caches gRPC connections to EndpointPickers by target, so that a connection (and its TLS
+// handshake) is reused across requests instead of being created for every request.
+// Connections that are in a failed state are replaced on the next request, and connections that are not used
+// for the idle timeout are closed.
+type extProcClientPool struct {
+	metricsCollector endpointPickerMetricsCollector
+	conns            map[string]*pooledExtProcConn
+	dial             extProcDialer
+	logger           logr.Logger
+	idleTimeout      time.Duration
+	lock             sync.Mutex
+}
+
+func newExtProcClientPool(
+	dial extProcDialer,
+	idleTimeout time.Duration,
+	metricsCollector endpointPickerMetricsCollector,
+	logger logr.Logger,
+) *extProcClientPool {
+	return &extProcClientPool{
+		metricsCollector: metricsCollector,
+		conns:            make(map[string]*pooledExtProcConn),
+		dial:             dial,
+		logger:           logger,
+		idleTimeout:      idleTimeout,
+	}
+}
+
+// getClient returns a client for the target, reusing a pooled connection if it is healthy.
+// The returned close function is a no-op, since the connection is owned by the pool.
+// Implements extProcClientFactory.
+func (p *extProcClientPool) getClient(target string) (extprocv3.ExternalProcessorClient, func() error, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if pooled, ok := p.conns[target]; ok {
+		if isConnUsable(pooled.conn.GetState()) {
+			pooled.lastUsed = time.Now()
+			return pooled.client, releasePooledConn, nil
+		}
+
+		p.logger.Info("Reconnecting to EndpointPicker", "endpointPicker", target, "state", pooled.conn.GetState())
+		p.closeConn(target, pooled)
+	}
+
+	conn, err := p.dial(target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pooled := &pooledExtProcConn{
+		lastUsed: time.Now(),
+		conn:     conn,
+		client:   extprocv3.NewExternalProcessorClient(conn),
+	}
+	p.conns[target] = pooled
+	p.metricsCollector.SetPooledConnections(len(p.conns))
+
+	return pooled.client, releasePooledConn, nil
+}
+
+// run periodically evicts idle connections until the context is canceled, after which all connections are closed.
+func (p *extProcClientPool) run(ctx context.Context) {
+	ticker := time.NewTicker(extProcConnEvictionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.closeAll()
+			return
+		case <-ticker.C:
+			p.evictIdle(time.Now())
+		}
+	}
+}
+
+// evictIdle closes all connections that have not been used since the idle timeout.
+func (p *extProcClientPool) evictIdle(now time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for target, pooled := range p.conns {
+		if now.Sub(pooled.lastUsed) >= p.idleTimeout {
+			p.logger.V(1).Info("Closing idle connection to EndpointPicker", "endpointPicker", target)
+			p.closeConn(target, pooled)
 		}
-		client := extprocv3.NewExternalProcessorClient(conn)
-		return client, conn.Close, nil
 	}
 }
 
+func (p *extProcClientPool) closeAll() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for target, pooled := range p.conns {
+		p.closeConn(target, pooled)
+	}
+}
+
+// closeConn closes and removes a connection from the pool. The pool lock MUST already be held.
+func (p *extProcClientPool) closeConn(target string, pooled *pooledExtProcConn) {
+	if err := pooled.conn.Close(); err != nil {
+		p.logger.Error(err, "error closing gRPC connection", "endpointPicker", target)
+	}
+
+	delete(p.conns, target)
+	p.metricsCollector.SetPooledConnections(len(p.conns))
+}
+
+// isConnUsable reports whether a connection in the given state can be used for new requests.
+// Idle and connecting connections are usable since gRPC connects them on demand.
+func isConnUsable(state connectivity.State) bool {
+	return state != connectivity.TransientFailure && state != connectivity.Shutdown
+}
+
+func releasePooledConn() error { return nil }
+
 // createEndpointPickerHandler returns an http.Handler that forwards requests to the EndpointPicker.
-func createEndpointPickerHandler(factory extProcClientFactory, logger logr.Logger) http.Handler {
+func createEndpointPickerHandler(
+	factory extProcClientFactory,
+	metricsCollector endpointPickerMetricsCollector,
+	logger logr.Logger,
+) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		host := r.Header.Get(types.EPPEndpointHostHeader)
 		port := r.Header.Get(types.EPPEndpointPortHeader)
@@ -69,16 +238,23 @@ func createEndpointPickerHandler(factory extProcClientFactory, logger logr.Logge
 				types.EPPEndpointPortHeader,
 			)
 			logger.Error(errors.New(msg), "error contacting EndpointPicker")
+			metricsCollector.IncrementRequestErrors(errReasonMissingHeaders)
 			http.Error(w, msg, http.StatusBadRequest)
 			return
 		}
 
+		start := time.Now()
+		defer func() {
+			metricsCollector.ObserveRequestTime(time.Since(start))
+		}()
+
 		target := net.JoinHostPort(host, port)
 		logger.Info("Getting inference workload endpoint from EndpointPicker", "endpointPicker", target)
 
 		client, closeConn, err := factory(target)
 		if err != nil {
 			logger.Error(err, "error creating gRPC client")
+			metricsCollector.IncrementRequestErrors(errReasonClient)
 			http.Error(w, fmt.Sprintf("error creating gRPC client: %v", err), http.StatusInternalServerError)
 			return
 		}
@@ -91,12 +267,14 @@ func createEndpointPickerHandler(factory extProcClientFactory, logger logr.Logge
 		stream, err := client.Process(r.Context())
 		if err != nil {
 			logger.Error(err, "error opening ext_proc stream")
+			metricsCollector.IncrementRequestErrors(errReasonStream)
 			http.Error(w, fmt.Sprintf("error opening ext_proc stream: %v", err), http.StatusBadGateway)
 			return
 		}
 
 		if code, err := sendRequest(stream, r); err != nil {
 			logger.Error(err, "error sending request")
+			metricsCollector.IncrementRequestErrors(errReasonSend)
 			http.Error(w, err.Error(), code)
 			return
 		}
@@ -108,6 +286,7 @@ func createEndpointPickerHandler(factory extProcClientFactory, logger logr.Logge
 				break // End of stream
 			} else if err != nil {
 				logger.Error(err, "error receiving from ext_proc")
+				metricsCollector.IncrementRequestErrors(errReasonReceive)
 				http.Error(w, fmt.Sprintf("error receiving from ext_proc: %v", err), http.StatusBadGateway)
 				return
 			}
@@ -116,6 +295,7 @@ func createEndpointPickerHandler(factory extProcClientFactory, logger logr.Logge
 				code := int(ir.GetStatus().GetCode())
 				body := ir.GetBody()
 				logger.Error(fmt.Errorf("code: %d, body: %s", code, body), "received immediate response")
+				metricsCollector.IncrementImmediateResponses(code)
 				http.Error(w, string(body), code)
 				return
 			}
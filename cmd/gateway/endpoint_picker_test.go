@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
@@ -15,9 +16,12 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	eppMetadata "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
@@ -79,6 +83,34 @@ func (m *mockProcessClient) Context() context.Context {
 func (*mockProcessClient) Header() (metadata.MD, error) { return nil, nil } //nolint:nilnil // interface satisfier
 func (*mockProcessClient) Trailer() metadata.MD         { return nil }
 
+type fakeEndpointPickerMetricsCollector struct {
+	requestErrors      map[string]int
+	immediateResponses map[int]int
+	requests           int
+	pooledConnections  int
+}
+
+func newFakeEndpointPickerMetricsCollector() *fakeEndpointPickerMetricsCollector {
+	return &fakeEndpointPickerMetricsCollector{
+		requestErrors:      make(map[string]int),
+		immediateResponses: make(map[int]int),
+	}
+}
+
+func (f *fakeEndpointPickerMetricsCollector) ObserveRequestTime(time.Duration) { f.requests++ }
+
+func (f *fakeEndpointPickerMetricsCollector) IncrementRequestErrors(reason string) {
+	f.requestErrors[reason]++
+}
+
+func (f *fakeEndpointPickerMetricsCollector) IncrementImmediateResponses(code int) {
+	f.immediateResponses[code]++
+}
+
+func (f *fakeEndpointPickerMetricsCollector) SetPooledConnections(count int) {
+	f.pooledConnections = count
+}
+
 func TestEndpointPickerHandler_Success(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -122,7 +154,7 @@ func TestEndpointPickerHandler_Success(t *testing.T) {
 		return extProcClient, func() error { return nil }, nil
 	}
 
-	h := createEndpointPickerHandler(factory, logr.Discard())
+	h := createEndpointPickerHandler(factory, collectors.NewEndpointPickerNoopCollector(), logr.Discard())
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader("test body"))
 	req.Header.Set(types.EPPEndpointHostHeader, "test-host")
 	req.Header.Set(types.EPPEndpointPortHeader, "1234")
@@ -165,7 +197,8 @@ func TestEndpointPickerHandler_ImmediateResponse(t *testing.T) {
 		return extClient, func() error { return nil }, nil
 	}
 
-	h := createEndpointPickerHandler(factory, logr.Discard())
+	metricsCollector := newFakeEndpointPickerMetricsCollector()
+	h := createEndpointPickerHandler(factory, metricsCollector, logr.Discard())
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader("test body"))
 	req.Header.Set(types.EPPEndpointHostHeader, "test-host")
 	req.Header.Set(types.EPPEndpointPortHeader, "1234")
@@ -178,6 +211,10 @@ func TestEndpointPickerHandler_ImmediateResponse(t *testing.T) {
 	g.Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
 	body, _ := io.ReadAll(resp.Body)
 	g.Expect(string(body)).To(ContainSubstring("some error"))
+
+	g.Expect(metricsCollector.immediateResponses).To(Equal(map[int]int{http.StatusInternalServerError: 1}))
+	g.Expect(metricsCollector.requestErrors).To(BeEmpty())
+	g.Expect(metricsCollector.requests).To(Equal(1))
 }
 
 func TestEndpointPickerHandler_Errors(t *testing.T) {
@@ -188,8 +225,10 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 		setHeaders bool,
 		expectedStatus int,
 		expectedBodySubstring string,
+		expectedErrReason string,
 	) {
-		h := createEndpointPickerHandler(factory, logr.Discard())
+		metricsCollector := newFakeEndpointPickerMetricsCollector()
+		h := createEndpointPickerHandler(factory, metricsCollector, logr.Discard())
 		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader("test body"))
 		if setHeaders {
 			req.Header.Set(types.EPPEndpointHostHeader, "test-host")
@@ -201,6 +240,7 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 		g.Expect(resp.StatusCode).To(Equal(expectedStatus))
 		body, _ := io.ReadAll(resp.Body)
 		g.Expect(string(body)).To(ContainSubstring(expectedBodySubstring))
+		g.Expect(metricsCollector.requestErrors).To(Equal(map[string]int{expectedErrReason: 1}))
 	}
 
 	// 1. Error creating gRPC client
@@ -208,7 +248,7 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 	factory := func(string) (extprocv3.ExternalProcessorClient, func() error, error) {
 		return nil, nil, factoryErr
 	}
-	runErrorTestCase(factory, true, http.StatusInternalServerError, "error creating gRPC client")
+	runErrorTestCase(factory, true, http.StatusInternalServerError, "error creating gRPC client", errReasonClient)
 
 	// 2. Error opening ext_proc stream
 	extProcClient := &mockExtProcClient{
@@ -219,7 +259,7 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 	factory = func(string) (extprocv3.ExternalProcessorClient, func() error, error) {
 		return extProcClient, func() error { return nil }, nil
 	}
-	runErrorTestCase(factory, true, http.StatusBadGateway, "error opening ext_proc stream")
+	runErrorTestCase(factory, true, http.StatusBadGateway, "error opening ext_proc stream", errReasonStream)
 
 	// 3. Error sending headers
 	client := &mockProcessClient{
@@ -236,7 +276,7 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 	factory = func(string) (extprocv3.ExternalProcessorClient, func() error, error) {
 		return extProcClient, func() error { return nil }, nil
 	}
-	runErrorTestCase(factory, true, http.StatusBadGateway, "error sending headers")
+	runErrorTestCase(factory, true, http.StatusBadGateway, "error sending headers", errReasonSend)
 
 	// 4. Error sending body
 	client = &mockProcessClient{
@@ -256,10 +296,16 @@ func TestEndpointPickerHandler_Errors(t *testing.T) {
 	factory = func(string) (extprocv3.ExternalProcessorClient, func() error, error) {
 		return extProcClient, func() error { return nil }, nil
 	}
-	runErrorTestCase(factory, true, http.StatusBadGateway, "error sending body")
+	runErrorTestCase(factory, true, http.StatusBadGateway, "error sending body", errReasonSend)
 
 	// 5. Error with empty headers
-	runErrorTestCase(factory, false, http.StatusBadRequest, "missing at least one of required headers")
+	runErrorTestCase(
+		factory,
+		false,
+		http.StatusBadRequest,
+		"missing at least one of required headers",
+		errReasonMissingHeaders,
+	)
 }
 
 func TestEndpointPickerHandler_GETRequest(t *testing.T) {
@@ -307,7 +353,7 @@ func TestEndpointPickerHandler_GETRequest(t *testing.T) {
 		return extProcClient, func() error { return nil }, nil
 	}
 
-	h := createEndpointPickerHandler(factory, logr.Discard())
+	h := createEndpointPickerHandler(factory, collectors.NewEndpointPickerNoopCollector(), logr.Discard())
 	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
 	req.Header.Set(types.EPPEndpointHostHeader, "test-host")
 	req.Header.Set(types.EPPEndpointPortHeader, "1234")
@@ -325,3 +371,82 @@ func TestEndpointPickerHandler_GETRequest(t *testing.T) {
 	g.Expect(sentRequests[0].GetRequestHeaders()).NotTo(BeNil())
 	g.Expect(sentRequests[0].GetRequestHeaders().GetEndOfStream()).To(BeTrue())
 }
+
+func TestExtProcClientPool(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var dialed []*grpc.ClientConn
+	dial := func(target string) (*grpc.ClientConn, error) {
+		conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err == nil {
+			dialed = append(dialed, conn)
+		}
+		return conn, err
+	}
+
+	metricsCollector := newFakeEndpointPickerMetricsCollector()
+	pool := newExtProcClientPool(dial, time.Minute, metricsCollector, logr.Discard())
+
+	// connections are reused for the same target
+	client1, closeConn, err := pool.getClient("epp-1:9002")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(closeConn()).To(Succeed())
+
+	client2, _, err := pool.getClient("epp-1:9002")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client2).To(BeIdenticalTo(client1))
+	g.Expect(dialed).To(HaveLen(1))
+
+	// closing a pooled connection must be a no-op
+	g.Expect(dialed[0].GetState()).ToNot(Equal(connectivity.Shutdown))
+
+	// a new connection is created for a different target
+	_, _, err = pool.getClient("epp-2:9002")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dialed).To(HaveLen(2))
+	g.Expect(metricsCollector.pooledConnections).To(Equal(2))
+
+	// a failed connection is replaced
+	g.Expect(dialed[0].Close()).To(Succeed())
+
+	client3, _, err := pool.getClient("epp-1:9002")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client3).ToNot(BeIdenticalTo(client1))
+	g.Expect(dialed).To(HaveLen(3))
+	g.Expect(metricsCollector.pooledConnections).To(Equal(2))
+
+	// idle connections are evicted
+	pool.lock.Lock()
+	pool.conns["epp-2:9002"].lastUsed = time.Now().Add(-2 * time.Minute)
+	pool.lock.Unlock()
+
+	pool.evictIdle(time.Now())
+
+	g.Expect(pool.conns).To(HaveLen(1))
+	g.Expect(pool.conns).To(HaveKey("epp-1:9002"))
+	g.Expect(dialed[1].GetState()).To(Equal(connectivity.Shutdown))
+	g.Expect(metricsCollector.pooledConnections).To(Equal(1))
+
+	// all connections are closed
+	pool.closeAll()
+
+	g.Expect(pool.conns).To(BeEmpty())
+	g.Expect(dialed[2].GetState()).To(Equal(connectivity.Shutdown))
+	g.Expect(metricsCollector.pooledConnections).To(Equal(0))
+}
+
+func TestExtProcClientPool_DialError(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dial := func(string) (*grpc.ClientConn, error) {
+		return nil, errors.New("dial error")
+	}
+
+	pool := newExtProcClientPool(dial, time.Minute, newFakeEndpointPickerMetricsCollector(), logr.Discard())
+
+	_, _, err := pool.getClient("epp:9002")
+	g.Expect(err).To(MatchError("dial error"))
+	g.Expect(pool.conns).To(BeEmpty())
+}
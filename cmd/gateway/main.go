@@ -23,6 +23,7 @@ func main() {
 	rootCmd.AddCommand(
 		createControllerCommand(),
 		createGenerateCertsCommand(),
+		createRenderCommand(),
 		createInitializeCommand(),
 		createSleepCommand(),
 		createEndpointPickerCommand(),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -231,6 +232,23 @@ func validateURL(value string) error {
 	return nil
 }
 
+// validateAbsolutePath makes sure a given value is a clean absolute file path.
+func validateAbsolutePath(value string) error {
+	if len(value) == 0 {
+		return errors.New("must be set")
+	}
+
+	if !filepath.IsAbs(value) {
+		return fmt.Errorf("path must be absolute: %q", value)
+	}
+
+	if filepath.Clean(value) != value {
+		return fmt.Errorf("path must be clean: %q", value)
+	}
+
+	return nil
+}
+
 // validatePort makes sure a given port is inside the valid port range for its usage.
 func validatePort(port int) error {
 	if port < 1024 || port > 65535 {
@@ -590,6 +590,52 @@ func TestValidateURL(t *testing.T) {
 	}
 }
 
+func TestValidateAbsolutePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		path   string
+		expErr bool
+	}{
+		{
+			name:   "absolute path",
+			path:   "/var/cache/nginx-gateway",
+			expErr: false,
+		},
+		{
+			name:   "empty path",
+			path:   "",
+			expErr: true,
+		},
+		{
+			name:   "relative path",
+			path:   "cache/nginx-gateway",
+			expErr: true,
+		},
+		{
+			name:   "path that is not clean",
+			path:   "/var/cache/../nginx-gateway/",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateAbsolutePath(tc.path)
+			if tc.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
 func TestProtocolPort(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
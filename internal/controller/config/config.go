@@ -31,10 +31,15 @@ type Config struct {
 	ImageSource string
 	// GatewayCtlrName is the name of this controller.
 	GatewayCtlrName string
+	// WAFBundleCacheDir is the directory in which fetched WAF bundles are persisted across restarts.
+	// WAF bundles are only cached in memory when empty.
+	WAFBundleCacheDir string
 	// NGINXSCCName is the name of the SecurityContextConstraints for the NGINX Pods. Only applicable in OpenShift.
 	NGINXSCCName string
 	// UsageReportConfig specifies the NGINX Plus usage reporting configuration.
 	UsageReportConfig UsageReportConfig
+	// ClusterDNS contains the DNS configuration of the cluster.
+	ClusterDNS ClusterDNSConfig
 	// Flags contains the NGF command-line flag names and values.
 	Flags Flags
 	// LeaderElection contains the configuration for leader election.
@@ -51,6 +56,8 @@ type Config struct {
 	HealthConfig HealthConfig
 	// MetricsConfig specifies the metrics config.
 	MetricsConfig MetricsConfig
+	// DebugConfig specifies the debug server config.
+	DebugConfig DebugConfig
 	// Plus indicates whether NGINX Plus is being used.
 	Plus bool
 	// ExperimentalFeatures indicates if experimental features are enabled.
@@ -83,6 +90,14 @@ type PLMStorageConfig struct {
 	SkipVerify bool
 }
 
+// ClusterDNSConfig contains the DNS configuration of the cluster.
+type ClusterDNSConfig struct {
+	// Domain is the DNS domain of the cluster.
+	Domain string
+	// Resolvers are the addresses of the DNS servers of the cluster.
+	Resolvers []string
+}
+
 // GatewayPodConfig contains information about this Pod.
 type GatewayPodConfig struct {
 	// ServiceName is the name of the Service that fronts this Pod.
@@ -120,6 +135,14 @@ type HealthConfig struct {
 	Enabled bool
 }
 
+// DebugConfig specifies the debug server config.
+type DebugConfig struct {
+	// Port is the port that the debug server listens on.
+	Port int
+	// Enabled is the flag for toggling the debug server on or off.
+	Enabled bool
+}
+
 // LeaderElectionConfig contains the configuration for leader election.
 type LeaderElectionConfig struct {
 	// LockName holds the name of the leader election lock.
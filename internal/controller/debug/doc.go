@@ -0,0 +1,4 @@
+/*
+Package debug contains the HTTP server that exposes the latest state of the control plane for troubleshooting.
+*/
+package debug
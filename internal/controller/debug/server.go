@@ -0,0 +1,377 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+)
+
+const (
+	// ConfigPath is the path of the endpoint that serves the latest configuration of each Gateway.
+	ConfigPath = "/debug/config"
+
+	redactedValue = "<redacted>"
+
+	readHeaderTimeout = 10 * time.Second
+	reviewTimeout     = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// redactedFields are the fields of the dataplane Configuration that can hold sensitive data,
+// such as auth user files, client secrets and CA certificates. Their values are never served.
+// The private keys of the SSLKeyPairs and the header values of the Telemetry are redacted separately.
+var redactedFields = map[string]struct{}{
+	"Data":             {},
+	"CACertData":       {},
+	"CRLData":          {},
+	"ClientSecret":     {},
+	"AuthSecrets":      {},
+	"AuxiliarySecrets": {},
+	"WAFBundles":       {},
+}
+
+// GraphGetter gets the latest Graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// ConfigurationGetter gets the latest Configuration of a Gateway.
+type ConfigurationGetter interface {
+	GetLatestConfigurationForGateway(types.NamespacedName) *dataplane.Configuration
+}
+
+// DeploymentGetter gets the nginx Deployments and the Pods connected to them.
+type DeploymentGetter interface {
+	Get(types.NamespacedName) *agent.Deployment
+	GetPodName(connID string) string
+}
+
+// ServerConfig holds the configuration for the debug Server.
+type ServerConfig struct {
+	// GraphGetter gets the latest Graph.
+	GraphGetter GraphGetter
+	// ConfigurationGetter gets the latest Configuration of a Gateway.
+	ConfigurationGetter ConfigurationGetter
+	// DeploymentGetter gets the nginx Deployments.
+	DeploymentGetter DeploymentGetter
+	// K8sClient is used to create the TokenReviews and SubjectAccessReviews that authenticate and authorize requests.
+	K8sClient client.Client
+	// Logger is the logger.
+	Logger logr.Logger
+	// Port is the port that the server listens on.
+	Port int
+}
+
+// Server is an HTTP server that serves the latest dataplane configuration, the generated nginx files
+// and the config status of the nginx Pods of each Gateway.
+//
+// Requests must carry a bearer token. The token is authenticated with a TokenReview, and the user
+// must be authorized to "get" the non-resource URL of the endpoint, which is checked with a SubjectAccessReview.
+type Server struct {
+	cfg ServerConfig
+}
+
+// NewServer creates a new debug Server.
+func NewServer(cfg ServerConfig) *Server {
+	return &Server{cfg: cfg}
+}
+
+// Start is a runnable that starts the debug server.
+func (s *Server) Start(ctx context.Context) error {
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", s.cfg.Port))
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.cfg.Logger.Info("Shutting down debug server")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.cfg.Logger.Error(err, "error shutting down debug server")
+		}
+	}()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(ConfigPath, s.withAuth(http.HandlerFunc(s.serveConfig)))
+
+	return mux
+}
+
+// withAuth authenticates and authorizes the request before passing it to the next handler.
+func (s *Server) withAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), reviewTimeout)
+		defer cancel()
+
+		tokenReview := &authnv1.TokenReview{
+			Spec: authnv1.TokenReviewSpec{
+				Token: token,
+			},
+		}
+
+		if err := s.cfg.K8sClient.Create(ctx, tokenReview); err != nil {
+			s.cfg.Logger.Error(err, "failed to create TokenReview")
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if !tokenReview.Status.Authenticated {
+			s.cfg.Logger.V(1).Info("token review was not authenticated", "reason", tokenReview.Status.Error)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		user := tokenReview.Status.User
+		extra := make(map[string]authzv1.ExtraValue, len(user.Extra))
+		for k, v := range user.Extra {
+			extra[k] = authzv1.ExtraValue(v)
+		}
+
+		accessReview := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				NonResourceAttributes: &authzv1.NonResourceAttributes{
+					Path: r.URL.Path,
+					Verb: "get",
+				},
+				User:   user.Username,
+				Groups: user.Groups,
+				UID:    user.UID,
+				Extra:  extra,
+			},
+		}
+
+		if err := s.cfg.K8sClient.Create(ctx, accessReview); err != nil {
+			s.cfg.Logger.Error(err, "failed to create SubjectAccessReview")
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if !accessReview.Status.Allowed {
+			s.cfg.Logger.V(1).Info(
+				"user is not authorized to access the debug endpoint",
+				"user", user.Username,
+				"reason", accessReview.Status.Reason,
+			)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GatewayInfo is the debug information of a Gateway.
+type GatewayInfo struct {
+	// Configuration is the latest dataplane configuration of the Gateway, with sensitive fields redacted.
+	Configuration any `json:"configuration,omitempty"`
+	// Gateway is the namespaced name of the Gateway.
+	Gateway string `json:"gateway"`
+	// Deployment is the namespaced name of the nginx Deployment of the Gateway.
+	Deployment string `json:"deployment"`
+	// ConfigVersion is the version of the nginx configuration that is sent to the Pods.
+	ConfigVersion string `json:"configVersion,omitempty"`
+	// Files are the generated nginx files.
+	Files []FileInfo `json:"files"`
+	// Pods are the config statuses of the nginx Pods.
+	Pods []PodInfo `json:"pods"`
+	// ConfigGeneration is incremented every time the nginx configuration changes.
+	ConfigGeneration int64 `json:"configGeneration"`
+}
+
+// FileInfo is an overview of a generated nginx file.
+type FileInfo struct {
+	// Name is the path of the file.
+	Name string `json:"name"`
+	// Hash is the hash of the file contents.
+	Hash string `json:"hash"`
+}
+
+// PodInfo is the config status of an nginx Pod.
+type PodInfo struct {
+	// Name is the name of the Pod.
+	Name string `json:"name"`
+	// Error is the error that occurred when the configuration was last applied, if any.
+	Error string `json:"error,omitempty"`
+}
+
+func (s *Server) serveConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	infos, err := s.getGatewayInfos()
+	if err != nil {
+		s.cfg.Logger.Error(err, "failed to build debug information")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(infos); err != nil {
+		s.cfg.Logger.Error(err, "failed to write debug information")
+	}
+}
+
+func (s *Server) getGatewayInfos() ([]GatewayInfo, error) {
+	infos := []GatewayInfo{}
+
+	g := s.cfg.GraphGetter.GetLatestGraph()
+	if g == nil {
+		return infos, nil
+	}
+
+	for nsName, gw := range g.Gateways {
+		info := GatewayInfo{
+			Gateway:    nsName.String(),
+			Deployment: gw.DeploymentName.String(),
+			Files:      []FileInfo{},
+			Pods:       []PodInfo{},
+		}
+
+		if cfg := s.cfg.ConfigurationGetter.GetLatestConfigurationForGateway(nsName); cfg != nil {
+			redacted, err := redactConfiguration(cfg)
+			if err != nil {
+				return nil, fmt.Errorf("error redacting configuration of Gateway %s: %w", nsName, err)
+			}
+			info.Configuration = redacted
+		}
+
+		if deployment := s.cfg.DeploymentGetter.Get(gw.DeploymentName); deployment != nil {
+			s.setDeploymentInfo(&info, deployment)
+		}
+
+		infos = append(infos, info)
+	}
+
+	slices.SortFunc(infos, func(a, b GatewayInfo) int {
+		return strings.Compare(a.Gateway, b.Gateway)
+	})
+
+	return infos, nil
+}
+
+func (s *Server) setDeploymentInfo(info *GatewayInfo, deployment *agent.Deployment) {
+	deployment.FileLock.RLock()
+	fileOverviews, configVersion := deployment.GetFileOverviews()
+	info.ConfigVersion = configVersion
+	info.ConfigGeneration = deployment.GetConfigGeneration()
+	for _, file := range fileOverviews {
+		if meta := file.GetFileMeta(); meta != nil {
+			info.Files = append(info.Files, FileInfo{Name: meta.GetName(), Hash: meta.GetHash()})
+		}
+	}
+	deployment.FileLock.RUnlock()
+
+	for connID, err := range deployment.GetPodStatuses() {
+		pod := PodInfo{Name: s.cfg.DeploymentGetter.GetPodName(connID)}
+		if pod.Name == "" {
+			pod.Name = connID
+		}
+		if err != nil {
+			pod.Error = err.Error()
+		}
+		info.Pods = append(info.Pods, pod)
+	}
+
+	slices.SortFunc(info.Files, func(a, b FileInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(info.Pods, func(a, b PodInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// redactConfiguration converts the Configuration into its generic JSON form and replaces the values
+// of all sensitive fields.
+func redactConfiguration(cfg *dataplane.Configuration) (any, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj any
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+
+	if keyPairs, ok := obj.(map[string]any)["SSLKeyPairs"].(map[string]any); ok {
+		for _, pair := range keyPairs {
+			if pair, ok := pair.(map[string]any); ok {
+				pair["Key"] = redactedValue
+			}
+		}
+	}
+
+	// The Telemetry headers are built from Secrets, for example to authenticate to the exporter endpoint.
+	if telemetry, ok := obj.(map[string]any)["Telemetry"].(map[string]any); ok {
+		if headers, ok := telemetry["Headers"].([]any); ok {
+			for _, header := range headers {
+				if header, ok := header.(map[string]any); ok {
+					header["Value"] = redactedValue
+				}
+			}
+		}
+	}
+
+	return redact(obj), nil
+}
+
+func redact(obj any) any {
+	switch v := obj.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if _, ok := redactedFields[key]; ok && val != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	}
+
+	return obj
+}
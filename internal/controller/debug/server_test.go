@@ -0,0 +1,301 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
+	. "github.com/onsi/gomega"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/grpcfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+)
+
+type fakeGraphGetter struct {
+	graph *graph.Graph
+}
+
+func (f *fakeGraphGetter) GetLatestGraph() *graph.Graph {
+	return f.graph
+}
+
+type fakeConfigurationGetter struct {
+	configs map[types.NamespacedName]*dataplane.Configuration
+}
+
+func (f *fakeConfigurationGetter) GetLatestConfigurationForGateway(
+	nsName types.NamespacedName,
+) *dataplane.Configuration {
+	return f.configs[nsName]
+}
+
+func createFakeK8sClient(authenticated, allowed bool, reviewErr error) client.Client {
+	return fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			if reviewErr != nil {
+				return reviewErr
+			}
+
+			switch review := obj.(type) {
+			case *authnv1.TokenReview:
+				if review.Spec.Token == "valid-token" {
+					review.Status.Authenticated = authenticated
+					review.Status.User = authnv1.UserInfo{Username: "user"}
+				}
+			case *authzv1.SubjectAccessReview:
+				if review.Spec.User == "user" &&
+					review.Spec.NonResourceAttributes.Path == ConfigPath &&
+					review.Spec.NonResourceAttributes.Verb == "get" {
+					review.Status.Allowed = allowed
+				}
+			}
+
+			return nil
+		},
+	}).Build()
+}
+
+func TestServeConfig_Auth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		reviewErr     error
+		name          string
+		token         string
+		method        string
+		expStatusCode int
+		authenticated bool
+		allowed       bool
+	}{
+		{
+			name:          "authenticated and authorized",
+			token:         "valid-token",
+			method:        http.MethodGet,
+			authenticated: true,
+			allowed:       true,
+			expStatusCode: http.StatusOK,
+		},
+		{
+			name:          "missing token",
+			method:        http.MethodGet,
+			authenticated: true,
+			allowed:       true,
+			expStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid token",
+			token:         "invalid-token",
+			method:        http.MethodGet,
+			authenticated: true,
+			allowed:       true,
+			expStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "not authenticated",
+			token:         "valid-token",
+			method:        http.MethodGet,
+			authenticated: false,
+			allowed:       true,
+			expStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "not authorized",
+			token:         "valid-token",
+			method:        http.MethodGet,
+			authenticated: true,
+			allowed:       false,
+			expStatusCode: http.StatusForbidden,
+		},
+		{
+			name:          "review fails",
+			token:         "valid-token",
+			method:        http.MethodGet,
+			reviewErr:     errors.New("review error"),
+			expStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:          "invalid method",
+			token:         "valid-token",
+			method:        http.MethodPost,
+			authenticated: true,
+			allowed:       true,
+			expStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			server := NewServer(ServerConfig{
+				GraphGetter:         &fakeGraphGetter{},
+				ConfigurationGetter: &fakeConfigurationGetter{},
+				DeploymentGetter:    agent.NewDeploymentStore(&grpcfakes.FakeConnectionsTracker{}),
+				K8sClient:           createFakeK8sClient(test.authenticated, test.allowed, test.reviewErr),
+			})
+
+			req := httptest.NewRequestWithContext(t.Context(), test.method, ConfigPath, nil)
+			if test.token != "" {
+				req.Header.Set("Authorization", "Bearer "+test.token)
+			}
+			rec := httptest.NewRecorder()
+
+			server.handler().ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expStatusCode))
+		})
+	}
+}
+
+func TestServeConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	deploymentNsName := types.NamespacedName{Namespace: "test", Name: "gateway-nginx"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other"}
+
+	graphGetter := &fakeGraphGetter{
+		graph: &graph.Graph{
+			Gateways: map[types.NamespacedName]*graph.Gateway{
+				gwNsName:      {DeploymentName: deploymentNsName},
+				otherGwNsName: {DeploymentName: types.NamespacedName{Namespace: "test", Name: "other-nginx"}},
+			},
+		},
+	}
+
+	configGetter := &fakeConfigurationGetter{
+		configs: map[types.NamespacedName]*dataplane.Configuration{
+			gwNsName: {
+				SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+					"ssl_keypair_test_secret": {Cert: []byte("cert"), Key: []byte("key")},
+				},
+				AuthSecrets: map[dataplane.AuthFileID]dataplane.AuthFileData{
+					"auth": []byte("user:password"),
+				},
+				OIDCProviders: []dataplane.OIDCProvider{
+					{Name: "provider", ClientSecret: "secret"},
+				},
+				Telemetry: dataplane.Telemetry{
+					CACertData: []byte("ca-cert"),
+					Headers: []dataplane.HTTPHeader{
+						{Name: "Authorization", Value: "Bearer exporter-token"},
+					},
+				},
+				WorkerConnections: 1024,
+			},
+		},
+	}
+
+	connTracker := &grpcfakes.FakeConnectionsTracker{}
+	connTracker.GetConnectionStub = func(key string) agentgrpc.Connection {
+		if key == "conn-1" {
+			return agentgrpc.Connection{PodName: "nginx-pod-1"}
+		}
+		return agentgrpc.Connection{}
+	}
+
+	store := agent.NewDeploymentStore(connTracker)
+	deployment := store.StoreWithBroadcaster(deploymentNsName, &broadcastfakes.FakeBroadcaster{}, "gateway")
+	deployment.SetFiles(
+		[]agent.File{
+			{
+				Meta:     &pb.FileMeta{Name: "/etc/nginx/conf.d/http.conf", Hash: "12345"},
+				Contents: []byte("http {}"),
+			},
+		},
+		[]v1.VolumeMount{},
+	)
+	deployment.SetPodErrorStatus("conn-1", nil)
+	deployment.SetPodErrorStatus("conn-2", errors.New("apply failed"))
+
+	server := NewServer(ServerConfig{
+		GraphGetter:         graphGetter,
+		ConfigurationGetter: configGetter,
+		DeploymentGetter:    store,
+		K8sClient:           createFakeK8sClient(true, true, nil),
+	})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, ConfigPath, nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+
+	server.handler().ServeHTTP(rec, req)
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	var infos []GatewayInfo
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &infos)).To(Succeed())
+	g.Expect(infos).To(HaveLen(2))
+
+	gwInfo := infos[0]
+	g.Expect(gwInfo.Gateway).To(Equal("test/gateway"))
+	g.Expect(gwInfo.Deployment).To(Equal("test/gateway-nginx"))
+	g.Expect(gwInfo.ConfigVersion).ToNot(BeEmpty())
+	g.Expect(gwInfo.ConfigGeneration).To(Equal(int64(1)))
+	g.Expect(gwInfo.Files).To(ContainElement(FileInfo{Name: "/etc/nginx/conf.d/http.conf", Hash: "12345"}))
+	g.Expect(gwInfo.Pods).To(Equal([]PodInfo{
+		{Name: "conn-2", Error: "apply failed"},
+		{Name: "nginx-pod-1"},
+	}))
+
+	cfg, ok := gwInfo.Configuration.(map[string]any)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(cfg).To(HaveKeyWithValue("WorkerConnections", BeEquivalentTo(1024)))
+	g.Expect(cfg).To(HaveKeyWithValue("AuthSecrets", redactedValue))
+	g.Expect(cfg["SSLKeyPairs"]).To(HaveKeyWithValue(
+		"ssl_keypair_test_secret",
+		HaveKeyWithValue("Key", redactedValue),
+	))
+	g.Expect(cfg["OIDCProviders"]).To(ContainElement(HaveKeyWithValue("ClientSecret", redactedValue)))
+	g.Expect(cfg["Telemetry"]).To(HaveKeyWithValue("CACertData", redactedValue))
+	g.Expect(cfg["Telemetry"]).To(HaveKeyWithValue("Headers", ConsistOf(
+		map[string]any{"Name": "Authorization", "Value": redactedValue},
+	)))
+	g.Expect(rec.Body.String()).ToNot(ContainSubstring("user:password"))
+	g.Expect(rec.Body.String()).ToNot(ContainSubstring("exporter-token"))
+
+	otherInfo := infos[1]
+	g.Expect(otherInfo.Gateway).To(Equal("test/other"))
+	g.Expect(otherInfo.Configuration).To(BeNil())
+	g.Expect(otherInfo.Files).To(BeEmpty())
+	g.Expect(otherInfo.Pods).To(BeEmpty())
+}
+
+func TestServeConfig_NoGraph(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := NewServer(ServerConfig{
+		GraphGetter:         &fakeGraphGetter{},
+		ConfigurationGetter: &fakeConfigurationGetter{},
+		DeploymentGetter:    agent.NewDeploymentStore(&grpcfakes.FakeConnectionsTracker{}),
+		K8sClient:           createFakeK8sClient(true, true, nil),
+	})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, ConfigPath, nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	rec := httptest.NewRecorder()
+
+	server.handler().ServeHTTP(rec, req)
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Body.String()).To(Equal("[]\n"))
+}
@@ -45,6 +45,9 @@ import (
 
 type handlerMetricsCollector interface {
 	ObserveLastEventBatchProcessTime(time.Duration)
+	IncrementEventsProcessed(kind string)
+	ObserveGraphBuildTime(time.Duration)
+	ObserveConfigGenerationTime(time.Duration)
 }
 
 // eventHandlerConfig holds configuration parameters for eventHandlerImpl.
@@ -204,10 +207,16 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 	}()
 
 	for _, event := range batch {
+		h.cfg.metricsCollector.IncrementEventsProcessed(eventResourceKind(event))
 		h.parseAndCaptureEvent(ctx, logger, event)
 	}
 
+	processStart := time.Now()
 	gr := h.cfg.processor.Process(ctx)
+	// Process only builds a new graph if the batch changed the cluster state.
+	if gr != nil {
+		h.cfg.metricsCollector.ObserveGraphBuildTime(time.Since(processStart))
+	}
 
 	// Once we've processed resources on startup and built our first graph, mark the Pod as ready.
 	if !h.cfg.graphBuiltHealthChecker.ready {
@@ -381,12 +390,16 @@ func (h *eventHandlerImpl) reconcileWAFPollers(ctx context.Context, gr *graph.Gr
 		// Build bundle sources (only includes sources with polling enabled).
 		var resolvedAuth *fetch.BundleAuth
 		var resolvedTLSCA []byte
+		var resolvedSigningKeys map[graph.WAFBundleKey][]byte
 		if policy.WAFState != nil {
 			resolvedAuth = policy.WAFState.ResolvedAuth
 			resolvedTLSCA = policy.WAFState.ResolvedTLSCA
+			resolvedSigningKeys = policy.WAFState.ResolvedSigningKeys
 		}
 
-		sources := wafPoller.BuildBundleSources(key.NsName, wafPolicy.Spec, resolvedAuth, resolvedTLSCA)
+		sources := wafPoller.BuildBundleSources(
+			key.NsName, wafPolicy.Spec, resolvedAuth, resolvedTLSCA, resolvedSigningKeys,
+		)
 		if len(sources) == 0 {
 			// No sources with polling enabled - stop any existing poller.
 			h.cfg.wafPollerManager.StopPoller(key.NsName)
@@ -426,11 +439,15 @@ func (h *eventHandlerImpl) reconcileWAFPollers(ctx context.Context, gr *graph.Gr
 			Sources:           sources,
 			TargetDeployments: targetDeployments,
 			InitialChecksums:  initialChecksums,
+			InitialBundles:    wafBundles,
 		})
 	}
 
 	// Stop pollers for policies that are no longer in the graph.
 	h.cfg.wafPollerManager.StopPollersNotIn(activePolicies)
+
+	// Persist the bundles that are in use so that they survive a controller restart.
+	h.cfg.wafPollerManager.PersistBundles(gr.ReferencedWAFBundles)
 }
 
 // gatewayHasPendingWAFBundle returns true if any WAFPolicy that targets this Gateway
@@ -774,6 +791,16 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 		transitionTime,
 		h.cfg.gatewayCtlrName,
 	)
+	canaryFilterReqs := status.PrepareCanaryFilterRequests(
+		gr.CanaryFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	mirrorFilterReqs := status.PrepareMirrorFilterRequests(
+		gr.MirrorFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
 	listenerSetReqs := status.PrepareListenerSetRequests(
 		gr.ListenerSets,
 		transitionTime,
@@ -819,6 +846,8 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 			len(ngfPolReqs)+
 			len(snippetsFilterReqs)+
 			len(authenticationFilterReqs)+
+			len(canaryFilterReqs)+
+			len(mirrorFilterReqs)+
 			len(listenerSetReqs)+
 			len(externalLoadBalancerReqs)+
 			len(inferencePoolReqs),
@@ -829,6 +858,8 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 	reqs = append(reqs, ngfPolReqs...)
 	reqs = append(reqs, snippetsFilterReqs...)
 	reqs = append(reqs, authenticationFilterReqs...)
+	reqs = append(reqs, canaryFilterReqs...)
+	reqs = append(reqs, mirrorFilterReqs...)
 	reqs = append(reqs, listenerSetReqs...)
 	reqs = append(reqs, externalLoadBalancerReqs...)
 	reqs = append(reqs, inferencePoolReqs...)
@@ -849,6 +880,9 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 }
 
 // mergeWAFPollErrors adds StaleBundleWarning conditions to policies that have active poll errors.
+// Poll errors caused by a bundle that failed signature verification are reported with the
+// SignatureVerificationFailed reason instead, and bundles that were rolled back during a canary rollout
+// with the BundleRolledBack reason.
 // This is called before preparing status requests so that poll failures are reflected in status.
 func (h *eventHandlerImpl) mergeWAFPollErrors(gr *graph.Graph) {
 	if h.cfg.wafPollerManager == nil {
@@ -878,7 +912,17 @@ func (h *eventHandlerImpl) mergeWAFPollErrors(gr *graph.Graph) {
 		// Replace any existing condition with the same Type so that repeated calls (e.g.,
 		// multiple status updates reusing the same graph) don't accumulate same-Type conditions.
 		// Status preparation deduplicates by Type only, so matching on Type is sufficient.
-		cond := conditions.NewPolicyProgrammedStaleBundleWarning(pollError.BundleDescription, pollError.Err.Error())
+		cond := graph.StaleBundleCondition(pollError.BundleDescription, pollError.Err)
+
+		var rolloutErr *wafPoller.RolloutError
+		if errors.As(pollError.Err, &rolloutErr) {
+			cond = conditions.NewPolicyProgrammedBundleRolledBack(
+				pollError.BundleDescription,
+				rolloutErr.FailedChecksum,
+				rolloutErr.LiveChecksum,
+				rolloutErr.Error(),
+			)
+		}
 
 		replaced := false
 		for i, existing := range policy.Conditions {
@@ -998,13 +1042,34 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 	}
 }
 
+// eventResourceKind returns the kind of the resource in the event, which is used as a label for event metrics.
+func eventResourceKind(event any) string {
+	var obj client.Object
+
+	switch e := event.(type) {
+	case *events.UpsertEvent:
+		obj = e.Resource
+	case *events.DeleteEvent:
+		obj = e.Type
+	case events.WAFBundleReconcileEvent:
+		return kinds.WAFPolicy
+	default:
+		return "Unknown"
+	}
+
+	return kinds.ObjectKind(obj)
+}
+
 // updateNginxConf updates nginx conf files and reloads nginx.
 func (h *eventHandlerImpl) updateNginxConf(
 	deployment *agent.Deployment,
 	conf dataplane.Configuration,
 	volumeMounts []v1.VolumeMount,
 ) {
+	generateStart := time.Now()
 	files := h.cfg.generator.Generate(conf)
+	h.cfg.metricsCollector.ObserveConfigGenerationTime(time.Since(generateStart))
+
 	h.cfg.nginxUpdater.UpdateConfig(deployment, files, volumeMounts)
 
 	// If using NGINX Plus, update upstream servers using the API.
@@ -1193,6 +1258,15 @@ func (h *eventHandlerImpl) GetLatestConfiguration() []*dataplane.Configuration {
 	return configs
 }
 
+// GetLatestConfigurationForGateway gets the configuration snapshot for the given Gateway.
+// Returns nil if no configuration has been built for the Gateway.
+func (h *eventHandlerImpl) GetLatestConfigurationForGateway(nsName types.NamespacedName) *dataplane.Configuration {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.latestConfigurations[nsName].Snapshot()
+}
+
 // setLatestConfiguration sets the latest configuration.
 func (h *eventHandlerImpl) setLatestConfiguration(gateway *graph.Gateway, cfg *dataplane.Configuration) {
 	if gateway == nil || gateway.Source == nil {
@@ -219,6 +219,14 @@ var _ = Describe("eventHandler", func() {
 				config := handler.GetLatestConfiguration()
 				Expect(config).To(HaveLen(1))
 				Expect(helpers.Diff(config[0], &dcfg)).To(BeEmpty())
+
+				gwConfig := handler.GetLatestConfigurationForGateway(
+					types.NamespacedName{Namespace: "test", Name: "gateway"},
+				)
+				Expect(helpers.Diff(gwConfig, &dcfg)).To(BeEmpty())
+				Expect(handler.GetLatestConfigurationForGateway(
+					types.NamespacedName{Namespace: "test", Name: "other"},
+				)).To(BeNil())
 			})
 			It("should process Delete", func() {
 				e := &events.DeleteEvent{
@@ -1541,7 +1549,7 @@ func TestReconcileWAFPollers(t *testing.T) {
 			},
 		},
 		{
-			name: "passes initial checksums to poller config",
+			name: "passes initial checksums and bundles to poller config",
 			ngfPolicies: map[graph.PolicyKey]*graph.Policy{
 				wafPolicyKey("waf-policy"): {
 					Source: makeWAFPolicy(true),
@@ -1611,9 +1619,14 @@ func TestReconcileWAFPollers(t *testing.T) {
 				},
 			}
 
+			referencedBundles := map[graph.WAFBundleKey]*graph.WAFBundleData{
+				graph.PolicyBundleKey(policyNsName): {Data: []byte("bundle"), Checksum: "abc123"},
+			}
+
 			gr := &graph.Graph{
-				NGFPolicies: tt.ngfPolicies,
-				Gateways:    tt.gateways,
+				NGFPolicies:          tt.ngfPolicies,
+				Gateways:             tt.gateways,
+				ReferencedWAFBundles: referencedBundles,
 			}
 
 			handler.reconcileWAFPollers(context.Background(), gr)
@@ -1626,6 +1639,10 @@ func TestReconcileWAFPollers(t *testing.T) {
 			g.Expect(fakeManager.StopPollerCallCount()).To(Equal(tt.expectStopPollerCount))
 			g.Expect(fakeManager.StopPollersNotInCallCount()).To(Equal(tt.expectStopNotInCount))
 
+			// The referenced bundles are persisted on every reconcile.
+			g.Expect(fakeManager.PersistBundlesCallCount()).To(Equal(1))
+			g.Expect(fakeManager.PersistBundlesArgsForCall(0)).To(Equal(referencedBundles))
+
 			if tt.expectStopNotInCount > 0 {
 				activePolicies := fakeManager.StopPollersNotInArgsForCall(0)
 				g.Expect(activePolicies).To(HaveLen(tt.expectActivePolicyCount))
@@ -1639,6 +1656,8 @@ func TestReconcileWAFPollers(t *testing.T) {
 
 				for k, v := range tt.expectInitialChecksums {
 					g.Expect(cfg.InitialChecksums).To(HaveKeyWithValue(k, v))
+					g.Expect(cfg.InitialBundles).To(HaveKey(k))
+					g.Expect(cfg.InitialBundles[k].Checksum).To(Equal(v))
 				}
 				if tt.expectSourceAuth != nil {
 					g.Expect(cfg.Sources[0].Request.Auth).To(Equal(tt.expectSourceAuth))
@@ -1896,6 +1915,52 @@ func TestMergeWAFPollErrors(t *testing.T) {
 		expectedCond := conditions.NewPolicyProgrammedStaleBundleWarning("policy bundle", "connection refused")
 		g.Expect(policy.Conditions[0]).To(Equal(expectedCond))
 	})
+
+	t.Run("reports rolled back bundle with the live checksum", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		rolloutErr := &wafPoller.RolloutError{
+			Err:            errors.New("nginx reload failed"),
+			Deployment:     types.NamespacedName{Namespace: "default", Name: "gateway-nginx"},
+			FailedChecksum: "def456",
+			LiveChecksum:   "abc123",
+		}
+
+		fakeManager := &pollerfakes.FakePollerManager{}
+		fakeManager.GetAllPollErrorsReturns(map[types.NamespacedName]wafPoller.PollError{
+			policyNsName: {BundleKey: bundleKey, BundleDescription: "policy bundle", Err: rolloutErr},
+		})
+
+		handler := &eventHandlerImpl{
+			cfg: eventHandlerConfig{wafPollerManager: fakeManager},
+		}
+
+		policy := &graph.Policy{
+			Source: makeWAFPolicy(true),
+			Valid:  true,
+			WAFState: &graph.PolicyWAFState{
+				Bundles: map[graph.WAFBundleKey]*graph.WAFBundleData{
+					bundleKey: {Checksum: "abc123"},
+				},
+			},
+		}
+
+		gr := &graph.Graph{
+			NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+				wafPolicyKey("waf-policy"): policy,
+			},
+		}
+
+		handler.mergeWAFPollErrors(gr)
+
+		g.Expect(policy.Conditions).To(HaveLen(1))
+		expectedCond := conditions.NewPolicyProgrammedBundleRolledBack(
+			"policy bundle", "def456", "abc123", rolloutErr.Error(),
+		)
+		g.Expect(policy.Conditions[0]).To(Equal(expectedCond))
+		g.Expect(policy.Conditions[0].Reason).To(Equal(string(conditions.PolicyReasonBundleRolledBack)))
+	})
 }
 
 func TestReconcileAPResourceFinalizers(t *testing.T) {
@@ -2631,3 +2696,48 @@ func TestGetLatestConfigurationReturnsSnapshots(t *testing.T) {
 	g.Expect(latest[0].WorkerProcesses).To(Equal("auto"))
 	g.Expect(latest[0].Upstreams[0].Endpoints[0].Address).To(Equal("10.0.0.1"))
 }
+
+func TestEventResourceKind(t *testing.T) {
+	t.Parallel()
+
+	apPolicy := kinds.NewAPPolicyObject()
+
+	tests := []struct {
+		event   any
+		name    string
+		expKind string
+	}{
+		{
+			name:    "upsert event",
+			event:   &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}},
+			expKind: kinds.HTTPRoute,
+		},
+		{
+			name: "delete event",
+			event: &events.DeleteEvent{
+				Type:           &v1.Service{},
+				NamespacedName: types.NamespacedName{Namespace: "test", Name: "svc"},
+			},
+			expKind: kinds.Service,
+		},
+		{
+			name:    "unstructured resource",
+			event:   &events.UpsertEvent{Resource: apPolicy},
+			expKind: kinds.APPolicy,
+		},
+		{
+			name:    "WAF bundle reconcile event",
+			event:   events.WAFBundleReconcileEvent{},
+			expKind: kinds.WAFPolicy,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(eventResourceKind(test.event)).To(Equal(test.expKind))
+		})
+	}
+}
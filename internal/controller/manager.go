@@ -46,13 +46,18 @@ import (
 	wafv1 "github.com/nginx/nginx-gateway-fabric/v2/apis/waf/v1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/crd"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/debug"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/licensing"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 	ngxcfg "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/cachepolicy"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/observability"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/ratelimit"
@@ -78,6 +83,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/runnables"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/bundlecache"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
 	s3fetch "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch/s3"
 	wafpolling "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/poller"
@@ -194,6 +200,7 @@ func StartManager(cfg config.Config) error {
 	statusUpdater := status.NewUpdater(
 		mgr.GetClient(),
 		cfg.Logger.WithName("statusUpdater"),
+		createStatusUpdaterMetricsCollector(cfg),
 	)
 
 	groupStatusUpdater := status.NewLeaderAwareGroupUpdater(statusUpdater)
@@ -205,7 +212,7 @@ func StartManager(cfg config.Config) error {
 
 	statusQueue := status.NewQueue()
 
-	nginxUpdater, err := createAgentServices(cfg, mgr, statusQueue)
+	nginxUpdater, err := createAgentServices(cfg, mgr, statusQueue, recorder)
 	if err != nil {
 		return err
 	}
@@ -215,7 +222,10 @@ func StartManager(cfg config.Config) error {
 		return err
 	}
 
-	wafPollerManager = createWAFPollerManager(ctx, cfg, wafFetcher, nginxUpdater, statusQueue, eventCh)
+	wafPollerManager, err = createWAFPollerManager(ctx, cfg, wafFetcher, nginxUpdater, statusQueue, eventCh)
+	if err != nil {
+		return err
+	}
 
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
 		ctx:              ctx,
@@ -228,6 +238,7 @@ func StartManager(cfg config.Config) error {
 		generator: ngxcfg.NewGeneratorImpl(
 			cfg.Plus,
 			&cfg.UsageReportConfig,
+			cfg.ClusterDNS,
 			cfg.Logger.WithName("generator"),
 		),
 		k8sClient:               mgr.GetClient(),
@@ -264,11 +275,17 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot register event loop: %w", err)
 	}
 
-	if err = mgr.Add(runnables.NewCallFunctionsAfterBecameLeader([]func(context.Context){
+	leaderFuncs := []func(context.Context){
 		groupStatusUpdater.Enable,
 		nginxProvisioner.Enable,
-		eventHandler.enable,
-	})); err != nil {
+	}
+	if wafPollerManager != nil {
+		// Must be enabled before the event handler, which persists the bundles in use when enabled.
+		leaderFuncs = append(leaderFuncs, wafPollerManager.EnableBundleCache)
+	}
+	leaderFuncs = append(leaderFuncs, eventHandler.enable)
+
+	if err = mgr.Add(runnables.NewCallFunctionsAfterBecameLeader(leaderFuncs)); err != nil {
 		return fmt.Errorf("cannot register functions that get called after Pod becomes leader: %w", err)
 	}
 
@@ -276,6 +293,10 @@ func StartManager(cfg config.Config) error {
 		return err
 	}
 
+	if err = registerDebugServer(cfg, mgr, processor, eventHandler, nginxUpdater.NginxDeployments); err != nil {
+		return err
+	}
+
 	cfg.Logger.Info("Starting manager")
 	go func() {
 		<-ctx.Done()
@@ -299,11 +320,57 @@ func createMetricsCollector(cfg config.Config) handlerMetricsCollector {
 	return handlerCollector
 }
 
+// createStatusUpdaterMetricsCollector creates a status updater metrics collector and registers it with the
+// Prometheus registry if enabled.
+func createStatusUpdaterMetricsCollector(cfg config.Config) status.MetricsCollector {
+	if !cfg.MetricsConfig.Enabled {
+		return collectors.NewStatusUpdaterNoopCollector()
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+
+	statusUpdaterCollector := collectors.NewStatusUpdaterCollector(constLabels)
+	metrics.Registry.MustRegister(statusUpdaterCollector)
+
+	return statusUpdaterCollector
+}
+
+// createAgentConnectionsMetricsCollector creates an agent connections metrics collector and registers it with the
+// Prometheus registry if enabled.
+func createAgentConnectionsMetricsCollector(cfg config.Config) agentgrpc.ConnectionsMetricsCollector {
+	if !cfg.MetricsConfig.Enabled {
+		return collectors.NewAgentConnectionsNoopCollector()
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+
+	agentConnectionsCollector := collectors.NewAgentConnectionsCollector(constLabels)
+	metrics.Registry.MustRegister(agentConnectionsCollector)
+
+	return agentConnectionsCollector
+}
+
+// createConfigApplyMetricsCollector creates a config apply metrics collector and registers it with the
+// Prometheus registry if enabled.
+func createConfigApplyMetricsCollector(cfg config.Config) agent.ConfigApplyMetricsCollector {
+	if !cfg.MetricsConfig.Enabled {
+		return collectors.NewConfigApplyNoopCollector()
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+
+	configApplyCollector := collectors.NewConfigApplyCollector(constLabels)
+	metrics.Registry.MustRegister(configApplyCollector)
+
+	return configApplyCollector
+}
+
 // createAgentServices creates the NGINX agent updater and gRPC server, and registers the server with the manager.
 func createAgentServices(
 	cfg config.Config,
 	mgr manager.Manager,
 	statusQueue *status.Queue,
+	recorder k8sEvents.EventRecorder,
 ) (*agent.NginxUpdaterImpl, error) {
 	resetConnChan := make(chan struct{})
 	nginxUpdater := agent.NewNginxUpdater(
@@ -312,6 +379,9 @@ func createAgentServices(
 		statusQueue,
 		resetConnChan,
 		cfg.Plus,
+		recorder,
+		createAgentConnectionsMetricsCollector(cfg),
+		createConfigApplyMetricsCollector(cfg),
 	)
 
 	tokenAudience := fmt.Sprintf(
@@ -390,6 +460,7 @@ func createAndRegisterProvisioner(
 
 // createWAFPollerManager creates a WAF polling manager if Plus is enabled.
 // Returns nil when Plus is not enabled.
+// When a WAF bundle cache directory is configured, the manager persists bundles in it.
 func createWAFPollerManager(
 	ctx context.Context,
 	cfg config.Config,
@@ -397,15 +468,25 @@ func createWAFPollerManager(
 	nginxUpdater *agent.NginxUpdaterImpl,
 	statusQueue *status.Queue,
 	eventCh chan<- any,
-) wafpolling.Manager {
+) (wafpolling.Manager, error) {
 	if !cfg.Plus {
-		return nil
+		return nil, nil //nolint:nilnil // WAF polling is only supported with NGINX Plus
+	}
+
+	var bundleCache bundlecache.Store
+	if cfg.WAFBundleCacheDir != "" {
+		store, err := bundlecache.NewDirStore(cfg.WAFBundleCacheDir)
+		if err != nil {
+			return nil, err
+		}
+		bundleCache = store
 	}
 
 	return wafpolling.NewManager(wafpolling.ManagerConfig{
 		Logger:      cfg.Logger.WithName("wafPollingManager"),
 		Fetcher:     wafFetcher,
 		Deployments: nginxUpdater.NginxDeployments,
+		BundleCache: bundleCache,
 		EventCh:     eventCh,
 		Ctx:         ctx,
 		StatusCallback: func(targets []types.NamespacedName) {
@@ -423,7 +504,7 @@ func createWAFPollerManager(
 				})
 			}
 		},
-	})
+	}), nil
 }
 
 // registerTelemetry sets up product telemetry if enabled.
@@ -464,6 +545,34 @@ func registerTelemetry(
 	return nil
 }
 
+// registerDebugServer registers the debug server with the manager if enabled.
+func registerDebugServer(
+	cfg config.Config,
+	mgr manager.Manager,
+	processor *state.ChangeProcessorImpl,
+	eventHandler *eventHandlerImpl,
+	deploymentStore *agent.DeploymentStore,
+) error {
+	if !cfg.DebugConfig.Enabled {
+		return nil
+	}
+
+	debugServer := debug.NewServer(debug.ServerConfig{
+		GraphGetter:         processor,
+		ConfigurationGetter: eventHandler,
+		DeploymentGetter:    deploymentStore,
+		K8sClient:           mgr.GetClient(),
+		Logger:              cfg.Logger.WithName("debugServer"),
+		Port:                cfg.DebugConfig.Port,
+	})
+
+	if err := mgr.Add(&runnables.LeaderOrNonLeader{Runnable: debugServer}); err != nil {
+		return fmt.Errorf("cannot register debug server: %w", err)
+	}
+
+	return nil
+}
+
 func createPolicyManager(
 	mustExtractGVK kinds.MustExtractGVK,
 	validator validation.GenericValidator,
@@ -486,9 +595,25 @@ func createPolicyManager(
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 			Validator: upstreamsettings.NewValidator(validator, cfg.Plus),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.HealthCheckPolicy{}),
+			Validator: healthcheck.NewValidator(validator, cfg.Plus),
+		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.RateLimitPolicy{}),
-			Validator: ratelimit.NewValidator(validator),
+			Validator: ratelimit.NewValidator(validator, cfg.Plus),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ConnectionLimitPolicy{}),
+			Validator: connectionlimit.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.AccessControlPolicy{}),
+			Validator: accesscontrol.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+			Validator: cachepolicy.NewValidator(validator),
 		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.WAFPolicy{}),
@@ -958,18 +1083,54 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.HealthCheckPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.AuthenticationFilter{},
 			options: []controller.Option{
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.CanaryFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.MirrorFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.RateLimitPolicy{},
 			options: []controller.Option{
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.ConnectionLimitPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.AccessControlPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.CachePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.WAFPolicy{},
 			options: []controller.Option{
@@ -1306,8 +1467,14 @@ func prepareFirstEventBatchPreparerArgs(
 		&ngfAPIv1alpha2.ObservabilityPolicyList{},
 		&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 		&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+		&ngfAPIv1alpha1.HealthCheckPolicyList{},
 		&ngfAPIv1alpha1.AuthenticationFilterList{},
+		&ngfAPIv1alpha1.CanaryFilterList{},
+		&ngfAPIv1alpha1.MirrorFilterList{},
 		&ngfAPIv1alpha1.RateLimitPolicyList{},
+		&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+		&ngfAPIv1alpha1.AccessControlPolicyList{},
+		&ngfAPIv1alpha1.CachePolicyList{},
 		&ngfAPIv1alpha1.WAFPolicyList{},
 		partialObjectMetadataList,
 	}
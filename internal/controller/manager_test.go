@@ -79,8 +79,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 				partialObjectMetadataList,
 				apPolicyList,
@@ -122,8 +128,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -155,8 +167,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				partialObjectMetadataList,
 				&gatewayv1.GatewayList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
@@ -199,8 +217,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -235,11 +259,17 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				partialObjectMetadataList,
 				&inference.InferencePoolList{},
 				&gatewayv1.GatewayList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -276,8 +306,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.SnippetsFilterList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -315,8 +351,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.SnippetsPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -352,8 +394,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&ngfAPIv1alpha1.ExternalLoadBalancerList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
@@ -401,8 +449,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.SnippetsFilterList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -434,8 +488,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 				partialObjectMetadataList,
 				&gatewayv1.GatewayList{},
@@ -484,8 +544,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.SnippetsPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -520,8 +586,14 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.HealthCheckPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.CanaryFilterList{},
+				&ngfAPIv1alpha1.MirrorFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -0,0 +1,54 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+)
+
+// AgentConnectionsCollector collects metrics for the connections between the control plane and NGINX agents.
+// Implements the prometheus.Collector interface.
+type AgentConnectionsCollector struct {
+	// Metrics
+	connectedAgents prometheus.Gauge
+}
+
+// NewAgentConnectionsCollector creates a new AgentConnectionsCollector.
+func NewAgentConnectionsCollector(constLabels map[string]string) *AgentConnectionsCollector {
+	return &AgentConnectionsCollector{
+		connectedAgents: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "connected_agents",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of NGINX agents connected to the control plane",
+				ConstLabels: constLabels,
+			},
+		),
+	}
+}
+
+// SetConnectedAgents sets the number of connected NGINX agents.
+func (c *AgentConnectionsCollector) SetConnectedAgents(count int) {
+	c.connectedAgents.Set(float64(count))
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *AgentConnectionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.connectedAgents.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *AgentConnectionsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.connectedAgents.Collect(ch)
+}
+
+// AgentConnectionsNoopCollector used to initialize the AgentConnectionsCollector when metrics are disabled to avoid
+// nil pointer errors.
+type AgentConnectionsNoopCollector struct{}
+
+// NewAgentConnectionsNoopCollector returns an instance of the AgentConnectionsNoopCollector.
+func NewAgentConnectionsNoopCollector() *AgentConnectionsNoopCollector {
+	return &AgentConnectionsNoopCollector{}
+}
+
+func (c *AgentConnectionsNoopCollector) SetConnectedAgents(_ int) {}
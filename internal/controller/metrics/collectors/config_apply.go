@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+)
+
+// ConfigApplyCollector collects metrics for the NGINX configuration applies by the NGINX agents.
+// Implements the prometheus.Collector interface.
+type ConfigApplyCollector struct {
+	// Metrics
+	configApplies    *prometheus.CounterVec
+	configGeneration *prometheus.GaugeVec
+}
+
+// NewConfigApplyCollector creates a new ConfigApplyCollector.
+func NewConfigApplyCollector(constLabels map[string]string) *ConfigApplyCollector {
+	return &ConfigApplyCollector{
+		configApplies: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "nginx_config_applies_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of NGINX configuration applies by the NGINX agents",
+				ConstLabels: constLabels,
+			},
+			[]string{"gateway", "result", "rollback"},
+		),
+		configGeneration: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "nginx_config_generation",
+				Namespace:   metrics.Namespace,
+				Help:        "Generation of the NGINX configuration that is applied to an NGINX Pod",
+				ConstLabels: constLabels,
+			},
+			[]string{"gateway", "pod"},
+		),
+	}
+}
+
+// IncrementConfigApplies increments the number of configuration applies for the Gateway.
+// The rollback argument indicates whether the apply is a rollback to the previous configuration
+// after a failed apply.
+func (c *ConfigApplyCollector) IncrementConfigApplies(gateway string, success, rollback bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+
+	c.configApplies.WithLabelValues(gateway, result, strconv.FormatBool(rollback)).Inc()
+}
+
+// SetConfigGeneration sets the generation of the configuration that is applied to the Pod of the Gateway.
+func (c *ConfigApplyCollector) SetConfigGeneration(gateway, pod string, generation int64) {
+	c.configGeneration.WithLabelValues(gateway, pod).Set(float64(generation))
+}
+
+// DeleteConfigGeneration deletes the configuration generation of the Pod of the Gateway.
+func (c *ConfigApplyCollector) DeleteConfigGeneration(gateway, pod string) {
+	c.configGeneration.DeleteLabelValues(gateway, pod)
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *ConfigApplyCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.configApplies.Describe(ch)
+	c.configGeneration.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *ConfigApplyCollector) Collect(ch chan<- prometheus.Metric) {
+	c.configApplies.Collect(ch)
+	c.configGeneration.Collect(ch)
+}
+
+// ConfigApplyNoopCollector used to initialize the ConfigApplyCollector when metrics are disabled to avoid
+// nil pointer errors.
+type ConfigApplyNoopCollector struct{}
+
+// NewConfigApplyNoopCollector returns an instance of the ConfigApplyNoopCollector.
+func NewConfigApplyNoopCollector() *ConfigApplyNoopCollector {
+	return &ConfigApplyNoopCollector{}
+}
+
+func (c *ConfigApplyNoopCollector) IncrementConfigApplies(_ string, _, _ bool) {}
+
+func (c *ConfigApplyNoopCollector) SetConfigGeneration(_, _ string, _ int64) {}
+
+func (c *ConfigApplyNoopCollector) DeleteConfigGeneration(_, _ string) {}
@@ -13,6 +13,9 @@ import (
 type ControllerCollector struct {
 	// Metrics
 	eventBatchProcessDuration prometheus.Histogram
+	eventsProcessed           *prometheus.CounterVec
+	graphBuildDuration        prometheus.Histogram
+	configGenerationDuration  prometheus.Histogram
 }
 
 // NewControllerCollector creates a new ControllerCollector.
@@ -27,6 +30,33 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		eventsProcessed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "events_processed_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of processed events by resource kind",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
+		graphBuildDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "graph_build_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of building the graph of resources",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		configGenerationDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "nginx_config_generation_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of generating the NGINX configuration",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+		),
 	}
 	return nc
 }
@@ -36,14 +66,35 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// IncrementEventsProcessed increments the number of processed events for the resource kind.
+func (c *ControllerCollector) IncrementEventsProcessed(kind string) {
+	c.eventsProcessed.WithLabelValues(kind).Inc()
+}
+
+// ObserveGraphBuildTime adds the graph build time to the histogram.
+func (c *ControllerCollector) ObserveGraphBuildTime(duration time.Duration) {
+	c.graphBuildDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveConfigGenerationTime adds the NGINX configuration generation time to the histogram.
+func (c *ControllerCollector) ObserveConfigGenerationTime(duration time.Duration) {
+	c.configGenerationDuration.Observe(float64(duration / time.Millisecond))
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.eventsProcessed.Describe(ch)
+	c.graphBuildDuration.Describe(ch)
+	c.configGenerationDuration.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.eventsProcessed.Collect(ch)
+	c.graphBuildDuration.Collect(ch)
+	c.configGenerationDuration.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +107,9 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) IncrementEventsProcessed(_ string) {}
+
+func (c *ControllerNoopCollector) ObserveGraphBuildTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveConfigGenerationTime(_ time.Duration) {}
@@ -0,0 +1,115 @@
+package collectors
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+)
+
+// EndpointPickerCollector collects metrics for the EndpointPicker shim.
+// Implements the prometheus.Collector interface.
+type EndpointPickerCollector struct {
+	// Metrics
+	requestDuration    prometheus.Histogram
+	requestErrors      *prometheus.CounterVec
+	immediateResponses *prometheus.CounterVec
+	pooledConnections  prometheus.Gauge
+}
+
+// NewEndpointPickerCollector creates a new EndpointPickerCollector.
+func NewEndpointPickerCollector(constLabels map[string]string) *EndpointPickerCollector {
+	return &EndpointPickerCollector{
+		requestDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "endpoint_picker_request_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of requests to the EndpointPicker",
+				ConstLabels: constLabels,
+				Buckets:     []float64{5, 10, 25, 50, 100, 250, 500, 1000, 5000},
+			},
+		),
+		requestErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "endpoint_picker_request_errors_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of failed requests to the EndpointPicker by reason",
+				ConstLabels: constLabels,
+			},
+			[]string{"reason"},
+		),
+		immediateResponses: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "endpoint_picker_immediate_responses_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of immediate responses returned by the EndpointPicker by status code",
+				ConstLabels: constLabels,
+			},
+			[]string{"code"},
+		),
+		pooledConnections: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "endpoint_picker_pooled_connections",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of pooled gRPC connections to EndpointPickers",
+				ConstLabels: constLabels,
+			},
+		),
+	}
+}
+
+// ObserveRequestTime adds an observation for the duration of a request to the EndpointPicker.
+func (c *EndpointPickerCollector) ObserveRequestTime(duration time.Duration) {
+	c.requestDuration.Observe(float64(duration.Milliseconds()))
+}
+
+// IncrementRequestErrors increments the number of failed requests to the EndpointPicker for the reason.
+func (c *EndpointPickerCollector) IncrementRequestErrors(reason string) {
+	c.requestErrors.WithLabelValues(reason).Inc()
+}
+
+// IncrementImmediateResponses increments the number of immediate responses returned by the EndpointPicker
+// for the status code.
+func (c *EndpointPickerCollector) IncrementImmediateResponses(code int) {
+	c.immediateResponses.WithLabelValues(strconv.Itoa(code)).Inc()
+}
+
+// SetPooledConnections sets the number of pooled gRPC connections to EndpointPickers.
+func (c *EndpointPickerCollector) SetPooledConnections(count int) {
+	c.pooledConnections.Set(float64(count))
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *EndpointPickerCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.requestDuration.Describe(ch)
+	c.requestErrors.Describe(ch)
+	c.immediateResponses.Describe(ch)
+	c.pooledConnections.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *EndpointPickerCollector) Collect(ch chan<- prometheus.Metric) {
+	c.requestDuration.Collect(ch)
+	c.requestErrors.Collect(ch)
+	c.immediateResponses.Collect(ch)
+	c.pooledConnections.Collect(ch)
+}
+
+// EndpointPickerNoopCollector used to initialize the EndpointPickerCollector when metrics are disabled to avoid
+// nil pointer errors.
+type EndpointPickerNoopCollector struct{}
+
+// NewEndpointPickerNoopCollector returns an instance of the EndpointPickerNoopCollector.
+func NewEndpointPickerNoopCollector() *EndpointPickerNoopCollector {
+	return &EndpointPickerNoopCollector{}
+}
+
+func (c *EndpointPickerNoopCollector) ObserveRequestTime(_ time.Duration) {}
+
+func (c *EndpointPickerNoopCollector) IncrementRequestErrors(_ string) {}
+
+func (c *EndpointPickerNoopCollector) IncrementImmediateResponses(_ int) {}
+
+func (c *EndpointPickerNoopCollector) SetPooledConnections(_ int) {}
@@ -0,0 +1,77 @@
+package collectors
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+)
+
+// StatusUpdaterCollector collects metrics for the status updater.
+// Implements the prometheus.Collector interface.
+type StatusUpdaterCollector struct {
+	// Metrics
+	statusUpdateDuration *prometheus.HistogramVec
+	statusUpdateFailures *prometheus.CounterVec
+}
+
+// NewStatusUpdaterCollector creates a new StatusUpdaterCollector.
+func NewStatusUpdaterCollector(constLabels map[string]string) *StatusUpdaterCollector {
+	return &StatusUpdaterCollector{
+		statusUpdateDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "status_update_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of updating the status of a resource",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+			[]string{"kind"},
+		),
+		statusUpdateFailures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "status_update_failures_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of failed status updates of resources",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
+	}
+}
+
+// ObserveStatusUpdateTime adds the status update time of a resource of the kind to the histogram.
+func (c *StatusUpdaterCollector) ObserveStatusUpdateTime(kind string, duration time.Duration) {
+	c.statusUpdateDuration.WithLabelValues(kind).Observe(float64(duration / time.Millisecond))
+}
+
+// IncrementStatusUpdateFailures increments the number of failed status updates for the resource kind.
+func (c *StatusUpdaterCollector) IncrementStatusUpdateFailures(kind string) {
+	c.statusUpdateFailures.WithLabelValues(kind).Inc()
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *StatusUpdaterCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.statusUpdateDuration.Describe(ch)
+	c.statusUpdateFailures.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *StatusUpdaterCollector) Collect(ch chan<- prometheus.Metric) {
+	c.statusUpdateDuration.Collect(ch)
+	c.statusUpdateFailures.Collect(ch)
+}
+
+// StatusUpdaterNoopCollector used to initialize the StatusUpdaterCollector when metrics are disabled to avoid
+// nil pointer errors.
+type StatusUpdaterNoopCollector struct{}
+
+// NewStatusUpdaterNoopCollector returns an instance of the StatusUpdaterNoopCollector.
+func NewStatusUpdaterNoopCollector() *StatusUpdaterNoopCollector {
+	return &StatusUpdaterNoopCollector{}
+}
+
+func (c *StatusUpdaterNoopCollector) ObserveStatusUpdateTime(_ string, _ time.Duration) {}
+
+func (c *StatusUpdaterNoopCollector) IncrementStatusUpdateFailures(_ string) {}
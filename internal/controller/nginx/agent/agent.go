@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	k8sEvents "k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
@@ -51,8 +52,11 @@ func NewNginxUpdater(
 	statusQueue *status.Queue,
 	resetConnChan <-chan struct{},
 	plus bool,
+	eventRecorder k8sEvents.EventRecorder,
+	connectionsMetricsCollector agentgrpc.ConnectionsMetricsCollector,
+	configApplyMetricsCollector ConfigApplyMetricsCollector,
 ) *NginxUpdaterImpl {
-	connTracker := agentgrpc.NewConnectionsTracker()
+	connTracker := agentgrpc.NewConnectionsTracker(connectionsMetricsCollector)
 	nginxDeployments := NewDeploymentStore(connTracker)
 
 	commandService := newCommandService(
@@ -62,6 +66,8 @@ func NewNginxUpdater(
 		connTracker,
 		statusQueue,
 		resetConnChan,
+		eventRecorder,
+		configApplyMetricsCollector,
 	)
 	fileService := newFileService(logger.WithName("fileService"), nginxDeployments, connTracker)
 
@@ -11,8 +11,10 @@ import (
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/structpb"
 	v1 "k8s.io/api/core/v1"
+	k8sEvents "k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
@@ -46,7 +48,16 @@ func TestUpdateConfig(t *testing.T) {
 			fakeBroadcaster.SendReturns(true)
 
 			plus := false
-			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, plus)
+			updater := NewNginxUpdater(
+				logr.Discard(),
+				fake.NewFakeClient(),
+				&status.Queue{},
+				nil,
+				plus,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewAgentConnectionsNoopCollector(),
+				collectors.NewConfigApplyNoopCollector(),
+			)
 			deployment := &Deployment{
 				broadcaster: fakeBroadcaster,
 				podStatuses: make(map[string]error),
@@ -92,7 +103,16 @@ func TestUpdateConfig_NoChange(t *testing.T) {
 
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, false)
+	updater := NewNginxUpdater(
+		logr.Discard(),
+		fake.NewFakeClient(),
+		&status.Queue{},
+		nil,
+		false,
+		&k8sEvents.FakeRecorder{},
+		collectors.NewAgentConnectionsNoopCollector(),
+		collectors.NewConfigApplyNoopCollector(),
+	)
 
 	deployment := &Deployment{
 		broadcaster: fakeBroadcaster,
@@ -166,7 +186,16 @@ func TestUpdateUpstreamServers(t *testing.T) {
 
 			fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, test.plus)
+			updater := NewNginxUpdater(
+				logr.Discard(),
+				fake.NewFakeClient(),
+				&status.Queue{},
+				nil,
+				test.plus,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewAgentConnectionsNoopCollector(),
+				collectors.NewConfigApplyNoopCollector(),
+			)
 			updater.retryTimeout = 0
 
 			deployment := &Deployment{
@@ -293,7 +322,16 @@ func TestUpdateUpstreamServers_NoChange(t *testing.T) {
 
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, true)
+	updater := NewNginxUpdater(
+		logr.Discard(),
+		fake.NewFakeClient(),
+		&status.Queue{},
+		nil,
+		true,
+		&k8sEvents.FakeRecorder{},
+		collectors.NewAgentConnectionsNoopCollector(),
+		collectors.NewConfigApplyNoopCollector(),
+	)
 	updater.retryTimeout = 0
 
 	deployment := &Deployment{
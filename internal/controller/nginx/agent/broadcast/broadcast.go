@@ -247,6 +247,8 @@ const (
 type NginxAgentMessage struct {
 	// ConfigVersion is the hashed configuration version of the included files.
 	ConfigVersion string
+	// ConfigGeneration is the generation of the configuration of the included files.
+	ConfigGeneration int64
 	// NGINXPlusAction is an NGINX Plus API action to be sent.
 	NGINXPlusAction *pb.NGINXPlusAction
 	// FileOverviews contain the overviews of all files to be sent.
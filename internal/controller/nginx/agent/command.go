@@ -19,7 +19,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	k8sEvents "k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
@@ -29,7 +31,20 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
 )
 
-const connectionWaitTimeout = 30 * time.Second
+const (
+	connectionWaitTimeout = 30 * time.Second
+
+	// configApplyFailedReason is the reason of the Event that is emitted on a Gateway when nginx fails
+	// to apply its configuration.
+	configApplyFailedReason = "ConfigApplyFailed"
+)
+
+// ConfigApplyMetricsCollector collects metrics for configuration applies by the nginx agents.
+type ConfigApplyMetricsCollector interface {
+	IncrementConfigApplies(gateway string, success, rollback bool)
+	SetConfigGeneration(gateway, pod string, generation int64)
+	DeleteConfigGeneration(gateway, pod string)
+}
 
 // commandService handles the connection and subscription to the data plane agent.
 type commandService struct {
@@ -39,6 +54,8 @@ type commandService struct {
 	resetConnChan     <-chan struct{}
 	connTracker       agentgrpc.ConnectionsTracker
 	k8sReader         client.Reader
+	eventRecorder     k8sEvents.EventRecorder
+	metricsCollector  ConfigApplyMetricsCollector
 	logger            logr.Logger
 	connectionTimeout time.Duration
 }
@@ -50,6 +67,8 @@ func newCommandService(
 	connTracker agentgrpc.ConnectionsTracker,
 	statusQueue *status.Queue,
 	resetConnChan <-chan struct{},
+	eventRecorder k8sEvents.EventRecorder,
+	metricsCollector ConfigApplyMetricsCollector,
 ) *commandService {
 	return &commandService{
 		connectionTimeout: connectionWaitTimeout,
@@ -59,6 +78,8 @@ func newCommandService(
 		nginxDeployments:  depStore,
 		statusQueue:       statusQueue,
 		resetConnChan:     resetConnChan,
+		eventRecorder:     eventRecorder,
+		metricsCollector:  metricsCollector,
 	}
 }
 
@@ -106,6 +127,7 @@ func (cs *commandService) CreateConnection(
 	conn := agentgrpc.Connection{
 		ParentName: name,
 		ParentType: depType,
+		PodName:    podName,
 		InstanceID: getNginxInstanceID(resource.GetInstances()),
 	}
 	cs.connTracker.Track(grpcInfo.UUID, conn)
@@ -144,6 +166,9 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 	}
 	defer deployment.RemovePodStatus(grpcInfo.UUID)
 
+	gatewayNsName := types.NamespacedName{Namespace: conn.ParentName.Namespace, Name: deployment.gatewayName}
+	defer cs.metricsCollector.DeleteConfigGeneration(gatewayNsName.String(), conn.PodName)
+
 	cs.logger.Info(
 		"Successfully connected to nginx agent",
 		conn.ParentType, conn.ParentName,
@@ -194,6 +219,9 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 	//
 	// An empty value means there is no broadcast request currently in flight.
 	var pendingCorrelationID string
+	// pendingConfigGeneration is the config generation of the in-flight broadcast request if it is a
+	// ConfigApplyRequest. A zero value means that the in-flight request doesn't apply a configuration.
+	var pendingConfigGeneration int64
 
 	for {
 		// When a message is received over the ListenCh, it is assumed and required that the
@@ -216,9 +244,11 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			return grpcStatus.Error(codes.Unavailable, "TLS files updated")
 		case msg := <-channels.ListenCh:
 			var req *pb.ManagementPlaneRequest
+			pendingConfigGeneration = 0
 			switch msg.Type {
 			case broadcast.ConfigApplyRequest:
 				req = buildRequest(msg.FileOverviews, conn.InstanceID, msg.ConfigVersion)
+				pendingConfigGeneration = msg.ConfigGeneration
 				cs.logger.V(1).Info(
 					"Sending configuration to agent",
 					"requestType", msg.Type,
@@ -274,22 +304,31 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			}
 
 			res := msg.GetCommandResponse()
+			var applyErr error
 			if res.GetStatus() != pb.CommandResponse_COMMAND_STATUS_OK {
 				if isRollbackMessage(res.GetMessage()) {
-					// we don't care about these messages, so ignore them
+					// the failed apply has already been handled, so only record the outcome of the rollback
+					cs.metricsCollector.IncrementConfigApplies(
+						gatewayNsName.String(),
+						!isRollbackFailedMessage(res.GetMessage()),
+						true,
+					)
 					continue
 				}
-				err := fmt.Errorf("msg: %s; error: %s", res.GetMessage(), res.GetError())
-				deployment.SetPodErrorStatus(grpcInfo.UUID, err)
-			} else {
-				deployment.SetPodErrorStatus(grpcInfo.UUID, nil)
+				applyErr = fmt.Errorf("msg: %s; error: %s", res.GetMessage(), res.GetError())
 			}
+			deployment.SetPodErrorStatus(grpcInfo.UUID, applyErr)
 
 			// Signal broadcast completion only for tracked broadcast operations.
 			// Initial config responses are ignored to prevent spurious success messages.
 			if pendingCorrelationID != "" {
+				if pendingConfigGeneration != 0 {
+					cs.recordConfigApply(ctx, gatewayNsName, conn.PodName, pendingConfigGeneration, applyErr)
+				}
+
 				signalBroadcastResponse(ctx, channels.ResponseCh)
 				pendingCorrelationID = ""
+				pendingConfigGeneration = 0
 			} else {
 				cs.logger.V(1).Info(
 					"Received response for non-broadcast request (likely initial config)",
@@ -364,6 +403,7 @@ func (cs *commandService) setInitialConfig(
 	}
 
 	fileOverviews, configVersion := deployment.GetFileOverviews()
+	configGeneration := deployment.GetConfigGeneration()
 
 	cs.logger.Info(
 		"Sending initial configuration to agent",
@@ -386,6 +426,9 @@ func (cs *commandService) setInitialConfig(
 		return connErr
 	}
 
+	gatewayNsName := types.NamespacedName{Namespace: conn.ParentName.Namespace, Name: deployment.gatewayName}
+	cs.recordConfigApply(ctx, gatewayNsName, conn.PodName, configGeneration, applyErr)
+
 	errs := []error{applyErr}
 	for _, action := range deployment.GetNGINXPlusActions() {
 		// retry the API update request because sometimes nginx isn't quite ready after the config apply reload
@@ -514,6 +557,54 @@ func (cs *commandService) logAndSendErrorStatus(
 	cs.statusQueue.Enqueue(queueObj)
 }
 
+// recordConfigApply records the result of a configuration apply by the nginx agent of a Pod. If the configuration
+// was applied, the config generation of the Pod is updated. Otherwise, a Warning Event is emitted on the Gateway.
+func (cs *commandService) recordConfigApply(
+	ctx context.Context,
+	gatewayNsName types.NamespacedName,
+	podName string,
+	generation int64,
+	applyErr error,
+) {
+	cs.metricsCollector.IncrementConfigApplies(gatewayNsName.String(), applyErr == nil, false)
+
+	if applyErr == nil {
+		cs.metricsCollector.SetConfigGeneration(gatewayNsName.String(), podName, generation)
+		return
+	}
+
+	// Getting the Gateway requires an API call, so don't block the config apply transaction on it.
+	go cs.emitConfigApplyFailedEvent(ctx, gatewayNsName, podName, applyErr)
+}
+
+func (cs *commandService) emitConfigApplyFailedEvent(
+	ctx context.Context,
+	gatewayNsName types.NamespacedName,
+	podName string,
+	applyErr error,
+) {
+	var gw gatewayv1.Gateway
+	if err := cs.k8sReader.Get(ctx, gatewayNsName, &gw); err != nil {
+		cs.logger.V(1).Info(
+			"Unable to get Gateway to emit config apply failure event",
+			"gateway", gatewayNsName,
+			"error", err,
+		)
+		return
+	}
+
+	cs.eventRecorder.Eventf(
+		&gw,
+		nil,
+		v1.EventTypeWarning,
+		configApplyFailedReason,
+		"ApplyConfig",
+		"Failed to apply NGINX configuration to Pod %s: %s",
+		podName,
+		applyErr.Error(),
+	)
+}
+
 func buildRequest(fileOverviews []*pb.File, instanceID, version string) *pb.ManagementPlaneRequest {
 	return &pb.ManagementPlaneRequest{
 		MessageMeta: &pb.MessageMeta{
@@ -541,6 +632,10 @@ func isRollbackMessage(msg string) bool {
 		strings.Contains(msgToLower, "rollback failed")
 }
 
+func isRollbackFailedMessage(msg string) bool {
+	return strings.Contains(strings.ToLower(msg), "rollback failed")
+}
+
 func buildPlusAPIRequest(action *pb.NGINXPlusAction, instanceID string) *pb.ManagementPlaneRequest {
 	return &pb.ManagementPlaneRequest{
 		MessageMeta: &pb.MessageMeta{
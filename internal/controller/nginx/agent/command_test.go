@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"maps"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,9 +19,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8sEvents "k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
@@ -412,6 +418,8 @@ func TestCreateConnection(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewConfigApplyNoopCollector(),
 			)
 
 			resp, err := cs.CreateConnection(test.ctx, test.request)
@@ -430,6 +438,7 @@ func TestCreateConnection(t *testing.T) {
 			expConn := agentgrpc.Connection{
 				ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
 				ParentType: nginxTypes.DeploymentType,
+				PodName:    "nginx-pod",
 				InstanceID: "nginx-id",
 			}
 
@@ -512,6 +521,8 @@ func TestSubscribe(t *testing.T) {
 		&connTracker,
 		status.NewQueue(),
 		nil,
+		&k8sEvents.FakeRecorder{},
+		collectors.NewConfigApplyNoopCollector(),
 	)
 
 	broadcaster := &broadcastfakes.FakeBroadcaster{}
@@ -658,6 +669,8 @@ func TestSubscribe_Reset(t *testing.T) {
 		&connTracker,
 		status.NewQueue(),
 		resetChan,
+		&k8sEvents.FakeRecorder{},
+		collectors.NewConfigApplyNoopCollector(),
 	)
 
 	broadcaster := &broadcastfakes.FakeBroadcaster{}
@@ -710,6 +723,229 @@ func TestSubscribe_Reset(t *testing.T) {
 	}).Should(MatchError(ContainSubstring("TLS files updated")))
 }
 
+type configApply struct {
+	gateway  string
+	success  bool
+	rollback bool
+}
+
+type fakeConfigApplyMetricsCollector struct {
+	generations map[string]int64
+	applies     []configApply
+	lock        sync.Mutex
+}
+
+func (f *fakeConfigApplyMetricsCollector) IncrementConfigApplies(gateway string, success, rollback bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.applies = append(f.applies, configApply{gateway: gateway, success: success, rollback: rollback})
+}
+
+func (f *fakeConfigApplyMetricsCollector) SetConfigGeneration(gateway, pod string, generation int64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.generations[gateway+"/"+pod] = generation
+}
+
+func (f *fakeConfigApplyMetricsCollector) DeleteConfigGeneration(gateway, pod string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	delete(f.generations, gateway+"/"+pod)
+}
+
+func (f *fakeConfigApplyMetricsCollector) getApplies() []configApply {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]configApply{}, f.applies...)
+}
+
+func (f *fakeConfigApplyMetricsCollector) getGenerations() map[string]int64 {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return maps.Clone(f.generations)
+}
+
+func TestSubscribe_ConfigApplyMetricsAndEvents(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connTracker := agentgrpcfakes.FakeConnectionsTracker{}
+	conn := agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+		ParentType: nginxTypes.DeploymentType,
+		PodName:    "nginx-pod",
+		InstanceID: "nginx-id",
+	}
+	connTracker.GetConnectionReturns(conn)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "test",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(append(getDefaultResources(), gateway)...).
+		Build()
+
+	recorder := k8sEvents.NewFakeRecorder(1)
+	metricsCollector := &fakeConfigApplyMetricsCollector{generations: make(map[string]int64)}
+
+	store := NewDeploymentStore(&connTracker)
+	cs := newCommandService(
+		logr.Discard(),
+		fakeClient,
+		store,
+		&connTracker,
+		status.NewQueue(),
+		nil,
+		recorder,
+		metricsCollector,
+	)
+
+	broadcaster := &broadcastfakes.FakeBroadcaster{}
+	responseCh := make(chan struct{})
+	listenCh := make(chan broadcast.NginxAgentMessage, 2)
+	subChannels := broadcast.SubscriberChannels{
+		ListenCh:   listenCh,
+		ResponseCh: responseCh,
+	}
+	broadcaster.SubscribeReturns(subChannels)
+
+	deployment := store.StoreWithBroadcaster(conn.ParentName, broadcaster, "gateway")
+	deployment.SetFiles([]File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "nginx.conf",
+				Hash: "12345",
+			},
+			Contents: []byte("file contents"),
+		},
+	}, []v1.VolumeMount{})
+	deployment.SetImageVersion("nginx:v1.0.0")
+
+	ctx, cancel := createGrpcContextWithCancel(t)
+	defer cancel()
+
+	mockServer := newMockSubscribeServer(ctx)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- cs.Subscribe(mockServer)
+	}()
+
+	// initial config is applied successfully
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status: pb.CommandResponse_COMMAND_STATUS_OK,
+		},
+	}
+
+	g.Eventually(func() string {
+		obj := cs.statusQueue.Dequeue(ctx)
+		return obj.Deployment.NamespacedName.Name
+	}).Should(Equal("nginx-deployment"))
+
+	g.Expect(metricsCollector.getApplies()).To(Equal([]configApply{
+		{gateway: "test/gateway", success: true},
+	}))
+	g.Expect(metricsCollector.getGenerations()).To(Equal(map[string]int64{"test/gateway/nginx-pod": 1}))
+
+	// new config fails to apply and is rolled back
+	msg := deployment.SetFiles([]File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "nginx.conf",
+				Hash: "56789",
+			},
+			Contents: []byte("new file contents"),
+		},
+	}, []v1.VolumeMount{})
+	g.Expect(msg).ToNot(BeNil())
+	listenCh <- *msg
+
+	<-mockServer.sendChan
+
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status:  pb.CommandResponse_COMMAND_STATUS_ERROR,
+			Message: "config apply failed",
+			Error:   "invalid directive",
+		},
+	}
+
+	g.Eventually(func() struct{} {
+		return <-responseCh
+	}).Should(Equal(struct{}{}))
+
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status:  pb.CommandResponse_COMMAND_STATUS_FAILURE,
+			Message: "Config apply failed, rollback successful",
+		},
+	}
+
+	g.Eventually(metricsCollector.getApplies).Should(Equal([]configApply{
+		{gateway: "test/gateway", success: true},
+		{gateway: "test/gateway", success: false},
+		{gateway: "test/gateway", success: true, rollback: true},
+	}))
+	// generation is not updated for a failed apply
+	g.Expect(metricsCollector.getGenerations()).To(Equal(map[string]int64{"test/gateway/nginx-pod": 1}))
+
+	g.Eventually(recorder.Events).Should(Receive(Equal(
+		"Warning ConfigApplyFailed Failed to apply NGINX configuration to Pod nginx-pod: " +
+			"msg: config apply failed; error: invalid directive",
+	)))
+
+	// the generation is taken from the message, since the deployment can be updated while the request is in flight
+	msg = deployment.SetFiles([]File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "nginx.conf",
+				Hash: "abcde",
+			},
+			Contents: []byte("newer file contents"),
+		},
+	}, []v1.VolumeMount{})
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.ConfigGeneration).To(Equal(int64(3)))
+	msg.ConfigGeneration = 10
+	listenCh <- *msg
+
+	<-mockServer.sendChan
+
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status: pb.CommandResponse_COMMAND_STATUS_OK,
+		},
+	}
+
+	g.Eventually(func() struct{} {
+		return <-responseCh
+	}).Should(Equal(struct{}{}))
+
+	g.Eventually(metricsCollector.getGenerations).Should(Equal(map[string]int64{"test/gateway/nginx-pod": 10}))
+
+	cancel()
+
+	g.Eventually(func() error {
+		return <-errCh
+	}).Should(MatchError(ContainSubstring("context canceled")))
+
+	g.Expect(metricsCollector.getGenerations()).To(BeEmpty())
+}
+
 func TestSubscribe_Errors(t *testing.T) {
 	t.Parallel()
 
@@ -764,6 +1000,8 @@ func TestSubscribe_Errors(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewConfigApplyNoopCollector(),
 			)
 
 			if test.setup != nil {
@@ -890,6 +1128,8 @@ func TestSetInitialConfig_Errors(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewConfigApplyNoopCollector(),
 			)
 
 			conn := &agentgrpc.Connection{
@@ -996,6 +1236,8 @@ func TestUpdateDataPlaneStatus(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&k8sEvents.FakeRecorder{},
+				collectors.NewConfigApplyNoopCollector(),
 			)
 
 			resp, err := cs.UpdateDataPlaneStatus(test.ctx, test.request)
@@ -1035,6 +1277,8 @@ func TestUpdateDataPlaneHealth(t *testing.T) {
 		&connTracker,
 		status.NewQueue(),
 		nil,
+		&k8sEvents.FakeRecorder{},
+		collectors.NewConfigApplyNoopCollector(),
 	)
 
 	resp, err := cs.UpdateDataPlaneHealth(t.Context(), &pb.UpdateDataPlaneHealthRequest{})
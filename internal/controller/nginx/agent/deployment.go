@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -40,6 +41,10 @@ type Deployment struct {
 	// podStatuses is a map of all Pods for this Deployment and the most recent error
 	// (or nil if successful) that occurred on a config call to the nginx agent.
 	podStatuses map[string]error
+	// podStatusVersions maps each Pod to the statusVersion at which its status was last set.
+	podStatusVersions map[string]uint64
+	// statusVersion is incremented every time the status of a Pod is set.
+	statusVersion uint64
 
 	broadcaster broadcast.Broadcaster
 
@@ -49,6 +54,8 @@ type Deployment struct {
 	imageVersion string
 
 	configVersion string
+	// configGeneration is incremented every time the configVersion changes.
+	configGeneration int64
 	// error that is set if a ConfigApply call failed for a Pod. This is needed
 	// because if subsequent upstream API calls are made within the same update event,
 	// and are successful, the previous error would be lost in the podStatuses map.
@@ -74,9 +81,10 @@ type Deployment struct {
 // newDeployment returns a new Deployment object.
 func newDeployment(broadcaster broadcast.Broadcaster, gatewayName string) *Deployment {
 	return &Deployment{
-		broadcaster: broadcaster,
-		podStatuses: make(map[string]error),
-		gatewayName: gatewayName,
+		broadcaster:       broadcaster,
+		podStatuses:       make(map[string]error),
+		podStatusVersions: make(map[string]uint64),
+		gatewayName:       gatewayName,
 	}
 }
 
@@ -145,6 +153,12 @@ func (d *Deployment) SetPodErrorStatus(pod string, err error) {
 	defer d.errLock.Unlock()
 
 	d.podStatuses[pod] = err
+
+	if d.podStatusVersions == nil {
+		d.podStatusVersions = make(map[string]uint64)
+	}
+	d.statusVersion++
+	d.podStatusVersions[pod] = d.statusVersion
 }
 
 // RemovePodStatus deletes a pod from the pod status map.
@@ -153,6 +167,16 @@ func (d *Deployment) RemovePodStatus(podName string) {
 	defer d.errLock.Unlock()
 
 	delete(d.podStatuses, podName)
+	delete(d.podStatusVersions, podName)
+}
+
+// GetPodStatuses returns a copy of the most recent config status of each Pod in this Deployment,
+// keyed by the ID of the Pod's agent connection.
+func (d *Deployment) GetPodStatuses() map[string]error {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return maps.Clone(d.podStatuses)
 }
 
 // GetConfigurationStatus returns the current config status for this Deployment. It combines
@@ -173,6 +197,31 @@ func (d *Deployment) GetConfigurationStatus() error {
 	return errors.Join(errs...)
 }
 
+// GetPodStatusVersion returns the version of the most recently set Pod status. Passing it to
+// GetConfigurationStatusSince before sending a message returns the status of the Pods that responded to it.
+func (d *Deployment) GetPodStatusVersion() uint64 {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return d.statusVersion
+}
+
+// GetConfigurationStatusSince returns the config status of the Pods whose status was set after the given
+// version, combining their errors into a single error. Errors of Pods that haven't responded since are ignored.
+func (d *Deployment) GetConfigurationStatusSince(version uint64) error {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	var errs []error
+	for pod, err := range d.podStatuses {
+		if d.podStatusVersions[pod] > version {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 /*
 The following functions for the Deployment object are UNLOCKED, meaning that they are unsafe.
 Callers of these functions MUST ensure the FileLock is set before calling.
@@ -187,6 +236,12 @@ func (d *Deployment) GetFileOverviews() ([]*pb.File, string) {
 	return d.fileOverviews, d.configVersion
 }
 
+// GetConfigGeneration returns the generation of the current configuration for the deployment.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) GetConfigGeneration() int64 {
+	return d.configGeneration
+}
+
 // GetNGINXPlusActions returns the current NGINX Plus API Actions for the deployment.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) GetNGINXPlusActions() []*pb.NGINXPlusAction {
@@ -317,12 +372,14 @@ func (d *Deployment) rebuildFileOverviews() *broadcast.NginxAgentMessage {
 	}
 
 	d.configVersion = newConfigVersion
+	d.configGeneration++
 	d.fileOverviews = fileOverviews
 
 	return &broadcast.NginxAgentMessage{
-		Type:          broadcast.ConfigApplyRequest,
-		FileOverviews: fileOverviews,
-		ConfigVersion: d.configVersion,
+		Type:             broadcast.ConfigApplyRequest,
+		FileOverviews:    fileOverviews,
+		ConfigVersion:    d.configVersion,
+		ConfigGeneration: d.configGeneration,
 	}
 }
 
@@ -394,6 +451,12 @@ func (d *DeploymentStore) StoreWithBroadcaster(
 	return deployment
 }
 
+// GetPodName returns the name of the Pod for the given agent connection ID.
+// Returns an empty string if the connection is not tracked.
+func (d *DeploymentStore) GetPodName(connID string) string {
+	return d.connTracker.GetConnection(connID).PodName
+}
+
 // Remove the deployment from the store.
 func (d *DeploymentStore) Remove(nsName types.NamespacedName) {
 	d.deployments.Delete(nsName)
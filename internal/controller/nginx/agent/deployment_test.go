@@ -12,6 +12,7 @@ import (
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 	agentgrpcfakes "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/grpcfakes"
 )
 
@@ -51,6 +52,7 @@ func TestSetAndGetFiles(t *testing.T) {
 
 	g.Expect(msg.Type).To(Equal(broadcast.ConfigApplyRequest))
 	g.Expect(msg.ConfigVersion).To(Equal(configVersion))
+	g.Expect(msg.ConfigGeneration).To(Equal(deployment.GetConfigGeneration()))
 	g.Expect(msg.FileOverviews).To(HaveLen(9)) // 1 file + 8 ignored files
 	g.Expect(fileOverviews).To(Equal(msg.FileOverviews))
 
@@ -218,8 +220,40 @@ func TestSetPodErrorStatus(t *testing.T) {
 	g.Expect(deployment.GetConfigurationStatus()).To(MatchError(ContainSubstring("test error")))
 	g.Expect(deployment.GetConfigurationStatus()).To(MatchError(ContainSubstring("test error 2")))
 
+	statuses := deployment.GetPodStatuses()
+	g.Expect(statuses).To(HaveLen(2))
+	g.Expect(statuses).To(HaveKeyWithValue("test-pod", err))
+	g.Expect(statuses).To(HaveKeyWithValue("test-pod2", err2))
+
 	deployment.RemovePodStatus("test-pod")
 	g.Expect(deployment.podStatuses).ToNot(HaveKey("test-pod"))
+	g.Expect(statuses).To(HaveKey("test-pod"))
+}
+
+func TestGetConfigurationStatusSince(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+
+	staleErr := errors.New("stale error")
+	deployment.SetPodErrorStatus("stale-pod", staleErr)
+	deployment.SetPodErrorStatus("test-pod", staleErr)
+
+	version := deployment.GetPodStatusVersion()
+	g.Expect(deployment.GetConfigurationStatusSince(version)).ToNot(HaveOccurred())
+
+	deployment.SetPodErrorStatus("test-pod", nil)
+	g.Expect(deployment.GetConfigurationStatusSince(version)).ToNot(HaveOccurred())
+	g.Expect(deployment.GetConfigurationStatus()).To(MatchError(staleErr))
+
+	err := errors.New("test error")
+	deployment.SetPodErrorStatus("test-pod2", err)
+	g.Expect(deployment.GetConfigurationStatusSince(version)).To(MatchError(err))
+	g.Expect(deployment.GetConfigurationStatusSince(version)).ToNot(MatchError(staleErr))
+
+	deployment.RemovePodStatus("test-pod2")
+	g.Expect(deployment.GetConfigurationStatusSince(version)).ToNot(HaveOccurred())
 }
 
 func TestSetLatestConfigError(t *testing.T) {
@@ -472,3 +506,16 @@ func TestDeploymentStore(t *testing.T) {
 	store.Remove(nsName)
 	g.Expect(store.Get(nsName)).To(BeNil())
 }
+
+func TestDeploymentStore_GetPodName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connTracker := &agentgrpcfakes.FakeConnectionsTracker{}
+	connTracker.GetConnectionReturns(agentgrpc.Connection{PodName: "nginx-pod"})
+
+	store := NewDeploymentStore(connTracker)
+
+	g.Expect(store.GetPodName("conn-id")).To(Equal("nginx-pod"))
+	g.Expect(connTracker.GetConnectionArgsForCall(0)).To(Equal("conn-id"))
+}
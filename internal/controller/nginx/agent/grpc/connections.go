@@ -23,6 +23,7 @@ type ConnectionsTracker interface {
 type Connection struct {
 	InstanceID string
 	ParentType string
+	PodName    string
 	ParentName types.NamespacedName
 }
 
@@ -32,8 +33,15 @@ func (c *Connection) Ready() bool {
 	return c.InstanceID != ""
 }
 
+// ConnectionsMetricsCollector collects metrics for the connections between the control plane and nginx agents.
+type ConnectionsMetricsCollector interface {
+	SetConnectedAgents(count int)
+}
+
 // AgentConnectionsTracker keeps track of all connections between the control plane and nginx agents.
 type AgentConnectionsTracker struct {
+	// metricsCollector collects metrics about the tracked connections.
+	metricsCollector ConnectionsMetricsCollector
 	// connections contains a map of all IP addresses that have connected and their connection info.
 	connections map[string]Connection
 
@@ -41,9 +49,10 @@ type AgentConnectionsTracker struct {
 }
 
 // NewConnectionsTracker returns a new AgentConnectionsTracker instance.
-func NewConnectionsTracker() ConnectionsTracker {
+func NewConnectionsTracker(metricsCollector ConnectionsMetricsCollector) ConnectionsTracker {
 	return &AgentConnectionsTracker{
-		connections: make(map[string]Connection),
+		metricsCollector: metricsCollector,
+		connections:      make(map[string]Connection),
 	}
 }
 
@@ -53,6 +62,7 @@ func (c *AgentConnectionsTracker) Track(key string, conn Connection) {
 	defer c.lock.Unlock()
 
 	c.connections[key] = conn
+	c.metricsCollector.SetConnectedAgents(len(c.connections))
 }
 
 // GetConnection returns the requested connection.
@@ -80,4 +90,5 @@ func (c *AgentConnectionsTracker) RemoveConnection(key string) {
 	defer c.lock.Unlock()
 
 	delete(c.connections, key)
+	c.metricsCollector.SetConnectedAgents(len(c.connections))
 }
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 )
 
@@ -13,7 +14,7 @@ func TestGetConnection(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	tracker := agentgrpc.NewConnectionsTracker()
+	tracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
 
 	conn := agentgrpc.Connection{
 		InstanceID: "instance1",
@@ -55,7 +56,7 @@ func TestSetInstanceID(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	tracker := agentgrpc.NewConnectionsTracker()
+	tracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
 	conn := agentgrpc.Connection{
 		ParentName: types.NamespacedName{Namespace: "default", Name: "parent1"},
 	}
@@ -75,7 +76,7 @@ func TestRemoveConnection(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	tracker := agentgrpc.NewConnectionsTracker()
+	tracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
 	conn := agentgrpc.Connection{
 		InstanceID: "instance1",
 		ParentName: types.NamespacedName{Namespace: "default", Name: "parent1"},
@@ -88,3 +89,26 @@ func TestRemoveConnection(t *testing.T) {
 	tracker.RemoveConnection("key1")
 	g.Expect(tracker.GetConnection("key1")).To(Equal(agentgrpc.Connection{}))
 }
+
+type fakeConnectionsMetricsCollector struct {
+	connectedAgents int
+}
+
+func (f *fakeConnectionsMetricsCollector) SetConnectedAgents(count int) {
+	f.connectedAgents = count
+}
+
+func TestConnectedAgentsMetric(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	collector := &fakeConnectionsMetricsCollector{}
+	tracker := agentgrpc.NewConnectionsTracker(collector)
+
+	tracker.Track("key1", agentgrpc.Connection{})
+	tracker.Track("key2", agentgrpc.Connection{})
+	g.Expect(collector.connectedAgents).To(Equal(2))
+
+	tracker.RemoveConnection("key1")
+	g.Expect(collector.connectedAgents).To(Equal(1))
+}
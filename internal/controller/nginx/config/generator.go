@@ -13,7 +13,10 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/cachepolicy"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/connectionlimit"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/observability"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/ratelimit"
@@ -93,6 +96,7 @@ type Generator interface {
 type GeneratorImpl struct {
 	usageReportConfig *ngfConfig.UsageReportConfig
 	logger            logr.Logger
+	clusterDNS        ngfConfig.ClusterDNSConfig
 	plus              bool
 }
 
@@ -100,11 +104,13 @@ type GeneratorImpl struct {
 func NewGeneratorImpl(
 	plus bool,
 	usageReportConfig *ngfConfig.UsageReportConfig,
+	clusterDNS ngfConfig.ClusterDNSConfig,
 	logger logr.Logger,
 ) GeneratorImpl {
 	return GeneratorImpl{
 		plus:              plus,
 		usageReportConfig: usageReportConfig,
+		clusterDNS:        clusterDNS,
 		logger:            logger,
 	}
 }
@@ -130,10 +136,13 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []agent.File {
 
 	policyGenerator := policies.NewCompositeGenerator(
 		clientsettings.NewGenerator(),
-		observability.NewGenerator(conf.Telemetry),
+		observability.NewGenerator(conf.Telemetry, conf.Logging.AccessLog),
 		snippetspolicy.NewGenerator(),
 		proxysettings.NewGenerator(),
 		ratelimit.NewGenerator(),
+		connectionlimit.NewGenerator(),
+		accesscontrol.NewGenerator(),
+		cachepolicy.NewGenerator(),
 		waf.NewGenerator(),
 	)
 
@@ -230,7 +239,7 @@ func (g GeneratorImpl) getExecuteFuncs(
 		executeSplitClients,
 		executeMaps,
 		executeTelemetry,
-		g.executeStreamServers,
+		g.newExecuteStreamServersFunc(generator),
 		g.executeStreamUpstreams,
 		executeStreamMaps,
 		executePlusAPI,
@@ -1,6 +1,8 @@
 package http //nolint:revive,nolintlint // ignoring conflicting package name
 
 import (
+	"k8s.io/apimachinery/pkg/types"
+
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 )
@@ -8,6 +10,7 @@ import (
 const (
 	InternalRoutePathPrefix       = "/_ngf-internal"
 	InternalMirrorRoutePathPrefix = InternalRoutePathPrefix + "-mirror"
+	InternalHealthCheckPathPrefix = InternalRoutePathPrefix + "-health-check"
 	HTTPSScheme                   = "https"
 )
 
@@ -67,12 +70,21 @@ type Location struct {
 	AuthJWT *AuthJWT
 	// AuthBasic contains the configuration for basic authentication.
 	AuthBasic *AuthBasic
+	// ProxyTimeouts holds the timeouts for proxying requests to the upstream, set from route rule timeouts.
+	ProxyTimeouts *ProxyTimeouts
+	// ProxyRetry holds the configuration for retrying requests to the upstream, set from route rule retry.
+	ProxyRetry *ProxyRetry
+	// HealthCheck holds the NGINX Plus active health check configuration for the upstream of this location.
+	HealthCheck *HealthCheck
 	// ProxyPassRequestBody renders proxy_pass_request_body ("on"/"off"); unset leaves the directive out.
 	ProxyPassRequestBody string
 	// ProxyPassRequestHeaders renders proxy_pass_request_headers ("on"/"off"); unset leaves the directive out.
 	ProxyPassRequestHeaders string
 	// MirrorSplitClientsVariableName is the variable name for split_clients, used in traffic mirroring scenarios.
 	MirrorSplitClientsVariableName string
+	// MirrorBodySizeExceededVariableName is the variable name for the map that indicates that the body of a
+	// mirrored request is larger than the maximum size, in which case the request is not mirrored.
+	MirrorBodySizeExceededVariableName string
 	// EPPInternalPath is the internal path for the inference NJS module to redirect to.
 	EPPInternalPath string
 	// EPPHost is the host for the EndpointPicker, used for inference routing.
@@ -83,6 +95,9 @@ type Location struct {
 	Path string
 	// HTTPMatchKey is the key for associating HTTP match rules, used for routing and NJS module logic.
 	HTTPMatchKey string
+	// Route is the route whose rules are served by the location.
+	// It is empty if the location serves the rules of several routes.
+	Route types.NamespacedName
 	// ProxyPass is the upstream backend (URL or name) to which requests are proxied.
 	ProxyPass string
 	// ProxyHTTPVersion is the HTTP protocol version for proxying (e.g. "1.1" or "2").
@@ -137,6 +152,57 @@ type AuthExternalRequest struct {
 	ForwardBody bool
 }
 
+// ProxyTimeouts holds the timeouts for proxying requests to the upstream.
+// An empty value leaves the corresponding directive out.
+type ProxyTimeouts struct {
+	// Connect renders proxy_connect_timeout.
+	Connect string
+	// Read renders proxy_read_timeout.
+	Read string
+	// Send renders proxy_send_timeout.
+	Send string
+	// NextUpstream renders proxy_next_upstream_timeout, which limits the overall time of the request
+	// including any attempts to pass it to the next upstream server.
+	NextUpstream string
+}
+
+// ProxyRetry holds the configuration for passing a failed request to the next upstream server.
+type ProxyRetry struct {
+	// NextUpstream renders proxy_next_upstream, which lists the cases in which the request is retried.
+	NextUpstream string
+	// Tries renders proxy_next_upstream_tries; zero leaves the directive out.
+	Tries int
+}
+
+// HealthCheck holds the configuration for an NGINX Plus active health check.
+// An empty or zero value leaves the corresponding parameter out.
+type HealthCheck struct {
+	// URI renders the uri parameter.
+	URI string
+	// Interval renders the interval parameter.
+	Interval string
+	// Match renders the match parameter, which is the name of the match block to test responses against.
+	Match string
+	// Fails renders the fails parameter.
+	Fails int32
+	// Passes renders the passes parameter.
+	Passes int32
+	// Port renders the port parameter.
+	Port int32
+	// GRPC renders the type=grpc parameter. The uri and match parameters are not rendered for gRPC health checks.
+	GRPC bool
+}
+
+// HealthCheckMatch holds the configuration for a match block that health check responses are tested against.
+type HealthCheckMatch struct {
+	// Name is the name of the match block.
+	Name string
+	// Status renders the status directive; empty leaves the directive out.
+	Status string
+	// Body renders the body directive; empty leaves the directive out.
+	Body string
+}
+
 // Header defines an HTTP header to be passed to the proxied server.
 type Header struct {
 	Name  string
@@ -188,6 +254,7 @@ const (
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
 	SessionPersistence  UpstreamSessionPersistence
+	HealthCheckMatch    *HealthCheckMatch
 	Name                string
 	ZoneSize            string // format: 512k, 1m
 	StateFile           string
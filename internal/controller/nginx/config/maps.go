@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
 	"sort"
 	"strconv"
@@ -10,6 +11,12 @@ import (
 
 	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/ratelimit"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -38,6 +45,10 @@ func executeMaps(conf dataplane.Configuration) []executeResult {
 	maps := buildAddHeaderMaps(httpAndSSLServers)
 	maps = append(maps, buildInferenceMaps(conf.BackendGroups)...)
 	maps = append(maps, buildCorsMaps(conf.HTTPServers, conf.SSLServers)...)
+	maps = append(maps, buildRateLimitMaps(conf.BaseHTTPConfig.Policies)...)
+	maps = append(maps, buildAccessControlGeoMaps(httpAndSSLServers)...)
+	maps = append(maps, buildCanaryMaps(httpAndSSLServers)...)
+	maps = append(maps, buildMirrorBodySizeMaps(httpAndSSLServers)...)
 
 	if !conf.BaseHTTPConfig.DisableSNIHostValidation {
 		maps = append(maps, buildMisdirectedRequestMaps(conf.SSLListenerHostnames)...)
@@ -127,6 +138,213 @@ func convertToNginxRegex(input string) string {
 	return "\"~^" + strings.ReplaceAll(strings.ReplaceAll(input, ".", "\\."), "*", ".*") + "$\""
 }
 
+// buildRateLimitMaps builds the maps for the conditional rules of the RateLimitPolicies.
+// Each condition of a rule gets a map that evaluates to "1" when the request matches the condition.
+// A final map combines the condition variables into the key of the rule, which is the configured key when
+// all conditions match and an empty string otherwise, so that NGINX does not limit the request.
+func buildRateLimitMaps(pols []policies.Policy) []shared.Map {
+	maps := make([]shared.Map, 0)
+	seen := make(map[string]struct{})
+
+	for _, pol := range pols {
+		rlp, ok := pol.(*ngfAPI.RateLimitPolicy)
+		if !ok {
+			continue
+		}
+
+		for _, rule := range ratelimit.GetConditionalRules(rlp) {
+			if _, exists := seen[rule.ZoneName]; exists {
+				continue
+			}
+			seen[rule.ZoneName] = struct{}{}
+
+			maps = append(maps, buildRateLimitRuleMaps(rule)...)
+		}
+	}
+
+	return maps
+}
+
+// buildAccessControlGeoMaps builds the geo maps of the AccessControlPolicies that use the Geo mode and apply to
+// the servers, their locations or the rules of their locations.
+// The geo maps use $remote_addr, which holds the real client IP address when RewriteClientIP is configured.
+func buildAccessControlGeoMaps(servers []dataplane.VirtualServer) []shared.Map {
+	maps := make([]shared.Map, 0)
+	seen := make(map[string]struct{})
+
+	addMaps := func(pols []policies.Policy) {
+		for _, pol := range pols {
+			acp, ok := pol.(*ngfAPI.AccessControlPolicy)
+			if !ok || !accesscontrol.UsesGeo(acp) {
+				continue
+			}
+
+			variable := accesscontrol.GeoVariable(acp)
+			if _, exists := seen[variable]; exists {
+				continue
+			}
+			seen[variable] = struct{}{}
+
+			maps = append(maps, buildAccessControlGeoMap(acp, variable))
+		}
+	}
+
+	for _, s := range servers {
+		addMaps(s.Policies)
+
+		for _, pr := range s.PathRules {
+			addMaps(pr.Policies)
+
+			for _, mr := range pr.MatchRules {
+				addMaps(mr.Policies)
+			}
+		}
+	}
+
+	return maps
+}
+
+func buildAccessControlGeoMap(acp *ngfAPI.AccessControlPolicy, variable string) shared.Map {
+	ac := acp.Spec.AccessControl
+
+	defaultResult := accesscontrol.GeoValueAllow
+	if ac.DefaultAction != nil && *ac.DefaultAction == ngfAPI.AccessControlActionDeny {
+		defaultResult = accesscontrol.GeoValueDeny
+	}
+
+	params := []shared.MapParameter{{Value: "default", Result: defaultResult}}
+	seen := make(map[string]struct{})
+
+	for _, rule := range ac.Rules {
+		result := accesscontrol.GeoValueAllow
+		if rule.Action == ngfAPI.AccessControlActionDeny {
+			result = accesscontrol.GeoValueDeny
+		}
+
+		for _, cidr := range rule.CIDRs {
+			// A range can only have one value in a geo map, so the first rule with the range takes precedence,
+			// as it does with the allow and deny directives.
+			if _, exists := seen[cidr]; exists {
+				continue
+			}
+			seen[cidr] = struct{}{}
+
+			params = append(params, shared.MapParameter{Value: cidr, Result: result})
+		}
+	}
+
+	return shared.Map{
+		Source:     "$remote_addr",
+		Variable:   variable,
+		Parameters: params,
+		Geo:        true,
+	}
+}
+
+func buildRateLimitRuleMaps(rule ratelimit.ConditionalRule) []shared.Map {
+	condMaps := buildRateLimitConditionMaps(*rule.Conditions)
+
+	var source, matched strings.Builder
+	for i := range condMaps {
+		condMaps[i].Variable = ratelimit.ConditionVariable(rule.ZoneName, i)
+		condMaps[i].Parameters = append(condMaps[i].Parameters, shared.MapParameter{
+			Value:  "default",
+			Result: `""`,
+		})
+
+		source.WriteString(condMaps[i].Variable)
+		matched.WriteString("1")
+	}
+
+	keyMap := shared.Map{
+		Source:   `"` + source.String() + `"`,
+		Variable: ratelimit.KeyVariable(rule.ZoneName),
+		Parameters: []shared.MapParameter{
+			{
+				Value:  matched.String(),
+				Result: `"` + rule.Key + `"`,
+			},
+			{
+				Value:  "default",
+				Result: `""`,
+			},
+		},
+	}
+
+	return append(condMaps, keyMap)
+}
+
+// buildRateLimitConditionMaps builds a map for each condition. The Variable of the maps is set by the caller.
+func buildRateLimitConditionMaps(conds ngfAPI.RateLimitConditions) []shared.Map {
+	var maps []shared.Map
+
+	if len(conds.Methods) > 0 {
+		params := make([]shared.MapParameter, 0, len(conds.Methods))
+		for _, method := range conds.Methods {
+			params = append(params, shared.MapParameter{Value: string(method), Result: "1"})
+		}
+
+		maps = append(maps, shared.Map{
+			Source:     "$request_method",
+			Parameters: params,
+		})
+	}
+
+	for _, header := range conds.Headers {
+		maps = append(maps, shared.Map{
+			Source: "$http_" + strings.ReplaceAll(strings.ToLower(header.Name), "-", "_"),
+			Parameters: []shared.MapParameter{
+				{Value: rateLimitMatchValue(header.Type, header.Value), Result: "1"},
+			},
+		})
+	}
+
+	if conds.Path != nil {
+		var value string
+		switch {
+		case conds.Path.Type == nil || *conds.Path.Type == ngfAPI.RateLimitPathMatchTypePathPrefix:
+			value = `"~^` + regexp.QuoteMeta(conds.Path.Value) + `"`
+		case *conds.Path.Type == ngfAPI.RateLimitPathMatchTypeRegularExpression:
+			value = `"~` + conds.Path.Value + `"`
+		default:
+			value = `"` + conds.Path.Value + `"`
+		}
+
+		maps = append(maps, shared.Map{
+			Source:     "$uri",
+			Parameters: []shared.MapParameter{{Value: value, Result: "1"}},
+		})
+	}
+
+	if conds.JWTClaim != nil {
+		maps = append(maps, shared.Map{
+			Source: "$jwt_claim_" + conds.JWTClaim.Name,
+			Parameters: []shared.MapParameter{
+				{Value: rateLimitMatchValue(conds.JWTClaim.Type, conds.JWTClaim.Value), Result: "1"},
+			},
+		})
+	}
+
+	return maps
+}
+
+// rateLimitMatchValue returns the quoted map parameter value for a header or claim match.
+// Exact values that nginx would otherwise treat as a regular expression or as a special map parameter
+// are prefixed with an escaped '\'.
+func rateLimitMatchValue(matchType *ngfAPI.RateLimitMatchType, value string) string {
+	if matchType != nil && *matchType == ngfAPI.RateLimitMatchTypeRegularExpression {
+		return `"~` + value + `"`
+	}
+
+	switch {
+	case strings.HasPrefix(value, "~"), strings.HasPrefix(value, `\`),
+		slices.Contains([]string{"default", "hostnames", "include", "volatile"}, value):
+		return `"\\` + value + `"`
+	default:
+		return `"` + value + `"`
+	}
+}
+
 func executeStreamMaps(conf dataplane.Configuration) []executeResult {
 	maps := createStreamMaps(conf)
 
@@ -386,6 +604,150 @@ func createAddHeadersMap(name string) shared.Map {
 	}
 }
 
+// buildCanaryMaps creates the maps for the CanaryFilters of the match rules.
+// NGINX maps have a single source, so each override gets its own map, and the maps are chained through their
+// default values: the first map of a backend group selects the upstream of the first override, or falls
+// through to the second map, and so on. The last map falls through to the weighted backend of the group.
+func buildCanaryMaps(servers []dataplane.VirtualServer) []shared.Map {
+	var maps []shared.Map
+	seen := make(map[string]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Filters.CanaryFilter == nil {
+					continue
+				}
+
+				// match rules of the same route rule share the backend group and the filter
+				variable := generateCanaryVariableName(mr.BackendGroup, 0)
+				if _, exists := seen[variable]; exists {
+					continue
+				}
+				seen[variable] = struct{}{}
+
+				maps = append(maps, buildCanaryMapsForGroup(mr.Filters.CanaryFilter, mr.BackendGroup)...)
+			}
+		}
+	}
+
+	return maps
+}
+
+func buildCanaryMapsForGroup(filter *dataplane.CanaryFilter, group dataplane.BackendGroup) []shared.Map {
+	fallback := backendGroupName(group)
+	if backendGroupNeedsSplit(group) {
+		fallback = "$" + convertStringToSafeVariableName(fallback)
+	}
+
+	maps := make([]shared.Map, 0, len(filter.Overrides))
+
+	for i, override := range filter.Overrides {
+		next := fallback
+		if i < len(filter.Overrides)-1 {
+			next = generateCanaryVariableName(group, i+1)
+		}
+
+		upstream := override.UpstreamName
+		if upstream == "" {
+			upstream = invalidBackendRef
+		}
+
+		maps = append(maps, shared.Map{
+			Source:   canaryMapSource(override),
+			Variable: generateCanaryVariableName(group, i),
+			Parameters: []shared.MapParameter{
+				{Value: override.Value, Result: upstream},
+				{Value: "default", Result: next},
+			},
+		})
+	}
+
+	return maps
+}
+
+func canaryMapSource(override dataplane.CanaryOverride) string {
+	if override.Type == dataplane.CanaryOverrideTypeCookie {
+		return "$cookie_" + override.Name
+	}
+
+	return "$http_" + strings.ReplaceAll(strings.ToLower(override.Name), "-", "_")
+}
+
+// buildMirrorBodySizeMaps creates the maps that indicate that the Content-Length of a request is larger than
+// the maximum body size of a MirrorFilter, or that the request has no Content-Length, for example because its
+// body is chunked. The internal mirror locations don't pass such requests to the mirror backend.
+func buildMirrorBodySizeMaps(servers []dataplane.VirtualServer) []shared.Map {
+	var maps []shared.Map
+	seen := make(map[int64]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			if !strings.HasPrefix(pr.Path, http.InternalMirrorRoutePathPrefix) {
+				continue
+			}
+
+			for _, mr := range pr.MatchRules {
+				if mr.Filters.MirrorFilter == nil || mr.Filters.MirrorFilter.MaxBodySize == "" {
+					continue
+				}
+
+				// the size has been validated when building the graph
+				maxBodySize, _ := proxysettings.ParseNginxSize(mr.Filters.MirrorFilter.MaxBodySize)
+				if _, exists := seen[maxBodySize]; exists {
+					continue
+				}
+				seen[maxBodySize] = struct{}{}
+
+				maps = append(maps, shared.Map{
+					Source:   "$http_content_length",
+					Variable: "$" + generateMirrorBodySizeExceededVariableName(maxBodySize),
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: "1"},
+						{Value: `"~^(` + greaterThanRegex(maxBodySize) + `)$"`, Result: "1"},
+						{Value: "default", Result: `""`},
+					},
+				})
+			}
+		}
+	}
+
+	return maps
+}
+
+// greaterThanRegex returns a regular expression that matches the decimal numbers without leading zeros
+// that are greater than n. NGINX can't compare numbers, so a map with this expression is used instead.
+// For example, for 1024, the expression is [1-9][0-9]{4,}|[2-9][0-9]{3}|1[1-9][0-9]{2}|10[3-9][0-9]|102[5-9].
+func greaterThanRegex(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+
+	// numbers with more digits
+	alternatives := []string{fmt.Sprintf("[1-9][0-9]{%d,}", len(digits))}
+
+	// numbers with the same number of digits and a larger digit at position i
+	for i := range len(digits) {
+		if digits[i] == '9' {
+			continue
+		}
+
+		var alt strings.Builder
+		alt.WriteString(digits[:i])
+		alt.WriteString(fmt.Sprintf("[%c-9]", digits[i]+1))
+
+		switch rest := len(digits) - i - 1; rest {
+		case 0:
+		case 1:
+			alt.WriteString("[0-9]")
+		default:
+			alt.WriteString(fmt.Sprintf("[0-9]{%d}", rest))
+		}
+
+		alternatives = append(alternatives, alt.String())
+	}
+
+	return strings.Join(alternatives, "|")
+}
+
 // buildInferenceMaps creates maps for InferencePool Backends.
 func buildInferenceMaps(groups []dataplane.BackendGroup) []shared.Map {
 	uniqueMaps := make(map[string]shared.Map)
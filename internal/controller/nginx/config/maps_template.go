@@ -2,7 +2,7 @@ package config
 
 const mapsTemplateText = `
 {{ range $m := . }}
-map {{ $m.Source }} {{ $m.Variable }} {
+{{ if $m.Geo }}geo{{ else }}map{{ end }} {{ $m.Source }} {{ $m.Variable }} {
 	{{- if $m.UseHostnames }}
 	hostnames;
 	{{ end }}
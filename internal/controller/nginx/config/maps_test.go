@@ -2,12 +2,19 @@ package config
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
@@ -1348,3 +1355,464 @@ func TestBuildMisdirectedRequestMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildRateLimitMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	rlp := &ngfAPI.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rl-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.RateLimitPolicySpec{
+			RateLimit: &ngfAPI.RateLimit{
+				Local: &ngfAPI.LocalRateLimit{
+					Rules: []ngfAPI.RateLimitRule{
+						{
+							Rate: "10r/s",
+						},
+						{
+							Rate: "10r/s",
+							Key:  "$http_x_api_key",
+							Conditions: &ngfAPI.RateLimitConditions{
+								Methods: []ngfAPI.RateLimitHTTPMethod{"POST", "PUT"},
+								Headers: []ngfAPI.RateLimitHeaderMatch{
+									{
+										Name:  "X-Tier",
+										Value: "~free",
+									},
+									{
+										Type:  helpers.GetPointer(ngfAPI.RateLimitMatchTypeRegularExpression),
+										Name:  "User-Agent",
+										Value: "^curl/",
+									},
+								},
+								Path: &ngfAPI.RateLimitPathMatch{
+									Value: "/api/v1.0",
+								},
+							},
+						},
+					},
+				},
+				Global: &ngfAPI.GlobalRateLimit{
+					Rules: []ngfAPI.RateLimitRule{
+						{
+							Rate: "10r/s",
+							Conditions: &ngfAPI.RateLimitConditions{
+								Path: &ngfAPI.RateLimitPathMatch{
+									Type:  helpers.GetPointer(ngfAPI.RateLimitPathMatchTypeExact),
+									Value: "/login",
+								},
+								JWTClaim: &ngfAPI.RateLimitJWTClaimMatch{
+									Name:  "tier",
+									Value: "free",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	defaultParam := shared.MapParameter{Value: "default", Result: `""`}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$request_method",
+			Variable: "$rl_test_rl_rl_policy_rule1_879f3382_cond0",
+			Parameters: []shared.MapParameter{
+				{Value: "POST", Result: "1"},
+				{Value: "PUT", Result: "1"},
+				defaultParam,
+			},
+		},
+		{
+			Source:     "$http_x_tier",
+			Variable:   "$rl_test_rl_rl_policy_rule1_879f3382_cond1",
+			Parameters: []shared.MapParameter{{Value: `"\\~free"`, Result: "1"}, defaultParam},
+		},
+		{
+			Source:     "$http_user_agent",
+			Variable:   "$rl_test_rl_rl_policy_rule1_879f3382_cond2",
+			Parameters: []shared.MapParameter{{Value: `"~^curl/"`, Result: "1"}, defaultParam},
+		},
+		{
+			Source:     "$uri",
+			Variable:   "$rl_test_rl_rl_policy_rule1_879f3382_cond3",
+			Parameters: []shared.MapParameter{{Value: `"~^/api/v1\.0"`, Result: "1"}, defaultParam},
+		},
+		{
+			Source: `"$rl_test_rl_rl_policy_rule1_879f3382_cond0$rl_test_rl_rl_policy_rule1_879f3382_cond1` +
+				`$rl_test_rl_rl_policy_rule1_879f3382_cond2$rl_test_rl_rl_policy_rule1_879f3382_cond3"`,
+			Variable: "$rl_test_rl_rl_policy_rule1_879f3382_key",
+			Parameters: []shared.MapParameter{
+				{Value: "1111", Result: `"$http_x_api_key"`},
+				defaultParam,
+			},
+		},
+		{
+			Source:     "$uri",
+			Variable:   "$rl_test_rl_rl_policy_global_rule0_0163cca3_cond0",
+			Parameters: []shared.MapParameter{{Value: `"/login"`, Result: "1"}, defaultParam},
+		},
+		{
+			Source:     "$jwt_claim_tier",
+			Variable:   "$rl_test_rl_rl_policy_global_rule0_0163cca3_cond1",
+			Parameters: []shared.MapParameter{{Value: `"free"`, Result: "1"}, defaultParam},
+		},
+		{
+			Source:   `"$rl_test_rl_rl_policy_global_rule0_0163cca3_cond0$rl_test_rl_rl_policy_global_rule0_0163cca3_cond1"`,
+			Variable: "$rl_test_rl_rl_policy_global_rule0_0163cca3_key",
+			Parameters: []shared.MapParameter{
+				{Value: "11", Result: `"$binary_remote_addr"`},
+				defaultParam,
+			},
+		},
+	}
+
+	// the same policy can be present more than once, but must only produce one set of maps
+	pols := []policies.Policy{rlp, rlp.DeepCopy(), &ngfAPI.ClientSettingsPolicy{}}
+
+	g.Expect(buildRateLimitMaps(pols)).To(Equal(expMaps))
+	g.Expect(buildRateLimitMaps(nil)).To(BeEmpty())
+
+	maps := string(helpers.MustExecuteTemplate(mapsTemplate, buildRateLimitMaps(pols)))
+	g.Expect(maps).To(ContainSubstring(
+		"map \"$rl_test_rl_rl_policy_global_rule0_0163cca3_cond0$rl_test_rl_rl_policy_global_rule0_0163cca3_cond1\" " +
+			"$rl_test_rl_rl_policy_global_rule0_0163cca3_key {",
+	))
+	g.Expect(maps).To(ContainSubstring("11 \"$binary_remote_addr\";"))
+	g.Expect(maps).To(ContainSubstring(`"\\~free" 1;`))
+}
+
+func TestBuildAccessControlGeoMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	geoPolicy := &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "geo-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.AccessControlPolicySpec{
+			AccessControl: &ngfAPI.AccessControl{
+				Mode:          helpers.GetPointer(ngfAPI.AccessControlModeGeo),
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+				Rules: []ngfAPI.AccessControlRule{
+					{
+						Action: ngfAPI.AccessControlActionDeny,
+						CIDRs:  []string{"10.0.0.1"},
+					},
+					{
+						Action: ngfAPI.AccessControlActionAllow,
+						CIDRs:  []string{"10.0.0.0/8", "10.0.0.1"},
+					},
+				},
+			},
+		},
+	}
+
+	routeGeoPolicy := &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.AccessControlPolicySpec{
+			AccessControl: &ngfAPI.AccessControl{
+				Mode: helpers.GetPointer(ngfAPI.AccessControlModeGeo),
+				Rules: []ngfAPI.AccessControlRule{
+					{
+						Action: ngfAPI.AccessControlActionDeny,
+						CIDRs:  []string{"2001:db8::/32"},
+					},
+				},
+			},
+		},
+	}
+
+	directivesPolicy := &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "directives-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.AccessControlPolicySpec{
+			AccessControl: &ngfAPI.AccessControl{
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+			},
+		},
+	}
+
+	servers := []dataplane.VirtualServer{
+		{
+			Policies: []policies.Policy{geoPolicy, directivesPolicy},
+			PathRules: []dataplane.PathRule{
+				{
+					Policies: []policies.Policy{directivesPolicy},
+					MatchRules: []dataplane.MatchRule{
+						{
+							Policies: []policies.Policy{routeGeoPolicy},
+						},
+					},
+				},
+			},
+		},
+		{
+			Policies: []policies.Policy{geoPolicy},
+		},
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$remote_addr",
+			Variable: "$acp_test_geo_policy_30e06f28",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "1"},
+				{Value: "10.0.0.1", Result: "1"},
+				{Value: "10.0.0.0/8", Result: "0"},
+			},
+			Geo: true,
+		},
+		{
+			Source:   "$remote_addr",
+			Variable: "$acp_test_route_policy_1100c070",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: "2001:db8::/32", Result: "1"},
+			},
+			Geo: true,
+		},
+	}
+
+	g.Expect(buildAccessControlGeoMaps(servers)).To(Equal(expMaps))
+	g.Expect(buildAccessControlGeoMaps(nil)).To(BeEmpty())
+}
+
+func TestBuildCanaryMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	canaryFilter := &dataplane.CanaryFilter{
+		Overrides: []dataplane.CanaryOverride{
+			{
+				Type:         dataplane.CanaryOverrideTypeHeader,
+				Name:         "X-Canary",
+				Value:        "always",
+				UpstreamName: "test_v2_80",
+			},
+			{
+				Type:  dataplane.CanaryOverrideTypeCookie,
+				Name:  "canary_user",
+				Value: "beta",
+			},
+		},
+	}
+
+	splitGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "split"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_v1_80", Valid: true, Weight: 90},
+			{UpstreamName: "test_v2_80", Valid: true, Weight: 10},
+		},
+	}
+
+	singleGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "single"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_v1_80", Valid: true, Weight: 1},
+		},
+	}
+
+	singleCanaryFilter := &dataplane.CanaryFilter{
+		Overrides: []dataplane.CanaryOverride{
+			{
+				Type:         dataplane.CanaryOverrideTypeHeader,
+				Name:         "x-beta",
+				Value:        "1",
+				UpstreamName: "test_v1_80",
+			},
+		},
+	}
+
+	servers := []dataplane.VirtualServer{
+		{
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						{
+							Filters:      dataplane.HTTPFilters{CanaryFilter: canaryFilter},
+							BackendGroup: splitGroup,
+						},
+						{
+							// same rule, so the maps are not duplicated
+							Filters:      dataplane.HTTPFilters{CanaryFilter: canaryFilter},
+							BackendGroup: splitGroup,
+						},
+					},
+				},
+				{
+					MatchRules: []dataplane.MatchRule{
+						{
+							Filters:      dataplane.HTTPFilters{CanaryFilter: singleCanaryFilter},
+							BackendGroup: singleGroup,
+						},
+						{
+							BackendGroup: splitGroup,
+						},
+					},
+				},
+			},
+		},
+		{
+			// SSL server with the same route
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						{
+							Filters:      dataplane.HTTPFilters{CanaryFilter: canaryFilter},
+							BackendGroup: splitGroup,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$http_x_canary",
+			Variable: "$canary_group_test__split_rule0_pathRule0",
+			Parameters: []shared.MapParameter{
+				{Value: "always", Result: "test_v2_80"},
+				{Value: "default", Result: "$canary_group_test__split_rule0_pathRule0_1"},
+			},
+		},
+		{
+			Source:   "$cookie_canary_user",
+			Variable: "$canary_group_test__split_rule0_pathRule0_1",
+			Parameters: []shared.MapParameter{
+				{Value: "beta", Result: invalidBackendRef},
+				{Value: "default", Result: "$group_test__split_rule0_pathRule0"},
+			},
+		},
+		{
+			Source:   "$http_x_beta",
+			Variable: "$canary_group_test__single_rule0_pathRule0",
+			Parameters: []shared.MapParameter{
+				{Value: "1", Result: "test_v1_80"},
+				{Value: "default", Result: "test_v1_80"},
+			},
+		},
+	}
+
+	g.Expect(buildCanaryMaps(servers)).To(Equal(expMaps))
+	g.Expect(buildCanaryMaps(nil)).To(BeEmpty())
+}
+
+func TestBuildMirrorBodySizeMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mirrorPathRule := func(path, maxBodySize string) dataplane.PathRule {
+		return dataplane.PathRule{
+			Path: path,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Filters: dataplane.HTTPFilters{
+						MirrorFilter: &dataplane.MirrorFilter{MaxBodySize: maxBodySize},
+					},
+				},
+			},
+		}
+	}
+
+	servers := []dataplane.VirtualServer{
+		{
+			PathRules: []dataplane.PathRule{
+				// the filter is also resolved for the route that mirrors the requests
+				mirrorPathRule("/coffee", "10"),
+				mirrorPathRule(http.InternalMirrorRoutePathPrefix+"-backend-test/coffee-0", "1k"),
+				mirrorPathRule(http.InternalMirrorRoutePathPrefix+"-backend-test/tea-0", "1024"),
+				mirrorPathRule(http.InternalMirrorRoutePathPrefix+"-backend-test/latte-0", ""),
+				mirrorPathRule(http.InternalMirrorRoutePathPrefix+"-backend-test/mocha-0", "99"),
+			},
+		},
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$http_content_length",
+			Variable: "$mirror_body_size_exceeds_1024",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "1"},
+				{
+					Value:  `"~^([1-9][0-9]{4,}|[2-9][0-9]{3}|1[1-9][0-9]{2}|10[3-9][0-9]|102[5-9])$"`,
+					Result: "1",
+				},
+				{Value: "default", Result: `""`},
+			},
+		},
+		{
+			Source:   "$http_content_length",
+			Variable: "$mirror_body_size_exceeds_99",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "1"},
+				{Value: `"~^([1-9][0-9]{2,})$"`, Result: "1"},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+
+	g.Expect(buildMirrorBodySizeMaps(servers)).To(Equal(expMaps))
+}
+
+func TestGreaterThanRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		greater    []string
+		notGreater []string
+		n          int64
+	}{
+		{
+			n:          0,
+			greater:    []string{"1", "9", "10", "100"},
+			notGreater: []string{"0", ""},
+		},
+		{
+			n:          1024,
+			greater:    []string{"1025", "1030", "1100", "2000", "9999", "10000"},
+			notGreater: []string{"0", "1", "999", "1000", "1019", "1024"},
+		},
+		{
+			n:          1048576,
+			greater:    []string{"1048577", "1048580", "1049000", "2000000", "10000000"},
+			notGreater: []string{"1048576", "1048575", "999999", "1048570"},
+		},
+		{
+			n:          99,
+			greater:    []string{"100", "999"},
+			notGreater: []string{"99", "10", "9"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(strconv.FormatInt(test.n, 10), func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			re := regexp.MustCompile("^(" + greaterThanRegex(test.n) + ")$")
+
+			for _, s := range test.greater {
+				g.Expect(re.MatchString(s)).To(BeTrue(), s)
+			}
+
+			for _, s := range test.notGreater {
+				g.Expect(re.MatchString(s)).To(BeFalse(), s)
+			}
+		})
+	}
+}
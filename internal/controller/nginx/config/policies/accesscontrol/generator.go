@@ -0,0 +1,164 @@
+package accesscontrol
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// accessControlDirectivesTemplate generates the allow and deny directives of the rules.
+// The directives are evaluated in order until the first match.
+const accessControlDirectivesTemplate = `
+{{- range $e := .Entries }}
+{{ $e.Action }} {{ $e.Address }};
+{{- end }}
+{{- if .DenyByDefault }}
+deny all;
+{{- end }}
+`
+
+// accessControlGeoTemplate rejects the requests of the clients that the geo map of the policy denies.
+const accessControlGeoTemplate = `
+if ({{ .GeoVariable }}) {
+    return 403;
+}
+`
+
+var (
+	tmplDirectives = template.Must(template.New("access control policy directives").Parse(accessControlDirectivesTemplate))
+	tmplGeo        = template.Must(template.New("access control policy geo").Parse(accessControlGeoTemplate))
+)
+
+const (
+	// fileNamePrefix is the prefix for all generated access control policy config file names.
+	fileNamePrefix = "AccessControlPolicy"
+
+	fileNameSuffixServer       = "server"
+	fileNameSuffixLocation     = "route"
+	fileNameSuffixStreamServer = "stream_server"
+
+	// GeoValueAllow is the value of the geo variable of a policy for the clients that are allowed.
+	GeoValueAllow = "0"
+	// GeoValueDeny is the value of the geo variable of a policy for the clients that are denied.
+	GeoValueDeny = "1"
+)
+
+// accessControlSettings represents the settings for an access control policy.
+type accessControlSettings struct {
+	// GeoVariable is the variable of the geo map of the policy. Only set in the Geo mode.
+	GeoVariable string
+	// Entries is the list of allow and deny directives.
+	Entries []accessControlEntry
+	// DenyByDefault denies the clients that do not match any entry.
+	DenyByDefault bool
+}
+
+// accessControlEntry represents a single allow or deny directive.
+type accessControlEntry struct {
+	// Action is either allow or deny.
+	Action string
+	// Address is the CIDR range or IP address of the directive.
+	Address string
+}
+
+func getAccessControlSettings(acp *ngfAPI.AccessControlPolicy) accessControlSettings {
+	ac := acp.Spec.AccessControl
+
+	settings := accessControlSettings{
+		DenyByDefault: ac.DefaultAction != nil && *ac.DefaultAction == ngfAPI.AccessControlActionDeny,
+	}
+
+	for _, rule := range ac.Rules {
+		for _, cidr := range rule.CIDRs {
+			settings.Entries = append(settings.Entries, accessControlEntry{
+				Action:  strings.ToLower(string(rule.Action)),
+				Address: cidr,
+			})
+		}
+	}
+
+	return settings
+}
+
+// UsesGeo returns true if the AccessControlPolicy evaluates its rules with a geo map for HTTP traffic.
+func UsesGeo(acp *ngfAPI.AccessControlPolicy) bool {
+	return acp.Spec.AccessControl != nil &&
+		acp.Spec.AccessControl.Mode != nil &&
+		*acp.Spec.AccessControl.Mode == ngfAPI.AccessControlModeGeo
+}
+
+// GeoVariable returns the name of the variable of the geo map of the AccessControlPolicy.
+// The variable is set to GeoValueDeny for the clients that the policy denies.
+func GeoVariable(acp *ngfAPI.AccessControlPolicy) string {
+	return "$acp_" + shared.UniqueVariableName(acp.Namespace+"/"+acp.Name)
+}
+
+// Generator generates nginx configuration based on an access control policy.
+//
+// Policies of a Route replace the policies of the Gateway or Listener, since NGINX does not inherit the
+// allow and deny directives into a block that defines its own. The same applies to a stream server, which
+// gets the policies of the Gateway, Listener and Route, ordered from the least to the most specific, so only
+// the last policy is generated.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols, fileNameSuffixServer, false)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols, fileNameSuffixLocation, false)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, fileNameSuffixLocation, false)
+}
+
+// GenerateForStreamServer generates policy configuration for a stream server block.
+// The geo mode is not supported in the stream context, so the rules are always generated as directives.
+func (g Generator) GenerateForStreamServer(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, fileNameSuffixStreamServer, true)
+}
+
+func generate(pols []policies.Policy, suffix string, stream bool) policies.GenerateResultFiles {
+	var acp *ngfAPI.AccessControlPolicy
+
+	for _, pol := range pols {
+		if p, ok := pol.(*ngfAPI.AccessControlPolicy); ok && p.Spec.AccessControl != nil {
+			acp = p
+		}
+	}
+
+	if acp == nil {
+		return nil
+	}
+
+	var content []byte
+	if UsesGeo(acp) && !stream {
+		content = helpers.MustExecuteTemplate(tmplGeo, accessControlSettings{GeoVariable: GeoVariable(acp)})
+	} else {
+		content = helpers.MustExecuteTemplate(tmplDirectives, getAccessControlSettings(acp))
+	}
+
+	return policies.GenerateResultFiles{
+		{
+			Name:    fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, acp.Namespace, acp.Name, suffix),
+			Content: content,
+		},
+	}
+}
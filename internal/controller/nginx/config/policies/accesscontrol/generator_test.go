@@ -0,0 +1,181 @@
+package accesscontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func createPolicy(name string, ac *ngfAPIv1alpha1.AccessControl) *ngfAPIv1alpha1.AccessControlPolicy {
+	return &ngfAPIv1alpha1.AccessControlPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+		},
+		Spec: ngfAPIv1alpha1.AccessControlPolicySpec{
+			AccessControl: ac,
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	rules := []ngfAPIv1alpha1.AccessControlRule{
+		{
+			Action: ngfAPIv1alpha1.AccessControlActionDeny,
+			CIDRs:  []string{"10.0.0.1"},
+		},
+		{
+			Action: ngfAPIv1alpha1.AccessControlActionAllow,
+			CIDRs:  []string{"10.0.0.0/8", "2001:db8::/32"},
+		},
+	}
+
+	tests := []struct {
+		policy           policies.Policy
+		name             string
+		expHTTPStrings   []string
+		expStreamStrings []string
+		expNotStrings    []string
+	}{
+		{
+			name: "directives with default deny",
+			policy: createPolicy("acp", &ngfAPIv1alpha1.AccessControl{
+				DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionDeny),
+				Rules:         rules,
+			}),
+			expHTTPStrings: []string{
+				"deny 10.0.0.1;\nallow 10.0.0.0/8;\nallow 2001:db8::/32;\ndeny all;",
+			},
+			expStreamStrings: []string{
+				"deny 10.0.0.1;\nallow 10.0.0.0/8;\nallow 2001:db8::/32;\ndeny all;",
+			},
+		},
+		{
+			name: "directives with default allow",
+			policy: createPolicy("acp", &ngfAPIv1alpha1.AccessControl{
+				Mode:          helpers.GetPointer(ngfAPIv1alpha1.AccessControlModeDirectives),
+				DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionAllow),
+				Rules:         rules[:1],
+			}),
+			expHTTPStrings:   []string{"deny 10.0.0.1;"},
+			expStreamStrings: []string{"deny 10.0.0.1;"},
+			expNotStrings:    []string{"deny all;", "allow"},
+		},
+		{
+			name: "geo",
+			policy: createPolicy("my-acp.v1", &ngfAPIv1alpha1.AccessControl{
+				Mode:          helpers.GetPointer(ngfAPIv1alpha1.AccessControlModeGeo),
+				DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionDeny),
+				Rules:         rules,
+			}),
+			expHTTPStrings: []string{
+				"if ($acp_test_ns_my_acp_v1_065a856e) {\n    return 403;\n}",
+			},
+			expStreamStrings: []string{
+				"deny 10.0.0.1;\nallow 10.0.0.0/8;\nallow 2001:db8::/32;\ndeny all;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			generator := accesscontrol.NewGenerator()
+			acp := helpers.MustCastObject[*ngfAPIv1alpha1.AccessControlPolicy](test.policy)
+
+			checkHTTP := func(resFiles policies.GenerateResultFiles, expName string) {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal(expName))
+				for _, str := range test.expHTTPStrings {
+					g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+				}
+				for _, str := range test.expNotStrings {
+					g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+				}
+			}
+
+			locationName := "AccessControlPolicy_test-ns_" + acp.Name + "_route.conf"
+
+			checkHTTP(
+				generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{}),
+				"AccessControlPolicy_test-ns_"+acp.Name+"_server.conf",
+			)
+			checkHTTP(generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{}), locationName)
+			checkHTTP(generator.GenerateForInternalLocation([]policies.Policy{test.policy}), locationName)
+
+			resFiles := generator.GenerateForStreamServer([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("AccessControlPolicy_test-ns_" + acp.Name + "_stream_server.conf"))
+			for _, str := range test.expStreamStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("return 403"))
+
+			g.Expect(generator.GenerateForHTTP([]policies.Policy{test.policy})).To(BeEmpty())
+			g.Expect(generator.GenerateForStream([]policies.Policy{test.policy})).To(BeEmpty())
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := accesscontrol.NewGenerator()
+	otherPolicy := &ngfAPIv1alpha2.ObservabilityPolicy{}
+	emptyPolicy := createPolicy("empty", nil)
+
+	g.Expect(generator.GenerateForServer([]policies.Policy{}, http.Server{})).To(BeEmpty())
+	g.Expect(generator.GenerateForServer([]policies.Policy{otherPolicy}, http.Server{})).To(BeEmpty())
+	g.Expect(generator.GenerateForLocation([]policies.Policy{emptyPolicy}, http.Location{})).To(BeEmpty())
+	g.Expect(generator.GenerateForInternalLocation([]policies.Policy{otherPolicy})).To(BeEmpty())
+	g.Expect(generator.GenerateForStreamServer([]policies.Policy{otherPolicy, emptyPolicy})).To(BeEmpty())
+}
+
+func TestGenerateMostSpecificPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := accesscontrol.NewGenerator()
+
+	gatewayPolicy := createPolicy("gateway-policy", &ngfAPIv1alpha1.AccessControl{
+		DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionDeny),
+	})
+	routePolicy := createPolicy("route-policy", &ngfAPIv1alpha1.AccessControl{
+		Rules: []ngfAPIv1alpha1.AccessControlRule{
+			{
+				Action: ngfAPIv1alpha1.AccessControlActionDeny,
+				CIDRs:  []string{"10.0.0.0/8"},
+			},
+		},
+	})
+
+	resFiles := generator.GenerateForStreamServer([]policies.Policy{gatewayPolicy, routePolicy})
+	g.Expect(resFiles).To(HaveLen(1))
+	g.Expect(resFiles[0].Name).To(Equal("AccessControlPolicy_test-ns_route-policy_stream_server.conf"))
+	g.Expect(string(resFiles[0].Content)).To(Equal("\ndeny 10.0.0.0/8;\n"))
+}
+
+func TestGeoVariable(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	acp := createPolicy("my-policy.v2", &ngfAPIv1alpha1.AccessControl{})
+
+	g.Expect(accesscontrol.GeoVariable(acp)).To(Equal("$acp_test_ns_my_policy_v2_f30a754b"))
+	g.Expect(accesscontrol.UsesGeo(acp)).To(BeFalse())
+
+	acp.Spec.AccessControl.Mode = helpers.GetPointer(ngfAPIv1alpha1.AccessControlModeGeo)
+	g.Expect(accesscontrol.UsesGeo(acp)).To(BeTrue())
+}
@@ -0,0 +1,72 @@
+package accesscontrol
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// Validator validates an AccessControlPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of an AccessControlPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	acp := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](policy)
+
+	if err := v.validateSettings(acp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates an AccessControlPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two AccessControlPolicies conflict.
+// The rules of two policies cannot be merged without changing the order in which they are evaluated,
+// so any two policies with access control settings conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	acpA := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](polA)
+	acpB := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](polB)
+
+	return acpA.Spec.AccessControl != nil && acpB.Spec.AccessControl != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.AccessControlPolicySpec) error {
+	if spec.AccessControl == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("accessControl").Child("rules")
+
+	for _, rule := range spec.AccessControl.Rules {
+		for _, cidr := range rule.CIDRs {
+			if err := v.genericValidator.ValidateCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("cidrs"), cidr, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
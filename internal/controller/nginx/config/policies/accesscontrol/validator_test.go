@@ -0,0 +1,184 @@
+package accesscontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy
+
+func createValidPolicy() *ngfAPI.AccessControlPolicy {
+	return &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.AccessControlPolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
+				{
+					LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  kinds.Gateway,
+						Name:  "gateway",
+					},
+					SectionName: helpers.GetPointer[v1.SectionName]("http"),
+				},
+			},
+			AccessControl: &ngfAPI.AccessControl{
+				DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+				Rules: []ngfAPI.AccessControlRule{
+					{
+						Action: ngfAPI.AccessControlActionAllow,
+						CIDRs:  []string{"10.0.0.0/8", "2001:db8::/32", "192.168.1.1"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.AccessControlPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.AccessControlPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid CIDR",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.AccessControl.Rules[0].CIDRs = append(p.Spec.AccessControl.Rules[0].CIDRs, "10.0.0.0/33")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessControl.rules.cidrs: Invalid value: \"10.0.0.0/33\": " +
+					"must be a valid CIDR value, (e.g. 10.9.8.0/24 or 2001:db8::/64)"),
+			},
+		},
+		{
+			name: "invalid IP address",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.AccessControl.Rules[0].CIDRs = []string{"all; deny"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessControl.rules.cidrs: Invalid value: \"all; deny\": " +
+					"must be a valid IP address, (e.g. 10.9.8.7 or 2001:db8::ffff)"),
+			},
+		},
+		{
+			name: "no access control",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.AccessControl = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := accesscontrol.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := accesscontrol.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := accesscontrol.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.AccessControlPolicy
+		polB      *ngfAPI.AccessControlPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name:      "no conflicts",
+			polA:      createValidPolicy(),
+			polB:      &ngfAPI.AccessControlPolicy{},
+			conflicts: false,
+		},
+		{
+			name: "access control conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.AccessControlPolicy{
+				Spec: ngfAPI.AccessControlPolicySpec{
+					AccessControl: &ngfAPI.AccessControl{
+						DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionAllow),
+					},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := accesscontrol.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := accesscontrol.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
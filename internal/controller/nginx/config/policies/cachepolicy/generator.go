@@ -0,0 +1,205 @@
+package cachepolicy
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// cacheHTTPTemplate generates only the proxy_cache_path directive at the http context.
+//
+//nolint:lll
+const cacheHTTPTemplate = `
+proxy_cache_path {{ .Path }} levels=1:2 keys_zone={{ .ZoneName }}:{{ .ZoneSize }}{{ if .MaxSize }} max_size={{ .MaxSize }}{{ end }} use_temp_path=off;
+`
+
+const cacheLocationTemplate = `
+proxy_cache {{ .ZoneName }};
+{{- if .Key }}
+proxy_cache_key {{ .Key }};
+{{- end }}
+{{- range $v := .Valid }}
+proxy_cache_valid {{ $v }};
+{{- end }}
+{{- if .Bypass }}
+proxy_cache_bypass {{ .Bypass }};
+{{- end }}
+{{- if .UseStale }}
+proxy_cache_use_stale {{ .UseStale }};
+{{- end }}
+{{- if .BackgroundUpdate }}
+proxy_cache_background_update on;
+{{- end }}
+{{- if .Lock }}
+proxy_cache_lock on;
+{{- end }}
+{{- if .LockTimeout }}
+proxy_cache_lock_timeout {{ .LockTimeout }};
+{{- end }}
+{{- if .LockAge }}
+proxy_cache_lock_age {{ .LockAge }};
+{{- end }}
+`
+
+var (
+	tmplHTTP     = template.Must(template.New("cache policy http").Parse(cacheHTTPTemplate))
+	tmplLocation = template.Must(template.New("cache policy location").Parse(cacheLocationTemplate))
+)
+
+const (
+	// fileNamePrefix is the prefix for all generated cache policy config file names.
+	fileNamePrefix = "CachePolicy"
+
+	fileNameSuffixHTTP     = "internal_http"
+	fileNameSuffixLocation = "route"
+
+	// CacheDirectory is the directory of the NGINX container that holds the caches of all CachePolicies.
+	// The provisioner mounts an emptyDir volume at this directory.
+	CacheDirectory = "/var/cache/nginx/proxy-cache"
+
+	// defaultZoneSize is the default size of the shared memory zone in the proxy_cache_path NGINX directive.
+	defaultZoneSize = "10m"
+)
+
+// cacheSettings represents the settings for a cache policy.
+type cacheSettings struct {
+	// Path is the directory of the cache.
+	Path string
+	// ZoneName is the name of the shared memory zone of the cache.
+	ZoneName string
+	// ZoneSize is the size of the shared memory zone of the cache.
+	ZoneSize string
+	// MaxSize is the maximum size of the cache.
+	MaxSize string
+	// Key is the key of the cached responses.
+	Key string
+	// Bypass is the list of variables that make NGINX bypass the cache, separated by spaces.
+	Bypass string
+	// UseStale is the list of conditions in which a stale response is served, separated by spaces.
+	UseStale string
+	// LockTimeout is the timeout of the cache lock.
+	LockTimeout string
+	// LockAge is the age of the cache lock.
+	LockAge string
+	// Valid is the list of the parameters of the proxy_cache_valid directives.
+	Valid []string
+	// BackgroundUpdate enables updating the expired cache elements in the background.
+	BackgroundUpdate bool
+	// Lock enables the cache lock.
+	Lock bool
+}
+
+func getCacheSettings(cp *ngfAPI.CachePolicy) cacheSettings {
+	cache := cp.Spec.Cache
+
+	settings := cacheSettings{
+		Path:     fmt.Sprintf("%s/%s_%s", CacheDirectory, cp.Namespace, cp.Name),
+		ZoneName: zoneName(cp),
+		ZoneSize: defaultZoneSize,
+	}
+
+	if cache.ZoneSize != nil {
+		settings.ZoneSize = string(*cache.ZoneSize)
+	}
+
+	if cache.MaxSize != nil {
+		settings.MaxSize = string(*cache.MaxSize)
+	}
+
+	if cache.Key != nil {
+		settings.Key = *cache.Key
+	}
+
+	for _, valid := range cache.Valid {
+		params := make([]string, 0, len(valid.Codes)+1)
+		for _, code := range valid.Codes {
+			params = append(params, string(code))
+		}
+		params = append(params, string(valid.Duration))
+
+		settings.Valid = append(settings.Valid, strings.Join(params, " "))
+	}
+
+	settings.Bypass = strings.Join(cache.Bypass, " ")
+
+	if cache.UseStale != nil {
+		conditions := make([]string, 0, len(cache.UseStale.Conditions))
+		for _, cond := range cache.UseStale.Conditions {
+			conditions = append(conditions, string(cond))
+		}
+		settings.UseStale = strings.Join(conditions, " ")
+
+		if cache.UseStale.BackgroundUpdate != nil {
+			settings.BackgroundUpdate = *cache.UseStale.BackgroundUpdate
+		}
+	}
+
+	if cache.Lock != nil {
+		settings.Lock = true
+
+		if cache.Lock.Timeout != nil {
+			settings.LockTimeout = string(*cache.Lock.Timeout)
+		}
+
+		if cache.Lock.Age != nil {
+			settings.LockAge = string(*cache.Lock.Age)
+		}
+	}
+
+	return settings
+}
+
+func zoneName(cp *ngfAPI.CachePolicy) string {
+	return fmt.Sprintf("%s_cache_%s", cp.Namespace, cp.Name)
+}
+
+// Generator generates nginx configuration based on a cache policy.
+//
+// CachePolicies only target Routes, so the http context only receives the copies of the policies that are
+// created for the Routes attached to the Gateway. These copies generate the proxy_cache_path directive.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForHTTP generates policy configuration for the http block.
+func (g Generator) GenerateForHTTP(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplHTTP, fileNameSuffixHTTP)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols, tmplLocation, fileNameSuffixLocation)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplLocation, fileNameSuffixLocation)
+}
+
+func generate(pols []policies.Policy, tmpl *template.Template, suffix string) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		cp, ok := pol.(*ngfAPI.CachePolicy)
+		if !ok || cp.Spec.Cache == nil {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, cp.Namespace, cp.Name, suffix),
+			Content: helpers.MustExecuteTemplate(tmpl, getCacheSettings(cp)),
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,193 @@
+package cachepolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/cachepolicy"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func createPolicy(name string, cache *ngfAPIv1alpha1.Cache) *ngfAPIv1alpha1.CachePolicy {
+	return &ngfAPIv1alpha1.CachePolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+		},
+		Spec: ngfAPIv1alpha1.CachePolicySpec{
+			Cache: cache,
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		policy             policies.Policy
+		name               string
+		expHTTPStrings     []string
+		expLocationStrings []string
+		expNotStrings      []string
+	}{
+		{
+			name:   "defaults",
+			policy: createPolicy("cp", &ngfAPIv1alpha1.Cache{}),
+			expHTTPStrings: []string{
+				"proxy_cache_path /var/cache/nginx/proxy-cache/test-ns_cp levels=1:2 keys_zone=test-ns_cache_cp:10m " +
+					"use_temp_path=off;",
+			},
+			expLocationStrings: []string{"proxy_cache test-ns_cache_cp;"},
+			expNotStrings: []string{
+				"max_size",
+				"proxy_cache_key",
+				"proxy_cache_valid",
+				"proxy_cache_bypass",
+				"proxy_cache_use_stale",
+				"proxy_cache_background_update",
+				"proxy_cache_lock",
+			},
+		},
+		{
+			name: "all fields",
+			policy: createPolicy("cp", &ngfAPIv1alpha1.Cache{
+				ZoneSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+				MaxSize:  helpers.GetPointer[ngfAPIv1alpha1.Size]("1g"),
+				Key:      helpers.GetPointer("$scheme$host$request_uri"),
+				Valid: []ngfAPIv1alpha1.CacheValid{
+					{
+						Codes:    []ngfAPIv1alpha1.CacheStatusCode{"200", "302"},
+						Duration: "10m",
+					},
+					{
+						Codes:    []ngfAPIv1alpha1.CacheStatusCode{"404"},
+						Duration: "1m",
+					},
+					{
+						Duration: "5m",
+					},
+				},
+				Bypass: []string{"$cookie_nocache", "$http_pragma"},
+				UseStale: &ngfAPIv1alpha1.CacheUseStale{
+					Conditions: []ngfAPIv1alpha1.CacheUseStaleCondition{
+						ngfAPIv1alpha1.CacheUseStaleError,
+						ngfAPIv1alpha1.CacheUseStaleUpdating,
+						ngfAPIv1alpha1.CacheUseStaleHTTP503,
+					},
+					BackgroundUpdate: helpers.GetPointer(true),
+				},
+				Lock: &ngfAPIv1alpha1.CacheLock{
+					Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("3s"),
+					Age:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+				},
+			}),
+			expHTTPStrings: []string{
+				"proxy_cache_path /var/cache/nginx/proxy-cache/test-ns_cp levels=1:2 keys_zone=test-ns_cache_cp:20m " +
+					"max_size=1g use_temp_path=off;",
+			},
+			expLocationStrings: []string{
+				"proxy_cache test-ns_cache_cp;",
+				"proxy_cache_key $scheme$host$request_uri;",
+				"proxy_cache_valid 200 302 10m;",
+				"proxy_cache_valid 404 1m;",
+				"proxy_cache_valid 5m;",
+				"proxy_cache_bypass $cookie_nocache $http_pragma;",
+				"proxy_cache_use_stale error updating http_503;",
+				"proxy_cache_background_update on;",
+				"proxy_cache_lock on;",
+				"proxy_cache_lock_timeout 3s;",
+				"proxy_cache_lock_age 10s;",
+			},
+		},
+		{
+			name: "lock without timeouts",
+			policy: createPolicy("cp", &ngfAPIv1alpha1.Cache{
+				UseStale: &ngfAPIv1alpha1.CacheUseStale{
+					Conditions: []ngfAPIv1alpha1.CacheUseStaleCondition{ngfAPIv1alpha1.CacheUseStaleTimeout},
+				},
+				Lock: &ngfAPIv1alpha1.CacheLock{},
+			}),
+			expLocationStrings: []string{
+				"proxy_cache_use_stale timeout;",
+				"proxy_cache_lock on;",
+			},
+			expNotStrings: []string{
+				"proxy_cache_background_update",
+				"proxy_cache_lock_timeout",
+				"proxy_cache_lock_age",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			generator := cachepolicy.NewGenerator()
+
+			resFiles := generator.GenerateForHTTP([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("CachePolicy_test-ns_cp_internal_http.conf"))
+			for _, str := range test.expHTTPStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("proxy_cache "))
+
+			checkLocation := func(resFiles policies.GenerateResultFiles) {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("CachePolicy_test-ns_cp_route.conf"))
+				for _, str := range test.expLocationStrings {
+					g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+				}
+				for _, str := range test.expNotStrings {
+					g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+				}
+				g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("proxy_cache_path"))
+			}
+
+			checkLocation(generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{}))
+			checkLocation(generator.GenerateForInternalLocation([]policies.Policy{test.policy}))
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})).To(BeEmpty())
+		})
+	}
+}
+
+func TestGenerateMultiplePolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := cachepolicy.NewGenerator()
+
+	pols := []policies.Policy{
+		createPolicy("cp1", &ngfAPIv1alpha1.Cache{}),
+		createPolicy("cp2", &ngfAPIv1alpha1.Cache{}),
+	}
+
+	resFiles := generator.GenerateForHTTP(pols)
+	g.Expect(resFiles).To(HaveLen(2))
+	g.Expect(resFiles[0].Name).To(Equal("CachePolicy_test-ns_cp1_internal_http.conf"))
+	g.Expect(string(resFiles[0].Content)).To(ContainSubstring("keys_zone=test-ns_cache_cp1:10m"))
+	g.Expect(resFiles[1].Name).To(Equal("CachePolicy_test-ns_cp2_internal_http.conf"))
+	g.Expect(string(resFiles[1].Content)).To(ContainSubstring("keys_zone=test-ns_cache_cp2:10m"))
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := cachepolicy.NewGenerator()
+	otherPolicy := &ngfAPIv1alpha2.ObservabilityPolicy{}
+	emptyPolicy := createPolicy("empty", nil)
+
+	g.Expect(generator.GenerateForHTTP([]policies.Policy{})).To(BeEmpty())
+	g.Expect(generator.GenerateForHTTP([]policies.Policy{otherPolicy, emptyPolicy})).To(BeEmpty())
+	g.Expect(generator.GenerateForLocation([]policies.Policy{emptyPolicy}, http.Location{})).To(BeEmpty())
+	g.Expect(generator.GenerateForInternalLocation([]policies.Policy{otherPolicy})).To(BeEmpty())
+}
@@ -0,0 +1,128 @@
+package cachepolicy
+
+import (
+	"regexp"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+const (
+	// ?: is a non-capturing group
+	// [^ \t\r\n;{}#$"'\\]+ matches any run of characters except the separators and quotes that
+	//   would make nginx stop parsing the argument.
+	// $\w+ matches an nginx variable.
+	cacheKeyFmt    = `^(?:[^ \t\r\n;{}#$"'\\]+|\$\w+)+$`
+	cacheKeyErrMsg = "must be a valid proxy_cache_key consisting of nginx variables " +
+		"and/or strings without spaces or special characters"
+)
+
+var cacheKeyRegexp = regexp.MustCompile(cacheKeyFmt)
+
+// Validator validates a CachePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a CachePolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CachePolicy](policy)
+
+	if err := v.validateSettings(cp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a CachePolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two CachePolicies conflict.
+// A location can only use a single cache, so any two policies with cache settings conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	cpA := helpers.MustCastObject[*ngfAPI.CachePolicy](polA)
+	cpB := helpers.MustCastObject[*ngfAPI.CachePolicy](polB)
+
+	return cpA.Spec.Cache != nil && cpB.Spec.Cache != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.CachePolicySpec) error {
+	if spec.Cache == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("cache")
+	cache := spec.Cache
+
+	if cache.ZoneSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*cache.ZoneSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("zoneSize"), cache.ZoneSize, err.Error()))
+		}
+	}
+
+	if cache.MaxSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*cache.MaxSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("maxSize"), cache.MaxSize, err.Error()))
+		}
+	}
+
+	if cache.Key != nil && !cacheKeyRegexp.MatchString(*cache.Key) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("key"),
+			*cache.Key,
+			k8svalidation.RegexError(cacheKeyErrMsg, cacheKeyFmt, "$scheme$host$request_uri", "$uri$is_args$args"),
+		))
+	}
+
+	for _, valid := range cache.Valid {
+		if err := v.genericValidator.ValidateNginxDuration(string(valid.Duration)); err != nil {
+			path := fieldPath.Child("valid").Child("duration")
+			allErrs = append(allErrs, field.Invalid(path, valid.Duration, err.Error()))
+		}
+	}
+
+	for _, variable := range cache.Bypass {
+		if err := v.genericValidator.ValidateNginxVariableName(variable); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("bypass"), variable, err.Error()))
+		}
+	}
+
+	if cache.Lock != nil {
+		if cache.Lock.Timeout != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*cache.Lock.Timeout)); err != nil {
+				path := fieldPath.Child("lock").Child("timeout")
+				allErrs = append(allErrs, field.Invalid(path, cache.Lock.Timeout, err.Error()))
+			}
+		}
+
+		if cache.Lock.Age != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*cache.Lock.Age)); err != nil {
+				path := fieldPath.Child("lock").Child("age")
+				allErrs = append(allErrs, field.Invalid(path, cache.Lock.Age, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,228 @@
+package cachepolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/cachepolicy"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.CachePolicy) *ngfAPI.CachePolicy
+
+func createValidPolicy() *ngfAPI.CachePolicy {
+	return &ngfAPI.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.CachePolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
+				{
+					LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  kinds.HTTPRoute,
+						Name:  "route",
+					},
+				},
+			},
+			Cache: &ngfAPI.Cache{
+				ZoneSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+				MaxSize:  helpers.GetPointer[ngfAPI.Size]("1g"),
+				Key:      helpers.GetPointer("$scheme$host$request_uri"),
+				Valid: []ngfAPI.CacheValid{
+					{
+						Codes:    []ngfAPI.CacheStatusCode{"200"},
+						Duration: "10m",
+					},
+				},
+				Bypass: []string{"$cookie_nocache"},
+				Lock: &ngfAPI.CacheLock{
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					Age:     helpers.GetPointer[ngfAPI.Duration]("5s"),
+				},
+			},
+		},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.CachePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	sizeErrMsg := "must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed " +
+		"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is '^\\d{1,4}(k|m|g)?$')"
+	durationErrMsg := "must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+		"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is '^[0-9]{1,4}(ms|s|m|h)?')"
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.CachePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid sizes",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Cache.ZoneSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Cache.MaxSize = helpers.GetPointer[ngfAPI.Size]("1t")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.cache.zoneSize: Invalid value: \"invalid\": " + sizeErrMsg +
+					", spec.cache.maxSize: Invalid value: \"1t\": " + sizeErrMsg + "]"),
+			},
+		},
+		{
+			name: "invalid key",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Cache.Key = helpers.GetPointer("$uri; return 200")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.cache.key: Invalid value: \"$uri; return 200\": must be a valid " +
+					"proxy_cache_key consisting of nginx variables and/or strings without spaces or special characters " +
+					"(e.g. '$scheme$host$request_uri',  or '$uri$is_args$args', regex used for validation is " +
+					"'^(?:[^ \\t\\r\\n;{}#$\"'\\\\]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "invalid durations",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Cache.Valid[0].Duration = "invalid"
+				p.Spec.Cache.Lock.Timeout = helpers.GetPointer[ngfAPI.Duration]("1y")
+				p.Spec.Cache.Lock.Age = helpers.GetPointer[ngfAPI.Duration]("5 s")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.cache.valid.duration: Invalid value: \"invalid\": " +
+					durationErrMsg + ", spec.cache.lock.timeout: Invalid value: \"1y\": " + durationErrMsg +
+					", spec.cache.lock.age: Invalid value: \"5 s\": " + durationErrMsg + "]"),
+			},
+		},
+		{
+			name: "no cache",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Cache = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := cachepolicy.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidateBypass(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := cachepolicy.NewValidator(validation.GenericValidator{})
+
+	policy := createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+		p.Spec.Cache.Bypass = []string{"$http_pragma", "nocache; return 200"}
+		return p
+	})
+
+	conds := v.Validate(policy)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.cache.bypass: Invalid value: \"nocache; return 200\""))
+	g.Expect(conds[0].Message).ToNot(ContainSubstring("$http_pragma"))
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := cachepolicy.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := cachepolicy.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.CachePolicy
+		polB      *ngfAPI.CachePolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name:      "no conflicts",
+			polA:      createValidPolicy(),
+			polB:      &ngfAPI.CachePolicy{},
+			conflicts: false,
+		},
+		{
+			name: "cache conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CachePolicy{
+				Spec: ngfAPI.CachePolicySpec{
+					Cache: &ngfAPI.Cache{},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := cachepolicy.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := cachepolicy.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
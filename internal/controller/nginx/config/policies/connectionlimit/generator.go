@@ -0,0 +1,272 @@
+package connectionlimit
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// connectionLimitZoneTemplate generates only the limit_conn_zone directive at the http or stream context.
+const connectionLimitZoneTemplate = `
+{{ range $r := .Rule }}
+limit_conn_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }};
+{{ end }}
+`
+
+const connectionLimitConnTemplate = `
+{{ range $r := .Rule }}
+limit_conn {{ .ZoneName }} {{ .Connections }};
+{{ end }}
+{{- if .LogLevel }}
+limit_conn_log_level {{ .LogLevel }};
+{{- end }}
+{{- if .RejectCode }}
+limit_conn_status {{ .RejectCode }};
+{{- end }}
+{{- if .DryRun }}
+limit_conn_dry_run on;
+{{- end }}
+`
+
+var (
+	tmplHTTP         = template.Must(template.New("connection limit policy http").Parse(connectionLimitZoneTemplate))
+	tmplServer       = template.Must(template.New("connection limit policy server").Parse(connectionLimitConnTemplate))
+	tmplLocation     = template.Must(template.New("connection limit policy location").Parse(connectionLimitConnTemplate))
+	tmplStream       = template.Must(template.New("connection limit policy stream").Parse(connectionLimitZoneTemplate))
+	tmplStreamServer = template.Must(
+		template.New("connection limit policy stream server").Parse(connectionLimitConnTemplate),
+	)
+)
+
+const (
+	// fileNamePrefix is the prefix for all generated connection limit policy config file names.
+	fileNamePrefix = "ConnectionLimitPolicy"
+
+	fileNameSuffixGateway            = "gateway"
+	fileNameSuffixHTTP               = "internal_http"
+	fileNameSuffixServer             = "gateway_server"
+	fileNameSuffixLocation           = "route"
+	fileNameSuffixStream             = "stream"
+	fileNameSuffixStreamServer       = "stream_server"
+	fileNameSuffixStreamServerLimits = "stream_server_limits"
+
+	// defaultZoneSize is the default size of the shared memory zone in the limit_conn_zone NGINX directive.
+	defaultZoneSize = "10m"
+	// defaultKey is the default key in the limit_conn_zone NGINX directive.
+	defaultKey = "$binary_remote_addr"
+)
+
+// connectionLimitSettings represents the settings for a connection limit policy.
+type connectionLimitSettings struct {
+	// LogLevel is the log level for cases when the server limits the number of connections.
+	LogLevel string
+	// Rule is the list of connection limit rules.
+	Rule []connectionLimitRule
+	// RejectCode is the status code to return in response to rejected requests.
+	RejectCode int
+	// DryRun enables the dry run mode, where the connection limit is not actually applied, but the number
+	// of excessive connections is accounted as usual in the shared memory zone.
+	DryRun bool
+}
+
+// connectionLimitRule represents a single connection limit rule.
+type connectionLimitRule struct {
+	// ZoneName is the name of the shared memory zone.
+	ZoneName string
+	// ZoneSize is the size of the shared memory zone.
+	ZoneSize string
+	// Key is the key to use for connection limiting.
+	Key string
+	// Connections is the maximum number of connections allowed per key.
+	Connections int
+}
+
+func getConnectionLimitSettings(clp ngfAPI.ConnectionLimitPolicy, stream bool) connectionLimitSettings {
+	settings := connectionLimitSettings{}
+
+	if clp.Spec.ConnectionLimit == nil {
+		return settings
+	}
+
+	if clp.Spec.ConnectionLimit.DryRun != nil {
+		settings.DryRun = *clp.Spec.ConnectionLimit.DryRun
+	}
+
+	if clp.Spec.ConnectionLimit.LogLevel != nil {
+		settings.LogLevel = string(*clp.Spec.ConnectionLimit.LogLevel)
+	}
+
+	// The stream limit_conn module does not support setting the status code, since excessive
+	// connections are closed.
+	if clp.Spec.ConnectionLimit.RejectCode != nil && !stream {
+		settings.RejectCode = int(*clp.Spec.ConnectionLimit.RejectCode)
+	}
+
+	for i, rule := range clp.Spec.ConnectionLimit.Rules {
+		clRule := connectionLimitRule{
+			ZoneName:    zoneName(&clp, i, stream),
+			ZoneSize:    defaultZoneSize,
+			Key:         defaultKey,
+			Connections: int(rule.Connections),
+		}
+
+		if rule.ZoneSize != nil {
+			clRule.ZoneSize = string(*rule.ZoneSize)
+		}
+
+		if rule.Key != nil && *rule.Key != "" {
+			clRule.Key = *rule.Key
+		}
+
+		settings.Rule = append(settings.Rule, clRule)
+	}
+
+	return settings
+}
+
+// zoneName returns the name of the shared memory zone of the rule. Shared memory zones are global
+// to nginx, so the zones in the stream context need a different name than those in the http context.
+func zoneName(clp *ngfAPI.ConnectionLimitPolicy, idx int, stream bool) string {
+	if stream {
+		return fmt.Sprintf("%s_cl_%s_stream_rule%d", clp.Namespace, clp.Name, idx)
+	}
+
+	return fmt.Sprintf("%s_cl_%s_rule%d", clp.Namespace, clp.Name, idx)
+}
+
+// Generator generates nginx configuration based on a connection limit policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForHTTP generates policy configuration for the http block.
+func (g Generator) GenerateForHTTP(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplHTTP)
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols, tmplServer)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols, tmplLocation)
+}
+
+// GenerateForStream generates policy configuration for the stream block.
+func (g Generator) GenerateForStream(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplStream)
+}
+
+// GenerateForStreamServer generates policy configuration for a stream server block.
+//
+// Unlike the http servers and locations, the policies of a Gateway and of a TCPRoute both apply to the
+// same stream server block, where the limit_conn_log_level and limit_conn_dry_run directives cannot be
+// duplicated. The settings of the last policy that defines any of them take precedence, so the policies
+// are expected to be ordered from the Gateway policies to the Route policies.
+func (g Generator) GenerateForStreamServer(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+	settingsDefined := false
+
+	for i := len(pols) - 1; i >= 0; i-- {
+		clp, ok := pols[i].(*ngfAPI.ConnectionLimitPolicy)
+		if !ok || isShadowPolicy(clp) {
+			continue
+		}
+
+		settings := getConnectionLimitSettings(*clp, true)
+		suffix := fileNameSuffixStreamServer
+
+		hasSettings := settings.LogLevel != "" || settings.DryRun
+		if hasSettings && settingsDefined {
+			settings.LogLevel = ""
+			settings.DryRun = false
+			suffix = fileNameSuffixStreamServerLimits
+		}
+		settingsDefined = settingsDefined || hasSettings
+
+		files = append(files, policies.File{
+			Name:    fileName(clp, suffix),
+			Content: helpers.MustExecuteTemplate(tmplStreamServer, settings),
+		})
+	}
+
+	// restore the order of the policies
+	for i, j := 0, len(files)-1; i < j; i, j = i+1, j-1 {
+		files[i], files[j] = files[j], files[i]
+	}
+
+	return files
+}
+
+func generate(pols []policies.Policy, tmpl *template.Template) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		clp, ok := pol.(*ngfAPI.ConnectionLimitPolicy)
+		if !ok {
+			continue
+		}
+
+		isHTTPContextOnly := isShadowPolicy(clp)
+
+		// Route-attached policies generate limit_conn_zone at the http context via an internally-created
+		// copy (marked with the http-context-only annotation). That copy should never generate limit_conn
+		// at the server level, since doing so would limit the connections of all routes on the server.
+		// It should not generate stream configuration either, since the stream servers of TCPRoutes
+		// get the original policy.
+		if isHTTPContextOnly && tmpl != tmplHTTP {
+			continue
+		}
+
+		settings := getConnectionLimitSettings(*clp, tmpl == tmplStream)
+
+		var suffix string
+		switch tmpl {
+		case tmplHTTP:
+			if isHTTPContextOnly {
+				suffix = fileNameSuffixHTTP
+			} else {
+				suffix = fileNameSuffixGateway
+			}
+		case tmplServer:
+			suffix = fileNameSuffixServer
+		case tmplLocation:
+			suffix = fileNameSuffixLocation
+		case tmplStream:
+			suffix = fileNameSuffixStream
+		}
+
+		files = append(files, policies.File{
+			Name:    fileName(clp, suffix),
+			Content: helpers.MustExecuteTemplate(tmpl, settings),
+		})
+	}
+
+	return files
+}
+
+func fileName(clp *ngfAPI.ConnectionLimitPolicy, suffix string) string {
+	return fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, clp.Namespace, clp.Name, suffix)
+}
+
+// isShadowPolicy checks if a ConnectionLimitPolicy is intended to
+// generate configuration only for the http context by looking for a specific annotation.
+func isShadowPolicy(clp *ngfAPI.ConnectionLimitPolicy) bool {
+	if clp.Annotations == nil {
+		return false
+	}
+	val, exists := clp.Annotations[dataplane.InternalRLPAnnotationKey]
+	return exists && val == dataplane.InternalRLPAnnotationValue
+}
@@ -0,0 +1,240 @@
+package connectionlimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func createPolicy(name string, connLimit *ngfAPIv1alpha1.ConnectionLimit) *ngfAPIv1alpha1.ConnectionLimitPolicy {
+	return &ngfAPIv1alpha1.ConnectionLimitPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: ngfAPIv1alpha1.ConnectionLimitPolicySpec{
+			ConnectionLimit: connLimit,
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		policy             policies.Policy
+		name               string
+		expZoneStrings     []string
+		expConnStrings     []string
+		expStreamZones     []string
+		expStreamStrings   []string
+		expNotStreamString string
+	}{
+		{
+			name: "single rule with default values",
+			policy: createPolicy("test-policy", &ngfAPIv1alpha1.ConnectionLimit{
+				Rules: []ngfAPIv1alpha1.ConnectionLimitRule{
+					{Connections: 10},
+				},
+			}),
+			expZoneStrings: []string{
+				"limit_conn_zone $binary_remote_addr zone=default_cl_test-policy_rule0:10m;",
+			},
+			expConnStrings: []string{
+				"limit_conn default_cl_test-policy_rule0 10;",
+			},
+			expStreamZones: []string{
+				"limit_conn_zone $binary_remote_addr zone=default_cl_test-policy_stream_rule0:10m;",
+			},
+			expStreamStrings: []string{
+				"limit_conn default_cl_test-policy_stream_rule0 10;",
+			},
+		},
+		{
+			name: "multiple rules with all settings",
+			policy: createPolicy("test-policy", &ngfAPIv1alpha1.ConnectionLimit{
+				DryRun:     helpers.GetPointer(true),
+				LogLevel:   helpers.GetPointer(ngfAPIv1alpha1.ConnectionLimitLogLevelWarn),
+				RejectCode: helpers.GetPointer[int32](429),
+				Rules: []ngfAPIv1alpha1.ConnectionLimitRule{
+					{
+						ZoneSize:    helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+						Key:         helpers.GetPointer("$server_name"),
+						Connections: 100,
+					},
+					{Connections: 5},
+				},
+			}),
+			expZoneStrings: []string{
+				"limit_conn_zone $server_name zone=default_cl_test-policy_rule0:20m;",
+				"limit_conn_zone $binary_remote_addr zone=default_cl_test-policy_rule1:10m;",
+			},
+			expConnStrings: []string{
+				"limit_conn default_cl_test-policy_rule0 100;",
+				"limit_conn default_cl_test-policy_rule1 5;",
+				"limit_conn_log_level warn;",
+				"limit_conn_status 429;",
+				"limit_conn_dry_run on;",
+			},
+			expStreamZones: []string{
+				"limit_conn_zone $server_name zone=default_cl_test-policy_stream_rule0:20m;",
+				"limit_conn_zone $binary_remote_addr zone=default_cl_test-policy_stream_rule1:10m;",
+			},
+			expStreamStrings: []string{
+				"limit_conn default_cl_test-policy_stream_rule0 100;",
+				"limit_conn default_cl_test-policy_stream_rule1 5;",
+				"limit_conn_log_level warn;",
+				"limit_conn_dry_run on;",
+			},
+			expNotStreamString: "limit_conn_status",
+		},
+		{
+			name: "key available in the stream context",
+			policy: createPolicy("test-policy", &ngfAPIv1alpha1.ConnectionLimit{
+				Rules: []ngfAPIv1alpha1.ConnectionLimitRule{
+					{
+						Key:         helpers.GetPointer("$remote_addr:$server_port"),
+						Connections: 10,
+					},
+				},
+			}),
+			expZoneStrings: []string{
+				"limit_conn_zone $remote_addr:$server_port zone=default_cl_test-policy_rule0:10m;",
+			},
+			expConnStrings: []string{
+				"limit_conn default_cl_test-policy_rule0 10;",
+			},
+			expStreamZones: []string{
+				"limit_conn_zone $remote_addr:$server_port zone=default_cl_test-policy_stream_rule0:10m;",
+			},
+			expStreamStrings: []string{
+				"limit_conn default_cl_test-policy_stream_rule0 10;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			generator := connectionlimit.NewGenerator()
+
+			resFiles := generator.GenerateForHTTP([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_test-policy_gateway.conf"))
+			for _, str := range test.expZoneStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("limit_conn "))
+
+			resFiles = generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_test-policy_gateway_server.conf"))
+			for _, str := range test.expConnStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("limit_conn_zone"))
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_test-policy_route.conf"))
+			for _, str := range test.expConnStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+
+			resFiles = generator.GenerateForStream([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_test-policy_stream.conf"))
+			for _, str := range test.expStreamZones {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+
+			resFiles = generator.GenerateForStreamServer([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_test-policy_stream_server.conf"))
+			for _, str := range test.expStreamStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			if test.expNotStreamString != "" {
+				g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(test.expNotStreamString))
+			}
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(BeEmpty())
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := connectionlimit.NewGenerator()
+	otherPolicy := &ngfAPIv1alpha2.ObservabilityPolicy{}
+
+	g.Expect(generator.GenerateForHTTP([]policies.Policy{})).To(BeEmpty())
+	g.Expect(generator.GenerateForHTTP([]policies.Policy{otherPolicy})).To(BeEmpty())
+	g.Expect(generator.GenerateForServer([]policies.Policy{otherPolicy}, http.Server{})).To(BeEmpty())
+	g.Expect(generator.GenerateForLocation([]policies.Policy{otherPolicy}, http.Location{})).To(BeEmpty())
+	g.Expect(generator.GenerateForStream([]policies.Policy{otherPolicy})).To(BeEmpty())
+	g.Expect(generator.GenerateForStreamServer([]policies.Policy{})).To(BeEmpty())
+	g.Expect(generator.GenerateForStreamServer([]policies.Policy{otherPolicy})).To(BeEmpty())
+}
+
+func TestGenerateSkipsShadowPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := connectionlimit.NewGenerator()
+
+	shadowPolicy := createPolicy("shadow-policy", &ngfAPIv1alpha1.ConnectionLimit{
+		Rules: []ngfAPIv1alpha1.ConnectionLimitRule{{Connections: 1}},
+	})
+	shadowPolicy.Annotations = map[string]string{
+		dataplane.InternalRLPAnnotationKey: dataplane.InternalRLPAnnotationValue,
+	}
+
+	g.Expect(generator.GenerateForServer([]policies.Policy{shadowPolicy}, http.Server{})).To(BeEmpty())
+	g.Expect(generator.GenerateForStream([]policies.Policy{shadowPolicy})).To(BeEmpty())
+	g.Expect(generator.GenerateForStreamServer([]policies.Policy{shadowPolicy})).To(BeEmpty())
+
+	resFiles := generator.GenerateForHTTP([]policies.Policy{shadowPolicy})
+	g.Expect(resFiles).To(HaveLen(1))
+	g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_shadow-policy_internal_http.conf"))
+}
+
+func TestGenerateForStreamServerSettingsPrecedence(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := connectionlimit.NewGenerator()
+
+	gatewayPolicy := createPolicy("gateway-policy", &ngfAPIv1alpha1.ConnectionLimit{
+		DryRun: helpers.GetPointer(true),
+		Rules:  []ngfAPIv1alpha1.ConnectionLimitRule{{Connections: 100}},
+	})
+	routePolicy := createPolicy("route-policy", &ngfAPIv1alpha1.ConnectionLimit{
+		LogLevel: helpers.GetPointer(ngfAPIv1alpha1.ConnectionLimitLogLevelError),
+		Rules:    []ngfAPIv1alpha1.ConnectionLimitRule{{Connections: 10}},
+	})
+
+	resFiles := generator.GenerateForStreamServer([]policies.Policy{gatewayPolicy, routePolicy})
+	g.Expect(resFiles).To(HaveLen(2))
+
+	g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_default_gateway-policy_stream_server_limits.conf"))
+	g.Expect(string(resFiles[0].Content)).To(ContainSubstring("limit_conn default_cl_gateway-policy_stream_rule0 100;"))
+	g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("limit_conn_dry_run"))
+
+	g.Expect(resFiles[1].Name).To(Equal("ConnectionLimitPolicy_default_route-policy_stream_server.conf"))
+	g.Expect(string(resFiles[1].Content)).To(ContainSubstring("limit_conn default_cl_route-policy_stream_rule0 10;"))
+	g.Expect(string(resFiles[1].Content)).To(ContainSubstring("limit_conn_log_level error;"))
+}
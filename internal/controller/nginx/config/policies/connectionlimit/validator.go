@@ -0,0 +1,125 @@
+package connectionlimit
+
+import (
+	"errors"
+	"regexp"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+const (
+	// ?: is a non-capturing group
+	// [^ \t\r\n;{}#$]+ matches any run of characters except the separators that
+	//   would make nginx stop parsing the argument.
+	// $\w+ matches an nginx variable.
+	limitConnKeyFmt = `^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	limitConnErrMsg = "must be a valid limit_conn_zone key consisting of nginx variables " +
+		"and/or strings without spaces or special characters"
+)
+
+var limitConnKeyRegexp = regexp.MustCompile(limitConnKeyFmt)
+
+// Validator validates a ConnectionLimitPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ConnectionLimitPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	clp := helpers.MustCastObject[*ngfAPI.ConnectionLimitPolicy](policy)
+
+	if err := v.validateSettings(clp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a ConnectionLimitPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two ConnectionLimitPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	clpA := helpers.MustCastObject[*ngfAPI.ConnectionLimitPolicy](polA)
+	clpB := helpers.MustCastObject[*ngfAPI.ConnectionLimitPolicy](polB)
+
+	return conflicts(clpA.Spec, clpB.Spec)
+}
+
+func conflicts(a, b ngfAPI.ConnectionLimitPolicySpec) bool {
+	if a.ConnectionLimit != nil && b.ConnectionLimit != nil {
+		if a.ConnectionLimit.DryRun != nil && b.ConnectionLimit.DryRun != nil {
+			return true
+		}
+
+		if a.ConnectionLimit.LogLevel != nil && b.ConnectionLimit.LogLevel != nil {
+			return true
+		}
+
+		if a.ConnectionLimit.RejectCode != nil && b.ConnectionLimit.RejectCode != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ConnectionLimitPolicySpec) error {
+	if spec.ConnectionLimit == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("connectionLimit").Child("rules")
+
+	for _, rule := range spec.ConnectionLimit.Rules {
+		if rule.ZoneSize != nil {
+			if err := v.genericValidator.ValidateNginxSize(string(*rule.ZoneSize)); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("zoneSize"), *rule.ZoneSize, err.Error()))
+			}
+		}
+
+		if rule.Key != nil && *rule.Key != "" {
+			if err := validateLimitConnKey(*rule.Key); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("key"), *rule.Key, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateLimitConnKey validates a limit_conn_zone key string that nginx can understand.
+func validateLimitConnKey(key string) error {
+	if !limitConnKeyRegexp.MatchString(key) {
+		examples := []string{
+			"$binary_remote_addr",
+			"$server_name",
+			"my_fixed_key",
+		}
+
+		return errors.New(k8svalidation.RegexError(limitConnErrMsg, limitConnKeyFmt, examples...))
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package connectionlimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy
+
+func createValidPolicy() *ngfAPI.ConnectionLimitPolicy {
+	return &ngfAPI.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ConnectionLimitPolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			ConnectionLimit: &ngfAPI.ConnectionLimit{
+				DryRun:     helpers.GetPointer(true),
+				LogLevel:   helpers.GetPointer(ngfAPI.ConnectionLimitLogLevelWarn),
+				RejectCode: helpers.GetPointer[int32](429),
+				Rules: []ngfAPI.ConnectionLimitRule{
+					{
+						ZoneSize:    helpers.GetPointer[ngfAPI.Size]("10m"),
+						Key:         helpers.GetPointer("$binary_remote_addr"),
+						Connections: 10,
+					},
+				},
+			},
+		},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ConnectionLimitPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ConnectionLimitPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid zone size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.ConnectionLimit.Rules[0].ZoneSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.connectionLimit.rules.zoneSize: Invalid value: \"invalid\": " +
+					"must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is '^\\d{1,4}(k|m|g)?$')"),
+			},
+		},
+		{
+			name: "invalid key",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.ConnectionLimit.Rules[0].Key = helpers.GetPointer("$invalid_key{}")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.connectionLimit.rules.key: Invalid value: " +
+					"\"$invalid_key{}\": must be a valid limit_conn_zone key consisting of nginx variables and/or " +
+					"strings without spaces or special characters (e.g. '$binary_remote_addr',  or " +
+					"'$server_name',  or 'my_fixed_key', regex used for validation is " +
+					"'^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "no connection limit",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.ConnectionLimit = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := connectionlimit.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.ConnectionLimitPolicy
+		polB      *ngfAPI.ConnectionLimitPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ConnectionLimitPolicy{
+				Spec: ngfAPI.ConnectionLimitPolicySpec{
+					ConnectionLimit: &ngfAPI.ConnectionLimit{
+						Rules: []ngfAPI.ConnectionLimitRule{{Connections: 1}},
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "dryrun conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ConnectionLimitPolicy{
+				Spec: ngfAPI.ConnectionLimitPolicySpec{
+					ConnectionLimit: &ngfAPI.ConnectionLimit{
+						DryRun: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "log level conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ConnectionLimitPolicy{
+				Spec: ngfAPI.ConnectionLimitPolicySpec{
+					ConnectionLimit: &ngfAPI.ConnectionLimit{
+						LogLevel: helpers.GetPointer(ngfAPI.ConnectionLimitLogLevelError),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "reject code conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ConnectionLimitPolicy{
+				Spec: ngfAPI.ConnectionLimitPolicySpec{
+					ConnectionLimit: &ngfAPI.ConnectionLimit{
+						RejectCode: helpers.GetPointer[int32](503),
+					},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := connectionlimit.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := connectionlimit.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -18,6 +18,10 @@ type Generator interface {
 	GenerateForLocation(policies []Policy, location http.Location) GenerateResultFiles
 	// GenerateForInternalLocation generates policy configuration for an internal location block.
 	GenerateForInternalLocation(policies []Policy) GenerateResultFiles
+	// GenerateForStream generates policy configuration for the stream block.
+	GenerateForStream(policies []Policy) GenerateResultFiles
+	// GenerateForStreamServer generates policy configuration for a stream server block.
+	GenerateForStreamServer(policies []Policy) GenerateResultFiles
 }
 
 // GenerateResultFiles is a list of files generated for inclusion by policy generators.
@@ -94,6 +98,28 @@ func (g *CompositeGenerator) GenerateForInternalLocation(policies []Policy) Gene
 	return compositeResult
 }
 
+// GenerateForStream calls all policy generators for the stream block.
+func (g *CompositeGenerator) GenerateForStream(policies []Policy) GenerateResultFiles {
+	var compositeResult GenerateResultFiles
+
+	for _, generator := range g.generators {
+		compositeResult = append(compositeResult, generator.GenerateForStream(policies)...)
+	}
+
+	return compositeResult
+}
+
+// GenerateForStreamServer calls all policy generators for a stream server block.
+func (g *CompositeGenerator) GenerateForStreamServer(policies []Policy) GenerateResultFiles {
+	var compositeResult GenerateResultFiles
+
+	for _, generator := range g.generators {
+		compositeResult = append(compositeResult, generator.GenerateForStreamServer(policies)...)
+	}
+
+	return compositeResult
+}
+
 // UnimplementedGenerator can be inherited by any policy generator that may not need to implement all of
 // possible generations, in order to satisfy the Generator interface.
 type UnimplementedGenerator struct{}
@@ -117,3 +143,11 @@ func (u UnimplementedGenerator) GenerateForLocation(_ []Policy, _ http.Location)
 func (u UnimplementedGenerator) GenerateForInternalLocation(_ []Policy) GenerateResultFiles {
 	return nil
 }
+
+func (u UnimplementedGenerator) GenerateForStream(_ []Policy) GenerateResultFiles {
+	return nil
+}
+
+func (u UnimplementedGenerator) GenerateForStreamServer(_ []Policy) GenerateResultFiles {
+	return nil
+}
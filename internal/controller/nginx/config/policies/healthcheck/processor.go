@@ -0,0 +1,89 @@
+package healthcheck
+
+import (
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+)
+
+// Processor processes HealthCheckPolicies.
+type Processor struct{}
+
+// HealthCheck contains settings from HealthCheckPolicy.
+type HealthCheck struct {
+	// Path is the URI requested by the health check.
+	Path string
+	// Interval is the time between two consecutive health checks.
+	Interval string
+	// MatchStatus is the expected status code(s) of the health check response.
+	MatchStatus string
+	// MatchBody is the regular expression that the health check response body must match.
+	MatchBody string
+	// Fails is the number of consecutive failed health checks after which a server is considered unhealthy.
+	Fails int32
+	// Passes is the number of consecutive passed health checks after which a server is considered healthy.
+	Passes int32
+	// Port is the port used to connect to a server to perform the health check.
+	Port int32
+	// Enabled indicates whether a HealthCheckPolicy applies to the upstream.
+	Enabled bool
+}
+
+// NewProcessor returns a new Processor.
+func NewProcessor() Processor {
+	return Processor{}
+}
+
+// Process processes policies into a HealthCheck object. The policies are already validated and are guaranteed
+// to not contain overlapping settings. This method merges all fields in the policies into a single HealthCheck
+// object.
+func (p Processor) Process(pols []policies.Policy) HealthCheck {
+	return processPolicies(pols)
+}
+
+// processPolicies merges a list of policies into a single HealthCheck configuration.
+func processPolicies(pols []policies.Policy) HealthCheck {
+	healthCheck := HealthCheck{}
+
+	for _, pol := range pols {
+		hcp, ok := pol.(*ngfAPI.HealthCheckPolicy)
+		if !ok {
+			continue
+		}
+
+		healthCheck.Enabled = true
+
+		// we can assume that there will be no instance of two or more policies setting the same
+		// field for the same service
+		if hcp.Spec.Path != nil {
+			healthCheck.Path = *hcp.Spec.Path
+		}
+
+		if hcp.Spec.Interval != nil {
+			healthCheck.Interval = string(*hcp.Spec.Interval)
+		}
+
+		if hcp.Spec.Fails != nil {
+			healthCheck.Fails = *hcp.Spec.Fails
+		}
+
+		if hcp.Spec.Passes != nil {
+			healthCheck.Passes = *hcp.Spec.Passes
+		}
+
+		if hcp.Spec.Port != nil {
+			healthCheck.Port = *hcp.Spec.Port
+		}
+
+		if hcp.Spec.Match != nil {
+			if hcp.Spec.Match.Status != nil {
+				healthCheck.MatchStatus = string(*hcp.Spec.Match.Status)
+			}
+
+			if hcp.Spec.Match.Body != nil {
+				healthCheck.MatchBody = *hcp.Spec.Match.Body
+			}
+		}
+	}
+
+	return healthCheck
+}
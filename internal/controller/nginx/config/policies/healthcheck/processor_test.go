@@ -0,0 +1,122 @@
+package healthcheck
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		policies       []policies.Policy
+		expHealthCheck HealthCheck
+	}{
+		{
+			name: "all fields populated",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.HealthCheckPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "hcp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+						Path:     helpers.GetPointer("/healthz"),
+						Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+						Fails:    helpers.GetPointer[int32](3),
+						Passes:   helpers.GetPointer[int32](2),
+						Port:     helpers.GetPointer[int32](8080),
+						Match: &ngfAPIv1alpha1.HealthCheckMatch{
+							Status: helpers.GetPointer[ngfAPIv1alpha1.HealthCheckStatusMatch]("200-399"),
+							Body:   helpers.GetPointer("ok"),
+						},
+					},
+				},
+			},
+			expHealthCheck: HealthCheck{
+				Enabled:     true,
+				Path:        "/healthz",
+				Interval:    "10s",
+				Fails:       3,
+				Passes:      2,
+				Port:        8080,
+				MatchStatus: "200-399",
+				MatchBody:   "ok",
+			},
+		},
+		{
+			name: "no fields populated",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.HealthCheckPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "hcp",
+						Namespace: "test",
+					},
+				},
+			},
+			expHealthCheck: HealthCheck{
+				Enabled: true,
+			},
+		},
+		{
+			name: "multiple policies merged",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.HealthCheckPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "hcp-path",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+						Path: helpers.GetPointer("/healthz"),
+					},
+				},
+				&ngfAPIv1alpha1.HealthCheckPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "hcp-match",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+						Match: &ngfAPIv1alpha1.HealthCheckMatch{
+							Body: helpers.GetPointer("ok"),
+						},
+					},
+				},
+			},
+			expHealthCheck: HealthCheck{
+				Enabled:   true,
+				Path:      "/healthz",
+				MatchBody: "ok",
+			},
+		},
+		{
+			name: "other policies are ignored",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp",
+						Namespace: "test",
+					},
+				},
+			},
+			expHealthCheck: HealthCheck{},
+		},
+	}
+
+	processor := NewProcessor()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(processor.Process(test.policies)).To(Equal(test.expHealthCheck))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package healthcheck
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+const plusRequiredMsg = "HealthCheckPolicy requires NGINX Plus; active health checks are not supported by NGINX OSS"
+
+var (
+	pathRegexp   = regexp.MustCompile(`^/[^\s{};"'\\]*$`)
+	statusRegexp = regexp.MustCompile(`^(! )?[1-5][0-9]{2}(-[1-5][0-9]{2})?( [1-5][0-9]{2}(-[1-5][0-9]{2})?)*$`)
+	// bodyRegexp matches a value that can be safely put in double quotes: all '"' must be escaped and the
+	// value must not end with an unescaped '\'.
+	bodyRegexp = regexp.MustCompile(`^([^"\\]|\\.)*$`)
+)
+
+// Validator validates a HealthCheckPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+	plusEnabled      bool
+}
+
+// NewValidator returns a new Validator.
+func NewValidator(genericValidator validation.GenericValidator, plusEnabled bool) Validator {
+	return Validator{
+		genericValidator: genericValidator,
+		plusEnabled:      plusEnabled,
+	}
+}
+
+// Validate validates the spec of a HealthCheckPolicy.
+func (v Validator) Validate(policy policies.Policy) []conditions.Condition {
+	hcp := helpers.MustCastObject[*ngfAPI.HealthCheckPolicy](policy)
+
+	targetRefsPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Service}
+	supportedGroups := []gatewayv1.Group{"", "core"}
+
+	for i, ref := range hcp.Spec.TargetRefs {
+		indexedPath := targetRefsPath.Index(i)
+		if err := policies.ValidateTargetRef(ref, indexedPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if !v.plusEnabled {
+		return []conditions.Condition{conditions.NewPolicyNotAcceptedNginxPlusRequired(plusRequiredMsg)}
+	}
+
+	if err := v.validateSettings(hcp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a HealthCheckPolicy with respect to the NginxProxy global settings.
+func (v Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two HealthCheckPolicies conflict.
+func (v Validator) Conflicts(polA, polB policies.Policy) bool {
+	hcpA := helpers.MustCastObject[*ngfAPI.HealthCheckPolicy](polA)
+	hcpB := helpers.MustCastObject[*ngfAPI.HealthCheckPolicy](polB)
+
+	return conflicts(hcpA.Spec, hcpB.Spec)
+}
+
+func conflicts(a, b ngfAPI.HealthCheckPolicySpec) bool {
+	if a.Path != nil && b.Path != nil {
+		return true
+	}
+
+	if a.Interval != nil && b.Interval != nil {
+		return true
+	}
+
+	if a.Fails != nil && b.Fails != nil {
+		return true
+	}
+
+	if a.Passes != nil && b.Passes != nil {
+		return true
+	}
+
+	if a.Port != nil && b.Port != nil {
+		return true
+	}
+
+	return a.Match != nil && b.Match != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v Validator) validateSettings(spec ngfAPI.HealthCheckPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Path != nil && !pathRegexp.MatchString(*spec.Path) {
+		path := fieldPath.Child("path")
+		allErrs = append(
+			allErrs,
+			field.Invalid(path, *spec.Path, "must start with '/' and must not contain whitespace, '{', '}', ';', "+
+				"quotes or '\\'"),
+		)
+	}
+
+	if spec.Interval != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*spec.Interval)); err != nil {
+			path := fieldPath.Child("interval")
+			allErrs = append(allErrs, field.Invalid(path, *spec.Interval, err.Error()))
+		}
+	}
+
+	if spec.Match != nil {
+		allErrs = append(allErrs, validateMatch(*spec.Match, fieldPath.Child("match"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateMatch(match ngfAPI.HealthCheckMatch, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if match.Status != nil && !statusRegexp.MatchString(string(*match.Status)) {
+		path := fieldPath.Child("status")
+		allErrs = append(
+			allErrs,
+			field.Invalid(path, *match.Status, "must be a status code, a range of codes, or a space-separated list "+
+				"of codes and ranges, optionally negated with a leading '! '"),
+		)
+	}
+
+	if match.Body != nil {
+		if err := validateBody(*match.Body); err != nil {
+			path := fieldPath.Child("body")
+			allErrs = append(allErrs, field.Invalid(path, *match.Body, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBody(body string) error {
+	if strings.ContainsAny(body, "\r\n") {
+		return errors.New("must not contain line breaks")
+	}
+
+	if !bodyRegexp.MatchString(body) {
+		return errors.New(`must have all '"' escaped and must not end with an unescaped '\'`)
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+const (
+	plusEnabled  = true
+	plusDisabled = false
+)
+
+type policyModFunc func(policy *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy
+
+func createValidPolicy() *ngfAPI.HealthCheckPolicy {
+	return &ngfAPI.HealthCheckPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.HealthCheckPolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReference{
+				{
+					Group: "core",
+					Kind:  kinds.Service,
+					Name:  "svc",
+				},
+			},
+			Path:     helpers.GetPointer("/healthz"),
+			Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			Fails:    helpers.GetPointer[int32](3),
+			Passes:   helpers.GetPointer[int32](2),
+			Port:     helpers.GetPointer[int32](8080),
+			Match: &ngfAPI.HealthCheckMatch{
+				Status: helpers.GetPointer[ngfAPI.HealthCheckStatusMatch]("200-399"),
+				Body:   helpers.GetPointer(`^\"status\": \"ok\"$`),
+			},
+		},
+		Status: v1.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.HealthCheckPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.HealthCheckPolicy
+		expConditions []conditions.Condition
+		plusEnabled   bool
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.TargetRefs = append(
+					p.Spec.TargetRefs,
+					v1.LocalPolicyTargetReference{
+						Group: "Unsupported",
+						Kind:  kinds.Service,
+						Name:  "svc",
+					})
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs[1].group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"\", \"core\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.TargetRefs = append(
+					p.Spec.TargetRefs,
+					v1.LocalPolicyTargetReference{
+						Group: "",
+						Kind:  "Unsupported",
+						Name:  "svc",
+					})
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs[1].kind: Unsupported value: \"Unsupported\": " +
+					"supported values: \"Service\""),
+			},
+		},
+		{
+			name:        "NGINX OSS",
+			policy:      createValidPolicy(),
+			plusEnabled: plusDisabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyNotAcceptedNginxPlusRequired(
+					"HealthCheckPolicy requires NGINX Plus; active health checks are not supported by NGINX OSS",
+				),
+			},
+		},
+		{
+			name: "invalid path",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.Path = helpers.GetPointer("/health; return 200")
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.path: Invalid value: \"/health; return 200\": must start with '/' " +
+					"and must not contain whitespace, '{', '}', ';', quotes or '\\'"),
+			},
+		},
+		{
+			name: "invalid interval",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.Interval = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.interval: Invalid value: \"invalid\": " +
+					"must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'^[0-9]{1,4}(ms|s|m|h)?')"),
+			},
+		},
+		{
+			name: "invalid match",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.Match.Status = helpers.GetPointer[ngfAPI.HealthCheckStatusMatch]("200;")
+				p.Spec.Match.Body = helpers.GetPointer(`ok"; }`)
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.match.status: Invalid value: \"200;\": must be a status code, " +
+					"a range of codes, or a space-separated list of codes and ranges, optionally negated with " +
+					"a leading '! ', spec.match.body: Invalid value: \"ok\\\"; }\": must have all '\"' escaped " +
+					"and must not end with an unescaped '\\']"),
+			},
+		},
+		{
+			name: "match body with line break",
+			policy: createModifiedPolicy(func(p *ngfAPI.HealthCheckPolicy) *ngfAPI.HealthCheckPolicy {
+				p.Spec.Match.Body = helpers.GetPointer("ok\n")
+				return p
+			}),
+			plusEnabled: plusEnabled,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.match.body: Invalid value: \"ok\\n\": must not contain line breaks"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			plusEnabled:   plusEnabled,
+			expConditions: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := healthcheck.NewValidator(validation.GenericValidator{}, test.plusEnabled)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := healthcheck.NewValidator(nil, plusEnabled)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := healthcheck.NewValidator(validation.GenericValidator{}, plusEnabled)
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.HealthCheckPolicy
+		polB      *ngfAPI.HealthCheckPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{
+					Path:     helpers.GetPointer("/healthz"),
+					Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					Fails:    helpers.GetPointer[int32](3),
+				},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{
+					Passes: helpers.GetPointer[int32](2),
+					Port:   helpers.GetPointer[int32](8080),
+					Match: &ngfAPI.HealthCheckMatch{
+						Status: helpers.GetPointer[ngfAPI.HealthCheckStatusMatch]("200"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "path conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Path: helpers.GetPointer("/healthz")},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Path: helpers.GetPointer("/ready")},
+			},
+			conflicts: true,
+		},
+		{
+			name: "interval conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Interval: helpers.GetPointer[ngfAPI.Duration]("10s")},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Interval: helpers.GetPointer[ngfAPI.Duration]("5s")},
+			},
+			conflicts: true,
+		},
+		{
+			name: "fails conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Fails: helpers.GetPointer[int32](1)},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Fails: helpers.GetPointer[int32](2)},
+			},
+			conflicts: true,
+		},
+		{
+			name: "passes conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Passes: helpers.GetPointer[int32](1)},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Passes: helpers.GetPointer[int32](2)},
+			},
+			conflicts: true,
+		},
+		{
+			name: "port conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Port: helpers.GetPointer[int32](8080)},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{Port: helpers.GetPointer[int32](9090)},
+			},
+			conflicts: true,
+		},
+		{
+			name: "match conflicts",
+			polA: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{
+					Match: &ngfAPI.HealthCheckMatch{Status: helpers.GetPointer[ngfAPI.HealthCheckStatusMatch]("200")},
+				},
+			},
+			polB: &ngfAPI.HealthCheckPolicy{
+				Spec: ngfAPI.HealthCheckPolicySpec{
+					Match: &ngfAPI.HealthCheckMatch{Body: helpers.GetPointer("ok")},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := healthcheck.NewValidator(nil, plusEnabled)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := healthcheck.NewValidator(nil, plusEnabled)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -7,6 +7,7 @@ import (
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
@@ -15,8 +16,41 @@ var (
 	tmpl            = template.Must(template.New("observability policy").Parse(observabilityTemplate))
 	tmplInternal    = template.Must(template.New("observability policy internal").Parse(internalTemplate))
 	tmplExtRedirect = template.Must(template.New("observability policy ext redirect").Parse(externalRedirectTemplate))
+	tmplHTTP        = template.Must(template.New("observability policy http").Parse(httpTemplate))
 )
 
+// accessLogTemplate is shared by all location templates, since NGINX logs a request with the access_log
+// directives of the location where the processing of the request ends.
+const accessLogTemplate = `
+{{- if .AccessLog }}
+  {{- if .AccessLog.Disable }}
+access_log off;
+  {{- else }}
+access_log {{ .AccessLog.Path }} {{ .AccessLog.FormatName }}{{ if .AccessLog.If }} if={{ .AccessLog.If }}{{ end }};
+  {{- end }}
+{{- end }}
+`
+
+const httpTemplate = `
+{{- if .Format }}
+log_format {{ .FormatName }}{{ if .Escape }} escape={{ .Escape }}{{ end }} '{{ .Format }}';
+{{- end }}
+{{- if .SampleVariable }}
+split_clients $request_id {{ .SampleVariable }} {
+    {{ .SampleRatio }}% 1;
+    * 0;
+}
+{{- end }}
+{{- if .ConditionVariable }}
+map "{{ .SampleVariable }}:{{ .Condition }}" {{ .ConditionVariable }} {
+    "~^0:" 0;
+    "1:" 0;
+    "1:0" 0;
+    default 1;
+}
+{{- end }}
+`
+
 const observabilityTemplate = `
 {{- if .Tracing }}
 otel_trace {{ .Strategy }};
@@ -33,7 +67,7 @@ otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
 otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- end }}
 {{- end }}
-`
+` + accessLogTemplate
 
 const internalTemplate = `
 {{- if .Tracing }}
@@ -49,7 +83,7 @@ otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
 otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- end }}
 {{- end }}
-`
+` + accessLogTemplate
 
 const externalRedirectTemplate = `
 {{- if .Tracing }}
@@ -58,18 +92,82 @@ otel_trace {{ .Strategy }};
 otel_trace_context {{ .Tracing.Context }};
   {{- end }}
 {{- end }}
-`
+` + accessLogTemplate
+
+const (
+	// accessLogFormatCombined is the name of the predefined NGINX combined log format.
+	accessLogFormatCombined = "combined"
+	// accessLogPrefix is the prefix of the log formats and variables generated for the access logs of policies.
+	accessLogPrefix = "ngf_access_log"
+)
+
+// accessLogSettings are the settings of the access log of an ObservabilityPolicy.
+type accessLogSettings struct {
+	// Path is the path of the access log.
+	Path string
+	// FormatName is the name of the log format used by the access log.
+	FormatName string
+	// Format is the log format that is generated into the http context. Empty if a predefined format is used.
+	Format string
+	// Escape is the escaping of the variables in Format.
+	Escape string
+	// Condition is the variable of the policy that enables the access log.
+	Condition string
+	// If is the variable of the if parameter of the access_log directive, if any.
+	// It is the Condition, the SampleVariable, or the ConditionVariable when both are set.
+	If string
+	// SampleVariable is the variable that samples the requests. Empty if all requests are logged.
+	SampleVariable string
+	// ConditionVariable is the variable that combines the sampling with the user condition.
+	// Empty unless both are set.
+	ConditionVariable string
+	// SampleRatio is the percentage of the requests that are logged.
+	SampleRatio int32
+	// Disable specifies whether the access log is disabled.
+	Disable bool
+}
 
 // Generator generates nginx configuration based on an observability policy.
 type Generator struct {
 	policies.UnimplementedGenerator
 
+	accessLogConf *dataplane.AccessLog
 	telemetryConf dataplane.Telemetry
 }
 
 // NewGenerator returns a new instance of Generator.
-func NewGenerator(telemetry dataplane.Telemetry) *Generator {
-	return &Generator{telemetryConf: telemetry}
+// The global access log settings determine the log format of the policies that don't specify one.
+func NewGenerator(telemetry dataplane.Telemetry, accessLog *dataplane.AccessLog) *Generator {
+	return &Generator{telemetryConf: telemetry, accessLogConf: accessLog}
+}
+
+// GenerateForHTTP generates the log formats and variables that the access logs of the policies use
+// into the http context.
+func (g Generator) GenerateForHTTP(pols []policies.Policy) policies.GenerateResultFiles {
+	var files policies.GenerateResultFiles
+
+	for _, pol := range pols {
+		obs, ok := pol.(*ngfAPIv1alpha2.ObservabilityPolicy)
+		if !ok {
+			continue
+		}
+
+		accessLog := g.buildAccessLog(obs)
+		if accessLog == nil || accessLog.Disable {
+			continue
+		}
+
+		if accessLog.Format == "" && accessLog.SampleVariable == "" {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_http.conf", obs.Namespace, obs.Name),
+			Content: helpers.MustExecuteTemplate(tmplHTTP, accessLog),
+		})
+	}
+
+	return files
 }
 
 // GenerateForLocation generates policy configuration for a normal location block.
@@ -82,6 +180,8 @@ func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Loc
 		fileSuffix string,
 		includeGlobalAttrs bool,
 	) policies.GenerateResultFiles {
+		var files policies.GenerateResultFiles
+
 		for _, pol := range pols {
 			obs, ok := pol.(*ngfAPIv1alpha2.ObservabilityPolicy)
 			if !ok {
@@ -89,21 +189,21 @@ func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Loc
 			}
 
 			fields := map[string]any{
-				"Tracing":  obs.Spec.Tracing,
-				"Strategy": getStrategy(obs),
+				"Tracing":   obs.Spec.Tracing,
+				"Strategy":  getStrategy(obs),
+				"AccessLog": g.buildAccessLog(obs),
 			}
 			if includeGlobalAttrs {
 				fields["GlobalSpanAttributes"] = g.telemetryConf.SpanAttributes
 			}
 
-			return policies.GenerateResultFiles{
-				{
-					Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_%s.conf", obs.Namespace, obs.Name, fileSuffix),
-					Content: helpers.MustExecuteTemplate(tmplate, fields),
-				},
-			}
+			files = append(files, policies.File{
+				Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_%s.conf", obs.Namespace, obs.Name, fileSuffix),
+				Content: helpers.MustExecuteTemplate(tmplate, fields),
+			})
 		}
-		return nil
+
+		return files
 	}
 
 	if location.Type == http.ExternalLocationType {
@@ -117,6 +217,8 @@ func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Loc
 // otel_span_attr and otel_span_name are set in the internal location, with otel_trace and otel_trace_context
 // being specified in the external location that redirects to the internal location.
 func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	var files policies.GenerateResultFiles
+
 	for _, pol := range pols {
 		obs, ok := pol.(*ngfAPIv1alpha2.ObservabilityPolicy)
 		if !ok {
@@ -126,17 +228,79 @@ func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.
 		fields := map[string]any{
 			"Tracing":              obs.Spec.Tracing,
 			"GlobalSpanAttributes": g.telemetryConf.SpanAttributes,
+			"AccessLog":            g.buildAccessLog(obs),
 		}
 
-		return policies.GenerateResultFiles{
-			{
-				Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_int.conf", obs.Namespace, obs.Name),
-				Content: helpers.MustExecuteTemplate(tmplInternal, fields),
-			},
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_int.conf", obs.Namespace, obs.Name),
+			Content: helpers.MustExecuteTemplate(tmplInternal, fields),
+		})
+	}
+
+	return files
+}
+
+// buildAccessLog returns the access log settings of the policy, or nil if the policy doesn't configure
+// the access log.
+func (g Generator) buildAccessLog(obs *ngfAPIv1alpha2.ObservabilityPolicy) *accessLogSettings {
+	spec := obs.Spec.AccessLog
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil && *spec.Disable {
+		return &accessLogSettings{Disable: true}
+	}
+
+	id := shared.UniqueVariableName(obs.Namespace + "/" + obs.Name)
+
+	settings := &accessLogSettings{
+		Path:       dataplane.DefaultAccessLogPath,
+		FormatName: g.defaultFormatName(),
+	}
+
+	switch {
+	case spec.Format != nil:
+		settings.FormatName = fmt.Sprintf("%s_%s", accessLogPrefix, id)
+		settings.Format = *spec.Format
+		if spec.Escape != nil {
+			settings.Escape = string(*spec.Escape)
 		}
+	case spec.FormatName != nil && *spec.FormatName == ngfAPIv1alpha2.AccessLogFormatJSON:
+		settings.FormatName = fmt.Sprintf("%s_%s", accessLogPrefix, id)
+		settings.Format = dataplane.JSONAccessLogFormat
+		settings.Escape = string(ngfAPIv1alpha2.NginxAccessLogEscapeJSON)
+	case spec.FormatName != nil && *spec.FormatName == ngfAPIv1alpha2.AccessLogFormatCombined:
+		settings.FormatName = accessLogFormatCombined
+	}
+
+	if spec.Condition != nil {
+		settings.Condition = *spec.Condition
+		settings.If = settings.Condition
+	}
+
+	if spec.SampleRatio != nil && *spec.SampleRatio < 100 {
+		settings.SampleRatio = *spec.SampleRatio
+		settings.SampleVariable = fmt.Sprintf("$%s_sample_%s", accessLogPrefix, id)
+		settings.If = settings.SampleVariable
+
+		if settings.Condition != "" {
+			settings.ConditionVariable = fmt.Sprintf("$%s_%s", accessLogPrefix, id)
+			settings.If = settings.ConditionVariable
+		}
+	}
+
+	return settings
+}
+
+// defaultFormatName returns the name of the log format of the NginxProxy, which is the NGINX combined
+// format unless the NginxProxy specifies a custom format.
+func (g Generator) defaultFormatName() string {
+	if g.accessLogConf != nil && !g.accessLogConf.Disable && g.accessLogConf.Format != "" {
+		return dataplane.DefaultLogFormatName
 	}
 
-	return nil
+	return accessLogFormatCombined
 }
 
 func getStrategy(obs *ngfAPIv1alpha2.ObservabilityPolicy) string {
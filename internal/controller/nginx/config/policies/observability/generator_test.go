@@ -247,7 +247,7 @@ func TestGenerate(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			generator := observability.NewGenerator(test.telemetryConf)
+			generator := observability.NewGenerator(test.telemetryConf, nil)
 
 			for _, locType := range []http.LocationType{
 				http.ExternalLocationType, http.RedirectLocationType, http.InternalLocationType,
@@ -286,7 +286,7 @@ func TestGenerateNoPolicies(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	generator := observability.NewGenerator(dataplane.Telemetry{})
+	generator := observability.NewGenerator(dataplane.Telemetry{}, nil)
 
 	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
@@ -300,3 +300,198 @@ func TestGenerateNoPolicies(t *testing.T) {
 	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha1.ClientSettingsPolicy{}})
 	g.Expect(resFiles).To(BeEmpty())
 }
+
+func createAccessLogPolicy(name string, accessLog *ngfAPIv1alpha2.AccessLog) *ngfAPIv1alpha2.ObservabilityPolicy {
+	return &ngfAPIv1alpha2.ObservabilityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+		},
+		Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+			AccessLog: accessLog,
+		},
+	}
+}
+
+func TestGenerateAccessLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		policy             policies.Policy
+		globalAccessLog    *dataplane.AccessLog
+		expHTTPStrings     []string
+		expLocationStrings []string
+		expNotStrings      []string
+	}{
+		{
+			name:               "disabled",
+			policy:             createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{Disable: helpers.GetPointer(true)}),
+			expLocationStrings: []string{"access_log off;"},
+			expNotStrings:      []string{"access_log /dev/stdout"},
+		},
+		{
+			name:               "default format",
+			policy:             createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{}),
+			expLocationStrings: []string{"access_log /dev/stdout combined;"},
+		},
+		{
+			name:               "default format of the NginxProxy",
+			policy:             createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{}),
+			globalAccessLog:    &dataplane.AccessLog{Format: "$remote_addr"},
+			expLocationStrings: []string{"access_log /dev/stdout ngf_user_defined_log_format;"},
+		},
+		{
+			name:               "NginxProxy access log disabled",
+			policy:             createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{}),
+			globalAccessLog:    &dataplane.AccessLog{Disable: true},
+			expLocationStrings: []string{"access_log /dev/stdout combined;"},
+		},
+		{
+			name: "combined format with condition",
+			policy: createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{
+				FormatName: helpers.GetPointer(ngfAPIv1alpha2.AccessLogFormatCombined),
+				Condition:  helpers.GetPointer("$loggable"),
+			}),
+			globalAccessLog:    &dataplane.AccessLog{Format: "$remote_addr"},
+			expLocationStrings: []string{"access_log /dev/stdout combined if=$loggable;"},
+		},
+		{
+			name: "json format",
+			policy: createAccessLogPolicy("obs-policy", &ngfAPIv1alpha2.AccessLog{
+				FormatName: helpers.GetPointer(ngfAPIv1alpha2.AccessLogFormatJSON),
+			}),
+			expHTTPStrings: []string{
+				"log_format ngf_access_log_test_ns_obs_policy_8ac305d3 escape=json '" + dataplane.JSONAccessLogFormat + "';",
+			},
+			expLocationStrings: []string{"access_log /dev/stdout ngf_access_log_test_ns_obs_policy_8ac305d3;"},
+		},
+		{
+			name: "custom format with sampling",
+			policy: createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{
+				Format:      helpers.GetPointer(`$remote_addr "$request" $status`),
+				Escape:      helpers.GetPointer(ngfAPIv1alpha2.NginxAccessLogEscapeNone),
+				SampleRatio: helpers.GetPointer[int32](10),
+			}),
+			expHTTPStrings: []string{
+				`log_format ngf_access_log_test_ns_obs_2fe7d18e escape=none '$remote_addr "$request" $status';`,
+				"split_clients $request_id $ngf_access_log_sample_test_ns_obs_2fe7d18e {\n    10% 1;\n    * 0;\n}",
+			},
+			expLocationStrings: []string{
+				"access_log /dev/stdout ngf_access_log_test_ns_obs_2fe7d18e if=$ngf_access_log_sample_test_ns_obs_2fe7d18e;",
+			},
+			expNotStrings: []string{"map "},
+		},
+		{
+			name: "sampling with condition",
+			policy: createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{
+				Condition:   helpers.GetPointer("$loggable"),
+				SampleRatio: helpers.GetPointer[int32](50),
+			}),
+			expHTTPStrings: []string{
+				"split_clients $request_id $ngf_access_log_sample_test_ns_obs_2fe7d18e {\n    50% 1;\n    * 0;\n}",
+				"map \"$ngf_access_log_sample_test_ns_obs_2fe7d18e:$loggable\" $ngf_access_log_test_ns_obs_2fe7d18e {\n" +
+					"    \"~^0:\" 0;\n    \"1:\" 0;\n    \"1:0\" 0;\n    default 1;\n}",
+			},
+			expLocationStrings: []string{
+				"access_log /dev/stdout combined if=$ngf_access_log_test_ns_obs_2fe7d18e;",
+			},
+			expNotStrings: []string{"log_format "},
+		},
+		{
+			name: "sample ratio of 100",
+			policy: createAccessLogPolicy("obs", &ngfAPIv1alpha2.AccessLog{
+				SampleRatio: helpers.GetPointer[int32](100),
+			}),
+			expLocationStrings: []string{"access_log /dev/stdout combined;"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := observability.NewGenerator(dataplane.Telemetry{}, test.globalAccessLog)
+
+			httpFiles := generator.GenerateForHTTP([]policies.Policy{test.policy})
+			if len(test.expHTTPStrings) == 0 {
+				g.Expect(httpFiles).To(BeEmpty())
+			} else {
+				g.Expect(httpFiles).To(HaveLen(1))
+				g.Expect(httpFiles[0].Name).To(HavePrefix("ObservabilityPolicy_test-ns_"))
+				g.Expect(httpFiles[0].Name).To(HaveSuffix("_http.conf"))
+				for _, str := range test.expHTTPStrings {
+					g.Expect(string(httpFiles[0].Content)).To(ContainSubstring(str))
+				}
+				for _, str := range test.expNotStrings {
+					g.Expect(string(httpFiles[0].Content)).ToNot(ContainSubstring(str))
+				}
+				g.Expect(string(httpFiles[0].Content)).ToNot(ContainSubstring("\naccess_log"))
+			}
+
+			locationFiles := []policies.GenerateResultFiles{
+				generator.GenerateForLocation(
+					[]policies.Policy{test.policy},
+					http.Location{Type: http.ExternalLocationType},
+				),
+				generator.GenerateForLocation(
+					[]policies.Policy{test.policy},
+					http.Location{Type: http.RedirectLocationType},
+				),
+				generator.GenerateForInternalLocation([]policies.Policy{test.policy}),
+			}
+
+			for _, resFiles := range locationFiles {
+				g.Expect(resFiles).To(HaveLen(1))
+
+				content := string(resFiles[0].Content)
+				for _, str := range test.expLocationStrings {
+					g.Expect(content).To(ContainSubstring(str))
+				}
+				for _, str := range test.expNotStrings {
+					g.Expect(content).ToNot(ContainSubstring(str))
+				}
+				g.Expect(content).ToNot(ContainSubstring("log_format "))
+				g.Expect(content).ToNot(ContainSubstring("otel_"))
+			}
+		})
+	}
+}
+
+func TestGenerateMultiplePolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := observability.NewGenerator(dataplane.Telemetry{}, nil)
+
+	tracingPolicy := &ngfAPIv1alpha2.ObservabilityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tracing",
+			Namespace: "test-ns",
+		},
+		Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+			Tracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+			},
+		},
+	}
+	accessLogPolicy := createAccessLogPolicy("access-log", &ngfAPIv1alpha2.AccessLog{Disable: helpers.GetPointer(true)})
+
+	pols := []policies.Policy{tracingPolicy, accessLogPolicy}
+
+	resFiles := generator.GenerateForLocation(pols, http.Location{Type: http.ExternalLocationType})
+	g.Expect(resFiles).To(HaveLen(2))
+	g.Expect(resFiles[0].Name).To(Equal("ObservabilityPolicy_test-ns_tracing_ext.conf"))
+	g.Expect(string(resFiles[0].Content)).To(ContainSubstring("otel_trace on;"))
+	g.Expect(resFiles[1].Name).To(Equal("ObservabilityPolicy_test-ns_access-log_ext.conf"))
+	g.Expect(string(resFiles[1].Content)).To(ContainSubstring("access_log off;"))
+
+	resFiles = generator.GenerateForInternalLocation(pols)
+	g.Expect(resFiles).To(HaveLen(2))
+	g.Expect(resFiles[0].Name).To(Equal("ObservabilityPolicy_test-ns_tracing_int.conf"))
+	g.Expect(resFiles[1].Name).To(Equal("ObservabilityPolicy_test-ns_access-log_int.conf"))
+	g.Expect(string(resFiles[1].Content)).To(ContainSubstring("access_log off;"))
+
+	g.Expect(generator.GenerateForHTTP(pols)).To(BeEmpty())
+}
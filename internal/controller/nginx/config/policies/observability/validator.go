@@ -45,10 +45,16 @@ func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
 }
 
 // ValidateGlobalSettings validates an ObservabilityPolicy with respect to the NginxProxy global settings.
+// Only tracing depends on the NginxProxy, so a policy without tracing is always valid.
 func (v *Validator) ValidateGlobalSettings(
-	_ policies.Policy,
+	policy policies.Policy,
 	globalSettings *policies.GlobalSettings,
 ) []conditions.Condition {
+	obs := helpers.MustCastObject[*ngfAPIv1alpha2.ObservabilityPolicy](policy)
+	if obs.Spec.Tracing == nil {
+		return nil
+	}
+
 	if globalSettings == nil {
 		return []conditions.Condition{
 			conditions.NewPolicyNotAcceptedNginxProxyNotSet(conditions.PolicyMessageNginxProxyInvalid),
@@ -69,7 +75,8 @@ func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
 	a := helpers.MustCastObject[*ngfAPIv1alpha2.ObservabilityPolicy](polA)
 	b := helpers.MustCastObject[*ngfAPIv1alpha2.ObservabilityPolicy](polB)
 
-	return a.Spec.Tracing != nil && b.Spec.Tracing != nil
+	return (a.Spec.Tracing != nil && b.Spec.Tracing != nil) ||
+		(a.Spec.AccessLog != nil && b.Spec.AccessLog != nil)
 }
 
 func (v *Validator) validateSettings(spec ngfAPIv1alpha2.ObservabilityPolicySpec) error {
@@ -139,5 +146,27 @@ func (v *Validator) validateSettings(spec ngfAPIv1alpha2.ObservabilityPolicySpec
 		}
 	}
 
+	if spec.AccessLog != nil {
+		accessLogPath := fieldPath.Child("accessLog")
+
+		if spec.AccessLog.Format != nil {
+			if err := v.genericValidator.ValidateAccessLogFormatString(*spec.AccessLog.Format); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(accessLogPath.Child("format"), *spec.AccessLog.Format, err.Error()),
+				)
+			}
+		}
+
+		if spec.AccessLog.Condition != nil {
+			if err := v.genericValidator.ValidateNginxVariableName(*spec.AccessLog.Condition); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(accessLogPath.Child("condition"), *spec.AccessLog.Condition, err.Error()),
+				)
+			}
+		}
+	}
+
 	return allErrs.ToAggregate()
 }
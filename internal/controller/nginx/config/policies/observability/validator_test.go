@@ -53,6 +53,10 @@ func createModifiedPolicy(mod policyModFunc) *ngfAPIv1alpha2.ObservabilityPolicy
 func TestValidator_Validate(t *testing.T) {
 	t.Parallel()
 
+	genericValidator := validation.GenericValidator{}
+	accessLogFormatErrMsg := genericValidator.ValidateAccessLogFormatString("$remote_addr '").Error()
+	variableNameErrMsg := genericValidator.ValidateNginxVariableName("loggable").Error()
+
 	tests := []struct {
 		name          string
 		policy        *ngfAPIv1alpha2.ObservabilityPolicy
@@ -138,6 +142,35 @@ func TestValidator_Validate(t *testing.T) {
 					"unescaped '\\' (regex used for validation is '([^\"$\\\\]|\\\\[^$])*')"),
 			},
 		},
+		{
+			name: "invalid access log format and condition",
+			policy: createModifiedPolicy(func(p *ngfAPIv1alpha2.ObservabilityPolicy) *ngfAPIv1alpha2.ObservabilityPolicy {
+				p.Spec.AccessLog = &ngfAPIv1alpha2.AccessLog{
+					Format:    helpers.GetPointer("$remote_addr '"),
+					Condition: helpers.GetPointer("loggable"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.accessLog.format: Invalid value: \"$remote_addr '\": " +
+					accessLogFormatErrMsg + ", spec.accessLog.condition: Invalid value: \"loggable\": " +
+					variableNameErrMsg + "]"),
+			},
+		},
+		{
+			name: "valid access log",
+			policy: createModifiedPolicy(func(p *ngfAPIv1alpha2.ObservabilityPolicy) *ngfAPIv1alpha2.ObservabilityPolicy {
+				p.Spec.Tracing = nil
+				p.Spec.AccessLog = &ngfAPIv1alpha2.AccessLog{
+					Format:      helpers.GetPointer(`$remote_addr "$request" $status`),
+					Escape:      helpers.GetPointer(ngfAPIv1alpha2.NginxAccessLogEscapeJSON),
+					Condition:   helpers.GetPointer("$loggable"),
+					SampleRatio: helpers.GetPointer[int32](10),
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
 		{
 			name:          "valid",
 			policy:        createValidPolicy(),
@@ -174,31 +207,52 @@ func TestValidator_ValidatePanics(t *testing.T) {
 func TestValidator_ValidateGlobalSettings(t *testing.T) {
 	t.Parallel()
 
+	accessLogOnly := createModifiedPolicy(func(p *ngfAPIv1alpha2.ObservabilityPolicy) *ngfAPIv1alpha2.ObservabilityPolicy {
+		p.Spec.Tracing = nil
+		p.Spec.AccessLog = &ngfAPIv1alpha2.AccessLog{Disable: helpers.GetPointer(true)}
+		return p
+	})
+
 	tests := []struct {
-		name           string
+		policy         *ngfAPIv1alpha2.ObservabilityPolicy
 		globalSettings *policies.GlobalSettings
+		name           string
 		expConditions  []conditions.Condition
 	}{
 		{
-			name: "global settings are nil",
+			name:   "global settings are nil",
+			policy: createValidPolicy(),
 			expConditions: []conditions.Condition{
 				conditions.NewPolicyNotAcceptedNginxProxyNotSet(conditions.PolicyMessageNginxProxyInvalid),
 			},
 		},
 		{
 			name:           "telemetry is not enabled",
+			policy:         createValidPolicy(),
 			globalSettings: &policies.GlobalSettings{TelemetryEnabled: false},
 			expConditions: []conditions.Condition{
 				conditions.NewPolicyNotAcceptedNginxProxyNotSet(conditions.PolicyMessageTelemetryNotEnabled),
 			},
 		},
 		{
-			name: "valid",
+			name:   "valid",
+			policy: createValidPolicy(),
 			globalSettings: &policies.GlobalSettings{
 				TelemetryEnabled: true,
 			},
 			expConditions: nil,
 		},
+		{
+			name:          "access log does not require global settings",
+			policy:        accessLogOnly,
+			expConditions: nil,
+		},
+		{
+			name:           "access log does not require telemetry",
+			policy:         accessLogOnly,
+			globalSettings: &policies.GlobalSettings{TelemetryEnabled: false},
+			expConditions:  nil,
+		},
 	}
 
 	v := observability.NewValidator(validation.GenericValidator{})
@@ -208,7 +262,7 @@ func TestValidator_ValidateGlobalSettings(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			conds := v.ValidateGlobalSettings(nil, test.globalSettings)
+			conds := v.ValidateGlobalSettings(test.policy, test.globalSettings)
 			g.Expect(conds).To(Equal(test.expConditions))
 		})
 	}
@@ -248,6 +302,34 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "no conflicts; different fields",
+			polA: &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					Tracing: &ngfAPIv1alpha2.Tracing{},
+				},
+			},
+			polB: &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					AccessLog: &ngfAPIv1alpha2.AccessLog{},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "access log conflicts",
+			polA: &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					AccessLog: &ngfAPIv1alpha2.AccessLog{},
+				},
+			},
+			polB: &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					AccessLog: &ngfAPIv1alpha2.AccessLog{Disable: helpers.GetPointer(true)},
+				},
+			},
+			conflicts: true,
+		},
 	}
 
 	v := observability.NewValidator(nil)
@@ -20,6 +20,12 @@ type Policy interface {
 	client.Object
 }
 
+// SectionNamePolicy is implemented by Policies whose targetRefs can reference a section of the target,
+// such as a named rule of an HTTPRoute or GRPCRoute.
+type SectionNamePolicy interface {
+	GetTargetRefsWithSectionName() []gatewayv1.LocalPolicyTargetReferenceWithSectionName
+}
+
 // GlobalSettings contains global settings from the current state of the graph that may be
 // needed for policy validation or generation if certain policies rely on those global settings.
 type GlobalSettings struct {
@@ -27,6 +33,9 @@ type GlobalSettings struct {
 	TelemetryEnabled bool
 	// WAFEnabled is whether WAF is enabled in the NginxProxy resource.
 	WAFEnabled bool
+	// MetricsExporterEnabled is whether the OTLP metrics exporter of the NGINX agent is configured in the
+	// NginxProxy resource.
+	MetricsExporterEnabled bool
 }
 
 // ValidateTargetRef validates a policy's targetRef for the proper group and kind.
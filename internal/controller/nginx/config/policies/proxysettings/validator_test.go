@@ -24,11 +24,13 @@ func createValidPolicy() *ngfAPI.ProxySettingsPolicy {
 			Namespace: "default",
 		},
 		Spec: ngfAPI.ProxySettingsPolicySpec{
-			TargetRefs: []v1.LocalPolicyTargetReference{
+			TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 				{
-					Group: v1.GroupName,
-					Kind:  kinds.Gateway,
-					Name:  "gateway",
+					LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  kinds.Gateway,
+						Name:  "gateway",
+					},
 				},
 			},
 			Buffering: &ngfAPI.ProxyBuffering{
@@ -7,6 +7,7 @@ import (
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
@@ -14,7 +15,7 @@ import (
 // rateLimitHTTPTemplate generates only the limit_req_zone directive at the http context.
 const rateLimitHTTPTemplate = `
 {{ range $r := .Rule }}
-limit_req_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }} rate={{ .Rate }};
+limit_req_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }} rate={{ .Rate }}{{ if .Sync }} sync{{ end }};
 {{ end }}
 `
 
@@ -87,6 +88,8 @@ type rateLimitRule struct {
 	Burst int
 	// NoDelay indicates whether excessive requests are processed without delay.
 	NoDelay bool
+	// Sync indicates whether the shared memory zone is synchronized between the nginx replicas.
+	Sync bool
 }
 
 func getRateLimitSettings(rlp ngfAPI.RateLimitPolicy) rateLimitSettings {
@@ -107,46 +110,138 @@ func getRateLimitSettings(rlp ngfAPI.RateLimitPolicy) rateLimitSettings {
 
 		if rlp.Spec.RateLimit.Local != nil {
 			for i, rule := range rlp.Spec.RateLimit.Local.Rules {
-				rlRule := rateLimitRule{}
+				rlRule := buildRateLimitRule(rule, localZoneName(&rlp, i))
 
-				rlRule.ZoneSize = defaultZoneSize
-				if rule.ZoneSize != nil {
-					rlRule.ZoneSize = string(*rule.ZoneSize)
-				}
+				settings.Rule = append(settings.Rule, rlRule)
+			}
+		}
+
+		if rlp.Spec.RateLimit.Global != nil {
+			for i, rule := range rlp.Spec.RateLimit.Global.Rules {
+				rlRule := buildRateLimitRule(rule, globalZoneName(&rlp, i))
+				rlRule.Sync = true
+
+				settings.Rule = append(settings.Rule, rlRule)
+			}
+		}
+	}
+
+	return settings
+}
 
-				if rule.Delay != nil {
-					rlRule.Delay = int(*rule.Delay)
-				}
+func buildRateLimitRule(rule ngfAPI.RateLimitRule, zoneName string) rateLimitRule {
+	rlRule := rateLimitRule{
+		ZoneName: zoneName,
+	}
+
+	rlRule.ZoneSize = defaultZoneSize
+	if rule.ZoneSize != nil {
+		rlRule.ZoneSize = string(*rule.ZoneSize)
+	}
 
-				if rule.Burst != nil {
-					rlRule.Burst = int(*rule.Burst)
-				}
+	if rule.Delay != nil {
+		rlRule.Delay = int(*rule.Delay)
+	}
+
+	if rule.Burst != nil {
+		rlRule.Burst = int(*rule.Burst)
+	}
 
-				if rule.NoDelay != nil {
-					rlRule.NoDelay = *rule.NoDelay
-				}
+	if rule.NoDelay != nil {
+		rlRule.NoDelay = *rule.NoDelay
+	}
 
-				rlRule.Rate = defaultRate
-				if rule.Rate != "" {
-					rlRule.Rate = string(rule.Rate)
-				}
+	rlRule.Rate = defaultRate
+	if rule.Rate != "" {
+		rlRule.Rate = string(rule.Rate)
+	}
 
-				rlRule.Key = defaultKey
-				if rule.Key != "" {
-					rlRule.Key = rule.Key
-				}
+	rlRule.Key = getKey(rule)
+	// The key of a conditional rule is set by a map, which evaluates to an empty string when
+	// the request does not match the conditions. NGINX does not limit requests with an empty key.
+	if rule.Conditions != nil {
+		rlRule.Key = KeyVariable(zoneName)
+	}
 
-				rlRule.ZoneName = fmt.Sprintf("%s_rl_%s_rule%d", rlp.Namespace, rlp.Name, i)
+	return rlRule
+}
 
-				settings.Rule = append(settings.Rule, rlRule)
+func getKey(rule ngfAPI.RateLimitRule) string {
+	if rule.Key != "" {
+		return rule.Key
+	}
+
+	return defaultKey
+}
+
+func localZoneName(rlp *ngfAPI.RateLimitPolicy, idx int) string {
+	return fmt.Sprintf("%s_rl_%s_rule%d", rlp.Namespace, rlp.Name, idx)
+}
+
+func globalZoneName(rlp *ngfAPI.RateLimitPolicy, idx int) string {
+	return fmt.Sprintf("%s_rl_%s_global_rule%d", rlp.Namespace, rlp.Name, idx)
+}
+
+// ConditionalRule is a rate limit rule that only applies to the requests that match its Conditions.
+type ConditionalRule struct {
+	// Conditions are the conditions that a request must match for the rule to apply.
+	Conditions *ngfAPI.RateLimitConditions
+	// ZoneName is the name of the shared memory zone of the rule.
+	ZoneName string
+	// Key is the key to use for rate limiting the requests that match the Conditions.
+	Key string
+}
+
+// GetConditionalRules returns the rules of the RateLimitPolicy that have Conditions.
+func GetConditionalRules(rlp *ngfAPI.RateLimitPolicy) []ConditionalRule {
+	if rlp.Spec.RateLimit == nil {
+		return nil
+	}
+
+	var rules []ConditionalRule
+
+	if rlp.Spec.RateLimit.Local != nil {
+		for i, rule := range rlp.Spec.RateLimit.Local.Rules {
+			if rule.Conditions != nil {
+				rules = append(rules, ConditionalRule{
+					Conditions: rule.Conditions,
+					ZoneName:   localZoneName(rlp, i),
+					Key:        getKey(rule),
+				})
 			}
 		}
 	}
 
-	return settings
+	if rlp.Spec.RateLimit.Global != nil {
+		for i, rule := range rlp.Spec.RateLimit.Global.Rules {
+			if rule.Conditions != nil {
+				rules = append(rules, ConditionalRule{
+					Conditions: rule.Conditions,
+					ZoneName:   globalZoneName(rlp, i),
+					Key:        getKey(rule),
+				})
+			}
+		}
+	}
+
+	return rules
+}
+
+// KeyVariable returns the name of the variable that holds the key of the conditional rule with the zone name.
+// The variable is empty when the request does not match the conditions of the rule.
+func KeyVariable(zoneName string) string {
+	return fmt.Sprintf("$rl_%s_key", shared.UniqueVariableName(zoneName))
+}
+
+// ConditionVariable returns the name of the variable that is set to "1" when the request matches
+// the condition with the index of the conditional rule with the zone name.
+func ConditionVariable(zoneName string, idx int) string {
+	return fmt.Sprintf("$rl_%s_cond%d", shared.UniqueVariableName(zoneName), idx)
 }
 
 // Generator generates nginx configuration based on a rate limit policy.
+// It doesn't generate configuration for internal locations: NGINX applies the rate limits of the external location
+// that first handles a request, so the graph rejects policies that target rules routed via internal locations.
 type Generator struct {
 	policies.UnimplementedGenerator
 }
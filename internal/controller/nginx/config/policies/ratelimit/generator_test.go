@@ -246,6 +246,101 @@ func TestGenerate(t *testing.T) {
 				"limit_req_dry_run on;",
 			},
 		},
+		{
+			name: "global rule",
+			policy: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      policyName,
+					Namespace: policyNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Global: &ngfAPIv1alpha1.GlobalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Key:      key,
+									Rate:     rate,
+									ZoneSize: &zoneSize,
+									Burst:    &burst,
+								},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_req_zone $binary_remote_addr:$request_uri zone=default_rl_test-policy_global_rule0:20m " +
+					"rate=10r/s sync;",
+				"limit_req zone=default_rl_test-policy_global_rule0 burst=5;",
+			},
+		},
+		{
+			name: "local and global rules",
+			policy: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      policyName,
+					Namespace: policyNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Local: &ngfAPIv1alpha1.LocalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Rate: rate,
+								},
+							},
+						},
+						Global: &ngfAPIv1alpha1.GlobalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Rate:    ngfAPIv1alpha1.Rate("100r/m"),
+									NoDelay: &noDelay,
+								},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_req_zone $binary_remote_addr zone=default_rl_test-policy_rule0:10m rate=10r/s;",
+				"limit_req zone=default_rl_test-policy_rule0;",
+				"limit_req_zone $binary_remote_addr zone=default_rl_test-policy_global_rule0:10m rate=100r/m sync;",
+				"limit_req zone=default_rl_test-policy_global_rule0 nodelay;",
+			},
+		},
+		{
+			name: "conditional rules",
+			policy: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      policyName,
+					Namespace: policyNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Local: &ngfAPIv1alpha1.LocalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Key:  key,
+									Rate: rate,
+									Conditions: &ngfAPIv1alpha1.RateLimitConditions{
+										Methods: []ngfAPIv1alpha1.RateLimitHTTPMethod{"POST"},
+									},
+								},
+								{
+									Rate: rate,
+								},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_req_zone $rl_default_rl_test_policy_rule0_f688368e_key zone=default_rl_test-policy_rule0:10m rate=10r/s;",
+				"limit_req zone=default_rl_test-policy_rule0;",
+				"limit_req_zone $binary_remote_addr zone=default_rl_test-policy_rule1:10m rate=10r/s;",
+				"limit_req zone=default_rl_test-policy_rule1;",
+			},
+		},
 	}
 
 	// checkHTTPResults verifies that the http-context output contains only limit_req_zone directives.
@@ -355,3 +450,53 @@ func TestGenerateServerSkipsShadowPolicies(t *testing.T) {
 	g.Expect(resFiles).To(HaveLen(1))
 	g.Expect(resFiles[0].Name).To(ContainSubstring("internal_http"))
 }
+
+func TestGetConditionalRules(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conditions := &ngfAPIv1alpha1.RateLimitConditions{
+		Path: &ngfAPIv1alpha1.RateLimitPathMatch{Value: "/api"},
+	}
+
+	rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+		Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+			RateLimit: &ngfAPIv1alpha1.RateLimit{
+				Local: &ngfAPIv1alpha1.LocalRateLimit{
+					Rules: []ngfAPIv1alpha1.RateLimitRule{
+						{Rate: "10r/s"},
+						{Rate: "10r/s", Key: "$http_x_api_key", Conditions: conditions},
+					},
+				},
+				Global: &ngfAPIv1alpha1.GlobalRateLimit{
+					Rules: []ngfAPIv1alpha1.RateLimitRule{
+						{Rate: "10r/s", Conditions: conditions},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(ratelimit.GetConditionalRules(rlp)).To(Equal([]ratelimit.ConditionalRule{
+		{
+			Conditions: conditions,
+			ZoneName:   "default_rl_test-policy_rule1",
+			Key:        "$http_x_api_key",
+		},
+		{
+			Conditions: conditions,
+			ZoneName:   "default_rl_test-policy_global_rule0",
+			Key:        "$binary_remote_addr",
+		},
+	}))
+
+	g.Expect(ratelimit.GetConditionalRules(&ngfAPIv1alpha1.RateLimitPolicy{})).To(BeEmpty())
+	g.Expect(ratelimit.KeyVariable("default_rl_test-policy.v1_rule1")).
+		To(Equal("$rl_default_rl_test_policy_v1_rule1_8e09ed5c_key"))
+	g.Expect(ratelimit.ConditionVariable("default_rl_test-policy_rule1", 2)).
+		To(Equal("$rl_default_rl_test_policy_rule1_f7883821_cond2"))
+}
@@ -19,34 +19,70 @@ const (
 	rateStringErrMsg = `must contain a number followed by 'r/s' or 'r/m'`
 
 	// ?: is a non-capturing group
-	// [^ \t\r\n;{}#$]+ matches any run of characters except the separators that
-	//   would make nginx stop parsing the argument.
+	// [^ \t\r\n;{}#$"'\\]+ matches any run of characters except the separators that
+	//   would make nginx stop parsing the argument and the quotes and escapes that would end the
+	//   quoted string the key is written into.
 	// $\w+ matches an nginx variable.
-	limitReqKeyFmt = `^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	limitReqKeyFmt = `^(?:[^ \t\r\n;{}#$"'\\]+|\$\w+)+$`
 	limitReqErrMsg = "must be a valid limit_req key consisting of nginx variables " +
 		"and/or strings without spaces or special characters"
+
+	plusRequiredMsg = "Global rate limiting requires NGINX Plus; " +
+		"synchronizing rate limits between NGINX replicas is not supported by NGINX OSS"
+	jwtClaimPlusRequiredMsg = "Matching a JWT claim in rate limit conditions requires NGINX Plus; " +
+		"JWT validation is not supported by NGINX OSS"
+
+	headerNameFmt    = `^[A-Za-z0-9_-]+$`
+	headerNameErrMsg = "must contain only alphanumeric characters or '-' or '_'"
+
+	claimNameFmt    = `^[a-zA-Z0-9_]+$`
+	claimNameErrMsg = "must contain only alphanumeric characters or '_'"
+
+	// matchValueFmt matches a string where every '"' and '\' is escaped, so that the value
+	// can be safely placed inside a quoted map parameter.
+	matchValueFmt    = `^(?:[^"\\\r\n]|\\[^\r\n])*$`
+	matchValueErrMsg = `must have all '"' and '\' escaped and must not contain line breaks`
+
+	pathFmt    = `^/[^\s"\\]*$`
+	pathErrMsg = `must start with '/' and must not contain whitespace, '"' or '\'`
 )
 
 var (
 	rateStringRegexp  = regexp.MustCompile(rateStringFmt)
 	limitReqKeyRegexp = regexp.MustCompile(limitReqKeyFmt)
+	headerNameRegexp  = regexp.MustCompile(headerNameFmt)
+	claimNameRegexp   = regexp.MustCompile(claimNameFmt)
+	matchValueRegexp  = regexp.MustCompile(matchValueFmt)
+	pathRegexp        = regexp.MustCompile(pathFmt)
 )
 
 // Validator validates a RateLimitPolicy.
 // Implements policies.Validator interface.
 type Validator struct {
 	genericValidator validation.GenericValidator
+	plusEnabled      bool
 }
 
 // NewValidator returns a new instance of Validator.
-func NewValidator(genericValidator validation.GenericValidator) *Validator {
-	return &Validator{genericValidator: genericValidator}
+func NewValidator(genericValidator validation.GenericValidator, plusEnabled bool) *Validator {
+	return &Validator{
+		genericValidator: genericValidator,
+		plusEnabled:      plusEnabled,
+	}
 }
 
 // Validate validates the spec of a RateLimitPolicy.
 func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
 	rlp := helpers.MustCastObject[*ngfAPI.RateLimitPolicy](policy)
 
+	if !v.plusEnabled && rlp.Spec.RateLimit != nil && rlp.Spec.RateLimit.Global != nil {
+		return []conditions.Condition{conditions.NewPolicyNotAcceptedNginxPlusRequired(plusRequiredMsg)}
+	}
+
+	if !v.plusEnabled && hasJWTClaimConditions(rlp.Spec) {
+		return []conditions.Condition{conditions.NewPolicyNotAcceptedNginxPlusRequired(jwtClaimPlusRequiredMsg)}
+	}
+
 	if err := v.validateSettings(rlp.Spec); err != nil {
 		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
 	}
@@ -94,49 +130,164 @@ func (v *Validator) validateSettings(spec ngfAPI.RateLimitPolicySpec) error {
 	var allErrs field.ErrorList
 	fieldPath := field.NewPath("spec")
 
-	if spec.RateLimit != nil && spec.RateLimit.Local != nil {
-		for _, rule := range spec.RateLimit.Local.Rules {
-			path := fieldPath.Child("rateLimit").Child("local").Child("rules")
-
-			if rule.ZoneSize != nil {
-				if err := v.genericValidator.ValidateNginxSize(string(*rule.ZoneSize)); err != nil {
-					allErrs = append(allErrs,
-						field.Invalid(
-							path.Child("zoneSize"),
-							*rule.ZoneSize,
-							err.Error(),
-						),
-					)
-				}
+	if spec.RateLimit != nil {
+		rateLimitPath := fieldPath.Child("rateLimit")
+
+		if spec.RateLimit.Local != nil {
+			allErrs = append(allErrs, v.validateRules(spec.RateLimit.Local.Rules, rateLimitPath.Child("local"))...)
+		}
+
+		if spec.RateLimit.Global != nil {
+			allErrs = append(allErrs, v.validateRules(spec.RateLimit.Global.Rules, rateLimitPath.Child("global"))...)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateRules(rules []ngfAPI.RateLimitRule, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, rule := range rules {
+		path := fieldPath.Child("rules")
+
+		if rule.ZoneSize != nil {
+			if err := v.genericValidator.ValidateNginxSize(string(*rule.ZoneSize)); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("zoneSize"),
+						*rule.ZoneSize,
+						err.Error(),
+					),
+				)
 			}
+		}
 
-			if rule.Rate != "" {
-				if err := validateNginxRate(string(rule.Rate)); err != nil {
-					allErrs = append(allErrs,
-						field.Invalid(
-							path.Child("rate"),
-							rule.Rate,
-							err.Error(),
-						),
-					)
-				}
+		if rule.Rate != "" {
+			if err := validateNginxRate(string(rule.Rate)); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("rate"),
+						rule.Rate,
+						err.Error(),
+					),
+				)
 			}
+		}
 
-			if rule.Key != "" {
-				if err := validateLimitReqKey(rule.Key); err != nil {
-					allErrs = append(allErrs,
-						field.Invalid(
-							path.Child("key"),
-							rule.Key,
-							err.Error(),
-						),
-					)
-				}
+		if rule.Key != "" {
+			if err := validateLimitReqKey(rule.Key); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("key"),
+						rule.Key,
+						err.Error(),
+					),
+				)
 			}
 		}
+
+		if rule.Conditions != nil {
+			allErrs = append(allErrs, validateConditions(*rule.Conditions, path.Child("conditions"))...)
+		}
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
+}
+
+func validateConditions(conds ngfAPI.RateLimitConditions, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(conds.Methods) == 0 && len(conds.Headers) == 0 && conds.Path == nil && conds.JWTClaim == nil {
+		allErrs = append(allErrs, field.Required(fieldPath, "at least one condition must be specified"))
+	}
+
+	for _, header := range conds.Headers {
+		headerPath := fieldPath.Child("headers")
+
+		if !headerNameRegexp.MatchString(header.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				headerPath.Child("name"),
+				header.Name,
+				k8svalidation.RegexError(headerNameErrMsg, headerNameFmt, "X-Api-Key", "user_agent"),
+			))
+		}
+
+		if err := validateMatchValue(header.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), header.Value, err.Error()))
+		}
+	}
+
+	if conds.Path != nil {
+		pathPath := fieldPath.Child("path")
+
+		if conds.Path.Type != nil && *conds.Path.Type == ngfAPI.RateLimitPathMatchTypeRegularExpression {
+			if err := validateMatchValue(conds.Path.Value); err != nil {
+				allErrs = append(allErrs, field.Invalid(pathPath.Child("value"), conds.Path.Value, err.Error()))
+			}
+		} else if !pathRegexp.MatchString(conds.Path.Value) {
+			allErrs = append(allErrs, field.Invalid(
+				pathPath.Child("value"),
+				conds.Path.Value,
+				k8svalidation.RegexError(pathErrMsg, pathFmt, "/api", "/api/v1/users"),
+			))
+		}
+	}
+
+	if conds.JWTClaim != nil {
+		claimPath := fieldPath.Child("jwtClaim")
+
+		if !claimNameRegexp.MatchString(conds.JWTClaim.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				claimPath.Child("name"),
+				conds.JWTClaim.Name,
+				k8svalidation.RegexError(claimNameErrMsg, claimNameFmt, "sub", "tenant_id"),
+			))
+		}
+
+		if err := validateMatchValue(conds.JWTClaim.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(claimPath.Child("value"), conds.JWTClaim.Value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMatchValue validates a value that is matched against a request attribute in an nginx map.
+func validateMatchValue(value string) error {
+	if !matchValueRegexp.MatchString(value) {
+		examples := []string{
+			"premium",
+			`^Bearer\s.+$`,
+			`say \"hello\"`,
+		}
+
+		return errors.New(k8svalidation.RegexError(matchValueErrMsg, matchValueFmt, examples...))
+	}
+
+	return nil
+}
+
+func hasJWTClaimConditions(spec ngfAPI.RateLimitPolicySpec) bool {
+	if spec.RateLimit == nil {
+		return false
+	}
+
+	var rules []ngfAPI.RateLimitRule
+	if spec.RateLimit.Local != nil {
+		rules = append(rules, spec.RateLimit.Local.Rules...)
+	}
+	if spec.RateLimit.Global != nil {
+		rules = append(rules, spec.RateLimit.Global.Rules...)
+	}
+
+	for _, rule := range rules {
+		if rule.Conditions != nil && rule.Conditions.JWTClaim != nil {
+			return true
+		}
+	}
+
+	return false
 }
 
 // validateNginxRate validates a rate string that nginx can understand.
@@ -24,11 +24,13 @@ func createValidPolicy() *ngfAPI.RateLimitPolicy {
 			Namespace: "default",
 		},
 		Spec: ngfAPI.RateLimitPolicySpec{
-			TargetRefs: []v1.LocalPolicyTargetReference{
+			TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 				{
-					Group: v1.GroupName,
-					Kind:  kinds.Gateway,
-					Name:  "gateway",
+					LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+						Group: v1.GroupName,
+						Kind:  kinds.Gateway,
+						Name:  "gateway",
+					},
 				},
 			},
 			RateLimit: &ngfAPI.RateLimit{
@@ -98,7 +100,107 @@ func TestValidator_Validate(t *testing.T) {
 					"\"$invalid_key{}\": must be a valid limit_req key consisting of nginx variables and/or " +
 					"strings without spaces or special characters (e.g. '$binary_remote_addr',  or " +
 					"'$binary_remote_addr:$request_uri',  or 'my_fixed_key', regex used for validation is " +
-					"'^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')"),
+					"'^(?:[^ \\t\\r\\n;{}#$\"'\\\\]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "key with a quote",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Key = `$binary_remote_addr"`
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.local.rules.key: Invalid value: " +
+					"\"$binary_remote_addr\\\"\": must be a valid limit_req key consisting of nginx variables and/or " +
+					"strings without spaces or special characters (e.g. '$binary_remote_addr',  or " +
+					"'$binary_remote_addr:$request_uri',  or 'my_fixed_key', regex used for validation is " +
+					"'^(?:[^ \\t\\r\\n;{}#$\"'\\\\]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "invalid global key",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Global = &ngfAPI.GlobalRateLimit{
+					Rules: []ngfAPI.RateLimitRule{
+						{
+							Rate: ngfAPI.Rate("10r/s"),
+							Key:  "$invalid_key{}",
+						},
+					},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.global.rules.key: Invalid value: " +
+					"\"$invalid_key{}\": must be a valid limit_req key consisting of nginx variables and/or " +
+					"strings without spaces or special characters (e.g. '$binary_remote_addr',  or " +
+					"'$binary_remote_addr:$request_uri',  or 'my_fixed_key', regex used for validation is " +
+					"'^(?:[^ \\t\\r\\n;{}#$\"'\\\\]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "invalid condition header",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{
+					Headers: []ngfAPI.RateLimitHeaderMatch{
+						{
+							Name:  "X-Tier",
+							Value: `premium"`,
+						},
+					},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.local.rules.conditions.headers.value: Invalid value: " +
+					`"premium\"": must have all '"' and '\' escaped and must not contain line breaks ` +
+					`(e.g. 'premium',  or '^Bearer\s.+$',  or 'say \"hello\"', regex used for validation is ` +
+					`'^(?:[^"\\\r\n]|\\[^\r\n])*$')`),
+			},
+		},
+		{
+			name: "invalid condition path",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{
+					Path: &ngfAPI.RateLimitPathMatch{
+						Type:  helpers.GetPointer(ngfAPI.RateLimitPathMatchTypePathPrefix),
+						Value: "api v1",
+					},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.local.rules.conditions.path.value: Invalid value: " +
+					`"api v1": must start with '/' and must not contain whitespace, '"' or '\' ` +
+					`(e.g. '/api',  or '/api/v1/users', regex used for validation is '^/[^\s"\\]*$')`),
+			},
+		},
+		{
+			name: "empty conditions",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.local.rules.conditions: Required value: " +
+					"at least one condition must be specified"),
+			},
+		},
+		{
+			name: "invalid condition jwt claim",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{
+					JWTClaim: &ngfAPI.RateLimitJWTClaimMatch{
+						Name:  "realm/roles",
+						Value: "admin",
+					},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.local.rules.conditions.jwtClaim.name: Invalid value: " +
+					"\"realm/roles\": must contain only alphanumeric characters or '_' " +
+					"(e.g. 'sub',  or 'tenant_id', regex used for validation is '^[a-zA-Z0-9_]+$')"),
 			},
 		},
 		{
@@ -106,6 +208,50 @@ func TestValidator_Validate(t *testing.T) {
 			policy:        createValidPolicy(),
 			expConditions: nil,
 		},
+		{
+			name: "valid with conditions",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{
+					Methods: []ngfAPI.RateLimitHTTPMethod{"POST", "PUT"},
+					Headers: []ngfAPI.RateLimitHeaderMatch{
+						{
+							Name:  "X-Tier",
+							Value: "free",
+						},
+						{
+							Type:  helpers.GetPointer(ngfAPI.RateLimitMatchTypeRegularExpression),
+							Name:  "User-Agent",
+							Value: `^curl/\d+$`,
+						},
+					},
+					Path: &ngfAPI.RateLimitPathMatch{
+						Value: "/api/v1",
+					},
+					JWTClaim: &ngfAPI.RateLimitJWTClaimMatch{
+						Name:  "tier",
+						Value: "free",
+					},
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid with global rules",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.RateLimit.Global = &ngfAPI.GlobalRateLimit{
+					Rules: []ngfAPI.RateLimitRule{
+						{
+							ZoneSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+							Rate:     ngfAPI.Rate("100r/m"),
+							Key:      "$binary_remote_addr",
+						},
+					},
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
 		{
 			name: "minimal valid with rate limit rule",
 			policy: &ngfAPI.RateLimitPolicy{
@@ -113,11 +259,13 @@ func TestValidator_Validate(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: ngfAPI.RateLimitPolicySpec{
-					TargetRefs: []v1.LocalPolicyTargetReference{
+					TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 						{
-							Group: v1.GroupName,
-							Kind:  kinds.Gateway,
-							Name:  "gateway",
+							LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+								Group: v1.GroupName,
+								Kind:  kinds.Gateway,
+								Name:  "gateway",
+							},
 						},
 					},
 					RateLimit: &ngfAPI.RateLimit{
@@ -137,7 +285,7 @@ func TestValidator_Validate(t *testing.T) {
 		},
 	}
 
-	v := ratelimit.NewValidator(validation.GenericValidator{})
+	v := ratelimit.NewValidator(validation.GenericValidator{}, true)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -150,9 +298,59 @@ func TestValidator_Validate(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateGlobalRequiresPlus(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := ratelimit.NewValidator(validation.GenericValidator{}, false)
+
+	g.Expect(v.Validate(createValidPolicy())).To(BeNil())
+
+	policy := createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+		p.Spec.RateLimit.Global = &ngfAPI.GlobalRateLimit{
+			Rules: []ngfAPI.RateLimitRule{
+				{
+					Rate: ngfAPI.Rate("10r/s"),
+					Key:  "$binary_remote_addr",
+				},
+			},
+		}
+		return p
+	})
+
+	conds := v.Validate(policy)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Type).To(Equal(string(v1.PolicyConditionAccepted)))
+	g.Expect(conds[0].Reason).To(Equal(string(conditions.PolicyReasonNginxPlusRequired)))
+	g.Expect(conds[0].Message).To(ContainSubstring("Global rate limiting requires NGINX Plus"))
+}
+
+func TestValidator_ValidateJWTClaimRequiresPlus(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+		p.Spec.RateLimit.Local.Rules[0].Conditions = &ngfAPI.RateLimitConditions{
+			JWTClaim: &ngfAPI.RateLimitJWTClaimMatch{
+				Name:  "tier",
+				Value: "free",
+			},
+		}
+		return p
+	})
+
+	g.Expect(ratelimit.NewValidator(validation.GenericValidator{}, true).Validate(policy)).To(BeNil())
+
+	conds := ratelimit.NewValidator(validation.GenericValidator{}, false).Validate(policy)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Type).To(Equal(string(v1.PolicyConditionAccepted)))
+	g.Expect(conds[0].Reason).To(Equal(string(conditions.PolicyReasonNginxPlusRequired)))
+	g.Expect(conds[0].Message).To(ContainSubstring("Matching a JWT claim in rate limit conditions requires NGINX Plus"))
+}
+
 func TestValidator_ValidatePanics(t *testing.T) {
 	t.Parallel()
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, true)
 
 	validate := func() {
 		_ = v.Validate(&policiesfakes.FakePolicy{})
@@ -167,7 +365,7 @@ func TestValidator_ValidateGlobalSettings(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	v := ratelimit.NewValidator(validation.GenericValidator{})
+	v := ratelimit.NewValidator(validation.GenericValidator{}, true)
 
 	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
 }
@@ -252,7 +450,7 @@ func TestValidator_Conflicts(t *testing.T) {
 		},
 	}
 
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, true)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -266,7 +464,7 @@ func TestValidator_Conflicts(t *testing.T) {
 
 func TestValidator_ConflictsPanics(t *testing.T) {
 	t.Parallel()
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, true)
 
 	conflicts := func() {
 		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
@@ -13,7 +13,14 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
-const appProtectBundleFolder = "/etc/app_protect/bundles"
+const (
+	appProtectBundleFolder = "/etc/app_protect/bundles"
+
+	// agentSyslogServer is the address of the syslog server of the NGINX agent, which receives the security logs
+	// of the otlp destination and exports them as OpenTelemetry logs. The agent only receives the security logs on
+	// a loopback address with its configured syslog port, which is 1514 by default.
+	agentSyslogServer = "127.0.0.1:1514"
+)
 
 var tmpl = template.Must(template.New("waf policy").Parse(wafTemplate))
 
@@ -46,15 +53,22 @@ func NewGenerator() *Generator {
 
 // GenerateForServer generates policy configuration for the server block.
 func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
-	return generate(pols)
+	return generate(pols, types.NamespacedName{})
 }
 
 // GenerateForLocation generates policy configuration for a normal location block.
-func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
-	return generate(pols)
+// If the location serves an HTTPRoute that is overridden in spec.routeSecurityLogs of a policy,
+// the security logs of the override are used instead of spec.securityLogs.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	var route types.NamespacedName
+	if !location.GRPC {
+		route = location.Route
+	}
+
+	return generate(pols, route)
 }
 
-func generate(pols []policies.Policy) policies.GenerateResultFiles {
+func generate(pols []policies.Policy, route types.NamespacedName) policies.GenerateResultFiles {
 	files := make(policies.GenerateResultFiles, 0, len(pols))
 
 	for _, pol := range pols {
@@ -64,10 +78,21 @@ func generate(pols []policies.Policy) policies.GenerateResultFiles {
 		}
 
 		fields := map[string]any{}
+		name := fmt.Sprintf("WAFPolicy_%s_%s.conf", wp.Namespace, wp.Name)
+		secLogs := wp.Spec.SecurityLogs
+
+		if route.Name != "" {
+			if routeLogs := graph.WAFRouteSecurityLogs(wp, route); routeLogs != nil {
+				// The file of the override differs from the file of the policy, so it needs its own name.
+				name = fmt.Sprintf("WAFPolicy_%s_%s_route_%s_%s.conf", wp.Namespace, wp.Name, route.Namespace, route.Name)
+				secLogs = routeLogs.SecurityLogs
+			}
+		}
 
 		if wp.Spec.PolicySource != nil && (wp.Spec.PolicySource.HTTPSource != nil ||
 			wp.Spec.PolicySource.NIMSource != nil ||
-			wp.Spec.PolicySource.N1CSource != nil) ||
+			wp.Spec.PolicySource.N1CSource != nil ||
+			wp.Spec.PolicySource.OCISource != nil) ||
 			wp.Spec.PolicyRef != nil && wp.Spec.PolicyRef.APPolicyRef != nil {
 			bundleName := string(graph.PLMPolicyBundleKey(types.NamespacedName{
 				Namespace: wp.Namespace,
@@ -77,12 +102,12 @@ func generate(pols []policies.Policy) policies.GenerateResultFiles {
 			fields["BundlePath"] = bundlePath
 		}
 
-		if securityLogs := buildSecurityLogEntries(wp, pol); len(securityLogs) > 0 {
+		if securityLogs := buildSecurityLogEntries(secLogs, pol); len(securityLogs) > 0 {
 			fields["SecurityLogs"] = securityLogs
 		}
 
 		files = append(files, policies.File{
-			Name:    fmt.Sprintf("WAFPolicy_%s_%s.conf", wp.Namespace, wp.Name),
+			Name:    name,
 			Content: helpers.MustExecuteTemplate(tmpl, fields),
 		})
 	}
@@ -90,11 +115,11 @@ func generate(pols []policies.Policy) policies.GenerateResultFiles {
 	return files
 }
 
-func buildSecurityLogEntries(wp *ngfAPI.WAFPolicy, pol policies.Policy) []map[string]string {
-	securityLogs := make([]map[string]string, 0, len(wp.Spec.SecurityLogs))
+func buildSecurityLogEntries(secLogs []ngfAPI.WAFSecurityLog, pol policies.Policy) []map[string]string {
+	securityLogs := make([]map[string]string, 0, len(secLogs))
 	polNsName := types.NamespacedName{Namespace: pol.GetNamespace(), Name: pol.GetName()}
 
-	for _, secLog := range wp.Spec.SecurityLogs {
+	for _, secLog := range secLogs {
 		logEntry := map[string]string{}
 
 		switch {
@@ -133,6 +158,8 @@ func formatSecurityLogDestination(dest ngfAPI.SecurityLogDestination) string {
 			return fmt.Sprintf("syslog:server=%s", dest.Syslog.Server)
 		}
 		return "stderr"
+	case ngfAPI.SecurityLogDestinationTypeOTLP:
+		return fmt.Sprintf("syslog:server=%s", agentSyslogServer)
 	default:
 		return "stderr"
 	}
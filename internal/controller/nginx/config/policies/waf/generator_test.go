@@ -5,12 +5,15 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/waf"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
 func TestGenerate(t *testing.T) {
@@ -46,6 +49,24 @@ func TestGenerate(t *testing.T) {
 				"app_protect_policy_file \"/etc/app_protect/bundles/my-namespace_my-name.tgz\";",
 			},
 		},
+		{
+			name: "OCI policy bundle",
+			policy: &ngfAPIv1alpha1.WAFPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "waf-oci",
+					Namespace: "my-namespace",
+				},
+				Spec: ngfAPIv1alpha1.WAFPolicySpec{
+					PolicySource: &ngfAPIv1alpha1.PolicySource{
+						OCISource: &ngfAPIv1alpha1.OCIBundleSource{Reference: "registry.example.com/waf/policy:v1"},
+					},
+				},
+			},
+			expStrings: []string{
+				"app_protect_enable on;",
+				"app_protect_policy_file \"/etc/app_protect/bundles/my-namespace_waf-oci.tgz\";",
+			},
+		},
 		{
 			name: "security log with log bundle URL and stderr destination",
 			policy: &ngfAPIv1alpha1.WAFPolicy{
@@ -139,6 +160,31 @@ func TestGenerate(t *testing.T) {
 					"syslog:server=syslog.example.com:514;",
 			},
 		},
+		{
+			name: "security log with otlp destination",
+			policy: &ngfAPIv1alpha1.WAFPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "waf-otlp",
+					Namespace: "test-ns",
+				},
+				Spec: ngfAPIv1alpha1.WAFPolicySpec{
+					SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+						{
+							LogSource: &ngfAPIv1alpha1.LogSource{
+								DefaultProfile: helpers.GetPointer(ngfAPIv1alpha1.DefaultLogProfileBlocked),
+							},
+							Destination: ngfAPIv1alpha1.SecurityLogDestination{
+								Type: ngfAPIv1alpha1.SecurityLogDestinationTypeOTLP,
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"app_protect_security_log_enable on;",
+				"app_protect_security_log log_blocked syslog:server=127.0.0.1:1514;",
+			},
+		},
 		{
 			name: "security log with NIM source and stderr destination",
 			policy: &ngfAPIv1alpha1.WAFPolicy{
@@ -343,3 +389,138 @@ func TestGenerateNoPolicies(t *testing.T) {
 	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 }
+
+func TestGenerateForLocationRouteSecurityLogs(t *testing.T) {
+	t.Parallel()
+
+	policy := &ngfAPIv1alpha1.WAFPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "waf",
+			Namespace: "test-ns",
+		},
+		Spec: ngfAPIv1alpha1.WAFPolicySpec{
+			PolicySource: &ngfAPIv1alpha1.PolicySource{
+				HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://storage.example.com/policy.tgz"},
+			},
+			SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+				{
+					LogSource: &ngfAPIv1alpha1.LogSource{
+						DefaultProfile: helpers.GetPointer(ngfAPIv1alpha1.DefaultLogProfileIllegal),
+					},
+					Destination: ngfAPIv1alpha1.SecurityLogDestination{
+						Type: ngfAPIv1alpha1.SecurityLogDestinationTypeStderr,
+					},
+				},
+			},
+			RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+				{
+					Name: "coffee",
+					SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+						{
+							LogSource: &ngfAPIv1alpha1.LogSource{
+								DefaultProfile: helpers.GetPointer(ngfAPIv1alpha1.DefaultLogProfileAll),
+							},
+							Destination: ngfAPIv1alpha1.SecurityLogDestination{
+								Type: ngfAPIv1alpha1.SecurityLogDestinationTypeOTLP,
+							},
+						},
+					},
+				},
+				{
+					Name:      "tea",
+					Namespace: helpers.GetPointer[gatewayv1.Namespace]("other-ns"),
+					SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+						{
+							LogSource: &ngfAPIv1alpha1.LogSource{
+								DefaultProfile: helpers.GetPointer(ngfAPIv1alpha1.DefaultLogProfileBlocked),
+							},
+							Destination: ngfAPIv1alpha1.SecurityLogDestination{
+								Type: ngfAPIv1alpha1.SecurityLogDestinationTypeFile,
+								File: &ngfAPIv1alpha1.SecurityLogFile{Path: "/var/log/nginx/tea.log"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	policyDirectives := []string{
+		"app_protect_enable on;",
+		"app_protect_policy_file \"/etc/app_protect/bundles/test-ns_waf.tgz\";",
+		"app_protect_security_log_enable on;",
+	}
+
+	tests := []struct {
+		name      string
+		expName   string
+		expLog    string
+		notExpLog string
+		location  http.Location
+	}{
+		{
+			name:      "location of an overridden route in the namespace of the policy",
+			location:  http.Location{Route: types.NamespacedName{Namespace: "test-ns", Name: "coffee"}},
+			expName:   "WAFPolicy_test-ns_waf_route_test-ns_coffee.conf",
+			expLog:    "app_protect_security_log log_all syslog:server=127.0.0.1:1514;",
+			notExpLog: "log_illegal",
+		},
+		{
+			name:      "location of an overridden route in another namespace",
+			location:  http.Location{Route: types.NamespacedName{Namespace: "other-ns", Name: "tea"}},
+			expName:   "WAFPolicy_test-ns_waf_route_other-ns_tea.conf",
+			expLog:    "app_protect_security_log log_blocked /var/log/nginx/tea.log;",
+			notExpLog: "log_illegal",
+		},
+		{
+			name:      "location of a route that is not overridden",
+			location:  http.Location{Route: types.NamespacedName{Namespace: "test-ns", Name: "tea"}},
+			expName:   "WAFPolicy_test-ns_waf.conf",
+			expLog:    "app_protect_security_log log_illegal stderr;",
+			notExpLog: "log_blocked",
+		},
+		{
+			name:      "location of several routes",
+			location:  http.Location{},
+			expName:   "WAFPolicy_test-ns_waf.conf",
+			expLog:    "app_protect_security_log log_illegal stderr;",
+			notExpLog: "log_all",
+		},
+		{
+			name: "gRPC location is not overridden",
+			location: http.Location{
+				Route: types.NamespacedName{Namespace: "test-ns", Name: "coffee"},
+				GRPC:  true,
+			},
+			expName:   "WAFPolicy_test-ns_waf.conf",
+			expLog:    "app_protect_security_log log_illegal stderr;",
+			notExpLog: "log_all",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := waf.NewGenerator()
+			resFiles := generator.GenerateForLocation([]policies.Policy{policy}, test.location)
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal(test.expName))
+
+			content := string(resFiles[0].Content)
+			for _, str := range policyDirectives {
+				g.Expect(content).To(ContainSubstring(str))
+			}
+			g.Expect(content).To(ContainSubstring(test.expLog))
+			g.Expect(content).ToNot(ContainSubstring(test.notExpLog))
+		})
+	}
+
+	// Route overrides never apply to the server.
+	g := NewWithT(t)
+	resFiles := waf.NewGenerator().GenerateForServer([]policies.Policy{policy}, http.Server{})
+	g.Expect(resFiles).To(HaveLen(1))
+	g.Expect(resFiles[0].Name).To(Equal("WAFPolicy_test-ns_waf.conf"))
+	g.Expect(string(resFiles[0].Content)).To(ContainSubstring("app_protect_security_log log_illegal stderr;"))
+}
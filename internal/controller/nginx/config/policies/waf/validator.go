@@ -7,6 +7,7 @@ import (
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
@@ -44,7 +45,7 @@ func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
 
 // ValidateGlobalSettings validates a WAFPolicy with respect to the NginxProxy global settings.
 func (v *Validator) ValidateGlobalSettings(
-	_ policies.Policy,
+	policy policies.Policy,
 	globalSettings *policies.GlobalSettings,
 ) []conditions.Condition {
 	if globalSettings == nil {
@@ -58,9 +59,30 @@ func (v *Validator) ValidateGlobalSettings(
 			conditions.NewPolicyNotAcceptedNginxProxyNotSet("WAF is not enabled in NginxProxy"),
 		}
 	}
+
+	wp := helpers.MustCastObject[*ngfAPI.WAFPolicy](policy)
+	if !globalSettings.MetricsExporterEnabled && exportsSecurityLogsToOTLP(wp) {
+		return []conditions.Condition{
+			conditions.NewPolicyNotAcceptedNginxProxyNotSet(
+				"Security logs with the otlp destination require the metrics exporter to be configured in NginxProxy",
+			),
+		}
+	}
+
 	return nil
 }
 
+// exportsSecurityLogsToOTLP returns whether any security log of the WAFPolicy has the otlp destination.
+func exportsSecurityLogsToOTLP(wp *ngfAPI.WAFPolicy) bool {
+	for _, secLog := range graph.WAFSecurityLogs(wp.Spec) {
+		if secLog.Destination.Type == ngfAPI.SecurityLogDestinationTypeOTLP {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Conflicts returns false as we don't allow merging for WAFPolicies.
 func (v Validator) Conflicts(_, _ policies.Policy) bool {
 	return false
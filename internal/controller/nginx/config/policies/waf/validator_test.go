@@ -11,6 +11,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/waf"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
 
@@ -84,8 +85,23 @@ func TestValidator_Validate(t *testing.T) {
 
 func TestValidator_ValidateGlobalSettings(t *testing.T) {
 	t.Parallel()
+
+	otlpPolicy := createValidPolicy()
+	otlpPolicy.Spec.RouteSecurityLogs = []ngfAPI.WAFRouteSecurityLogs{
+		{
+			Name: "route",
+			SecurityLogs: []ngfAPI.WAFSecurityLog{
+				{
+					LogSource:   &ngfAPI.LogSource{DefaultProfile: helpers.GetPointer(ngfAPI.DefaultLogProfileAll)},
+					Destination: ngfAPI.SecurityLogDestination{Type: ngfAPI.SecurityLogDestinationTypeOTLP},
+				},
+			},
+		},
+	}
+
 	tests := []struct {
 		globalSettings    *policies.GlobalSettings
+		policy            *ngfAPI.WAFPolicy
 		name              string
 		expValidCondCount int
 	}{
@@ -108,15 +124,37 @@ func TestValidator_ValidateGlobalSettings(t *testing.T) {
 			},
 			expValidCondCount: 0,
 		},
+		{
+			name: "otlp destination without metrics exporter",
+			globalSettings: &policies.GlobalSettings{
+				WAFEnabled: true,
+			},
+			policy:            otlpPolicy,
+			expValidCondCount: 1,
+		},
+		{
+			name: "otlp destination with metrics exporter",
+			globalSettings: &policies.GlobalSettings{
+				WAFEnabled:             true,
+				MetricsExporterEnabled: true,
+			},
+			policy:            otlpPolicy,
+			expValidCondCount: 0,
+		},
 	}
 
 	validator := waf.NewValidator()
-	pol := createValidPolicy()
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
+
+			pol := test.policy
+			if pol == nil {
+				pol = createValidPolicy()
+			}
+
 			conds := validator.ValidateGlobalSettings(pol, test.globalSettings)
 			g.Expect(conds).To(HaveLen(test.expValidCondCount))
 		})
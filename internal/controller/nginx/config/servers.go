@@ -15,6 +15,8 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
@@ -70,6 +72,11 @@ const (
 	misdirectedRequestSNIVarPrefix = "$sni_listener_id_"
 	// misdirectedRequestHostVarPrefix is the prefix for the per-port Host listener ID variable.
 	misdirectedRequestHostVarPrefix = "$host_listener_id_"
+
+	// disabledTimeout is the value of a route rule timeout that is disabled.
+	disabledTimeout = "0s"
+	// maxProxyTimeout is the longest proxy timeout that still fits in a signed 32-bit number of milliseconds.
+	maxProxyTimeout = "24d"
 )
 
 // misdirectedRequestSNIVar returns the NGINX variable name for the SNI-derived listener ID for a given port.
@@ -82,6 +89,13 @@ func misdirectedRequestHostVar(port int32) string {
 	return misdirectedRequestHostVarPrefix + strconv.Itoa(int(port))
 }
 
+// mirrorAuthHeaders are the headers that are removed from mirrored requests when a MirrorFilter strips
+// the authentication headers.
+var mirrorAuthHeaders = []string{"Authorization", "Proxy-Authorization"}
+
+// mirrorCookieHeader is the header that is removed from mirrored requests when a MirrorFilter strips the cookies.
+const mirrorCookieHeader = "Cookie"
+
 var grpcAuthorityHeader = http.Header{
 	Name:  "Authority",
 	Value: "$gw_api_compliant_host",
@@ -172,6 +186,8 @@ func createServers(
 	servers := make([]http.Server, 0, len(conf.HTTPServers)+len(conf.SSLServers))
 	finalMatchPairs := make(httpMatchPairs)
 	sharedTLSPorts := make(map[int32]struct{})
+	healthChecks := getUpstreamHealthChecks(conf.Upstreams)
+	healthCheckedUpstreams := make(map[string]struct{})
 
 	for _, tlsServer := range conf.TLSServers {
 		sharedTLSPorts[tlsServer.Port] = struct{}{}
@@ -186,6 +202,10 @@ func createServers(
 			keepAliveCheck,
 			conf.BaseHTTPConfig.DisableBaseProxySetHeaders,
 		)
+		httpServer.Locations = append(
+			httpServer.Locations,
+			createHealthCheckLocations(s, healthChecks, healthCheckedUpstreams)...,
+		)
 		servers = append(servers, httpServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
@@ -207,6 +227,10 @@ func createServers(
 			sslServer.Listen = getSocketNameHTTPS(s.Port)
 			sslServer.IsSocket = true
 		}
+		sslServer.Locations = append(
+			sslServer.Locations,
+			createHealthCheckLocations(s, healthChecks, healthCheckedUpstreams)...,
+		)
 		servers = append(servers, sslServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
@@ -348,6 +372,87 @@ func createServer(
 	return server, matchPairs
 }
 
+// getUpstreamHealthChecks returns the health checks of the upstreams that are targeted by a HealthCheckPolicy,
+// keyed by upstream name.
+func getUpstreamHealthChecks(upstreams []dataplane.Upstream) map[string]healthcheck.HealthCheck {
+	healthChecks := make(map[string]healthcheck.HealthCheck)
+
+	for _, up := range upstreams {
+		if up.HealthCheck.Enabled {
+			healthChecks[up.Name] = up.HealthCheck
+		}
+	}
+
+	return healthChecks
+}
+
+// createHealthCheckLocations creates an internal location with an NGINX Plus health check for every health checked
+// upstream that the VirtualServer proxies to. An upstream only needs to be health checked once, so upstreams in
+// healthCheckedUpstreams are skipped, and upstreams that get a location are added to it.
+func createHealthCheckLocations(
+	virtualServer dataplane.VirtualServer,
+	healthChecks map[string]healthcheck.HealthCheck,
+	healthCheckedUpstreams map[string]struct{},
+) []http.Location {
+	if len(healthChecks) == 0 {
+		return nil
+	}
+
+	var locations []http.Location
+
+	for _, rule := range virtualServer.PathRules {
+		for _, r := range rule.MatchRules {
+			for _, b := range r.BackendGroup.Backends {
+				if !b.Valid {
+					continue
+				}
+
+				hc, ok := healthChecks[b.UpstreamName]
+				if !ok {
+					continue
+				}
+
+				if _, checked := healthCheckedUpstreams[b.UpstreamName]; checked {
+					continue
+				}
+
+				healthCheckedUpstreams[b.UpstreamName] = struct{}{}
+				locations = append(locations, createHealthCheckLocation(b, hc, rule.GRPC))
+			}
+		}
+	}
+
+	return locations
+}
+
+func createHealthCheckLocation(backend dataplane.Backend, hc healthcheck.HealthCheck, grpc bool) http.Location {
+	healthCheck := &http.HealthCheck{
+		Interval: hc.Interval,
+		Fails:    hc.Fails,
+		Passes:   hc.Passes,
+		Port:     hc.Port,
+		GRPC:     grpc,
+	}
+
+	if !grpc {
+		healthCheck.URI = hc.Path
+		if hc.MatchStatus != "" || hc.MatchBody != "" {
+			healthCheck.Match = healthCheckMatchName(backend.UpstreamName)
+		}
+	}
+
+	proxySSLVerify := createProxySSLVerify(backend.VerifyTLS)
+
+	return http.Location{
+		Path:           http.InternalHealthCheckPathPrefix + "-" + backend.UpstreamName,
+		Type:           http.InternalLocationType,
+		ProxyPass:      generateProtocolString(proxySSLVerify, grpc) + "://" + backend.UpstreamName,
+		ProxySSLVerify: proxySSLVerify,
+		HealthCheck:    healthCheck,
+		GRPC:           grpc,
+	}
+}
+
 // rewriteConfig contains the configuration for a location to rewrite paths,
 // as specified in a URLRewrite filter.
 type rewriteConfig struct {
@@ -554,11 +659,20 @@ func createLocations(
 			grpcServer = true
 		}
 
+		// When the external locations route the request themselves, there is exactly one MatchRule,
+		// so the policies that target its rule by name apply to the external locations as well.
+		extPolicies := rule.Policies
+		if !needsInternalLocationsForMatches(rule) && len(rule.MatchRules) == 1 {
+			extPolicies = matchRulePolicies(rule, rule.MatchRules[0])
+		}
+
 		mirrorPercentage := mirrorPathToPercentage[rule.Path]
 		extLocations := initializeExternalLocations(rule, pathsAndTypes)
+		route := pathRuleRoute(rule)
 		for i := range extLocations {
+			extLocations[i].Route = route
 			extLocations[i].Includes = createIncludesFromPolicyGenerateResult(
-				generator.GenerateForLocation(rule.Policies, extLocations[i]),
+				generator.GenerateForLocation(extPolicies, extLocations[i]),
 			)
 		}
 
@@ -693,7 +807,7 @@ func createInternalLocationsForRule(
 		if !rule.HasInferenceBackends {
 			intLocation, match = initializeInternalMatchLocation(pathRuleIdx, matchRuleIdx, r.Match, rule.GRPC)
 			intLocation.Includes = createIncludesFromPolicyGenerateResult(
-				generator.GenerateForInternalLocation(rule.Policies),
+				generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 			)
 			intLocation = updateLocation(
 				r,
@@ -731,7 +845,7 @@ func createInternalLocationsForRule(
 			for backendIdx, b := range r.BackendGroup.Backends {
 				intProxyPassLocation := initializeInternalInferenceProxyPassLocation(pathRuleIdx, matchRuleIdx, backendIdx)
 				intProxyPassLocation.Includes = createIncludesFromPolicyGenerateResult(
-					generator.GenerateForInternalLocation(rule.Policies),
+					generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 				)
 
 				// Since we are creating a separate intProxyPassLocation per backend,
@@ -739,9 +853,11 @@ func createInternalLocationsForRule(
 				// This ensures the correct name gets generated to correlate with the split clients generation.
 				// If there is only one backend, this is effectively a no-op.
 				tempRule := dataplane.MatchRule{
-					Source:  r.Source,
-					Match:   r.Match,
-					Filters: r.Filters,
+					Source:   r.Source,
+					Match:    r.Match,
+					Filters:  r.Filters,
+					Timeouts: r.Timeouts,
+					Retry:    r.Retry,
 					BackendGroup: dataplane.BackendGroup{
 						Source:      r.BackendGroup.Source,
 						RuleIdx:     r.BackendGroup.RuleIdx,
@@ -769,7 +885,7 @@ func createInternalLocationsForRule(
 					r.BackendGroup.PathRuleIdx,
 				)
 				intEPPLocation.Includes = createIncludesFromPolicyGenerateResult(
-					generator.GenerateForInternalLocation(rule.Policies),
+					generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 				)
 
 				mapKey := intEPPLocation.Path // Use this as the key to detect duplicates
@@ -799,7 +915,7 @@ func createInternalLocationsForRule(
 			if len(r.BackendGroup.Backends) > 1 {
 				intSplitClientsLocation := initializeInternalInferenceSplitClientsLocation(pathRuleIdx, matchRuleIdx)
 				intSplitClientsLocation.Includes = createIncludesFromPolicyGenerateResult(
-					generator.GenerateForInternalLocation(rule.Policies),
+					generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 				)
 
 				splitClientsVariableName := createInferenceSplitClientsVariableName(
@@ -878,7 +994,7 @@ func createInferenceLocationsForRule(
 		for backendIdx, b := range r.BackendGroup.Backends {
 			intProxyPassLocation := initializeInternalInferenceProxyPassLocation(pathRuleIdx, matchRuleIdx, backendIdx)
 			intProxyPassLocation.Includes = createIncludesFromPolicyGenerateResult(
-				generator.GenerateForInternalLocation(rule.Policies),
+				generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 			)
 
 			// Since we are creating a separate intProxyPassLocation per backend,
@@ -886,9 +1002,11 @@ func createInferenceLocationsForRule(
 			// This ensures the correct name gets generated to correlate with the split clients generation.
 			// If there is only one backend, this is effectively a no-op.
 			tempRule := dataplane.MatchRule{
-				Source:  r.Source,
-				Match:   r.Match,
-				Filters: r.Filters,
+				Source:   r.Source,
+				Match:    r.Match,
+				Filters:  r.Filters,
+				Timeouts: r.Timeouts,
+				Retry:    r.Retry,
 				BackendGroup: dataplane.BackendGroup{
 					Source:      r.BackendGroup.Source,
 					RuleIdx:     r.BackendGroup.RuleIdx,
@@ -921,7 +1039,7 @@ func createInferenceLocationsForRule(
 						r.BackendGroup.PathRuleIdx,
 					)
 					intEPPLocation.Includes = createIncludesFromPolicyGenerateResult(
-						generator.GenerateForInternalLocation(rule.Policies),
+						generator.GenerateForInternalLocation(matchRulePolicies(rule, r)),
 					)
 					intEPPLocation = setLocationEPPConfig(intEPPLocation, intProxyPassLocation.Path, eppHost, portNum)
 					locs = append(locs, intEPPLocation)
@@ -963,6 +1081,36 @@ func extractEPPConfig(backend dataplane.Backend) (string, int) {
 	return eppHost, eppPort
 }
 
+// matchRulePolicies returns the policies that apply to the locations of a MatchRule: the policies of the
+// PathRule, followed by the policies that target the rule of the MatchRule by name.
+func matchRulePolicies(rule dataplane.PathRule, r dataplane.MatchRule) []policies.Policy {
+	if len(r.Policies) == 0 {
+		return rule.Policies
+	}
+
+	return slices.Concat(rule.Policies, r.Policies)
+}
+
+// pathRuleRoute returns the route that all MatchRules of the PathRule belong to.
+// It returns an empty NamespacedName if the MatchRules belong to several routes.
+func pathRuleRoute(rule dataplane.PathRule) types.NamespacedName {
+	var route types.NamespacedName
+
+	for i, r := range rule.MatchRules {
+		if r.Source == nil {
+			return types.NamespacedName{}
+		}
+
+		nsName := types.NamespacedName{Namespace: r.Source.Namespace, Name: r.Source.Name}
+		if i > 0 && nsName != route {
+			return types.NamespacedName{}
+		}
+		route = nsName
+	}
+
+	return route
+}
+
 func needsInternalLocationsForMatches(rule dataplane.PathRule) bool {
 	if len(rule.MatchRules) > 1 {
 		return true
@@ -1234,6 +1382,7 @@ func updateLocation(
 		keepAliveCheck,
 		disableBaseProxySetHeaders,
 	)
+	location = updateLocationMirrorFilter(location, filters.MirrorFilter, pathRule.Path)
 
 	return location
 }
@@ -1438,6 +1587,59 @@ func updateLocationCORSFilter(
 	return location
 }
 
+// updateLocationMirrorFilter applies the settings of a MirrorFilter to the internal location of a mirror route.
+// The MirrorFilter is also resolved for the locations that mirror the requests, but it has no effect on them.
+func updateLocationMirrorFilter(location http.Location, filter *dataplane.MirrorFilter, path string) http.Location {
+	if filter == nil || !strings.HasPrefix(path, http.InternalMirrorRoutePathPrefix) {
+		return location
+	}
+
+	if filter.Timeout != "" {
+		location.ProxyTimeouts = &http.ProxyTimeouts{
+			Connect: filter.Timeout,
+			Read:    filter.Timeout,
+			Send:    filter.Timeout,
+		}
+	}
+
+	if filter.MaxBodySize != "" {
+		// the size has been validated when building the graph
+		maxBodySize, _ := proxysettings.ParseNginxSize(filter.MaxBodySize)
+		location.MirrorBodySizeExceededVariableName = generateMirrorBodySizeExceededVariableName(maxBodySize)
+	}
+
+	var strippedHeaders []string
+	if filter.StripAuthHeaders {
+		strippedHeaders = append(strippedHeaders, mirrorAuthHeaders...)
+	}
+	if filter.StripCookies {
+		strippedHeaders = append(strippedHeaders, mirrorCookieHeader)
+	}
+
+	if len(strippedHeaders) > 0 {
+		location.ProxySetHeaders = stripProxySetHeaders(location.ProxySetHeaders, strippedHeaders)
+	}
+
+	return location
+}
+
+// stripProxySetHeaders returns the headers with the stripped headers set to an empty value.
+func stripProxySetHeaders(headers []http.Header, strippedHeaders []string) []http.Header {
+	result := make([]http.Header, 0, len(headers)+len(strippedHeaders))
+	for _, h := range headers {
+		if !slices.ContainsFunc(strippedHeaders, func(name string) bool { return strings.EqualFold(name, h.Name) }) {
+			result = append(result, h)
+		}
+	}
+
+	// an empty value prevents NGINX from passing the header to the mirror backend
+	for _, name := range strippedHeaders {
+		result = append(result, http.Header{Name: name, Value: ""})
+	}
+
+	return result
+}
+
 func updateLocationMirrorRoute(location http.Location, path string, grpc bool) http.Location {
 	if strings.HasPrefix(path, http.InternalMirrorRoutePathPrefix) {
 		location.Type = http.InternalLocationType
@@ -1589,16 +1791,92 @@ func updateLocationProxySettings(
 		generateProtocolString(location.ProxySSLVerify, grpc),
 		grpc,
 		inferenceBackend,
+		matchRule.Filters.CanaryFilter != nil,
 		location.Type,
 	)
 
 	location.ResponseHeaders = responseHeaders
 	location.ProxyPass = proxyPass
 	location.GRPC = grpc
+	location.ProxyTimeouts = createProxyTimeouts(matchRule.Timeouts)
+	location.ProxyRetry = createProxyRetry(matchRule.Retry)
 
 	return location
 }
 
+// createProxyTimeouts converts the timeouts of a routing rule into proxy timeouts.
+//
+// The backendRequest timeout applies to every individual request to the backend, so it is used for the
+// connect, read, and send timeouts. The request timeout limits the overall time of the request including retries,
+// and is also used for the individual timeouts if backendRequest is not set, since a single attempt
+// cannot take longer than the whole request.
+func createProxyTimeouts(timeouts *dataplane.HTTPTimeouts) *http.ProxyTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	var proxyTimeouts http.ProxyTimeouts
+
+	backendRequest := timeouts.BackendRequest
+	if backendRequest == "" && timeouts.Request != disabledTimeout {
+		backendRequest = timeouts.Request
+	}
+
+	switch backendRequest {
+	case "":
+	case disabledTimeout:
+		// NGINX can't disable the proxy timeouts, so we use the longest practical value instead.
+		proxyTimeouts.Connect = maxProxyTimeout
+		proxyTimeouts.Read = maxProxyTimeout
+		proxyTimeouts.Send = maxProxyTimeout
+	default:
+		proxyTimeouts.Connect = backendRequest
+		proxyTimeouts.Read = backendRequest
+		proxyTimeouts.Send = backendRequest
+	}
+
+	if timeouts.Request != "" && timeouts.Request != disabledTimeout {
+		proxyTimeouts.NextUpstream = timeouts.Request
+	}
+
+	if proxyTimeouts == (http.ProxyTimeouts{}) {
+		return nil
+	}
+
+	return &proxyTimeouts
+}
+
+// createProxyRetry converts the retry configuration of a routing rule into the configuration for passing
+// a failed request to the next upstream server. Connection errors and timeouts are always retried, along with
+// the configured status codes. NGINX only retries a request on a different server, so the number of retries
+// is also limited by the number of backend endpoints. Zero attempts disables the retries.
+func createProxyRetry(retry *dataplane.HTTPRetry) *http.ProxyRetry {
+	if retry == nil {
+		return nil
+	}
+
+	if retry.Attempts != nil && *retry.Attempts == 0 {
+		return &http.ProxyRetry{NextUpstream: "off"}
+	}
+
+	conditions := make([]string, 0, len(retry.Codes)+2)
+	conditions = append(conditions, "error", "timeout")
+	for _, code := range retry.Codes {
+		conditions = append(conditions, fmt.Sprintf("http_%d", code))
+	}
+
+	proxyRetry := &http.ProxyRetry{
+		NextUpstream: strings.Join(conditions, " "),
+	}
+
+	// proxy_next_upstream_tries includes the initial request, so we add it to the number of retries.
+	if retry.Attempts != nil {
+		proxyRetry.Tries = *retry.Attempts + 1
+	}
+
+	return proxyRetry
+}
+
 // resolveProxyHTTPVersion decides whether to emit a proxy_http_version directive for a location.
 // The directive is only written when the value differs from NGINX's default (1.1).
 //
@@ -1934,6 +2212,7 @@ func createProxyPass(
 	protocol string,
 	grpc bool,
 	inferenceBackend bool,
+	canary bool,
 	locationType http.LocationType,
 ) string {
 	var requestURI string
@@ -1954,6 +2233,11 @@ func createProxyPass(
 		return "http://$inference_backend_" + backendVarName + requestURI
 	}
 
+	// the canary maps select the upstream of the matching override or fall back to the backend group
+	if canary {
+		return protocol + "://" + generateCanaryVariableName(backendGroup, 0) + requestURI
+	}
+
 	if backendGroupNeedsSplit(backendGroup) {
 		return protocol + "://$" + convertStringToSafeVariableName(backendName) + requestURI
 	}
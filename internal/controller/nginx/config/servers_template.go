@@ -182,6 +182,12 @@ server {
         }
         {{- end }}
 
+        {{- if ne $l.MirrorBodySizeExceededVariableName "" }}
+        if (${{ $l.MirrorBodySizeExceededVariableName }}) {
+            return 204;
+        }
+        {{- end }}
+
         {{- range $i := $l.Includes }}
         include {{ $i.Name }};
         {{- end }}
@@ -306,6 +312,26 @@ server {
         {{ $proxyOrGRPC }}_set_header {{ $h.Name }} "{{ $h.Value }}";
             {{- end }}
         {{ $proxyOrGRPC }}_pass {{ $l.ProxyPass }};
+            {{- if $l.ProxyTimeouts }}
+                {{- if $l.ProxyTimeouts.Connect }}
+        {{ $proxyOrGRPC }}_connect_timeout {{ $l.ProxyTimeouts.Connect }};
+                {{- end }}
+                {{- if $l.ProxyTimeouts.Read }}
+        {{ $proxyOrGRPC }}_read_timeout {{ $l.ProxyTimeouts.Read }};
+                {{- end }}
+                {{- if $l.ProxyTimeouts.Send }}
+        {{ $proxyOrGRPC }}_send_timeout {{ $l.ProxyTimeouts.Send }};
+                {{- end }}
+                {{- if $l.ProxyTimeouts.NextUpstream }}
+        {{ $proxyOrGRPC }}_next_upstream_timeout {{ $l.ProxyTimeouts.NextUpstream }};
+                {{- end }}
+            {{- end }}
+            {{- if $l.ProxyRetry }}
+        {{ $proxyOrGRPC }}_next_upstream {{ $l.ProxyRetry.NextUpstream }};
+                {{- if $l.ProxyRetry.Tries }}
+        {{ $proxyOrGRPC }}_next_upstream_tries {{ $l.ProxyRetry.Tries }};
+                {{- end }}
+            {{- end }}
             {{- if $l.ProxyPassRequestBody }}
         proxy_pass_request_body {{ $l.ProxyPassRequestBody }};
                 {{- if eq $l.ProxyPassRequestBody "off" }}
@@ -336,6 +362,16 @@ server {
         {{ $proxyOrGRPC }}_ssl_trusted_certificate {{ $l.ProxySSLVerify.TrustedCertificate }};
                 {{- end }}
             {{- end }}
+            {{- if $l.HealthCheck }}
+        health_check
+                {{- if $l.HealthCheck.GRPC }} type=grpc{{ end }}
+                {{- if $l.HealthCheck.URI }} uri={{ $l.HealthCheck.URI }}{{ end }}
+                {{- if $l.HealthCheck.Interval }} interval={{ $l.HealthCheck.Interval }}{{ end }}
+                {{- if $l.HealthCheck.Fails }} fails={{ $l.HealthCheck.Fails }}{{ end }}
+                {{- if $l.HealthCheck.Passes }} passes={{ $l.HealthCheck.Passes }}{{ end }}
+                {{- if $l.HealthCheck.Port }} port={{ $l.HealthCheck.Port }}{{ end }}
+                {{- if $l.HealthCheck.Match }} match={{ $l.HealthCheck.Match }}{{ end }};
+            {{- end }}
         {{- end }}
     }
         {{- end }}
@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
@@ -104,6 +106,14 @@ func TestExecuteServers(t *testing.T) {
 						PathType: dataplane.PathTypeExact,
 						MatchRules: []dataplane.MatchRule{
 							{
+								Filters: dataplane.HTTPFilters{
+									MirrorFilter: &dataplane.MirrorFilter{
+										Timeout:          "500ms",
+										MaxBodySize:      "1m",
+										StripAuthHeaders: true,
+										StripCookies:     true,
+									},
+								},
 								Match: dataplane.Match{},
 								BackendGroup: dataplane.BackendGroup{
 									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
@@ -212,7 +222,14 @@ func TestExecuteServers(t *testing.T) {
 		"auth_jwt_key_cache 10s;":                                                1,
 		"mirror /_ngf-internal-mirror-my-backend-test/route1-0;":                 1,
 		"if ($__ngf_internal_mirror_my_backend_test_route1_0_50_00 = \"\")":      1,
-		"return 204": 1,
+		"if ($mirror_body_size_exceeds_1048576)":                                 1,
+		"return 204":                                                             2,
+		"proxy_set_header Authorization \"\";":                                   1,
+		"proxy_set_header Proxy-Authorization \"\";":                             1,
+		"proxy_set_header Cookie \"\";":                                          1,
+		"proxy_connect_timeout 500ms;":                                           1,
+		"proxy_read_timeout 500ms;":                                              1,
+		"proxy_send_timeout 500ms;":                                              1,
 	}
 
 	type assertion func(g *WithT, data string)
@@ -1252,7 +1269,10 @@ func TestCreateServers(t *testing.T) {
 			PathType: dataplane.PathTypeExact,
 			MatchRules: []dataplane.MatchRule{
 				{
-					Match:        dataplane.Match{},
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						MirrorFilter: &dataplane.MirrorFilter{Timeout: "1s"},
+					},
 					BackendGroup: fooGroup,
 				},
 			},
@@ -1841,8 +1861,13 @@ func TestCreateServers(t *testing.T) {
 				ProxyPass:       "grpc://test_foo_80",
 				Rewrites:        []string{"^ $request_uri break"},
 				ProxySetHeaders: grpcBaseHeaders,
-				Type:            http.InternalLocationType,
-				Includes:        externalIncludes,
+				ProxyTimeouts: &http.ProxyTimeouts{
+					Connect: "1s",
+					Read:    "1s",
+					Send:    "1s",
+				},
+				Type:     http.InternalLocationType,
+				Includes: externalIncludes,
 			},
 			{
 				Path: "/invalid-filter/",
@@ -2622,6 +2647,159 @@ func TestCreateLocations_Includes(t *testing.T) {
 	}
 }
 
+func TestCreateLocations_RulePolicies(t *testing.T) {
+	t.Parallel()
+
+	routePolicy := &policiesfakes.FakePolicy{}
+	routePolicy.GetNameReturns("route-policy")
+
+	rulePolicy := &policiesfakes.FakePolicy{}
+	rulePolicy.GetNameReturns("rule-policy")
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/path-only",
+				PathType: dataplane.PathTypeExact,
+				Policies: []policies.Policy{routePolicy},
+				MatchRules: []dataplane.MatchRule{
+					{
+						Policies: []policies.Policy{rulePolicy},
+					},
+				},
+			},
+			{
+				Path:     "/method-match",
+				PathType: dataplane.PathTypeExact,
+				Policies: []policies.Policy{routePolicy},
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Method: helpers.GetPointer("GET"),
+						},
+						Policies: []policies.Policy{rulePolicy},
+					},
+					{
+						Match: dataplane.Match{
+							Method: helpers.GetPointer("POST"),
+						},
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	includesForPolicies := func(prefix string, pols []policies.Policy) policies.GenerateResultFiles {
+		files := make(policies.GenerateResultFiles, 0, len(pols))
+		for _, pol := range pols {
+			files = append(files, policies.File{
+				Name:    prefix + pol.GetName() + ".conf",
+				Content: []byte(pol.GetName()),
+			})
+		}
+
+		return files
+	}
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+	fakeGenerator.GenerateForLocationStub = func(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+		return includesForPolicies("ext-", pols)
+	}
+	fakeGenerator.GenerateForInternalLocationStub = func(pols []policies.Policy) policies.GenerateResultFiles {
+		return includesForPolicies("int-", pols)
+	}
+
+	// this test only covers the policy includes generated for locations, it does not test other location fields.
+	expIncludes := map[string][]string{
+		"= /path-only": {
+			includesFolder + "/ext-route-policy.conf",
+			includesFolder + "/ext-rule-policy.conf",
+		},
+		"= /method-match": {
+			includesFolder + "/ext-route-policy.conf",
+		},
+		"/_ngf-internal-rule1-route0": {
+			includesFolder + "/int-route-policy.conf",
+			includesFolder + "/int-rule-policy.conf",
+		},
+		"/_ngf-internal-rule1-route1": {
+			includesFolder + "/int-route-policy.conf",
+		},
+	}
+
+	locations, _, _ := createLocations(&httpServer, "1", fakeGenerator, alwaysFalseKeepAliveChecker, nil)
+
+	g := NewWithT(t)
+
+	includes := make(map[string][]string)
+	for _, location := range locations {
+		if _, ok := expIncludes[location.Path]; !ok {
+			continue
+		}
+
+		names := make([]string, 0, len(location.Includes))
+		for _, include := range location.Includes {
+			names = append(names, include.Name)
+		}
+		includes[location.Path] = names
+	}
+
+	g.Expect(includes).To(Equal(expIncludes))
+}
+
+func TestCreateLocations_Route(t *testing.T) {
+	t.Parallel()
+
+	coffee := &metav1.ObjectMeta{Namespace: "test", Name: "coffee"}
+	tea := &metav1.ObjectMeta{Namespace: "test", Name: "tea"}
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:       "/coffee",
+				PathType:   dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{{Source: coffee}},
+			},
+			{
+				Path:     "/coffee-methods",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{Source: coffee, Match: dataplane.Match{Method: helpers.GetPointer("GET")}},
+					{Source: coffee, Match: dataplane.Match{Method: helpers.GetPointer("POST")}},
+				},
+			},
+			{
+				Path:     "/shared",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{Source: coffee, Match: dataplane.Match{Method: helpers.GetPointer("GET")}},
+					{Source: tea, Match: dataplane.Match{Method: helpers.GetPointer("POST")}},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+	createLocations(&httpServer, "1", fakeGenerator, alwaysFalseKeepAliveChecker, nil)
+
+	routes := make(map[string]types.NamespacedName)
+	for i := range fakeGenerator.GenerateForLocationCallCount() {
+		_, location := fakeGenerator.GenerateForLocationArgsForCall(i)
+		routes[location.Path] = location.Route
+	}
+
+	g := NewWithT(t)
+	g.Expect(routes).To(Equal(map[string]types.NamespacedName{
+		"= /coffee":         {Namespace: "test", Name: "coffee"},
+		"= /coffee-methods": {Namespace: "test", Name: "coffee"},
+		"= /shared":         {},
+	}))
+}
+
 //nolint:gosec // Tests with mock SSL/TLS configuration data, not real credentials.
 func TestCreateLocations_InferenceBackends(t *testing.T) {
 	t.Parallel()
@@ -4400,6 +4578,7 @@ func TestCreateProxyPass(t *testing.T) {
 		grp              dataplane.BackendGroup
 		GRPC             bool
 		inferenceBackend bool
+		canary           bool
 	}{
 		{
 			expected: "http://10.0.0.1:80",
@@ -4491,6 +4670,21 @@ func TestCreateProxyPass(t *testing.T) {
 			GRPC:         true,
 			locationType: http.ExternalLocationType,
 		},
+		{
+			expected: "http://$canary_group_ns1__bg_rule0_pathRule0$request_uri",
+			grp: dataplane.BackendGroup{
+				Source: types.NamespacedName{Namespace: "ns1", Name: "bg"},
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "10.0.0.1:80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
+			},
+			canary:       true,
+			locationType: http.InternalLocationType,
+		},
 	}
 
 	for _, tc := range tests {
@@ -4503,6 +4697,7 @@ func TestCreateProxyPass(t *testing.T) {
 				generateProtocolString(nil, tc.GRPC),
 				tc.GRPC,
 				tc.inferenceBackend,
+				tc.canary,
 				tc.locationType,
 			)
 			g.Expect(result).To(Equal(tc.expected))
@@ -8831,3 +9026,475 @@ func TestUpdateLocationProxySettings_Headers(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateProxyTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *dataplane.HTTPTimeouts
+		expected *http.ProxyTimeouts
+		name     string
+	}{
+		{
+			name:     "no timeouts",
+			timeouts: nil,
+			expected: nil,
+		},
+		{
+			name: "request and backendRequest",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+			expected: &http.ProxyTimeouts{
+				Connect:      "2s",
+				Read:         "2s",
+				Send:         "2s",
+				NextUpstream: "10s",
+			},
+		},
+		{
+			name: "request only",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: "10s",
+			},
+			expected: &http.ProxyTimeouts{
+				Connect:      "10s",
+				Read:         "10s",
+				Send:         "10s",
+				NextUpstream: "10s",
+			},
+		},
+		{
+			name: "backendRequest only",
+			timeouts: &dataplane.HTTPTimeouts{
+				BackendRequest: "500ms",
+			},
+			expected: &http.ProxyTimeouts{
+				Connect: "500ms",
+				Read:    "500ms",
+				Send:    "500ms",
+			},
+		},
+		{
+			name: "disabled backendRequest",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        "1m",
+				BackendRequest: "0s",
+			},
+			expected: &http.ProxyTimeouts{
+				Connect:      maxProxyTimeout,
+				Read:         maxProxyTimeout,
+				Send:         maxProxyTimeout,
+				NextUpstream: "1m",
+			},
+		},
+		{
+			name: "disabled request",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: "0s",
+			},
+			expected: nil,
+		},
+		{
+			name: "disabled request with backendRequest",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        "0s",
+				BackendRequest: "5s",
+			},
+			expected: &http.ProxyTimeouts{
+				Connect: "5s",
+				Read:    "5s",
+				Send:    "5s",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createProxyTimeouts(tc.timeouts)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestExecuteServers_TimeoutsAndRetry(t *testing.T) {
+	t.Parallel()
+
+	makePathRule := func(grpc bool) dataplane.PathRule {
+		return dataplane.PathRule{
+			Path:     "/app",
+			PathType: dataplane.PathTypePrefix,
+			GRPC:     grpc,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					BackendGroup: dataplane.BackendGroup{
+						Source:  types.NamespacedName{Namespace: "default", Name: "route1"},
+						RuleIdx: 0,
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_backend_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					Timeouts: &dataplane.HTTPTimeouts{
+						Request:        "10s",
+						BackendRequest: "2s",
+					},
+					Retry: &dataplane.HTTPRetry{
+						Attempts: helpers.GetPointer(1),
+						Codes:    []int{503},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		expPresent  []string
+		grpc        bool
+		expAbsentOf string
+	}{
+		{
+			name: "http",
+			expPresent: []string{
+				"proxy_connect_timeout 2s;",
+				"proxy_read_timeout 2s;",
+				"proxy_send_timeout 2s;",
+				"proxy_next_upstream_timeout 10s;",
+				"proxy_next_upstream error timeout http_503;",
+				"proxy_next_upstream_tries 2;",
+			},
+			expAbsentOf: "grpc_read_timeout",
+		},
+		{
+			name: "grpc",
+			grpc: true,
+			expPresent: []string{
+				"grpc_connect_timeout 2s;",
+				"grpc_read_timeout 2s;",
+				"grpc_send_timeout 2s;",
+				"grpc_next_upstream_timeout 10s;",
+				"grpc_next_upstream error timeout http_503;",
+				"grpc_next_upstream_tries 2;",
+			},
+			expAbsentOf: "proxy_read_timeout",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						Hostname:  "http.example.com",
+						Port:      8080,
+						PathRules: []dataplane.PathRule{makePathRule(tc.grpc)},
+					},
+				},
+			}
+			gen := GeneratorImpl{}
+			results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+			var serverConf string
+			for _, res := range results {
+				if res.dest == httpConfigFile {
+					serverConf = string(res.data)
+					break
+				}
+			}
+
+			for _, exp := range tc.expPresent {
+				g.Expect(serverConf).To(ContainSubstring(exp))
+			}
+			g.Expect(serverConf).NotTo(ContainSubstring(tc.expAbsentOf))
+		})
+	}
+}
+
+func TestCreateProxyRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		retry    *dataplane.HTTPRetry
+		expected *http.ProxyRetry
+		name     string
+	}{
+		{
+			name:     "no retry",
+			retry:    nil,
+			expected: nil,
+		},
+		{
+			name:  "empty retry",
+			retry: &dataplane.HTTPRetry{},
+			expected: &http.ProxyRetry{
+				NextUpstream: "error timeout",
+			},
+		},
+		{
+			name: "codes and attempts",
+			retry: &dataplane.HTTPRetry{
+				Attempts: helpers.GetPointer(2),
+				Codes:    []int{502, 503, 504},
+			},
+			expected: &http.ProxyRetry{
+				NextUpstream: "error timeout http_502 http_503 http_504",
+				Tries:        3,
+			},
+		},
+		{
+			name: "zero attempts",
+			retry: &dataplane.HTTPRetry{
+				Attempts: helpers.GetPointer(0),
+				Codes:    []int{502},
+			},
+			expected: &http.ProxyRetry{
+				NextUpstream: "off",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createProxyRetry(tc.retry)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestExecuteServers_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	makeServer := func(hostname string, grpc bool) dataplane.VirtualServer {
+		return dataplane.VirtualServer{
+			Hostname: hostname,
+			Port:     8080,
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/app",
+					PathType: dataplane.PathTypePrefix,
+					GRPC:     grpc,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match: dataplane.Match{},
+							BackendGroup: dataplane.BackendGroup{
+								Source:  types.NamespacedName{Namespace: "default", Name: "route1"},
+								RuleIdx: 0,
+								Backends: []dataplane.Backend{
+									{
+										UpstreamName: "test_backend_80",
+										Valid:        true,
+										Weight:       1,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	upstreams := []dataplane.Upstream{
+		{
+			Name: "test_backend_80",
+			HealthCheck: healthcheck.HealthCheck{
+				Enabled:     true,
+				Path:        "/healthz",
+				Interval:    "10s",
+				Fails:       3,
+				Passes:      2,
+				Port:        8080,
+				MatchStatus: "200-399",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expPresent []string
+		expAbsent  []string
+		grpc       bool
+	}{
+		{
+			name: "http",
+			expPresent: []string{
+				"health_check uri=/healthz interval=10s fails=3 passes=2 port=8080 " +
+					"match=test_backend_80_health_check_match;",
+				"proxy_pass http://test_backend_80;",
+			},
+			expAbsent: []string{"type=grpc"},
+		},
+		{
+			name: "grpc",
+			grpc: true,
+			expPresent: []string{
+				"health_check type=grpc interval=10s fails=3 passes=2 port=8080;",
+				"grpc_pass grpc://test_backend_80;",
+			},
+			expAbsent: []string{"uri=/healthz", "match="},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					makeServer("one.example.com", tc.grpc),
+					makeServer("two.example.com", tc.grpc),
+				},
+				Upstreams: upstreams,
+			}
+			gen := GeneratorImpl{plus: true}
+			results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+			var serverConf string
+			for _, res := range results {
+				if res.dest == httpConfigFile {
+					serverConf = string(res.data)
+					break
+				}
+			}
+
+			// the upstream is health checked only once, even though two servers proxy to it
+			g.Expect(strings.Count(serverConf, "location /_ngf-internal-health-check-test_backend_80 {")).To(Equal(1))
+			g.Expect(strings.Count(serverConf, "health_check ")).To(Equal(1))
+
+			for _, exp := range tc.expPresent {
+				g.Expect(serverConf).To(ContainSubstring(exp))
+			}
+			for _, exp := range tc.expAbsent {
+				g.Expect(serverConf).NotTo(ContainSubstring(exp))
+			}
+		})
+	}
+}
+
+func TestUpdateLocationMirrorFilter(t *testing.T) {
+	t.Parallel()
+
+	mirrorPath := http.InternalMirrorRoutePathPrefix + "-my-backend-test/route1-0"
+
+	tests := []struct {
+		filter   *dataplane.MirrorFilter
+		location http.Location
+		expected http.Location
+		name     string
+		path     string
+	}{
+		{
+			name:     "no filter",
+			path:     mirrorPath,
+			location: http.Location{Path: mirrorPath},
+			expected: http.Location{Path: mirrorPath},
+		},
+		{
+			name: "not a mirror location",
+			path: "/coffee",
+			filter: &dataplane.MirrorFilter{
+				Timeout:          "1s",
+				StripAuthHeaders: true,
+			},
+			location: http.Location{Path: "/coffee"},
+			expected: http.Location{Path: "/coffee"},
+		},
+		{
+			name: "all settings",
+			path: mirrorPath,
+			filter: &dataplane.MirrorFilter{
+				Timeout:          "1s",
+				MaxBodySize:      "8k",
+				StripAuthHeaders: true,
+				StripCookies:     true,
+			},
+			location: http.Location{
+				Path: mirrorPath,
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+					{Name: "authorization", Value: "Bearer token"},
+				},
+			},
+			expected: http.Location{
+				Path: mirrorPath,
+				ProxyTimeouts: &http.ProxyTimeouts{
+					Connect: "1s",
+					Read:    "1s",
+					Send:    "1s",
+				},
+				MirrorBodySizeExceededVariableName: "mirror_body_size_exceeds_8192",
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+					{Name: "Authorization", Value: ""},
+					{Name: "Proxy-Authorization", Value: ""},
+					{Name: "Cookie", Value: ""},
+				},
+			},
+		},
+		{
+			name: "auth headers without cookies",
+			path: mirrorPath,
+			filter: &dataplane.MirrorFilter{
+				StripAuthHeaders: true,
+			},
+			location: http.Location{
+				Path: mirrorPath,
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+				},
+			},
+			expected: http.Location{
+				Path: mirrorPath,
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+					{Name: "Authorization", Value: ""},
+					{Name: "Proxy-Authorization", Value: ""},
+				},
+			},
+		},
+		{
+			name: "cookies only",
+			path: mirrorPath,
+			filter: &dataplane.MirrorFilter{
+				StripCookies: true,
+			},
+			location: http.Location{
+				Path: mirrorPath,
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+					{Name: "cookie", Value: "$http_cookie"},
+				},
+			},
+			expected: http.Location{
+				Path: mirrorPath,
+				ProxySetHeaders: []http.Header{
+					{Name: "Host", Value: "$gw_api_compliant_host"},
+					{Name: "Cookie", Value: ""},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(updateLocationMirrorFilter(test.location, test.filter, test.path)).To(Equal(test.expected))
+		})
+	}
+}
@@ -6,6 +6,8 @@ type Map struct {
 	Variable     string
 	Parameters   []MapParameter
 	UseHostnames bool
+	// Geo defines the map as a geo block, which maps the CIDR ranges of the Parameters to their Results.
+	Geo bool
 }
 
 // MapParameter defines a Value and Result pair in a Map.
@@ -0,0 +1,67 @@
+package shared //nolint:revive,nolintlint // ignoring meaningless package name
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+var (
+	variableRegexp = regexp.MustCompile(`\$(\w+)`)
+	// variableReplacer replaces the characters of Kubernetes names that are not allowed in nginx variable names.
+	variableReplacer = strings.NewReplacer("-", "_", ".", "_", "/", "_")
+)
+
+// streamVariables are the variables that are available in the stream context. Most variables, such as
+// $request_uri or $server_name, are only defined by the http modules, and nginx fails to load a stream
+// configuration that references them.
+var streamVariables = map[string]struct{}{
+	"binary_remote_addr":         {},
+	"bytes_received":             {},
+	"bytes_sent":                 {},
+	"connection":                 {},
+	"hostname":                   {},
+	"msec":                       {},
+	"nginx_version":              {},
+	"pid":                        {},
+	"protocol":                   {},
+	"proxy_protocol_addr":        {},
+	"proxy_protocol_port":        {},
+	"proxy_protocol_server_addr": {},
+	"proxy_protocol_server_port": {},
+	"realip_remote_addr":         {},
+	"realip_remote_port":         {},
+	"remote_addr":                {},
+	"remote_port":                {},
+	"server_addr":                {},
+	"server_port":                {},
+	"session_time":               {},
+	"ssl_preread_alpn_protocols": {},
+	"ssl_preread_protocol":       {},
+	"ssl_preread_server_name":    {},
+	"status":                     {},
+	"time_iso8601":               {},
+	"time_local":                 {},
+}
+
+// IsStreamSafe returns true if all nginx variables used in the value are available in the stream context.
+func IsStreamSafe(value string) bool {
+	for _, match := range variableRegexp.FindAllStringSubmatch(value, -1) {
+		if _, ok := streamVariables[match[1]]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UniqueVariableName returns a string built from the value that can be used in nginx variable names.
+// The characters that are not allowed in variable names are replaced by underscores, and a hash of the value
+// is appended, so that values such as "a-b/c" and "a/b-c" don't result in the same name.
+func UniqueVariableName(value string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(value))
+
+	return fmt.Sprintf("%s_%08x", variableReplacer.Replace(value), h.Sum32())
+}
@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsStreamSafe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{
+			name:     "no variables",
+			value:    "my_fixed_key",
+			expected: true,
+		},
+		{
+			name:     "stream variable",
+			value:    "$binary_remote_addr",
+			expected: true,
+		},
+		{
+			name:     "stream variables mixed with strings",
+			value:    "prefix-$remote_addr:$ssl_preread_server_name",
+			expected: true,
+		},
+		{
+			name:     "http-only variable",
+			value:    "$request_uri",
+			expected: false,
+		},
+		{
+			name:     "stream and http-only variables",
+			value:    "$remote_addr$server_name",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(IsStreamSafe(test.value)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestUniqueVariableName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(UniqueVariableName("test-ns/my-policy.v1")).To(MatchRegexp(`^test_ns_my_policy_v1_[0-9a-f]{8}$`))
+	g.Expect(UniqueVariableName("a-b/c")).To(Equal(UniqueVariableName("a-b/c")))
+	g.Expect(UniqueVariableName("a-b/c")).ToNot(Equal(UniqueVariableName("a/b-c")))
+}
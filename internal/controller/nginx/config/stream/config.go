@@ -14,6 +14,7 @@ type Server struct {
 	ProxyPass       string
 	Target          string
 	RewriteClientIP shared.RewriteClientIPSettings
+	Includes        []shared.Include
 	SSLPreread      bool
 	IsSocket        bool
 }
@@ -39,10 +40,11 @@ type SSL struct {
 
 // Upstream holds all configuration for a stream upstream.
 type Upstream struct {
-	Name      string
-	ZoneSize  string // format: 512k, 1m
-	StateFile string
-	Servers   []UpstreamServer
+	Name                string
+	ZoneSize            string // format: 512k, 1m
+	StateFile           string
+	LoadBalancingMethod string
+	Servers             []UpstreamServer
 }
 
 // UpstreamServer holds all configuration for a stream upstream server.
@@ -67,9 +69,19 @@ type SplitClientDistribution struct {
 // ServerConfig holds configuration for a stream server and IP family to be used by NGINX.
 type ServerConfig struct {
 	DNSResolver     *dataplane.DNSResolverConfig
+	ZoneSync        *ZoneSync
 	GatewaySecretID dataplane.SSLKeyPairID
+	Includes        []shared.Include
 	Servers         []Server
 	SplitClients    []SplitClient
 	IPFamily        shared.IPFamily
 	Plus            bool
 }
+
+// ZoneSync holds the configuration for synchronizing shared memory zones between the nginx replicas.
+type ZoneSync struct {
+	Server    string
+	SSLName   string
+	Resolvers []string
+	Port      int32
+}
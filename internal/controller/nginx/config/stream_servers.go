@@ -8,24 +8,50 @@ import (
 	"github.com/go-logr/logr"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
+const (
+	// defaultClusterDomain is the DNS domain of the cluster when it is not configured.
+	defaultClusterDomain = "cluster.local"
+	// agentTLSFolder is the folder where the NGINX Agent TLS Secret is mounted. The nginx replicas use its
+	// certificate and CA to authenticate each other for zone synchronization.
+	agentTLSFolder = "/var/run/secrets/ngf"
+)
+
 var streamServersTemplate = gotemplate.Must(gotemplate.New("streamServers").Parse(streamServersTemplateText))
 
-func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []executeResult {
-	streamServers := createStreamServers(g.logger, conf)
+func (g GeneratorImpl) newExecuteStreamServersFunc(generator policies.Generator) executeFunc {
+	return func(conf dataplane.Configuration) []executeResult {
+		return g.executeStreamServers(conf, generator)
+	}
+}
+
+func (g GeneratorImpl) executeStreamServers(
+	conf dataplane.Configuration,
+	generator policies.Generator,
+) []executeResult {
+	streamServers := createStreamServers(g.logger, conf, generator)
 	splitClients := createStreamSplitClients(conf)
 
+	// Policies only generate configuration in the stream context when there are stream servers they apply to.
+	var includes []shared.Include
+	if len(streamServers) > 0 {
+		includes = createIncludesFromPolicyGenerateResult(generator.GenerateForStream(getStreamPolicies(conf)))
+	}
+
 	streamServerConfig := stream.ServerConfig{
+		Includes:        includes,
 		Servers:         streamServers,
 		SplitClients:    splitClients,
 		IPFamily:        getIPFamily(conf.BaseHTTPConfig),
 		Plus:            g.plus,
 		DNSResolver:     buildDNSResolver(conf.BaseStreamConfig.DNSResolver),
+		ZoneSync:        g.createZoneSync(conf.BaseStreamConfig.ZoneSync),
 		GatewaySecretID: conf.BaseHTTPConfig.GatewaySecretID,
 	}
 
@@ -34,18 +60,123 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 		data: helpers.MustExecuteTemplate(streamServersTemplate, streamServerConfig),
 	}
 
-	return []executeResult{
-		streamServerResult,
+	results := make([]executeResult, 0, len(includes)+1)
+	results = append(results, streamServerResult)
+	results = append(results, createIncludeExecuteResults(includes)...)
+	results = append(results, createIncludeExecuteResultsFromStreamServers(streamServers)...)
+
+	return results
+}
+
+// createZoneSync creates the zone synchronization config for the nginx replicas. The replicas are resolved
+// through the cluster DNS, since nginx doesn't use the search domains of the Pod, and authenticate each other
+// with the NGINX Agent TLS certificate, which is valid for the subdomains of the cluster domain.
+func (g GeneratorImpl) createZoneSync(zoneSync *dataplane.ZoneSyncConfig) *stream.ZoneSync {
+	if zoneSync == nil {
+		return nil
+	}
+
+	clusterDomain := g.clusterDNS.Domain
+	if clusterDomain == "" {
+		clusterDomain = defaultClusterDomain
+	}
+
+	resolvers := g.clusterDNS.Resolvers
+	if len(resolvers) == 0 {
+		resolvers = []string{"kube-dns.kube-system.svc." + clusterDomain}
+	}
+
+	return &stream.ZoneSync{
+		Server:    fmt.Sprintf("%s.%s:%d", zoneSync.Service, clusterDomain, zoneSync.Port),
+		SSLName:   "zone-sync." + clusterDomain,
+		Resolvers: resolvers,
+		Port:      zoneSync.Port,
 	}
 }
 
+// getStreamPolicies returns the deduplicated policies of the Gateway and of all Layer4 servers.
+func getStreamPolicies(conf dataplane.Configuration) []policies.Policy {
+	streamPolicies := make([]policies.Policy, 0, len(conf.BaseStreamConfig.Policies))
+	seen := make(map[policies.Policy]struct{})
+
+	add := func(pols []policies.Policy) {
+		for _, pol := range pols {
+			if _, ok := seen[pol]; ok {
+				continue
+			}
+			seen[pol] = struct{}{}
+			streamPolicies = append(streamPolicies, pol)
+		}
+	}
+
+	add(conf.BaseStreamConfig.Policies)
+	for _, server := range conf.TCPServers {
+		add(server.Policies)
+	}
+	for _, server := range conf.UDPServers {
+		add(server.Policies)
+	}
+
+	return streamPolicies
+}
+
+// createStreamServerIncludes creates the includes for a stream server from the policies of the
+// Gateway followed by the policies of the server.
+func createStreamServerIncludes(
+	generator policies.Generator,
+	gatewayPolicies []policies.Policy,
+	serverPolicies []policies.Policy,
+) []shared.Include {
+	if len(gatewayPolicies) == 0 && len(serverPolicies) == 0 {
+		return nil
+	}
+
+	pols := make([]policies.Policy, 0, len(gatewayPolicies)+len(serverPolicies))
+	pols = append(pols, gatewayPolicies...)
+	pols = append(pols, serverPolicies...)
+
+	return createIncludesFromPolicyGenerateResult(generator.GenerateForStreamServer(pols))
+}
+
+// createTLSServerIncludes creates the includes of the Gateway policies for the servers of the TLS listeners:
+// the includes of the server that listens on the port and routes the connections by SNI, and the includes of
+// the socket servers it passes the connections to. With the PROXY protocol, only the socket servers
+// know the address of the client, so the policies are evaluated there instead.
+func createTLSServerIncludes(
+	generator policies.Generator,
+	conf dataplane.Configuration,
+) (portIncludes, socketIncludes []shared.Include) {
+	includes := createStreamServerIncludes(generator, conf.BaseStreamConfig.Policies, nil)
+
+	if conf.BaseHTTPConfig.RewriteClientIPSettings.Mode == dataplane.RewriteIPModeProxyProtocol {
+		return nil, includes
+	}
+
+	return includes, nil
+}
+
+// createIncludeExecuteResultsFromStreamServers creates a list of executeResults from the deduplicated
+// includes of the stream servers.
+func createIncludeExecuteResultsFromStreamServers(servers []stream.Server) []executeResult {
+	var includes []shared.Include
+	for _, server := range servers {
+		includes = append(includes, server.Includes...)
+	}
+
+	return createIncludeExecuteResults(deduplicateIncludes(includes))
+}
+
 // portProtoKey uniquely identifies a port and protocol combination for deduplication.
 type portProtoKey struct {
 	protocol string
 	port     int32
 }
 
-func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []stream.Server {
+func createStreamServers(
+	logger logr.Logger,
+	conf dataplane.Configuration,
+	generator policies.Generator,
+) []stream.Server {
 	totalServers := len(conf.TLSServers) + len(conf.TCPServers) + len(conf.UDPServers)
 	if totalServers == 0 {
 		return nil
@@ -59,10 +190,15 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 		upstreams[u.Name] = u
 	}
 
+	portIncludes, socketIncludes := createTLSServerIncludes(generator, conf)
+
 	for _, server := range conf.TLSServers {
 		if server.SSL != nil {
 			// TLS Terminate mode: create a socket server with SSL termination
-			streamServers = append(streamServers, createTLSTerminateSocketServer(server, upstreams, conf)...)
+			streamServers = append(
+				streamServers,
+				createTLSTerminateSocketServer(server, upstreams, conf, socketIncludes)...,
+			)
 		} else if len(server.Upstreams) > 0 {
 			// TLS Passthrough mode: create a socket server that proxies encrypted traffic
 			upstreamName := server.Upstreams[0].Name
@@ -72,6 +208,7 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 					StatusZone: server.Hostname,
 					ProxyPass:  upstreamName,
 					IsSocket:   true,
+					Includes:   socketIncludes,
 				}
 				// set rewriteClientIP settings as this is a socket stream server
 				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
@@ -94,26 +231,42 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 			StatusZone: server.Hostname,
 			Target:     getTLSPassthroughVarName(server.Port),
 			SSLPreread: true,
+			Includes:   portIncludes,
 		}
 		streamServers = append(streamServers, streamServer)
 	}
 
 	// Process Layer4 servers (TCP and UDP)
-	processLayer4Servers(logger, conf.TCPServers, upstreams, portSet, &streamServers, string(v1.TCPProtocolType))
-	processLayer4Servers(logger, conf.UDPServers, upstreams, portSet, &streamServers, string(v1.UDPProtocolType))
+	l4Settings := layer4ServerSettings{
+		upstreams:       upstreams,
+		portSet:         portSet,
+		generator:       generator,
+		gatewayPolicies: conf.BaseStreamConfig.Policies,
+	}
+	processLayer4Servers(logger, conf.TCPServers, l4Settings, &streamServers, string(v1.TCPProtocolType))
+	processLayer4Servers(logger, conf.UDPServers, l4Settings, &streamServers, string(v1.UDPProtocolType))
 
 	return streamServers
 }
 
+// layer4ServerSettings holds the settings shared by all Layer4 servers.
+type layer4ServerSettings struct {
+	upstreams       map[string]dataplane.Upstream
+	portSet         map[portProtoKey]struct{}
+	generator       policies.Generator
+	gatewayPolicies []policies.Policy
+}
+
 // processLayer4Servers processes TCP and UDP servers to create stream servers.
 func processLayer4Servers(
 	logger logr.Logger,
 	servers []dataplane.Layer4VirtualServer,
-	upstreams map[string]dataplane.Upstream,
-	portSet map[portProtoKey]struct{},
+	settings layer4ServerSettings,
 	streamServers *[]stream.Server,
 	protocol string,
 ) {
+	upstreams, portSet := settings.upstreams, settings.portSet
+
 	protocolSuffix := ""
 	if protocol == string(v1.UDPProtocolType) {
 		protocolSuffix = " " + strings.ToLower(string(v1.UDPProtocolType))
@@ -171,6 +324,7 @@ func processLayer4Servers(
 			Listen:     fmt.Sprintf("%d%s", server.Port, protocolSuffix),
 			StatusZone: fmt.Sprintf("%s_%d", protocol, server.Port),
 			ProxyPass:  proxyPass,
+			Includes:   createStreamServerIncludes(settings.generator, settings.gatewayPolicies, server.Policies),
 		}
 		*streamServers = append(*streamServers, streamServer)
 		portSet[key] = struct{}{}
@@ -280,6 +434,7 @@ func createTLSTerminateSocketServer(
 	server dataplane.Layer4VirtualServer,
 	upstreams map[string]dataplane.Upstream,
 	conf dataplane.Configuration,
+	includes []shared.Include,
 ) []stream.Server {
 	if server.IsDefault {
 		// Default server for TLS Terminate: reject TLS handshake for unmatched traffic.
@@ -317,6 +472,7 @@ func createTLSTerminateSocketServer(
 		IsSocket:       true,
 		SSL:            buildStreamSSL(server.SSL),
 		ProxySSLVerify: buildStreamProxySSLVerify(server.VerifyTLS),
+		Includes:       includes,
 	}
 	streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
 		conf.BaseHTTPConfig.RewriteClientIPSettings,
@@ -15,6 +15,10 @@ proxy_ssl_certificate /etc/nginx/secrets/{{ .GatewaySecretID }}.pem;
 proxy_ssl_certificate_key /etc/nginx/secrets/{{ .GatewaySecretID }}.pem;
 {{- end }}
 
+{{- range $i := .Includes }}
+include {{ $i.Name }};
+{{- end }}
+
 {{- if .SplitClients }}
 # Split clients configuration for weighted load balancing
 {{- range $sc := .SplitClients }}
@@ -94,6 +98,39 @@ server {
 	{{- if $s.SSLPreread }}
     ssl_preread on;
 	{{- end }}
+
+	{{- range $i := $s.Includes }}
+    include {{ $i.Name }};
+	{{- end }}
+}
+{{- end }}
+
+{{- if .ZoneSync }}
+# Zone synchronization between the nginx replicas of the Gateway
+server {
+	{{- if $.IPFamily.IPv4 }}
+    listen {{ .ZoneSync.Port }} ssl;
+	{{- end }}
+	{{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ .ZoneSync.Port }} ssl;
+	{{- end }}
+	{{- if not .DNSResolver }}
+    resolver{{ range $r := .ZoneSync.Resolvers }} {{ $r }}{{ end }} valid=5s;
+	{{- end }}
+
+    ssl_certificate ` + agentTLSFolder + `/tls.crt;
+    ssl_certificate_key ` + agentTLSFolder + `/tls.key;
+    ssl_client_certificate ` + agentTLSFolder + `/ca.crt;
+    ssl_verify_client on;
+
+    zone_sync;
+    zone_sync_server {{ .ZoneSync.Server }} resolve;
+    zone_sync_ssl on;
+    zone_sync_ssl_certificate ` + agentTLSFolder + `/tls.crt;
+    zone_sync_ssl_certificate_key ` + agentTLSFolder + `/tls.key;
+    zone_sync_ssl_trusted_certificate ` + agentTLSFolder + `/ca.crt;
+    zone_sync_ssl_verify on;
+    zone_sync_ssl_name {{ .ZoneSync.SSLName }};
 }
 {{- end }}
 
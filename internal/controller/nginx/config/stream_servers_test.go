@@ -7,8 +7,14 @@ import (
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfConfig "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
@@ -74,7 +80,7 @@ func TestExecuteStreamServers(t *testing.T) {
 	g := NewWithT(t)
 
 	gen := GeneratorImpl{}
-	results := gen.executeStreamServers(conf)
+	results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 	result := results[0]
 
@@ -119,7 +125,7 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	g := NewWithT(t)
 
 	gen := GeneratorImpl{plus: true}
-	results := gen.executeStreamServers(config)
+	results := gen.executeStreamServers(config, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 
 	serverConf := string(results[0].data)
@@ -129,6 +135,187 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	}
 }
 
+func TestExecuteStreamServers_Policies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayPolicy := &ngfAPI.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway-policy"},
+	}
+	routePolicy := &ngfAPI.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-policy"},
+	}
+
+	conf := dataplane.Configuration{
+		BaseStreamConfig: dataplane.BaseStreamConfig{
+			Policies: []policies.Policy{gatewayPolicy},
+		},
+		TLSServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8443,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1"},
+				},
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port: 9000,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1"},
+				},
+				Policies: []policies.Policy{routePolicy},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend1",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+			},
+		},
+	}
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+	fakeGenerator.GenerateForStreamStub = func(pols []policies.Policy) policies.GenerateResultFiles {
+		files := make(policies.GenerateResultFiles, 0, len(pols))
+		for _, pol := range pols {
+			files = append(files, policies.File{Name: pol.GetName() + "_stream.conf", Content: []byte("stream")})
+		}
+		return files
+	}
+	fakeGenerator.GenerateForStreamServerStub = func(pols []policies.Policy) policies.GenerateResultFiles {
+		files := make(policies.GenerateResultFiles, 0, len(pols))
+		for _, pol := range pols {
+			files = append(files, policies.File{Name: pol.GetName() + "_server.conf", Content: []byte("server")})
+		}
+		return files
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf, fakeGenerator)
+
+	files := make(map[string]string, len(results))
+	for _, res := range results {
+		files[res.dest] = string(res.data)
+	}
+
+	g.Expect(files).To(HaveLen(5))
+	g.Expect(files).To(HaveKeyWithValue(includesFolder+"/gateway-policy_stream.conf", "stream"))
+	g.Expect(files).To(HaveKeyWithValue(includesFolder+"/route-policy_stream.conf", "stream"))
+	g.Expect(files).To(HaveKeyWithValue(includesFolder+"/gateway-policy_server.conf", "server"))
+	g.Expect(files).To(HaveKeyWithValue(includesFolder+"/route-policy_server.conf", "server"))
+
+	streamConf := files[streamConfigFile]
+	g.Expect(strings.Count(streamConf, "include "+includesFolder+"/gateway-policy_stream.conf;")).To(Equal(1))
+	g.Expect(strings.Count(streamConf, "include "+includesFolder+"/route-policy_stream.conf;")).To(Equal(1))
+	// the TLS passthrough and TCP servers get the Gateway policy; only the TCP server gets the Route policy
+	g.Expect(strings.Count(streamConf, "include "+includesFolder+"/gateway-policy_server.conf;")).To(Equal(2))
+	g.Expect(strings.Count(streamConf, "include "+includesFolder+"/route-policy_server.conf;")).To(Equal(1))
+
+	// no stream servers; no policy configuration is generated
+	results = gen.executeStreamServers(
+		dataplane.Configuration{BaseStreamConfig: conf.BaseStreamConfig},
+		fakeGenerator,
+	)
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(string(results[0].data)).ToNot(ContainSubstring("include"))
+}
+
+func TestCreateStreamServers_TLSPoliciesWithProxyProtocol(t *testing.T) {
+	t.Parallel()
+
+	gatewayPolicy := &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway-policy"},
+	}
+
+	tlsServers := []dataplane.Layer4VirtualServer{
+		{
+			Hostname:  "passthrough.example.com",
+			Port:      8443,
+			Upstreams: []dataplane.Layer4Upstream{{Name: "backend1"}},
+		},
+		{
+			Hostname: "terminate.example.com",
+			Port:     8443,
+			SSL: &dataplane.SSL{
+				KeyPairIDs: []dataplane.SSLKeyPairID{"ssl_keypair_default_cert"},
+			},
+			Upstreams: []dataplane.Layer4Upstream{{Name: "backend1"}},
+		},
+	}
+
+	expIncludes := []shared.Include{{Name: includesFolder + "/gateway-policy_server.conf", Content: []byte("server")}}
+
+	tests := []struct {
+		name              string
+		mode              dataplane.RewriteIPModeType
+		expPortIncludes   []shared.Include
+		expSocketIncludes []shared.Include
+	}{
+		{
+			name:            "rewrite client IP not configured; the port server evaluates the policies",
+			expPortIncludes: expIncludes,
+		},
+		{
+			name:            "rewrite client IP configured with xforwardedfor; the port server evaluates the policies",
+			mode:            dataplane.RewriteIPModeXForwardedFor,
+			expPortIncludes: expIncludes,
+		},
+		{
+			name:              "rewrite client IP configured with proxy protocol; the socket servers evaluate the policies",
+			mode:              dataplane.RewriteIPModeProxyProtocol,
+			expSocketIncludes: expIncludes,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					RewriteClientIPSettings: dataplane.RewriteClientIPSettings{
+						Mode:             test.mode,
+						TrustedAddresses: []string{"10.0.0.0/8"},
+					},
+				},
+				BaseStreamConfig: dataplane.BaseStreamConfig{
+					Policies: []policies.Policy{gatewayPolicy},
+				},
+				TLSServers: tlsServers,
+				StreamUpstreams: []dataplane.Upstream{
+					{
+						Name:      "backend1",
+						Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+					},
+				},
+			}
+
+			fakeGenerator := &policiesfakes.FakeGenerator{}
+			fakeGenerator.GenerateForStreamServerStub = func(pols []policies.Policy) policies.GenerateResultFiles {
+				files := make(policies.GenerateResultFiles, 0, len(pols))
+				for _, pol := range pols {
+					files = append(files, policies.File{Name: pol.GetName() + "_server.conf", Content: []byte("server")})
+				}
+				return files
+			}
+
+			streamServers := createStreamServers(logr.Discard(), conf, fakeGenerator)
+			g.Expect(streamServers).To(HaveLen(3))
+
+			for _, server := range streamServers {
+				if server.IsSocket {
+					g.Expect(server.Includes).To(Equal(test.expSocketIncludes), server.Listen)
+				} else {
+					g.Expect(server.Includes).To(Equal(test.expPortIncludes), server.Listen)
+				}
+			}
+		})
+	}
+}
+
 func TestExecuteStreamServersWithTLSTerminate(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -187,7 +374,7 @@ func TestExecuteStreamServersWithTLSTerminate(t *testing.T) {
 	}
 
 	gen := GeneratorImpl{}
-	results := gen.executeStreamServers(conf)
+	results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 
 	serverConf := string(results[0].data)
@@ -291,7 +478,7 @@ func TestCreateStreamServers(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -410,7 +597,7 @@ func TestExecuteStreamServersForIPFamily(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeStreamServers(test.config)
+			results := gen.executeStreamServers(test.config, &policiesfakes.FakeGenerator{})
 			g.Expect(results).To(HaveLen(1))
 			serverConf := string(results[0].data)
 
@@ -509,7 +696,7 @@ func TestExecuteStreamServers_RewriteClientIP(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeStreamServers(test.config)
+			results := gen.executeStreamServers(test.config, &policiesfakes.FakeGenerator{})
 			g.Expect(results).To(HaveLen(1))
 			serverConf := string(results[0].data)
 
@@ -527,7 +714,7 @@ func TestCreateStreamServersWithNone(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -578,7 +765,7 @@ func TestCreateStreamServersWithTLSTerminate(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -827,7 +1014,7 @@ func TestCreateTLSTerminateSocketServer(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			result := createTLSTerminateSocketServer(tt.server, upstreams, conf)
+			result := createTLSTerminateSocketServer(tt.server, upstreams, conf, nil)
 
 			if tt.expected == nil {
 				g.Expect(result).To(BeNil())
@@ -1022,7 +1209,7 @@ server {
 			t.Parallel()
 			g := NewWithT(t)
 			generator := GeneratorImpl{}
-			results := generator.executeStreamServers(test.conf)
+			results := generator.executeStreamServers(test.conf, &policiesfakes.FakeGenerator{})
 
 			g.Expect(results).To(HaveLen(1))
 			g.Expect(string(results[0].data)).To(Equal(test.expectedConfig))
@@ -1030,6 +1217,103 @@ server {
 	}
 }
 
+func TestExecuteStreamServersWithZoneSync(t *testing.T) {
+	t.Parallel()
+
+	zoneSync := &dataplane.ZoneSyncConfig{
+		Service: "gateway-nginx-zone-sync.test.svc",
+		Port:    12345,
+	}
+
+	tests := []struct {
+		expSubStrings    map[string]int
+		name             string
+		clusterDNS       ngfConfig.ClusterDNSConfig
+		baseStreamConfig dataplane.BaseStreamConfig
+		ipFamily         dataplane.IPFamilyType
+	}{
+		{
+			name:             "zone sync with default cluster DNS",
+			baseStreamConfig: dataplane.BaseStreamConfig{ZoneSync: zoneSync},
+			expSubStrings: map[string]int{
+				"listen 12345 ssl;":      1,
+				"listen [::]:12345 ssl;": 1,
+				"resolver kube-dns.kube-system.svc.cluster.local valid=5s;": 1,
+				"zone_sync;": 1,
+				"zone_sync_server gateway-nginx-zone-sync.test.svc.cluster.local:12345 resolve;": 1,
+				" ssl_certificate /var/run/secrets/ngf/tls.crt;":                                 1,
+				" ssl_certificate_key /var/run/secrets/ngf/tls.key;":                             1,
+				"ssl_client_certificate /var/run/secrets/ngf/ca.crt;":                            1,
+				"ssl_verify_client on;":                                                          1,
+				"zone_sync_ssl on;":                                                              1,
+				"zone_sync_ssl_certificate /var/run/secrets/ngf/tls.crt;":                        1,
+				"zone_sync_ssl_certificate_key /var/run/secrets/ngf/tls.key;":                    1,
+				"zone_sync_ssl_trusted_certificate /var/run/secrets/ngf/ca.crt;":                 1,
+				"zone_sync_ssl_verify on;":                                                       1,
+				"zone_sync_ssl_name zone-sync.cluster.local;":                                    1,
+			},
+		},
+		{
+			name:             "zone sync with configured cluster DNS",
+			baseStreamConfig: dataplane.BaseStreamConfig{ZoneSync: zoneSync},
+			clusterDNS: ngfConfig.ClusterDNSConfig{
+				Domain:    "example.local",
+				Resolvers: []string{"10.96.0.10", "[fd00::a]"},
+			},
+			expSubStrings: map[string]int{
+				"resolver 10.96.0.10 [fd00::a] valid=5s;":                                        1,
+				"zone_sync_server gateway-nginx-zone-sync.test.svc.example.local:12345 resolve;": 1,
+				"zone_sync_ssl_name zone-sync.example.local;":                                    1,
+				"cluster.local": 0,
+			},
+		},
+		{
+			name: "zone sync with resolver configured in the NginxProxy",
+			baseStreamConfig: dataplane.BaseStreamConfig{
+				ZoneSync: zoneSync,
+				DNSResolver: &dataplane.DNSResolverConfig{
+					Addresses: []string{"8.8.8.8"},
+				},
+			},
+			ipFamily: dataplane.IPv4,
+			expSubStrings: map[string]int{
+				"listen 12345 ssl;":      1,
+				"listen [::]:12345 ssl;": 0,
+				"resolver 8.8.8.8;":      1,
+				"resolver kube-dns.kube-system.svc.cluster.local valid=5s;":                      0,
+				"zone_sync_server gateway-nginx-zone-sync.test.svc.cluster.local:12345 resolve;": 1,
+			},
+		},
+		{
+			name: "no zone sync",
+			expSubStrings: map[string]int{
+				"zone_sync": 0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseStreamConfig: test.baseStreamConfig,
+				BaseHTTPConfig:   dataplane.BaseHTTPConfig{IPFamily: test.ipFamily},
+			}
+
+			gen := GeneratorImpl{plus: true, clusterDNS: test.clusterDNS}
+			results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
+			g.Expect(results).To(HaveLen(1))
+
+			serverConf := string(results[0].data)
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
 func TestCreateSplitClientForL4Server(t *testing.T) {
 	t.Parallel()
 
@@ -1462,7 +1746,12 @@ func TestProcessLayer4Servers(t *testing.T) {
 			}
 
 			logger := logr.Discard()
-			processLayer4Servers(logger, tt.servers, tt.upstreams, portSet, &streamServers, tt.protocol)
+			settings := layer4ServerSettings{
+				upstreams: tt.upstreams,
+				portSet:   portSet,
+				generator: &policiesfakes.FakeGenerator{},
+			}
+			processLayer4Servers(logger, tt.servers, settings, &streamServers, tt.protocol)
 
 			g.Expect(streamServers).To(HaveLen(tt.expectedCount))
 
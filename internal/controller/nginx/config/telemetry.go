@@ -9,11 +9,23 @@ import (
 
 var otelTemplate = gotemplate.Must(gotemplate.New("otel").Parse(otelTemplateText))
 
+// otelConfig holds the data for the otel template.
+type otelConfig struct {
+	// TrustedCertificate is the path of the CA certificate bundle that verifies the endpoint, if any.
+	TrustedCertificate string
+	dataplane.Telemetry
+}
+
 func executeTelemetry(conf dataplane.Configuration) []executeResult {
 	if conf.Telemetry.Endpoint != "" {
+		otelConf := otelConfig{Telemetry: conf.Telemetry}
+		if conf.Telemetry.CACertBundleID != "" {
+			otelConf.TrustedCertificate = generateCertBundleFileName(conf.Telemetry.CACertBundleID)
+		}
+
 		result := executeResult{
 			dest: httpConfigFile,
-			data: helpers.MustExecuteTemplate(otelTemplate, conf.Telemetry),
+			data: helpers.MustExecuteTemplate(otelTemplate, otelConf),
 		}
 
 		return []executeResult{result}
@@ -12,6 +12,12 @@ otel_exporter {
 	{{- if .BatchCount }}
 	batch_count {{ .BatchCount }};
 	{{- end }}
+	{{- if .TrustedCertificate }}
+	trusted_certificate {{ .TrustedCertificate }};
+	{{- end }}
+	{{- range $header := .Headers }}
+	header {{ $header.Name }} "{{ $header.Value }}";
+	{{- end }}
 }
 
 otel_service_name {{ .ServiceName }};
@@ -50,6 +50,42 @@ func TestExecuteTelemetry(t *testing.T) {
 	}
 }
 
+func TestExecuteTelemetryExporterTLSAndHeaders(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		Telemetry: dataplane.Telemetry{
+			Endpoint:       "https://collector.example.com:4317",
+			ServiceName:    "ngf:gw-ns:gw-name",
+			CACertBundleID: "cert_bundle_gw-ns_collector-ca",
+			Headers: []dataplane.HTTPHeader{
+				{Name: "Authorization", Value: "Bearer token"},
+				{Name: "X-Tenant", Value: "tenant"},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	res := executeTelemetry(conf)
+	g.Expect(res).To(HaveLen(1))
+
+	data := string(res[0].data)
+	g.Expect(data).To(ContainSubstring("endpoint https://collector.example.com:4317;"))
+	g.Expect(data).To(ContainSubstring(
+		"trusted_certificate /etc/nginx/secrets/cert_bundle_gw-ns_collector-ca.crt;",
+	))
+	g.Expect(data).To(ContainSubstring(`header Authorization "Bearer token";`))
+	g.Expect(data).To(ContainSubstring(`header X-Tenant "tenant";`))
+
+	conf.Telemetry.CACertBundleID = ""
+	conf.Telemetry.Headers = nil
+
+	res = executeTelemetry(conf)
+	g.Expect(res).To(HaveLen(1))
+	g.Expect(string(res[0].data)).ToNot(ContainSubstring("trusted_certificate"))
+	g.Expect(string(res[0].data)).ToNot(ContainSubstring("header "))
+}
+
 func TestExecuteTelemetryNil(t *testing.T) {
 	t.Parallel()
 	conf := dataplane.Configuration{
@@ -6,6 +6,7 @@ import (
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
@@ -105,6 +106,10 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 		}
 	}
 
+	if up.UpstreamSettings.ZoneSize != "" {
+		zoneSize = up.UpstreamSettings.ZoneSize
+	}
+
 	upstreamServers := make([]stream.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
 		format := "%s:%d"
@@ -122,10 +127,39 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 	}
 
 	return stream.Upstream{
-		Name:      up.Name,
-		ZoneSize:  zoneSize,
-		StateFile: stateFile,
-		Servers:   upstreamServers,
+		Name:                up.Name,
+		ZoneSize:            zoneSize,
+		StateFile:           stateFile,
+		LoadBalancingMethod: getStreamLoadBalancingMethod(up.UpstreamSettings),
+		Servers:             upstreamServers,
+	}
+}
+
+// getStreamLoadBalancingMethod translates the load balancing method from an UpstreamSettingsPolicy into
+// its stream module equivalent. The stream module doesn't support ip_hash, so it is emulated with a hash of
+// the client address, and least_time measures the time to the first byte instead of the response header.
+// Policies with a hash key that uses variables only available in the http context are rejected for Services
+// of Layer 4 Routes, since nginx fails to load a stream configuration that references them.
+func getStreamLoadBalancingMethod(settings upstreamsettings.UpstreamSettings) string {
+	switch ngfAPI.LoadBalancingType(settings.LoadBalancingMethod) {
+	case "":
+		return defaultLBMethod
+	case ngfAPI.LoadBalancingTypeRoundRobin:
+		return ""
+	case ngfAPI.LoadBalancingTypeIPHash:
+		return "hash $remote_addr"
+	case ngfAPI.LoadBalancingTypeHash:
+		return fmt.Sprintf("hash %s", settings.HashMethodKey)
+	case ngfAPI.LoadBalancingTypeHashConsistent:
+		return fmt.Sprintf("hash %s consistent", settings.HashMethodKey)
+	case ngfAPI.LoadBalancingTypeRandomTwoLeastTimeHeader:
+		return "random two least_time=first_byte"
+	case ngfAPI.LoadBalancingTypeLeastTimeHeader:
+		return "least_time first_byte"
+	case ngfAPI.LoadBalancingTypeLeastTimeHeaderInflight:
+		return "least_time first_byte inflight"
+	default:
+		return settings.LoadBalancingMethod
 	}
 }
 
@@ -187,6 +221,11 @@ func (g GeneratorImpl) createUpstream(
 		chosenLBMethod = lbMethod
 	}
 
+	var healthCheckMatch *http.HealthCheckMatch
+	if g.plus {
+		healthCheckMatch = createHealthCheckMatch(up)
+	}
+
 	keepAliveSettings := processKeepAliveSettings(upstreamPolicySettings.KeepAlive)
 	if len(up.Endpoints) == 0 {
 		return http.Upstream{
@@ -200,6 +239,7 @@ func (g GeneratorImpl) createUpstream(
 			},
 			LoadBalancingMethod: chosenLBMethod,
 			KeepAlive:           keepAliveSettings,
+			HealthCheckMatch:    healthCheckMatch,
 		}
 	}
 
@@ -223,7 +263,28 @@ func (g GeneratorImpl) createUpstream(
 		KeepAlive:           keepAliveSettings,
 		LoadBalancingMethod: chosenLBMethod,
 		SessionPersistence:  sp,
+		HealthCheckMatch:    healthCheckMatch,
+	}
+}
+
+// createHealthCheckMatch creates the match block for the health check of an upstream.
+// It returns nil if the upstream is not health checked or its health check has no match conditions.
+func createHealthCheckMatch(up dataplane.Upstream) *http.HealthCheckMatch {
+	hc := up.HealthCheck
+	if !hc.Enabled || (hc.MatchStatus == "" && hc.MatchBody == "") {
+		return nil
 	}
+
+	return &http.HealthCheckMatch{
+		Name:   healthCheckMatchName(up.Name),
+		Status: hc.MatchStatus,
+		Body:   hc.MatchBody,
+	}
+}
+
+// healthCheckMatchName returns the name of the match block for the health check of an upstream.
+func healthCheckMatchName(upstreamName string) string {
+	return upstreamName + "_health_check_match"
 }
 
 // processKeepAliveSettings normalizes keepalive configuration from an upstream policy.
@@ -43,13 +43,26 @@ upstream {{ $u.Name }} {
     keepalive_timeout {{ $u.KeepAlive.Timeout }};
     {{- end }}
 }
+    {{- if $u.HealthCheckMatch }}
+
+match {{ $u.HealthCheckMatch.Name }} {
+        {{- if $u.HealthCheckMatch.Status }}
+    status {{ $u.HealthCheckMatch.Status }};
+        {{- end }}
+        {{- if $u.HealthCheckMatch.Body }}
+    body ~ "{{ $u.HealthCheckMatch.Body }}";
+        {{- end }}
+}
+    {{- end }}
 {{ end -}}
 `
 
 const streamUpstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
+    {{ if $u.LoadBalancingMethod -}}
+    {{ $u.LoadBalancingMethod }};
+    {{- end }}
     {{ if $u.ZoneSize -}}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{- end }}
@@ -9,6 +9,7 @@ import (
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
@@ -287,6 +288,20 @@ func TestExecuteUpstreams_NginxPlus(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "with-health-check",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "12.0.0.7",
+					Port:    80,
+				},
+			},
+			HealthCheck: healthcheck.HealthCheck{
+				Enabled:     true,
+				MatchStatus: "200-399",
+				MatchBody:   "ok",
+			},
+		},
 	}
 
 	expectedSubStrings := map[string]int{
@@ -299,9 +314,10 @@ func TestExecuteUpstreams_NginxPlus(t *testing.T) {
 		"upstream up7-with-sp":                        1,
 		"upstream up8-with-sp-expiry-and-path-empty":  1,
 		"upstream up9-usp-keepAlive-connections-zero": 1,
+		"upstream with-health-check":                  1,
 		"upstream invalid-backend-ref":                1,
 
-		defaultLBMethod + ";": 9,
+		defaultLBMethod + ";": 10,
 		"ip_hash;":            1,
 
 		"zone up1 1m;":                                1,
@@ -313,6 +329,11 @@ func TestExecuteUpstreams_NginxPlus(t *testing.T) {
 		"zone up7-with-sp 1m;":                        1,
 		"zone up8-with-sp-expiry-and-path-empty 1m;":  1,
 		"zone up9-usp-keepAlive-connections-zero 2m;": 1,
+		"zone with-health-check 1m;":                  1,
+
+		"match with-health-check_health_check_match {": 1,
+		"status 200-399;": 1,
+		`body ~ "ok";`:    1,
 
 		"sticky cookie session-persistence expires=30m path=/session;":   1,
 		"sticky cookie session-persistence expires=100h path=/v1/users;": 1,
@@ -949,6 +970,69 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				LoadBalancingMethod: defaultLBMethod,
 			},
 		},
+		{
+			msg: "health check with match",
+			stateUpstream: dataplane.Upstream{
+				Name:         "hc-match",
+				StateFileKey: "hc-match",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.3",
+						Port:    80,
+					},
+				},
+				HealthCheck: healthcheck.HealthCheck{
+					Enabled:     true,
+					Path:        "/healthz",
+					MatchStatus: "200-399",
+					MatchBody:   "ok",
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "hc-match",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/hc-match.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.3:80",
+					},
+				},
+				LoadBalancingMethod: defaultLBMethod,
+				HealthCheckMatch: &http.HealthCheckMatch{
+					Name:   "hc-match_health_check_match",
+					Status: "200-399",
+					Body:   "ok",
+				},
+			},
+		},
+		{
+			msg: "health check without match",
+			stateUpstream: dataplane.Upstream{
+				Name:         "hc-no-match",
+				StateFileKey: "hc-no-match",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.4",
+						Port:    80,
+					},
+				},
+				HealthCheck: healthcheck.HealthCheck{
+					Enabled: true,
+					Path:    "/healthz",
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "hc-no-match",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/hc-no-match.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.4:80",
+					},
+				},
+				LoadBalancingMethod: defaultLBMethod,
+			},
+		},
 		{
 			msg: "session persistence config with endpoints",
 			stateUpstream: dataplane.Upstream{
@@ -1023,13 +1107,32 @@ func TestExecuteStreamUpstreams(t *testing.T) {
 			Name:      "up3",
 			Endpoints: []resolver.Endpoint{},
 		},
+		{
+			Name: "up4-usp",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "12.0.0.0",
+					Port:    5432,
+				},
+			},
+			UpstreamSettings: upstreamsettings.UpstreamSettings{
+				ZoneSize:            "2m",
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeHashConsistent),
+				HashMethodKey:       "$remote_addr",
+			},
+		},
 	}
 
 	expectedSubStrings := []string{
 		"upstream up1",
 		"upstream up2",
+		"upstream up4-usp",
 		"server 10.0.0.0:80;",
 		"server 11.0.0.0:80;",
+		"server 12.0.0.0:5432;",
+		"random two least_conn;",
+		"hash $remote_addr consistent;",
+		"zone up4-usp 2m;",
 	}
 
 	upstreamResults := gen.executeStreamUpstreams(dataplane.Configuration{StreamUpstreams: stateUpstreams})
@@ -1252,8 +1355,9 @@ func TestCreateStreamUpstreams(t *testing.T) {
 
 	expUpstreams := []stream.Upstream{
 		{
-			Name:     "up1",
-			ZoneSize: ossZoneSize,
+			Name:                "up1",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLBMethod,
 			Servers: []stream.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -1270,8 +1374,9 @@ func TestCreateStreamUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up2",
-			ZoneSize: ossZoneSize,
+			Name:                "up2",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLBMethod,
 			Servers: []stream.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -1313,8 +1418,9 @@ func TestCreateStreamUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: stream.Upstream{
-				Name:     "multiple-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "multiple-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLBMethod,
 				Servers: []stream.UpstreamServer{
 					{
 						Address: "10.0.0.1:80",
@@ -1341,8 +1447,9 @@ func TestCreateStreamUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: stream.Upstream{
-				Name:     "external-name-service",
-				ZoneSize: ossZoneSize,
+				Name:                "external-name-service",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLBMethod,
 				Servers: []stream.UpstreamServer{
 					{
 						Address: "backend.example.com:443",
@@ -1373,8 +1480,9 @@ func TestCreateStreamUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: stream.Upstream{
-				Name:     "mixed-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "mixed-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLBMethod,
 				Servers: []stream.UpstreamServer{
 					{
 						Address: "192.168.1.10:8080",
@@ -1390,6 +1498,33 @@ func TestCreateStreamUpstream(t *testing.T) {
 			},
 			msg: "mixed IP addresses and DNS names",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "upstream-settings",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    5432,
+					},
+				},
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					ZoneSize:            "2m",
+					LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeHashConsistent),
+					HashMethodKey:       "$remote_addr",
+				},
+			},
+			expectedUpstream: stream.Upstream{
+				Name:                "upstream-settings",
+				ZoneSize:            "2m",
+				LoadBalancingMethod: "hash $remote_addr consistent",
+				Servers: []stream.UpstreamServer{
+					{
+						Address: "10.0.0.1:5432",
+					},
+				},
+			},
+			msg: "upstream settings policy",
+		},
 	}
 
 	for _, test := range tests {
@@ -1416,9 +1551,10 @@ func TestCreateStreamUpstreamPlus(t *testing.T) {
 		},
 	}
 	expectedUpstream := stream.Upstream{
-		Name:      "multiple-endpoints",
-		ZoneSize:  plusZoneSize,
-		StateFile: stateDir + "/multiple-endpoints.conf",
+		Name:                "multiple-endpoints",
+		ZoneSize:            plusZoneSize,
+		StateFile:           stateDir + "/multiple-endpoints.conf",
+		LoadBalancingMethod: defaultLBMethod,
 		Servers: []stream.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
@@ -1432,6 +1568,94 @@ func TestCreateStreamUpstreamPlus(t *testing.T) {
 	g.Expect(result).To(Equal(expectedUpstream))
 }
 
+func TestGetStreamLoadBalancingMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg      string
+		expected string
+		settings upstreamsettings.UpstreamSettings
+	}{
+		{
+			msg:      "default",
+			expected: defaultLBMethod,
+		},
+		{
+			msg: "round robin",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeRoundRobin),
+			},
+			expected: "",
+		},
+		{
+			msg: "least_conn",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastConnection),
+			},
+			expected: "least_conn",
+		},
+		{
+			msg: "ip_hash",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeIPHash),
+			},
+			expected: "hash $remote_addr",
+		},
+		{
+			msg: "hash",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeHash),
+				HashMethodKey:       "$ssl_preread_server_name",
+			},
+			expected: "hash $ssl_preread_server_name",
+		},
+		{
+			msg: "hash consistent",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeHashConsistent),
+				HashMethodKey:       "$remote_addr",
+			},
+			expected: "hash $remote_addr consistent",
+		},
+		{
+			msg: "random two least_time=header",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeRandomTwoLeastTimeHeader),
+			},
+			expected: "random two least_time=first_byte",
+		},
+		{
+			msg: "least_time header",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastTimeHeader),
+			},
+			expected: "least_time first_byte",
+		},
+		{
+			msg: "least_time header inflight",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastTimeHeaderInflight),
+			},
+			expected: "least_time first_byte inflight",
+		},
+		{
+			msg: "least_time last_byte inflight",
+			settings: upstreamsettings.UpstreamSettings{
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastTimeLastByteInflight),
+			},
+			expected: "least_time last_byte inflight",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(getStreamLoadBalancingMethod(test.settings)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestKeepAliveChecker(t *testing.T) {
 	t.Parallel()
 
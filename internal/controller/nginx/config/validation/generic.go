@@ -3,8 +3,10 @@ package validation
 import (
 	"errors"
 	"regexp"
+	"strings"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GenericValidator validates values for generic cases in the nginx conf.
@@ -149,3 +151,20 @@ func (GenericValidator) ValidateAccessLogFormatString(value string) error {
 		},
 	)
 }
+
+// ValidateCIDR validates an IPv4 or IPv6 CIDR range that can be used in the allow, deny and geo directives.
+// A single IP address is accepted as the range that only contains that address.
+func (GenericValidator) ValidateCIDR(value string) error {
+	var errs field.ErrorList
+	if strings.Contains(value, "/") {
+		errs = k8svalidation.IsValidCIDR(nil, value)
+	} else {
+		errs = k8svalidation.IsValidIP(nil, value)
+	}
+
+	if len(errs) > 0 {
+		return errors.New(errs[0].Detail)
+	}
+
+	return nil
+}
@@ -187,3 +187,28 @@ func TestValidateAccessLogFormatString(t *testing.T) {
 		"$remote_addr\n$status",
 	)
 }
+
+func TestValidateCIDR(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateCIDR,
+		`10.0.0.0/8`,
+		`192.168.1.1`,
+		`2001:db8::/32`,
+		`::1`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateCIDR,
+		`10.0.0.0/33`,
+		`10.0.0`,
+		`all`,
+		`10.0.0.1;`,
+		`fe80::1%eth0`,
+		``,
+	)
+}
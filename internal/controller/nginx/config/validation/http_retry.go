@@ -0,0 +1,21 @@
+package validation
+
+// HTTPRetryValidator validates values for retrying requests, which in NGINX is done with the
+// proxy_next_upstream directive.
+type HTTPRetryValidator struct{}
+
+// retryableStatusCodes are the HTTP status codes that the proxy_next_upstream directive can retry on.
+var retryableStatusCodes = map[int]struct{}{
+	403: {},
+	404: {},
+	429: {},
+	500: {},
+	502: {},
+	503: {},
+	504: {},
+}
+
+// ValidateRetryStatusCode validates an HTTP response status code for which a request should be retried.
+func (HTTPRetryValidator) ValidateRetryStatusCode(code int) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(code, retryableStatusCodes)
+}
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestValidateRetryStatusCode(t *testing.T) {
+	t.Parallel()
+	validator := HTTPRetryValidator{}
+
+	testValidValuesForSupportedValuesValidator(
+		t,
+		validator.ValidateRetryStatusCode,
+		403,
+		404,
+		429,
+		500,
+		502,
+		503,
+		504,
+	)
+
+	testInvalidValuesForSupportedValuesValidator(
+		t,
+		validator.ValidateRetryStatusCode,
+		retryableStatusCodes,
+		400,
+		501,
+		599,
+	)
+}
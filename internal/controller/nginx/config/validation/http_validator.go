@@ -14,6 +14,7 @@ type HTTPValidator struct {
 	HTTPHeaderValidator
 	HTTPPathValidator
 	HTTPDurationValidator
+	HTTPRetryValidator
 }
 
 func (HTTPValidator) SkipValidation() bool { return false }
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"strings"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 )
 
 // NGINX Variable names cannot have hyphens.
@@ -28,3 +30,20 @@ func generateCORSAllowedOriginVariableName(serverID string, pathRuleIndex, match
 func generateCORSAllowCredentialsVariableName(serverID string, pathRuleIndex, matchRuleIndex int) string {
 	return fmt.Sprintf("$cors_allow_credentials_server%s_path%d_match%d", serverID, pathRuleIndex, matchRuleIndex)
 }
+
+// generateCanaryVariableName generates the variable name of the map of the override at the given index of the
+// CanaryFilter of a backend group. The variable of the first map selects the upstream of the group.
+func generateCanaryVariableName(group dataplane.BackendGroup, index int) string {
+	name := "$canary_" + convertStringToSafeVariableName(group.Name())
+	if index == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s_%d", name, index)
+}
+
+// generateMirrorBodySizeExceededVariableName generates the variable name of the map that indicates that the
+// Content-Length of a request is missing or larger than the maximum body size of mirrored requests.
+func generateMirrorBodySizeExceededVariableName(maxBodySize int64) string {
+	return fmt.Sprintf("mirror_body_size_exceeds_%d", maxBodySize)
+}
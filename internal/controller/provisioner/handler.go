@@ -131,6 +131,11 @@ func (h *eventHandler) handleServiceUpsert(ctx context.Context, logger logr.Logg
 		return fmt.Errorf("error handling resource update: %w", err)
 	}
 
+	// The zone sync Service is internal to the nginx replicas and does not affect the Gateway's address.
+	if controller.IsZoneSyncService(svc) {
+		return nil
+	}
+
 	h.provisioner.cfg.StatusQueue.Enqueue(&status.QueueObject{
 		Deployment: status.Deployment{
 			NamespacedName: client.ObjectKeyFromObject(svc),
@@ -273,6 +278,7 @@ func (h *eventHandler) provisionResource(
 				resources.Gateway.EffectiveNginxProxy,
 				resources.Gateway.Listeners,
 				extractExternalLoadBalancer(resources.Gateway),
+				resources.Gateway.ZoneSync,
 			)
 			if err != nil {
 				logger.Error(err, "error building some nginx resources")
@@ -324,6 +330,7 @@ func (h *eventHandler) reprovisionResources(ctx context.Context, event *events.D
 				gateway.EffectiveNginxProxy,
 				gateway.Listeners,
 				extractExternalLoadBalancer(gateway),
+				gateway.ZoneSync,
 			); err != nil {
 				return err
 			}
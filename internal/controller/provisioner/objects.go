@@ -38,6 +38,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf"
 )
 
@@ -49,6 +50,8 @@ const (
 	defaultServiceType   = corev1.ServiceTypeLoadBalancer
 	defaultServicePolicy = corev1.ServiceExternalTrafficPolicyLocal
 
+	zoneSyncPortName = "zone-sync"
+
 	defaultNginxImagePath        = "ghcr.io/nginx/nginx-gateway-fabric/nginx"
 	defaultNginxPlusImagePath    = "private-registry.nginx.com/nginx-gateway-fabric/nginx-plus"
 	defaultNginxPlusWAFImagePath = "private-registry.nginx.com/nginx-gateway-fabric/nginx-plus-f5waf"
@@ -64,6 +67,10 @@ const (
 	appProtectConfigVolumeName   = "app-protect-config"
 	appProtectBdConfigVolumeName = "app-protect-bd-config"
 	appProtectLockVolumeName     = "app-protect-lock"
+
+	// OTLP metrics exporter Secrets volume.
+	otlpMetricsSecretsVolumeName = "nginx-agent-otlp-metrics"
+	otlpMetricsSecretsMountPath  = "/var/run/secrets/ngf-otlp-metrics"
 )
 
 // portProtoEntry represents a unique port and protocol combination.
@@ -101,6 +108,7 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 	nProxyCfg *graph.EffectiveNginxProxy,
 	allListeners []*graph.Listener,
 	elb *ngfAPIv1alpha1.ExternalLoadBalancer,
+	zoneSync bool,
 ) ([]client.Object, error) {
 	// NOTE: When adding new fields to the generated objects, please ensure to update the corresponding spec
 	// setter function in setter.go to set the new fields when updating the object.
@@ -183,6 +191,21 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		errs = append(errs, fmt.Errorf("failed to set owner reference on Service %s: %w", service.GetName(), err))
 	}
 
+	// zone sync between the nginx replicas is only supported by NGINX Plus
+	zoneSyncEnabled := p.cfg.Plus && zoneSync
+
+	var zoneSyncService *corev1.Service
+	if zoneSyncEnabled {
+		zoneSyncService = buildZoneSyncService(cloneObjectMeta(objectMeta), selectorLabels)
+		if err := p.setOwnerReference(zoneSyncService, gateway); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"failed to set owner reference on Service %s: %w",
+				zoneSyncService.GetName(),
+				err,
+			))
+		}
+	}
+
 	// build deployment/daemonset
 	deployment, err := p.buildNginxDeployment(
 		cloneObjectMeta(objectMeta),
@@ -190,6 +213,7 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		ports,
 		selectorLabels,
 		resourceNames,
+		zoneSyncEnabled,
 	)
 	if err != nil {
 		errs = append(errs, err)
@@ -209,6 +233,7 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 	// serviceaccount
 	// role/binding (if openshift)
 	// service
+	// zone sync service (if enabled)
 	// deployment/daemonset
 	// hpa
 	// pdb
@@ -222,7 +247,11 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		objects = append(objects, openshiftObjs...)
 	}
 
-	objects = append(objects, service, deployment)
+	objects = append(objects, service)
+	if zoneSyncService != nil {
+		objects = append(objects, zoneSyncService)
+	}
+	objects = append(objects, deployment)
 
 	objects, errs = p.buildHPAAndPDB(objectMeta, nProxyCfg, selectorLabels, gateway, objects, errs)
 
@@ -692,6 +721,10 @@ func (p *NginxProvisioner) buildAgentConfigMap(
 		if nProxyCfg.Logging != nil && nProxyCfg.Logging.AgentLevel != nil {
 			agentFields["LogLevel"] = *nProxyCfg.Logging.AgentLevel
 		}
+
+		if exporter := buildOTLPMetricsExporter(nProxyCfg); exporter != nil {
+			agentFields["OTLPMetrics"] = exporter
+		}
 	}
 
 	if p.cfg.NginxOneConsoleTelemetryConfig.DataplaneKeySecretName != "" {
@@ -714,6 +747,99 @@ func (p *NginxProvisioner) buildAgentConfigMap(
 	}
 }
 
+// otlpMetricsExporter holds the settings of the OTLP metrics exporter of the NGINX agent.
+type otlpMetricsExporter struct {
+	Host         string
+	Port         string
+	BatchTimeout string
+	CACert       string
+	ClientCert   string
+	ClientKey    string
+	ServerName   string
+	Headers      []otlpHeader
+	TLS          bool
+}
+
+// otlpHeader is a header of the OTLP metrics exporter, whose value is read from a file.
+type otlpHeader struct {
+	Key      string
+	FilePath string
+}
+
+// getMetricsExporter returns the OTLP metrics exporter of the EffectiveNginxProxy, if configured.
+func getMetricsExporter(nProxyCfg *graph.EffectiveNginxProxy) *ngfAPIv1alpha2.MetricsExporter {
+	if nProxyCfg == nil || nProxyCfg.Telemetry == nil {
+		return nil
+	}
+
+	return nProxyCfg.Telemetry.MetricsExporter
+}
+
+// buildOTLPMetricsExporter builds the agent settings of the OTLP metrics exporter. The files of the
+// referenced Secrets are mounted into the NGINX container by configureOTLPMetricsSecrets.
+func buildOTLPMetricsExporter(nProxyCfg *graph.EffectiveNginxProxy) *otlpMetricsExporter {
+	exp := getMetricsExporter(nProxyCfg)
+	if exp == nil {
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(exp.Endpoint)
+	if err != nil {
+		// the endpoint is validated by the CRD, so this should never happen
+		return nil
+	}
+
+	exporter := &otlpMetricsExporter{
+		Host: host,
+		Port: port,
+	}
+
+	if exp.BatchTimeout != nil {
+		exporter.BatchTimeout = agentDuration(*exp.BatchTimeout)
+	}
+
+	if exp.TLS != nil {
+		exporter.TLS = true
+
+		if exp.TLS.CACertificateRef != nil {
+			exporter.CACert = otlpMetricsSecretsMountPath + "/" + secrets.CAKey
+		}
+
+		if exp.TLS.ClientCertificateRef != nil {
+			exporter.ClientCert = otlpMetricsSecretsMountPath + "/" + corev1.TLSCertKey
+			exporter.ClientKey = otlpMetricsSecretsMountPath + "/" + corev1.TLSPrivateKeyKey
+		}
+
+		if exp.TLS.ServerName != nil {
+			exporter.ServerName = *exp.TLS.ServerName
+		}
+	}
+
+	for _, header := range exp.Headers {
+		exporter.Headers = append(exporter.Headers, otlpHeader{
+			Key:      header.Name,
+			FilePath: otlpMetricsSecretsMountPath + "/" + otlpHeaderPath(header.Name),
+		})
+	}
+
+	return exporter
+}
+
+func otlpHeaderPath(name string) string {
+	return "headers/" + name
+}
+
+// agentDuration converts an NGINX duration to a duration that the NGINX agent accepts.
+// A value without a suffix is seconds in NGINX, while the agent requires a suffix.
+func agentDuration(duration ngfAPIv1alpha1.Duration) string {
+	d := string(duration)
+	if _, err := strconv.Atoi(d); err == nil {
+		return d + "s"
+	}
+
+	return d
+}
+
 func (p *NginxProvisioner) buildOpenshiftObjects(
 	objectMeta metav1.ObjectMeta,
 	gateway *gatewayv1.Gateway,
@@ -922,6 +1048,35 @@ func (p *NginxProvisioner) setIPFamily(nProxyCfg *graph.EffectiveNginxProxy, svc
 	}
 }
 
+// buildZoneSyncService builds the headless Service that the nginx replicas use to discover each other
+// for zone synchronization. Not ready addresses are published so that replicas can synchronize
+// before they pass their readiness checks.
+func buildZoneSyncService(objectMeta metav1.ObjectMeta, selectorLabels map[string]string) *corev1.Service {
+	objectMeta.Name = controller.CreateZoneSyncServiceName(objectMeta.Name)
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = make(map[string]string)
+	}
+	objectMeta.Labels[controller.ZoneSyncLabel] = "true"
+
+	return &corev1.Service{
+		ObjectMeta: objectMeta,
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       zoneSyncPortName,
+					Port:       dataplane.ZoneSyncPort,
+					TargetPort: intstr.FromInt32(dataplane.ZoneSyncPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Selector:                 selectorLabels,
+			PublishNotReadyAddresses: true,
+		},
+	}
+}
+
 func setSvcLoadBalancerSettings(svcCfg ngfAPIv1alpha2.ServiceSpec, svcSpec *corev1.ServiceSpec) {
 	if svcCfg.LoadBalancerIP != nil {
 		svcSpec.LoadBalancerIP = *svcCfg.LoadBalancerIP
@@ -940,12 +1095,14 @@ func (p *NginxProvisioner) buildNginxDeployment(
 	ports []portProtoEntry,
 	selectorLabels map[string]string,
 	names resourceNames,
+	zoneSync bool,
 ) (client.Object, error) {
 	podTemplateSpec := p.buildNginxPodTemplateSpec(
 		objectMeta,
 		nProxyCfg,
 		ports,
 		names,
+		zoneSync,
 	)
 
 	if nProxyCfg != nil && nProxyCfg.Kubernetes != nil && nProxyCfg.Kubernetes.DaemonSet != nil {
@@ -1115,9 +1272,15 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	nProxyCfg *graph.EffectiveNginxProxy,
 	ports []portProtoEntry,
 	names resourceNames,
+	zoneSync bool,
 ) corev1.PodTemplateSpec {
 	// Build container ports and pod annotations
-	containerPorts, podAnnotations := p.buildContainerPortsAndAnnotations(ports, nProxyCfg, objectMeta.Annotations)
+	containerPorts, podAnnotations := p.buildContainerPortsAndAnnotations(
+		ports,
+		nProxyCfg,
+		objectMeta.Annotations,
+		zoneSync,
+	)
 
 	// Build NGINX container
 	nginxContainer := p.buildNginxContainer(containerPorts, nProxyCfg)
@@ -1155,6 +1318,9 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 		p.configureDataplaneKeySecret(&spec, names)
 	}
 
+	// Configure the Secrets of the OTLP metrics exporter
+	configureOTLPMetricsSecrets(&spec, getMetricsExporter(nProxyCfg))
+
 	// Configure inference extension if enabled
 	if p.cfg.InferenceExtension {
 		var containerResources corev1.ResourceRequirements
@@ -1180,6 +1346,7 @@ func (p *NginxProvisioner) buildContainerPortsAndAnnotations(
 	ports []portProtoEntry,
 	nProxyCfg *graph.EffectiveNginxProxy,
 	baseAnnotations map[string]string,
+	zoneSync bool,
 ) ([]corev1.ContainerPort, map[string]string) {
 	// Determine which port numbers have multiple protocols for naming.
 	protocolsPerPort := make(map[int32]int)
@@ -1219,6 +1386,14 @@ func (p *NginxProvisioner) buildContainerPortsAndAnnotations(
 		podAnnotations["prometheus.io/port"] = strconv.Itoa(int(metricsPort))
 	}
 
+	if zoneSync {
+		containerPorts = append(containerPorts, corev1.ContainerPort{
+			Name:          zoneSyncPortName,
+			ContainerPort: dataplane.ZoneSyncPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
 	// need to sort ports so everytime buildNginxPodTemplateSpec is called it will generate the exact same
 	// array of ports. This is needed to satisfy deterministic results of the method.
 	sort.Slice(containerPorts, func(i, j int) bool {
@@ -1269,6 +1444,7 @@ func (p *NginxProvisioner) buildNginxContainer(
 			{MountPath: "/etc/nginx/secrets", Name: "nginx-secrets"},
 			{MountPath: "/var/run/nginx", Name: "nginx-run"},
 			{MountPath: "/var/cache/nginx", Name: "nginx-cache"},
+			{MountPath: "/var/cache/nginx/proxy-cache", Name: "nginx-proxy-cache"},
 			{MountPath: "/etc/nginx/includes", Name: "nginx-includes"},
 		},
 	}
@@ -1322,6 +1498,7 @@ func (p *NginxProvisioner) buildBaseVolumes(names resourceNames) []corev1.Volume
 		{Name: "nginx-secrets", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-run", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-cache", VolumeSource: emptyDirVolumeSource},
+		{Name: "nginx-proxy-cache", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-includes", VolumeSource: emptyDirVolumeSource},
 		{
 			Name: "nginx-includes-bootstrap",
@@ -1600,6 +1777,60 @@ func (p *NginxProvisioner) configureDataplaneKeySecret(
 	spec.Spec.Containers[0].VolumeMounts = volumeMounts
 }
 
+// configureOTLPMetricsSecrets mounts the Secrets that are referenced by the OTLP metrics exporter into the
+// NGINX container, where the NGINX agent reads them. The Secrets are in the namespace of the Gateway.
+func configureOTLPMetricsSecrets(spec *corev1.PodTemplateSpec, exp *ngfAPIv1alpha2.MetricsExporter) {
+	if exp == nil {
+		return
+	}
+
+	var sources []corev1.VolumeProjection
+	addSource := func(secretName string, items ...corev1.KeyToPath) {
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Items:                items,
+			},
+		})
+	}
+
+	if exp.TLS != nil {
+		if exp.TLS.CACertificateRef != nil {
+			addSource(exp.TLS.CACertificateRef.Name, corev1.KeyToPath{Key: secrets.CAKey, Path: secrets.CAKey})
+		}
+
+		if exp.TLS.ClientCertificateRef != nil {
+			addSource(
+				exp.TLS.ClientCertificateRef.Name,
+				corev1.KeyToPath{Key: corev1.TLSCertKey, Path: corev1.TLSCertKey},
+				corev1.KeyToPath{Key: corev1.TLSPrivateKeyKey, Path: corev1.TLSPrivateKeyKey},
+			)
+		}
+	}
+
+	for _, header := range exp.Headers {
+		addSource(header.SecretRef.Name, corev1.KeyToPath{Key: secrets.HeaderValueKey, Path: otlpHeaderPath(header.Name)})
+	}
+
+	if len(sources) == 0 {
+		return
+	}
+
+	spec.Spec.Containers[0].VolumeMounts = append(spec.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      otlpMetricsSecretsVolumeName,
+		MountPath: otlpMetricsSecretsMountPath,
+		ReadOnly:  true,
+	})
+	spec.Spec.Volumes = append(spec.Spec.Volumes, corev1.Volume{
+		Name: otlpMetricsSecretsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	})
+}
+
 // configureInferenceExtension configures the inference extension endpoint-picker sidecar.
 func (p *NginxProvisioner) configureInferenceExtension(
 	spec *corev1.PodTemplateSpec,
@@ -1623,6 +1854,12 @@ func (p *NginxProvisioner) configureInferenceExtension(
 		ImagePullPolicy: defaultImagePullPolicy,
 		Command:         command,
 		Resources:       containerResources,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "shim-metrics",
+				ContainerPort: ngftypes.GoShimMetricsPort,
+			},
+		},
 		SecurityContext: &corev1.SecurityContext{
 			AllowPrivilegeEscalation: helpers.GetPointer(false),
 			Capabilities: &corev1.Capabilities{
@@ -1945,7 +2182,7 @@ func (p *NginxProvisioner) buildResourcesForInvalidGatewayCleanup(
 	// Order to delete:
 	// 1. external load balancer
 	// 2. deployment/daemonset
-	// 3. service
+	// 3. services
 	// 4. hpa (Horizontal Pod Autoscaler)
 	// 5. pdb (Pod Disruption Budget)
 	// 6. role/binding (if openshift)
@@ -1983,8 +2220,14 @@ func (p *NginxProvisioner) buildResourcesForInvalidGatewayCleanup(
 		&appsv1.DaemonSet{ObjectMeta: baseMeta},
 	)
 
-	// 3. Service
+	// 3. Services (the zone sync Service is only created for NGINX Plus)
 	objects = append(objects, &corev1.Service{ObjectMeta: baseMeta})
+	if p.cfg.Plus {
+		objects = append(
+			objects,
+			&corev1.Service{ObjectMeta: meta(controller.CreateZoneSyncServiceName(deploymentNSName.Name))},
+		)
+	}
 
 	// 4. HorizontalPodAutoscaler
 	objects = append(objects, &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: baseMeta})
@@ -3,6 +3,8 @@ package provisioner
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -19,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
@@ -30,6 +33,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
 // graphListenersFromGateway converts raw Gateway spec listeners to graph Listeners for testing.
@@ -171,6 +175,7 @@ func TestBuildNginxResourceObjects(t *testing.T) {
 		},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -419,6 +424,7 @@ func TestBuildNginxResourceObjects_ListenerSetPorts(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		allListeners,
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -556,6 +562,7 @@ func TestBuildNginxResourceObjects_NginxProxyConfig(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -734,6 +741,7 @@ func TestBuildNginxResourceObjects_ExposeHealthcheck(t *testing.T) {
 				test.nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -909,6 +917,7 @@ func TestBuildNginxResourceObjects_DeploymentReplicasFromHPA(t *testing.T) {
 				nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -1011,6 +1020,7 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1094,6 +1104,137 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 	g.Expect(container.Image).To(Equal(fmt.Sprintf("%s:1.0.0", defaultNginxPlusImagePath)))
 }
 
+func TestBuildNginxResourceObjects_ZoneSync(t *testing.T) {
+	t.Parallel()
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{Port: 80}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		plus        bool
+		zoneSync    bool
+		expZoneSync bool
+	}{
+		{
+			name:        "zone sync on plus",
+			plus:        true,
+			zoneSync:    true,
+			expZoneSync: true,
+		},
+		{
+			name:     "zone sync on oss",
+			zoneSync: true,
+		},
+		{
+			name: "zone sync not needed",
+			plus: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			agentTLSSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      agentTLSTestSecretName,
+					Namespace: ngfNamespace,
+				},
+				Data: map[string][]byte{secrets.TLSCertKey: []byte("tls")},
+			}
+
+			jwtSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      jwtTestSecretName,
+					Namespace: ngfNamespace,
+				},
+				Data: map[string][]byte{secrets.LicenseJWTKey: []byte("jwt")},
+			}
+
+			provisioner := &NginxProvisioner{
+				cfg: Config{
+					GatewayPodConfig: &config.GatewayPodConfig{
+						Namespace: ngfNamespace,
+						Version:   "1.0.0",
+					},
+					Plus:               test.plus,
+					PlusUsageConfig:    &config.UsageReportConfig{SecretName: jwtTestSecretName},
+					AgentTLSSecretName: agentTLSTestSecretName,
+					AgentLabels:        make(map[string]string),
+				},
+				k8sClient: createFakeClientWithScheme(agentTLSSecret, jwtSecret),
+				baseLabelSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app": "nginx",
+					},
+				},
+			}
+
+			resourceName := "gw-nginx"
+			objects, err := provisioner.buildNginxResourceObjects(
+				resourceName,
+				gateway,
+				&graph.EffectiveNginxProxy{},
+				graphListenersFromGateway(gateway),
+				nil,
+				test.zoneSync,
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var zoneSyncSvc *corev1.Service
+			var dep *appsv1.Deployment
+			for _, obj := range objects {
+				switch o := obj.(type) {
+				case *corev1.Service:
+					if o.GetName() == controller.CreateZoneSyncServiceName(resourceName) {
+						zoneSyncSvc = o
+					}
+				case *appsv1.Deployment:
+					dep = o
+				}
+			}
+			g.Expect(dep).ToNot(BeNil())
+
+			expContainerPort := corev1.ContainerPort{
+				Name:          "zone-sync",
+				ContainerPort: 12345,
+				Protocol:      corev1.ProtocolTCP,
+			}
+
+			if !test.expZoneSync {
+				g.Expect(zoneSyncSvc).To(BeNil())
+				g.Expect(dep.Spec.Template.Spec.Containers[0].Ports).ToNot(ContainElement(expContainerPort))
+				return
+			}
+
+			g.Expect(zoneSyncSvc).ToNot(BeNil())
+			g.Expect(zoneSyncSvc.Labels).To(HaveKeyWithValue(controller.ZoneSyncLabel, "true"))
+			g.Expect(zoneSyncSvc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+			g.Expect(zoneSyncSvc.Spec.PublishNotReadyAddresses).To(BeTrue())
+			g.Expect(zoneSyncSvc.Spec.Selector).To(Equal(dep.Spec.Selector.MatchLabels))
+			g.Expect(zoneSyncSvc.Spec.Ports).To(Equal([]corev1.ServicePort{
+				{
+					Name:       "zone-sync",
+					Port:       12345,
+					TargetPort: intstr.FromInt32(12345),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			}))
+			g.Expect(zoneSyncSvc.GetOwnerReferences()).To(HaveLen(1))
+			g.Expect(dep.Spec.Template.Spec.Containers[0].Ports).To(ContainElement(expContainerPort))
+		})
+	}
+}
+
 func TestBuildNginxResourceObjects_DockerSecrets(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -1167,6 +1308,7 @@ func TestBuildNginxResourceObjects_DockerSecrets(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1302,6 +1444,7 @@ func TestBuildNginxResourceObjects_DaemonSet(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1389,6 +1532,7 @@ func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1472,6 +1616,7 @@ func TestBuildNginxResourceObjects_DataplaneKeySecret(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(7)) // 2 secrets, 2 configmaps, serviceaccount, service, deployment
@@ -1637,7 +1782,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 
 	objects := provisioner.buildResourcesForInvalidGatewayCleanup(deploymentNSName)
 
-	g.Expect(objects).To(HaveLen(13))
+	g.Expect(objects).To(HaveLen(14))
 
 	validateMeta := func(obj client.Object, name string) {
 		g.Expect(obj.GetName()).To(Equal(name))
@@ -1659,32 +1804,37 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svc, deploymentNSName.Name)
 
-	hpaObj := objects[3]
+	svcObj = objects[3]
+	svc, ok = svcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(svc, controller.CreateZoneSyncServiceName(deploymentNSName.Name))
+
+	hpaObj := objects[4]
 	hpa, ok := hpaObj.(*autoscalingv2.HorizontalPodAutoscaler)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(hpa, deploymentNSName.Name)
 
-	pdbObj := objects[4]
+	pdbObj := objects[5]
 	pdb, ok := pdbObj.(*policyv1.PodDisruptionBudget)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(pdb, deploymentNSName.Name)
 
-	svcAcctObj := objects[5]
+	svcAcctObj := objects[6]
 	svcAcct, ok := svcAcctObj.(*corev1.ServiceAccount)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svcAcct, deploymentNSName.Name)
 
-	cmObj := objects[6]
+	cmObj := objects[7]
 	cm, ok := cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxIncludesConfigMapNameSuffix))
 
-	cmObj = objects[7]
+	cmObj = objects[8]
 	cm, ok = cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxAgentConfigMapNameSuffix))
 
-	secretObj := objects[8]
+	secretObj := objects[9]
 	secret, ok := secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1692,7 +1842,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.AgentTLSSecretName,
 	))
 
-	secretObj = objects[9]
+	secretObj = objects[10]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1700,7 +1850,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.NginxDockerSecretNames[0],
 	))
 
-	secretObj = objects[10]
+	secretObj = objects[11]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1708,7 +1858,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.PlusUsageConfig.CASecretName,
 	))
 
-	secretObj = objects[11]
+	secretObj = objects[12]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -2241,6 +2391,242 @@ func TestBuildNginxConfigMaps_AgentConfigUsesCustomServerTLSDomain(t *testing.T)
 	g.Expect(data).To(ContainSubstring("server_name: test-service.default.internal.mycompany.com"))
 }
 
+func TestBuildNginxConfigMaps_AgentConfigOTLPMetricsExporter(t *testing.T) {
+	t.Parallel()
+
+	exporter := &ngfAPIv1alpha2.MetricsExporter{
+		Endpoint:     "collector.monitoring.svc:4317",
+		BatchTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("30"),
+		TLS: &ngfAPIv1alpha2.ExporterTLS{
+			CACertificateRef:     &ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-ca"},
+			ClientCertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-client"},
+			ServerName:           helpers.GetPointer("collector.example.com"),
+		},
+		Headers: []ngfAPIv1alpha2.ExporterHeader{
+			{
+				Name:      "Authorization",
+				SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-auth"},
+			},
+		},
+	}
+
+	tests := []struct {
+		nProxyCfg     *graph.EffectiveNginxProxy
+		name          string
+		expStrings    []string
+		expNotStrings []string
+		// expLogsPipelines are the names of the rendered logs pipelines.
+		expLogsPipelines []string
+	}{
+		{
+			name: "exporter with TLS, headers and Prometheus metrics",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Telemetry: &ngfAPIv1alpha2.Telemetry{MetricsExporter: exporter},
+			},
+			expStrings: []string{
+				"- metrics",
+				"prometheus:",
+				"otlp:\n            \"ngf\":\n                server:\n                    host: collector.monitoring.svc\n" +
+					"                    port: 4317",
+				"tls:\n                    skip_verify: false\n" +
+					"                    ca: /var/run/secrets/ngf-otlp-metrics/ca.crt\n" +
+					"                    cert: /var/run/secrets/ngf-otlp-metrics/tls.crt\n" +
+					"                    key: /var/run/secrets/ngf-otlp-metrics/tls.key\n" +
+					"                    server_name: collector.example.com",
+				"authenticator: headers_setter",
+				"- action: insert\n              key: Authorization\n" +
+					"              file_path: /var/run/secrets/ngf-otlp-metrics/headers/Authorization",
+				"batch:\n            \"ngf-otlp\":\n                timeout: 30s",
+				"\"default\":\n                receivers: [\"host_metrics\", \"nginx_metrics\"]\n" +
+					"                exporters: [\"prometheus\"]",
+				"\"ngf-otlp\":\n                receivers: [\"host_metrics\", \"nginx_metrics\"]\n" +
+					"                processors: [\"batch/ngf-otlp\"]\n                exporters: [\"otlp/ngf\"]",
+			},
+		},
+		{
+			name: "exporter without Prometheus metrics",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Metrics: &ngfAPIv1alpha2.Metrics{Disable: helpers.GetPointer(true)},
+				Telemetry: &ngfAPIv1alpha2.Telemetry{
+					MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{Endpoint: "collector:4317"},
+				},
+			},
+			expStrings: []string{
+				"- metrics",
+				"host: collector\n                    port: 4317",
+				"\"ngf-otlp\":\n                receivers: [\"host_metrics\", \"nginx_metrics\"]\n" +
+					"                exporters: [\"otlp/ngf\"]",
+			},
+			expNotStrings: []string{
+				"prometheus",
+				"tls:\n                    skip_verify",
+				"headers_setter",
+				"processors",
+				"logs:",
+			},
+		},
+		{
+			name: "exporter with WAF exports the WAF security logs",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				WAF: &ngfAPIv1alpha2.WAFSpec{Enable: helpers.GetPointer(true)},
+				Telemetry: &ngfAPIv1alpha2.Telemetry{
+					MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{Endpoint: "collector:4317"},
+				},
+			},
+			expStrings: []string{
+				"- logs-nap",
+				"logs:\n            \"default\":\n                receivers: [\"tcplog/nginx_app_protect\"]\n" +
+					"                processors: [\"securityviolationsfilter/default\", \"batch/default_logs\"]\n" +
+					"                exporters: [\"otlp/ngf\"]",
+			},
+			// The pipeline replaces the default logs pipeline of NGINX Agent rather than being added next to it.
+			expLogsPipelines: []string{"default"},
+		},
+		{
+			name: "WAF without exporter",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				WAF: &ngfAPIv1alpha2.WAFSpec{Enable: helpers.GetPointer(true)},
+			},
+			expStrings: []string{
+				"- logs-nap",
+			},
+			expNotStrings: []string{
+				"logs:",
+			},
+		},
+		{
+			name: "no exporter and no Prometheus metrics",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Metrics: &ngfAPIv1alpha2.Metrics{Disable: helpers.GetPointer(true)},
+			},
+			expNotStrings: []string{
+				"- metrics",
+				"collector:",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			provisioner := &NginxProvisioner{
+				k8sClient: createFakeClientWithScheme(),
+				cfg: Config{
+					GatewayPodConfig: &config.GatewayPodConfig{
+						Namespace:   "default",
+						ServiceName: "test-service",
+					},
+					AgentLabels:     make(map[string]string),
+					ServerTLSDomain: "svc",
+				},
+			}
+			objectMeta := metav1.ObjectMeta{Name: "test", Namespace: "default"}
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+			}
+
+			names := provisioner.buildResourceNames("gw-nginx")
+			configMaps, errs := provisioner.buildNginxConfigMaps(objectMeta, test.nProxyCfg, names, gateway)
+			g.Expect(errs).To(BeNil())
+
+			agentCM, ok := configMaps[1].(*corev1.ConfigMap)
+			g.Expect(ok).To(BeTrue())
+			data := agentCM.Data[configmaps.AgentConfKey]
+
+			for _, str := range test.expStrings {
+				g.Expect(data).To(ContainSubstring(str))
+			}
+			for _, str := range test.expNotStrings {
+				g.Expect(data).ToNot(ContainSubstring(str))
+			}
+
+			var agentConfig struct {
+				Collector struct {
+					Pipelines struct {
+						Logs map[string]any `json:"logs"`
+					} `json:"pipelines"`
+				} `json:"collector"`
+			}
+			g.Expect(yaml.Unmarshal([]byte(data), &agentConfig)).To(Succeed())
+			g.Expect(slices.Collect(maps.Keys(agentConfig.Collector.Pipelines.Logs))).To(ConsistOf(test.expLogsPipelines))
+		})
+	}
+}
+
+func TestConfigureOTLPMetricsSecrets(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	spec := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "nginx"}},
+		},
+	}
+
+	configureOTLPMetricsSecrets(spec, nil)
+	g.Expect(spec.Spec.Volumes).To(BeEmpty())
+
+	configureOTLPMetricsSecrets(spec, &ngfAPIv1alpha2.MetricsExporter{Endpoint: "collector:4317"})
+	g.Expect(spec.Spec.Volumes).To(BeEmpty())
+	g.Expect(spec.Spec.Containers[0].VolumeMounts).To(BeEmpty())
+
+	configureOTLPMetricsSecrets(spec, &ngfAPIv1alpha2.MetricsExporter{
+		Endpoint: "collector:4317",
+		TLS: &ngfAPIv1alpha2.ExporterTLS{
+			CACertificateRef:     &ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-ca"},
+			ClientCertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-client"},
+		},
+		Headers: []ngfAPIv1alpha2.ExporterHeader{
+			{
+				Name:      "Authorization",
+				SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: "otlp-auth"},
+			},
+		},
+	})
+
+	g.Expect(spec.Spec.Containers[0].VolumeMounts).To(Equal([]corev1.VolumeMount{
+		{
+			Name:      "nginx-agent-otlp-metrics",
+			MountPath: "/var/run/secrets/ngf-otlp-metrics",
+			ReadOnly:  true,
+		},
+	}))
+	g.Expect(spec.Spec.Volumes).To(Equal([]corev1.Volume{
+		{
+			Name: "nginx-agent-otlp-metrics",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							Secret: &corev1.SecretProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-ca"},
+								Items:                []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+							},
+						},
+						{
+							Secret: &corev1.SecretProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-client"},
+								Items: []corev1.KeyToPath{
+									{Key: "tls.crt", Path: "tls.crt"},
+									{Key: "tls.key", Path: "tls.key"},
+								},
+							},
+						},
+						{
+							Secret: &corev1.SecretProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-auth"},
+								Items:                []corev1.KeyToPath{{Key: secrets.HeaderValueKey, Path: "headers/Authorization"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}))
+}
+
 func TestBuildReadinessProbe(t *testing.T) {
 	t.Parallel()
 
@@ -2476,6 +2862,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2527,6 +2914,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2564,6 +2952,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2611,6 +3000,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("failed to apply service patches"))
@@ -2639,6 +3029,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("unsupported patch type"))
@@ -2670,6 +3061,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2716,6 +3108,7 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2802,6 +3195,7 @@ func TestBuildNginxResourceObjects_InferenceExtension(t *testing.T) {
 		npCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -2820,6 +3214,10 @@ func TestBuildNginxResourceObjects_InferenceExtension(t *testing.T) {
 	g.Expect(containers).To(HaveLen(2))
 	g.Expect(containers[1].Name).To(Equal("endpoint-picker-shim"))
 	g.Expect(containers[1].Command).To(Equal(expectedCommands))
+	g.Expect(containers[1].Ports).To(ConsistOf(corev1.ContainerPort{
+		Name:          "shim-metrics",
+		ContainerPort: ngftypes.GoShimMetricsPort,
+	}))
 	g.Expect(containers[1].Resources.Limits).To(HaveKeyWithValue(corev1.ResourceCPU, resource.MustParse("500m")))
 }
 
@@ -2937,6 +3335,7 @@ func TestOwnerReferencesAreSet(t *testing.T) {
 		elbWithGatewayLink(&ngfAPIv1alpha1.GatewayLinkConfig{
 			VirtualServerAddress: helpers.GetPointer("10.0.0.1"),
 		}),
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).ToNot(BeEmpty())
@@ -3062,6 +3461,7 @@ func TestBuildNginxResourceObjects_LoadBalancerClass(t *testing.T) {
 				test.nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -3179,6 +3579,7 @@ func TestBuildNginxResourceObjects_WAF(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -673,6 +673,7 @@ func (p *NginxProvisioner) reprovisionNginx(
 	nProxyCfg *graph.EffectiveNginxProxy,
 	allListeners []*graph.Listener,
 	elb *ngfAPIv1alpha1.ExternalLoadBalancer,
+	zoneSync bool,
 ) error {
 	if !p.isLeader() {
 		return nil
@@ -680,7 +681,7 @@ func (p *NginxProvisioner) reprovisionNginx(
 	if len(allListeners) == 0 {
 		return nil
 	}
-	objects, err := p.buildNginxResourceObjects(resourceName, gateway, nProxyCfg, allListeners, elb)
+	objects, err := p.buildNginxResourceObjects(resourceName, gateway, nProxyCfg, allListeners, elb, zoneSync)
 	if err != nil {
 		p.cfg.Logger.Error(err, "error provisioning some nginx resources")
 	}
@@ -833,6 +834,7 @@ func (p *NginxProvisioner) RegisterGateway(
 			gateway.EffectiveNginxProxy,
 			gateway.Listeners,
 			extractExternalLoadBalancer(gateway),
+			gateway.ZoneSync,
 		)
 		if err != nil {
 			p.cfg.Logger.Error(err, "error building some nginx resources")
@@ -880,6 +882,12 @@ func (p *NginxProvisioner) handleObjectDeletion(ctx context.Context, nginxResour
 		}
 	}
 
+	if p.needToDeleteZoneSyncService(nginxResources) {
+		if err := p.deleteObject(ctx, &corev1.Service{ObjectMeta: nginxResources.ZoneSyncService}); err != nil {
+			p.cfg.Logger.Error(err, "error deleting nginx resource")
+		}
+	}
+
 	if p.needToDeleteIngressLink(nginxResources) {
 		il := &unstructured.Unstructured{}
 		il.SetGroupVersionKind(kinds.IngressLinkGVK)
@@ -1091,3 +1099,10 @@ func (p *NginxProvisioner) needToDeleteIngressLink(cfg *NginxResources) bool {
 		cfg.ExternalLoadBalancer.Name != "" &&
 		extractExternalLoadBalancer(cfg.Gateway) == nil
 }
+
+// needToDeleteZoneSyncService returns true if a zone sync Service was previously provisioned for this Gateway
+// but its nginx replicas no longer need to synchronize their zones.
+func (p *NginxProvisioner) needToDeleteZoneSyncService(cfg *NginxResources) bool {
+	return cfg.ZoneSyncService.Name != "" &&
+		(!p.cfg.Plus || cfg.Gateway == nil || !cfg.Gateway.ZoneSync)
+}
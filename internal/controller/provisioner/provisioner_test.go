@@ -791,6 +791,58 @@ func TestNeedToDeleteIngressLink(t *testing.T) {
 	}
 }
 
+func TestNeedToDeleteZoneSyncService(t *testing.T) {
+	t.Parallel()
+
+	trackedService := metav1.ObjectMeta{Name: "gw-nginx-zone-sync", Namespace: "default"}
+
+	tests := []struct {
+		gateway        *graph.Gateway
+		name           string
+		trackedService metav1.ObjectMeta
+		plus           bool
+		expected       bool
+	}{
+		{
+			name:     "no zone sync Service was previously provisioned",
+			gateway:  &graph.Gateway{},
+			plus:     true,
+			expected: false,
+		},
+		{
+			name:           "zone sync still needed",
+			trackedService: trackedService,
+			gateway:        &graph.Gateway{ZoneSync: true},
+			plus:           true,
+			expected:       false,
+		},
+		{
+			name:           "zone sync no longer needed",
+			trackedService: trackedService,
+			gateway:        &graph.Gateway{},
+			plus:           true,
+			expected:       true,
+		},
+		{
+			name:           "zone sync Service provisioned but not running NGINX Plus",
+			trackedService: trackedService,
+			gateway:        &graph.Gateway{ZoneSync: true},
+			expected:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			p := &NginxProvisioner{cfg: Config{Plus: test.plus}}
+			cfg := &NginxResources{Gateway: test.gateway, ZoneSyncService: test.trackedService}
+			g.Expect(p.needToDeleteZoneSyncService(cfg)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestRegisterGateway_EmptyListeners(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -825,7 +877,7 @@ func TestNonLeaderProvisioner(t *testing.T) {
 	g.Expect(provisioner.provisionNginx(t.Context(), "gw-nginx", nil, nil)).To(Succeed())
 	expectResourcesToNotExist(t, g, fakeClient, nsName)
 
-	g.Expect(provisioner.reprovisionNginx(t.Context(), "gw-nginx", nil, nil, nil, nil)).To(Succeed())
+	g.Expect(provisioner.reprovisionNginx(t.Context(), "gw-nginx", nil, nil, nil, nil, false)).To(Succeed())
 	expectResourcesToNotExist(t, g, fakeClient, nsName)
 
 	g.Expect(provisioner.deprovisionNginxForInvalidGateway(t.Context(), nsName)).To(Succeed())
@@ -17,6 +17,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
 
@@ -29,6 +30,7 @@ type NginxResources struct {
 	DaemonSet            metav1.ObjectMeta
 	Service              metav1.ObjectMeta
 	ServiceLBClass       *string
+	ZoneSyncService      metav1.ObjectMeta
 	ServiceAccount       metav1.ObjectMeta
 	Role                 metav1.ObjectMeta
 	RoleBinding          metav1.ObjectMeta
@@ -151,6 +153,10 @@ func (s *store) registerResourceInGatewayConfig(gatewayNSName types.NamespacedNa
 		s.getOrCreateNginxResources(gatewayNSName).DaemonSet = obj.ObjectMeta
 	case *corev1.Service:
 		res := s.getOrCreateNginxResources(gatewayNSName)
+		if controller.IsZoneSyncService(obj) {
+			res.ZoneSyncService = obj.ObjectMeta
+			break
+		}
 		res.Service = obj.ObjectMeta
 		res.ServiceLBClass = obj.Spec.LoadBalancerClass
 	case *corev1.ServiceAccount:
@@ -288,6 +294,10 @@ func gatewayChanged(original, updated *graph.Gateway) bool {
 		return true
 	}
 
+	if original.ZoneSync != updated.ZoneSync {
+		return true
+	}
+
 	// The IngressLink is built from an ExternalLoadBalancer resource attached to the Gateway,
 	// so a change to the attached gatewayLink config must trigger a rebuild.
 	if !reflect.DeepEqual(extractExternalLoadBalancer(original), extractExternalLoadBalancer(updated)) {
@@ -389,7 +399,7 @@ func (r *NginxResources) matchesObject(object client.Object, nsName types.Namesp
 	case *appsv1.DaemonSet:
 		return resourceMatches(r.DaemonSet, nsName)
 	case *corev1.Service:
-		return resourceMatches(r.Service, nsName)
+		return resourceMatches(r.Service, nsName) || resourceMatches(r.ZoneSyncService, nsName)
 	case *corev1.ServiceAccount:
 		return resourceMatches(r.ServiceAccount, nsName)
 	case *rbacv1.Role:
@@ -456,6 +466,9 @@ func (s *store) getResourceVersionForObject(gatewayNSName types.NamespacedName,
 	case *appsv1.DaemonSet:
 		return resourceVersionIfNameMatches(resources.DaemonSet, obj.GetName())
 	case *corev1.Service:
+		if controller.IsZoneSyncService(obj) {
+			return resourceVersionIfNameMatches(resources.ZoneSyncService, obj.GetName())
+		}
 		return resourceVersionIfNameMatches(resources.Service, obj.GetName())
 	case *corev1.ServiceAccount:
 		return resourceVersionIfNameMatches(resources.ServiceAccount, obj.GetName())
@@ -184,6 +184,28 @@ func TestRegisterResourceInGatewayConfig(t *testing.T) {
 	resources = registerAndGetResources(svc)
 	g.Expect(resources.Service).To(Equal(defaultMeta))
 
+	// Zone sync Service
+	zoneSyncMeta := metav1.ObjectMeta{
+		Name:      controller.CreateZoneSyncServiceName(defaultMeta.Name),
+		Namespace: "default",
+		Labels:    map[string]string{controller.ZoneSyncLabel: "true"},
+	}
+	resources = registerAndGetResources(&corev1.Service{ObjectMeta: zoneSyncMeta})
+	g.Expect(resources.ZoneSyncService).To(Equal(zoneSyncMeta))
+	g.Expect(resources.Service).To(Equal(defaultMeta))
+
+	// clear out resources before next test
+	store.deleteResourcesForGateway(nsName)
+
+	// Service with the zone sync suffix, but without the zone sync label
+	suffixMeta := metav1.ObjectMeta{
+		Name:      controller.CreateZoneSyncServiceName(defaultMeta.Name),
+		Namespace: "default",
+	}
+	resources = registerAndGetResources(&corev1.Service{ObjectMeta: suffixMeta})
+	g.Expect(resources.Service).To(Equal(suffixMeta))
+	g.Expect(resources.ZoneSyncService).To(Equal(metav1.ObjectMeta{}))
+
 	// clear out resources before next test
 	store.deleteResourcesForGateway(nsName)
 
@@ -421,6 +443,12 @@ func TestGatewayChanged(t *testing.T) {
 			updated:  &graph.Gateway{Valid: false},
 			changed:  true,
 		},
+		{
+			name:     "zone sync changes",
+			original: &graph.Gateway{ZoneSync: false},
+			updated:  &graph.Gateway{ZoneSync: true},
+			changed:  true,
+		},
 		{
 			name: "source changes",
 			original: &graph.Gateway{Source: &gatewayv1.Gateway{
@@ -58,7 +58,7 @@ allowed_directories:
 features:
 - configuration
 - certificates
-{{- if .EnableMetrics }}
+{{- if or .EnableMetrics .OTLPMetrics }}
 - metrics
 {{- end }}
 {{- if eq true .WafEnabled }}
@@ -87,17 +87,58 @@ auxiliary_command:
     tls:
         skip_verify: {{ .EndpointTLSSkipVerify }}
 {{- end }}
-{{- if .EnableMetrics }}
+{{- if or .EnableMetrics .OTLPMetrics }}
 collector:
     log:
        path: "stdout"
     exporters:
+{{- if .EnableMetrics }}
         prometheus:
             server:
                 host: "0.0.0.0"
                 port: {{ .MetricsPort }}
+{{- end }}
+{{- with .OTLPMetrics }}
+        otlp:
+            "ngf":
+                server:
+                    host: {{ .Host }}
+                    port: {{ .Port }}
+{{- if .TLS }}
+                tls:
+                    skip_verify: false
+{{- if .CACert }}
+                    ca: {{ .CACert }}
+{{- end }}
+{{- if .ClientCert }}
+                    cert: {{ .ClientCert }}
+                    key: {{ .ClientKey }}
+{{- end }}
+{{- if .ServerName }}
+                    server_name: {{ .ServerName }}
+{{- end }}
+{{- end }}
+{{- if .Headers }}
+                authenticator: headers_setter
+    extensions:
+        headers_setter:
+            headers:
+{{- range $h := .Headers }}
+            - action: insert
+              key: {{ $h.Key }}
+              file_path: {{ $h.FilePath }}
+{{- end }}
+{{- end }}
+{{- if .BatchTimeout }}
+    processors:
+        batch:
+            "ngf-otlp":
+                timeout: {{ .BatchTimeout }}
+{{- end }}
+{{- end }}
     pipelines:
         metrics:
+{{- if .EnableMetrics }}
 {{- if .NginxOneReporting }}
             "ngf":
 {{- else }}
@@ -106,4 +147,20 @@ collector:
                 receivers: ["host_metrics", "nginx_metrics"]
                 exporters: ["prometheus"]
 {{- end }}
+{{- with .OTLPMetrics }}
+            "ngf-otlp":
+                receivers: ["host_metrics", "nginx_metrics"]
+{{- if .BatchTimeout }}
+                processors: ["batch/ngf-otlp"]
+{{- end }}
+                exporters: ["otlp/ngf"]
+{{- end }}
+{{- if and .WafEnabled .OTLPMetrics }}
+        logs:
+            "default":
+                receivers: ["tcplog/nginx_app_protect"]
+                processors: ["securityviolationsfilter/default", "batch/default_logs"]
+                exporters: ["otlp/ngf"]
+{{- end }}
+{{- end }}
 `
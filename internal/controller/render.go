@@ -0,0 +1,581 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8sEvents "k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	ngxcfg "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	ngxvalidation "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// RenderConfig holds the configuration for rendering NGINX configuration from manifests.
+type RenderConfig struct {
+	// Logger is the logger.
+	Logger logr.Logger
+	// Out is where the conditions of the resources are written.
+	Out io.Writer
+	// OutputDir is the directory where the generated NGINX configuration files are written.
+	// The files of every Gateway are written to the <OutputDir>/<namespace>/<name> directory.
+	OutputDir string
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass resource.
+	GatewayClassName string
+	// Files are the manifest files or directories containing manifest files.
+	Files []string
+	// Plus indicates whether NGINX Plus is being used.
+	Plus bool
+	// ExperimentalFeatures indicates if experimental features are enabled.
+	ExperimentalFeatures bool
+	// Snippets indicates if Snippets are enabled.
+	Snippets bool
+}
+
+// Render builds the NGINX configuration for the Gateways in the manifests of the RenderConfig without
+// a Kubernetes cluster. The manifests are processed the same way the controller processes the resources in a
+// cluster, with Service endpoints resolved from the EndpointSlices in the manifests. The generated files are written
+// to the output directory, and the conditions that each resource would get are written to the output writer.
+func Render(ctx context.Context, cfg RenderConfig) error {
+	objects, err := readManifests(cfg.Logger, cfg.Files)
+	if err != nil {
+		return err
+	}
+
+	if !hasGatewayClass(objects, cfg.GatewayClassName) {
+		objects = append(objects, &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: cfg.GatewayClassName},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: gatewayv1.GatewayController(cfg.GatewayCtlrName),
+			},
+		})
+	}
+
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+	genericValidator := ngxvalidation.GenericValidator{}
+	policyManager := createPolicyManager(
+		mustExtractGVK,
+		genericValidator,
+		config.Config{Plus: cfg.Plus, Snippets: cfg.Snippets},
+	)
+
+	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+		GatewayCtlrName:  cfg.GatewayCtlrName,
+		GatewayClassName: cfg.GatewayClassName,
+		Logger:           cfg.Logger.WithName("changeProcessor"),
+		Validators: validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    genericValidator,
+			AuthFieldsValidator: ngxvalidation.AuthFieldValidator{},
+			PolicyValidator:     policyManager,
+		},
+		EventRecorder:  &k8sEvents.FakeRecorder{},
+		MustExtractGVK: mustExtractGVK,
+		WAFFetcher:     createWAFFetcher(cfg.Logger.WithName("wafFetcher")),
+		FeatureFlags: graph.FeatureFlags{
+			Plus:         cfg.Plus,
+			Experimental: cfg.ExperimentalFeatures,
+		},
+		Snippets: cfg.Snippets,
+	})
+
+	endpointSlices := make([]client.Object, 0)
+	for _, obj := range objects {
+		if !isRenderable(obj, cfg.Snippets) {
+			cfg.Logger.Info(
+				"Skipping unsupported resource",
+				"kind", obj.GetObjectKind().GroupVersionKind().Kind,
+				"resource", client.ObjectKeyFromObject(obj),
+			)
+			continue
+		}
+
+		if _, ok := obj.(*discoveryV1.EndpointSlice); ok {
+			endpointSlices = append(endpointSlices, obj)
+		}
+
+		processor.CaptureUpsertChange(obj)
+	}
+
+	gr := processor.Process(ctx)
+	if gr == nil {
+		return errors.New("no resources to render")
+	}
+
+	// the EndpointSlices are served by a fake client, so that Services are resolved the same way as in a cluster
+	serviceResolver := resolver.NewServiceResolverImpl(
+		fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(endpointSlices...).
+			WithIndex(&discoveryV1.EndpointSlice{}, index.KubernetesServiceNameIndexField, index.ServiceNameIndexFunc).
+			Build(),
+	)
+
+	generator := ngxcfg.NewGeneratorImpl(
+		cfg.Plus,
+		&config.UsageReportConfig{},
+		config.ClusterDNSConfig{},
+		cfg.Logger.WithName("generator"),
+	)
+
+	for nsName, gw := range gr.Gateways {
+		if !gw.Valid {
+			continue
+		}
+
+		conf := dataplane.BuildConfiguration(
+			ctx,
+			cfg.Logger,
+			gr,
+			gw,
+			serviceResolver,
+			cfg.Plus,
+			ngfAPIv1alpha2.Dual,
+		)
+
+		if err := writeRenderedFiles(cfg.OutputDir, nsName, generator.Generate(conf)); err != nil {
+			return err
+		}
+	}
+
+	return writeConditions(cfg.Out, objects, prepareRenderStatusRequests(gr, cfg.GatewayCtlrName), mustExtractGVK)
+}
+
+// readManifests decodes the resources from the manifest files. Directories are read non-recursively and only
+// files with the .yaml, .yml, or .json extension are decoded. Resources of unknown kinds are skipped.
+func readManifests(logger logr.Logger, paths []string) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var objects []client.Object
+
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			fileObjects, err := readManifestFile(logger, decoder, file)
+			if err != nil {
+				return nil, fmt.Errorf("error reading manifest %q: %w", file, err)
+			}
+
+			objects = append(objects, fileObjects...)
+		}
+	}
+
+	return objects, nil
+}
+
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func readManifestFile(logger logr.Logger, decoder runtime.Decoder, path string) ([]client.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+
+	var objects []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(strings.TrimSpace(string(doc))) == 0 {
+			continue
+		}
+
+		runtimeObj, gvk, err := decoder.Decode(doc, nil, nil)
+		if runtime.IsNotRegisteredError(err) {
+			logger.Info("Skipping resource of unknown kind", "file", path, "error", err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		obj, ok := runtimeObj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unsupported resource %s", gvk)
+		}
+
+		obj.GetObjectKind().SetGroupVersionKind(*gvk)
+		if obj.GetNamespace() == "" && !isClusterScoped(obj) {
+			obj.SetNamespace(metav1.NamespaceDefault)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+func isClusterScoped(obj client.Object) bool {
+	switch obj.(type) {
+	case *gatewayv1.GatewayClass, *apiv1.Namespace:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRenderable returns true if the resource is processed by the ChangeProcessor.
+func isRenderable(obj client.Object, snippets bool) bool {
+	switch obj.(type) {
+	case *gatewayv1.GatewayClass,
+		*gatewayv1.Gateway,
+		*gatewayv1.ListenerSet,
+		*gatewayv1.HTTPRoute,
+		*gatewayv1.GRPCRoute,
+		*gatewayv1.TLSRoute,
+		*gatewayv1.TCPRoute,
+		*gatewayv1.UDPRoute,
+		*gatewayv1.BackendTLSPolicy,
+		*gatewayv1.ReferenceGrant,
+		*apiv1.Namespace,
+		*apiv1.Service,
+		*apiv1.Secret,
+		*apiv1.ConfigMap,
+		*discoveryV1.EndpointSlice,
+		*inference.InferencePool,
+		*ngfAPIv1alpha2.NginxProxy,
+		*ngfAPIv1alpha2.ObservabilityPolicy,
+		*ngfAPIv1alpha1.ClientSettingsPolicy,
+		*ngfAPIv1alpha1.UpstreamSettingsPolicy,
+		*ngfAPIv1alpha1.HealthCheckPolicy,
+		*ngfAPIv1alpha1.ProxySettingsPolicy,
+		*ngfAPIv1alpha1.RateLimitPolicy,
+		*ngfAPIv1alpha1.ConnectionLimitPolicy,
+		*ngfAPIv1alpha1.AccessControlPolicy,
+		*ngfAPIv1alpha1.CachePolicy,
+		*ngfAPIv1alpha1.WAFPolicy,
+		*ngfAPIv1alpha1.SnippetsFilter,
+		*ngfAPIv1alpha1.AuthenticationFilter,
+		*ngfAPIv1alpha1.CanaryFilter,
+		*ngfAPIv1alpha1.MirrorFilter,
+		*ngfAPIv1alpha1.ExternalLoadBalancer:
+		return true
+	case *ngfAPIv1alpha1.SnippetsPolicy:
+		return snippets
+	default:
+		return false
+	}
+}
+
+func hasGatewayClass(objects []client.Object, name string) bool {
+	for _, obj := range objects {
+		if gc, ok := obj.(*gatewayv1.GatewayClass); ok && gc.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeRenderedFiles writes the generated files of a Gateway to the <outputDir>/<namespace>/<name> directory.
+func writeRenderedFiles(outputDir string, gwNsName types.NamespacedName, files []agent.File) error {
+	gwDir := filepath.Join(outputDir, gwNsName.Namespace, gwNsName.Name)
+
+	for _, file := range files {
+		path := filepath.Join(gwDir, filepath.FromSlash(file.Meta.GetName()))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return fmt.Errorf("error creating directory for %q: %w", path, err)
+		}
+
+		// files can contain Secret data, so only the owner can read them
+		if err := os.WriteFile(path, file.Contents, 0o600); err != nil {
+			return fmt.Errorf("error writing %q: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// prepareRenderStatusRequests prepares the status updates of the resources in the graph. Unlike the status updates
+// of the controller, the Gateways have no addresses and the NGINX configuration is assumed to be applied.
+func prepareRenderStatusRequests(gr *graph.Graph, gatewayCtlrName string) []status.UpdateRequest {
+	transitionTime := metav1.Now()
+
+	var reqs []status.UpdateRequest
+	reqs = append(reqs, status.PrepareGatewayClassRequests(gr.GatewayClass, gr.IgnoredGatewayClasses, transitionTime)...)
+	for _, gw := range gr.Gateways {
+		reqs = append(reqs, status.PrepareGatewayRequests(gw, transitionTime, nil, graph.NginxReloadResult{})...)
+	}
+	reqs = append(reqs, status.PrepareRouteRequests(gr.L4Routes, gr.Routes, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareBackendTLSPolicyRequests(gr.BackendTLSPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareSnippetsFilterRequests(gr.SnippetsFilters, transitionTime, gatewayCtlrName)...)
+	reqs = append(
+		reqs,
+		status.PrepareAuthenticationFilterRequests(gr.AuthenticationFilters, transitionTime, gatewayCtlrName)...,
+	)
+	reqs = append(reqs, status.PrepareCanaryFilterRequests(gr.CanaryFilters, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareMirrorFilterRequests(gr.MirrorFilters, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareListenerSetRequests(gr.ListenerSets, transitionTime)...)
+	reqs = append(
+		reqs,
+		status.PrepareExternalLoadBalancerRequests(gr.ExternalLoadBalancers, transitionTime, gatewayCtlrName)...,
+	)
+	reqs = append(
+		reqs,
+		status.PrepareInferencePoolRequests(
+			gr.ReferencedInferencePools,
+			&inference.InferencePoolList{},
+			gr.Gateways,
+			transitionTime,
+		)...,
+	)
+
+	return reqs
+}
+
+// renderedStatus is the status that a resource would get.
+type renderedStatus struct {
+	kind       string
+	nsName     types.NamespacedName
+	conditions []renderedCondition
+}
+
+// renderedCondition is a condition at a path in the status of a resource.
+type renderedCondition struct {
+	path      string
+	condition metav1.Condition
+}
+
+// writeConditions applies the status update requests to the matching resources from the manifests and writes
+// their conditions.
+func writeConditions(
+	out io.Writer,
+	objects []client.Object,
+	reqs []status.UpdateRequest,
+	mustExtractGVK kinds.MustExtractGVK,
+) error {
+	type objectKey struct {
+		objType reflect.Type
+		nsName  types.NamespacedName
+	}
+
+	objectsByKey := make(map[objectKey]client.Object, len(objects))
+	for _, obj := range objects {
+		objectsByKey[objectKey{objType: reflect.TypeOf(obj), nsName: client.ObjectKeyFromObject(obj)}] = obj
+	}
+
+	statuses := make([]renderedStatus, 0, len(reqs))
+	for _, req := range reqs {
+		obj, ok := objectsByKey[objectKey{objType: reflect.TypeOf(req.ResourceType), nsName: req.NsName}]
+		if !ok {
+			continue
+		}
+
+		obj, ok = obj.DeepCopyObject().(client.Object)
+		if !ok {
+			continue
+		}
+		req.Setter(obj)
+
+		conds, err := collectConditions(obj)
+		if err != nil {
+			return err
+		}
+
+		statuses = append(statuses, renderedStatus{
+			kind:       mustExtractGVK(obj).Kind,
+			nsName:     req.NsName,
+			conditions: conds,
+		})
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].kind != statuses[j].kind {
+			return statuses[i].kind < statuses[j].kind
+		}
+		return statuses[i].nsName.String() < statuses[j].nsName.String()
+	})
+
+	for _, s := range statuses {
+		name := s.nsName.String()
+		if s.nsName.Namespace == "" {
+			name = s.nsName.Name
+		}
+
+		if _, err := fmt.Fprintf(out, "%s %s:\n", s.kind, name); err != nil {
+			return err
+		}
+
+		for _, c := range s.conditions {
+			if _, err := fmt.Fprintf(
+				out,
+				"  %s: %s=%s (%s): %s\n",
+				c.path,
+				c.condition.Type,
+				c.condition.Status,
+				c.condition.Reason,
+				c.condition.Message,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectConditions returns all conditions in the status of the resource along with their path.
+func collectConditions(obj client.Object) ([]renderedCondition, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting %s to unstructured: %w", client.ObjectKeyFromObject(obj), err)
+	}
+
+	var conds []renderedCondition
+	collectConditionsAtPath(content["status"], "status", &conds)
+
+	return conds, nil
+}
+
+func collectConditionsAtPath(value any, path string, conds *[]renderedCondition) {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if key == "conditions" {
+				*conds = append(*conds, toRenderedConditions(v[key], path)...)
+				continue
+			}
+
+			collectConditionsAtPath(v[key], path+"."+key, conds)
+		}
+	case []any:
+		for i, item := range v {
+			collectConditionsAtPath(item, fmt.Sprintf("%s[%s]", path, itemLabel(item, i)), conds)
+		}
+	}
+}
+
+// itemLabel returns a label that identifies an item of a status list, such as the name of a listener or the
+// parent of a route. The index of the item is used if the item can't be identified.
+func itemLabel(item any, i int) string {
+	fields, ok := item.(map[string]any)
+	if !ok {
+		return fmt.Sprint(i)
+	}
+
+	if name, ok := fields["name"].(string); ok {
+		return name
+	}
+
+	for _, refField := range []string{"parentRef", "ancestorRef"} {
+		ref, ok := fields[refField].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		label, _ := ref["name"].(string)
+		if ns, ok := ref["namespace"].(string); ok {
+			label = ns + "/" + label
+		}
+		if section, ok := ref["sectionName"].(string); ok {
+			label += "/" + section
+		}
+
+		return label
+	}
+
+	return fmt.Sprint(i)
+}
+
+func toRenderedConditions(value any, path string) []renderedCondition {
+	items, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+
+	conds := make([]renderedCondition, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var cond metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fields, &cond); err != nil {
+			continue
+		}
+
+		conds = append(conds, renderedCondition{path: path, condition: cond})
+	}
+
+	return conds
+}
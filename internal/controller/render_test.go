@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+)
+
+const renderManifests = `apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: test
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+    sectionName: http
+  hostnames:
+  - cafe.example.com
+  rules:
+  - backendRefs:
+    - name: coffee
+      port: 80
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: tea
+  namespace: test
+spec:
+  parentRefs:
+  - name: other-gateway
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-1
+  namespace: test
+  labels:
+    kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: http
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.1
+  conditions:
+    ready: true
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+  namespace: test
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  selector:
+    matchLabels:
+      app: coffee
+  template:
+    metadata:
+      labels:
+        app: coffee
+    spec:
+      containers:
+      - name: coffee
+        image: coffee
+`
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	manifestDir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(manifestDir, "cafe.yaml"), []byte(renderManifests), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(manifestDir, "README.md"), []byte("not a manifest"), 0o600)).To(Succeed())
+
+	outputDir := t.TempDir()
+	var out bytes.Buffer
+
+	err := Render(t.Context(), RenderConfig{
+		Logger:           logr.Discard(),
+		Out:              &out,
+		OutputDir:        outputDir,
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		Files:            []string{manifestDir},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpConf, err := os.ReadFile(filepath.Join(outputDir, "test", "gateway", "etc", "nginx", "conf.d", "http.conf"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(httpConf)).To(ContainSubstring("server_name cafe.example.com;"))
+	g.Expect(string(httpConf)).To(ContainSubstring("upstream test_coffee_80 {"))
+	g.Expect(string(httpConf)).To(ContainSubstring("server 10.0.0.1:8080;"))
+
+	g.Expect(out.String()).To(ContainSubstring("GatewayClass nginx:\n" +
+		"  status: Accepted=True (Accepted): The GatewayClass is accepted\n"))
+	g.Expect(out.String()).To(ContainSubstring("Gateway test/gateway:\n" +
+		"  status: Accepted=True (Accepted): The Gateway is accepted\n"))
+	g.Expect(out.String()).To(ContainSubstring(
+		"  status.listeners[http]: Accepted=True (Accepted): The Listener is accepted\n",
+	))
+	g.Expect(out.String()).To(ContainSubstring("HTTPRoute test/coffee:\n" +
+		"  status.parents[test/gateway/http]: Accepted=True (Accepted): The Route is accepted\n"))
+	g.Expect(out.String()).ToNot(ContainSubstring("HTTPRoute test/tea"))
+	g.Expect(out.String()).ToNot(ContainSubstring("Deployment"))
+	g.Expect(out.String()).ToNot(ContainSubstring("Unknown"))
+}
+
+func TestRender_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		manifests string
+		errString string
+	}{
+		{
+			name:      "invalid manifest",
+			manifests: "kind: Gateway\napiVersion: gateway.networking.k8s.io/v1\nspec: [",
+			errString: "error reading manifest",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			manifest := filepath.Join(t.TempDir(), "manifest.yaml")
+			g.Expect(os.WriteFile(manifest, []byte(test.manifests), 0o600)).To(Succeed())
+
+			err := Render(t.Context(), RenderConfig{
+				Logger:           logr.Discard(),
+				Out:              &bytes.Buffer{},
+				OutputDir:        t.TempDir(),
+				GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+				GatewayClassName: "nginx",
+				Files:            []string{manifest},
+			})
+			g.Expect(err).To(MatchError(ContainSubstring(test.errString)))
+		})
+	}
+
+	g := NewWithT(t)
+	err := Render(t.Context(), RenderConfig{
+		Logger: logr.Discard(),
+		Files:  []string{filepath.Join(t.TempDir(), "missing.yaml")},
+	})
+	g.Expect(err).To(MatchError(os.ErrNotExist))
+}
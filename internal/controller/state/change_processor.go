@@ -131,6 +131,8 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		NGFPolicies:           make(map[graph.PolicyKey]policies.Policy),
 		SnippetsFilters:       make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
 		AuthenticationFilters: make(map[types.NamespacedName]*ngfAPIv1alpha1.AuthenticationFilter),
+		CanaryFilters:         make(map[types.NamespacedName]*ngfAPIv1alpha1.CanaryFilter),
+		MirrorFilters:         make(map[types.NamespacedName]*ngfAPIv1alpha1.MirrorFilter),
 		InferencePools:        make(map[types.NamespacedName]*inference.InferencePool),
 		ListenerSets:          make(map[types.NamespacedName]*v1.ListenerSet),
 		APPolicies:            make(map[types.NamespacedName]*unstructured.Unstructured),
@@ -243,6 +245,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     commonPolicyObjectStore,
 			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.HealthCheckPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ProxySettingsPolicy{}),
 			store:     commonPolicyObjectStore,
@@ -293,11 +300,36 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     newObjectStoreMapAdapter(clusterStore.AuthenticationFilters),
 			predicate: nil, // we always want to write status to AuthenticationFilters so we don't filter them out
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CanaryFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.CanaryFilters),
+			predicate: nil, // we always want to write status to CanaryFilters so we don't filter them out
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.MirrorFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.MirrorFilters),
+			predicate: nil, // we always want to write status to MirrorFilters so we don't filter them out
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.RateLimitPolicy{}),
 			store:     commonPolicyObjectStore,
 			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ConnectionLimitPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.AccessControlPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&v1.ListenerSet{}),
 			store:     newObjectStoreMapAdapter(clusterStore.ListenerSets),
@@ -1197,7 +1197,8 @@ var _ = Describe("ChangeProcessor", func() {
 							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
 						},
 						refTLSSvc: {
-							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
+							GatewayNsNames:   map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
+							L4GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
 						},
 						refGRPCSvc: {
 							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
@@ -1402,7 +1403,8 @@ var _ = Describe("ChangeProcessor", func() {
 							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
 						},
 						refTLSSvc: {
-							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
+							GatewayNsNames:   map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
+							L4GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
 						},
 						refGRPCSvc: {
 							GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: "test", Name: "gateway-1"}: {}},
@@ -2077,6 +2079,7 @@ var _ = Describe("ChangeProcessor", func() {
 					expGraph2.ReferencedServices[refSvc].GatewayNsNames[gw2NSName] = struct{}{}
 					expGraph2.ReferencedServices[refGRPCSvc].GatewayNsNames[gw2NSName] = struct{}{}
 					expGraph2.ReferencedServices[refTLSSvc].GatewayNsNames[gw2NSName] = struct{}{}
+					expGraph2.ReferencedServices[refTLSSvc].L4GatewayNsNames[gw2NSName] = struct{}{}
 
 					processAndValidateGraph(expGraph2)
 				})
@@ -2120,6 +2123,7 @@ var _ = Describe("ChangeProcessor", func() {
 					expGraph2.ReferencedServices[refSvc].GatewayNsNames[gw2NSName] = struct{}{}
 					expGraph2.ReferencedServices[refGRPCSvc].GatewayNsNames[gw2NSName] = struct{}{}
 					expGraph2.ReferencedServices[refTLSSvc].GatewayNsNames[gw2NSName] = struct{}{}
+					expGraph2.ReferencedServices[refTLSSvc].L4GatewayNsNames[gw2NSName] = struct{}{}
 
 					expGraph2.ListenerSets[types.NamespacedName{Namespace: ls2.Namespace, Name: ls2.Name}] = &graph.ListenerSet{
 						Source:  ls2,
@@ -2230,6 +2234,9 @@ var _ = Describe("ChangeProcessor", func() {
 							GatewayNsNames: map[types.NamespacedName]struct{}{
 								{Namespace: "test", Name: "gateway-2"}: {},
 							},
+							L4GatewayNsNames: map[types.NamespacedName]struct{}{
+								{Namespace: "test", Name: "gateway-2"}: {},
+							},
 						},
 						refGRPCSvc: {
 							GatewayNsNames: map[types.NamespacedName]struct{}{
@@ -2346,6 +2353,9 @@ var _ = Describe("ChangeProcessor", func() {
 							GatewayNsNames: map[types.NamespacedName]struct{}{
 								{Namespace: "test", Name: "gateway-2"}: {},
 							},
+							L4GatewayNsNames: map[types.NamespacedName]struct{}{
+								{Namespace: "test", Name: "gateway-2"}: {},
+							},
 						},
 						refGRPCSvc: {
 							GatewayNsNames: map[types.NamespacedName]struct{}{
@@ -2457,6 +2467,9 @@ var _ = Describe("ChangeProcessor", func() {
 							GatewayNsNames: map[types.NamespacedName]struct{}{
 								{Namespace: "test", Name: "gateway-2"}: {},
 							},
+							L4GatewayNsNames: map[types.NamespacedName]struct{}{
+								{Namespace: "test", Name: "gateway-2"}: {},
+							},
 						},
 					}
 					expGraph2.ReferencedSecrets = map[types.NamespacedName]*secrets.Secret{
@@ -3478,11 +3491,13 @@ var _ = Describe("ChangeProcessor", func() {
 						Namespace: "test",
 					},
 					Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-						TargetRefs: []v1.LocalPolicyTargetReference{
+						TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 							{
-								Group: v1.GroupName,
-								Kind:  kinds.Gateway,
-								Name:  "gw",
+								LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+									Group: v1.GroupName,
+									Kind:  kinds.Gateway,
+									Name:  "gw",
+								},
 							},
 						},
 						RateLimit: &ngfAPIv1alpha1.RateLimit{
@@ -3504,11 +3519,13 @@ var _ = Describe("ChangeProcessor", func() {
 						Namespace: "test",
 					},
 					Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-						TargetRefs: []v1.LocalPolicyTargetReference{
+						TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 							{
-								Group: v1.GroupName,
-								Kind:  kinds.Gateway,
-								Name:  "gw",
+								LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+									Group: v1.GroupName,
+									Kind:  kinds.Gateway,
+									Name:  "gw",
+								},
 							},
 						},
 						Buffering: &ngfAPIv1alpha1.ProxyBuffering{
@@ -117,6 +117,10 @@ const (
 	// when telemetry is not enabled in the NginxProxy resource.
 	PolicyMessageTelemetryNotEnabled = "Telemetry is not enabled in the NginxProxy resource"
 
+	// PolicyReasonNginxPlusRequired is used with the "PolicyAccepted" condition when the Policy configures
+	// a feature that is only supported by NGINX Plus, but NGINX Plus is not enabled.
+	PolicyReasonNginxPlusRequired v1.PolicyConditionReason = "NginxPlusRequired"
+
 	// PolicyReasonTargetConflict is used with the "PolicyAccepted" condition when a Route that it targets
 	// has an overlapping hostname:port/path combination with another Route.
 	PolicyReasonTargetConflict v1.PolicyConditionReason = "TargetConflict"
@@ -146,6 +150,10 @@ const (
 	// PolicyReasonIntegrityError is used when a bundle checksum verification fails.
 	PolicyReasonIntegrityError v1.PolicyConditionReason = "IntegrityError"
 
+	// PolicyReasonSignatureVerificationFailed is used when a bundle does not match its detached signature.
+	// Such a bundle is never deployed.
+	PolicyReasonSignatureVerificationFailed v1.PolicyConditionReason = "SignatureVerificationFailed"
+
 	// PolicyReasonStaleBundleWarning is used when a bundle fetch fails but a previously fetched bundle is used.
 	PolicyReasonStaleBundleWarning v1.PolicyConditionReason = "StaleBundleWarning"
 
@@ -153,6 +161,10 @@ const (
 	// pushes the new bundle to the data plane.
 	PolicyReasonBundleUpdated v1.PolicyConditionReason = "BundleUpdated"
 
+	// PolicyReasonBundleRolledBack is used when a changed bundle failed to apply during a staged rollout
+	// and the data plane was reverted to the last bundle that was applied successfully.
+	PolicyReasonBundleRolledBack v1.PolicyConditionReason = "BundleRolledBack"
+
 	// PolicyConditionProgrammed is the GEP-713 "Programmed" condition type. It indicates whether the policy's
 	// spec is guaranteed by the controller to be fully programmed for enforcement in the data plane.
 	// It shares the "Programmed" type value with WAFProgrammedConditionType; the two are kept separate for now
@@ -196,6 +208,18 @@ const (
 	// RateLimitPolicy is applied to a Gateway, HTTPRoute, or GRPCRoute.
 	RateLimitPolicyAffected v1.PolicyConditionType = "RateLimitPolicyAffected"
 
+	// ConnectionLimitPolicyAffected is used with the "PolicyAffected" condition when a
+	// ConnectionLimitPolicy is applied to a Gateway, HTTPRoute, or TCPRoute.
+	ConnectionLimitPolicyAffected v1.PolicyConditionType = "ConnectionLimitPolicyAffected"
+
+	// AccessControlPolicyAffected is used with the "PolicyAffected" condition when an
+	// AccessControlPolicy is applied to a Gateway, HTTPRoute, GRPCRoute, or TCPRoute.
+	AccessControlPolicyAffected v1.PolicyConditionType = "AccessControlPolicyAffected"
+
+	// CachePolicyAffected is used with the "PolicyAffected" condition when a
+	// CachePolicy is applied to an HTTPRoute.
+	CachePolicyAffected v1.PolicyConditionType = "CachePolicyAffected"
+
 	// PolicyAffectedReason is used with the "PolicyAffected" condition when a
 	// custom policy is applied to Gateways or Routes.
 	PolicyAffectedReason v1.PolicyConditionReason = "PolicyAffected"
@@ -1330,6 +1354,17 @@ func NewPolicyNotAcceptedNginxProxyNotSet(msg string) Condition {
 	}
 }
 
+// NewPolicyNotAcceptedNginxPlusRequired returns a Condition that indicates that the Policy is not accepted
+// because it configures a feature that is only supported by NGINX Plus.
+func NewPolicyNotAcceptedNginxPlusRequired(msg string) Condition {
+	return Condition{
+		Type:    string(v1.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonNginxPlusRequired),
+		Message: msg,
+	}
+}
+
 // NewSnippetsFilterInvalid returns a Condition that indicates that the SnippetsFilter is not accepted because it is
 // syntactically or semantically invalid.
 func NewSnippetsFilterInvalid(msg string) Condition {
@@ -1352,6 +1387,50 @@ func NewSnippetsFilterAccepted() Condition {
 	}
 }
 
+// NewCanaryFilterInvalid returns a Condition that indicates that the CanaryFilter is not accepted because it is
+// syntactically or semantically invalid.
+func NewCanaryFilterInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(ngfAPI.CanaryFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.CanaryFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewCanaryFilterAccepted returns a Condition that indicates that the CanaryFilter is accepted because it is
+// valid.
+func NewCanaryFilterAccepted() Condition {
+	return Condition{
+		Type:    string(ngfAPI.CanaryFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.CanaryFilterConditionReasonAccepted),
+		Message: "The CanaryFilter is accepted",
+	}
+}
+
+// NewMirrorFilterInvalid returns a Condition that indicates that the MirrorFilter is not accepted because it is
+// syntactically or semantically invalid.
+func NewMirrorFilterInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(ngfAPI.MirrorFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.MirrorFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewMirrorFilterAccepted returns a Condition that indicates that the MirrorFilter is accepted because it is
+// valid.
+func NewMirrorFilterAccepted() Condition {
+	return Condition{
+		Type:    string(ngfAPI.MirrorFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.MirrorFilterConditionReasonAccepted),
+		Message: "The MirrorFilter is accepted",
+	}
+}
+
 // NewExternalLoadBalancerAccepted returns a Condition that indicates that the ExternalLoadBalancer is accepted
 // and attached to its Gateway.
 func NewExternalLoadBalancerAccepted() Condition {
@@ -1473,6 +1552,39 @@ func NewRateLimitPolicyAffected() Condition {
 	}
 }
 
+// NewConnectionLimitPolicyAffected returns a Condition that indicates that a ConnectionLimitPolicy
+// is applied to the resource.
+func NewConnectionLimitPolicyAffected() Condition {
+	return Condition{
+		Type:    string(ConnectionLimitPolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The ConnectionLimitPolicy is applied to the resource",
+	}
+}
+
+// NewAccessControlPolicyAffected returns a Condition that indicates that an AccessControlPolicy
+// is applied to the resource.
+func NewAccessControlPolicyAffected() Condition {
+	return Condition{
+		Type:    string(AccessControlPolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The AccessControlPolicy is applied to the resource",
+	}
+}
+
+// NewCachePolicyAffected returns a Condition that indicates that a CachePolicy
+// is applied to the resource.
+func NewCachePolicyAffected() Condition {
+	return Condition{
+		Type:    string(CachePolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The CachePolicy is applied to the resource",
+	}
+}
+
 // NewBackendTLSPolicyResolvedRefs returns a Condition that indicates that all CACertificateRefs
 // in the BackendTLSPolicy are resolved.
 func NewBackendTLSPolicyResolvedRefs() Condition {
@@ -1737,6 +1849,31 @@ func NewPolicyNotProgrammedIntegrityError(errMsg string) Condition {
 	}
 }
 
+// NewPolicyNotProgrammedSignatureVerificationFailed returns a Condition that indicates a bundle failed
+// signature verification and no previously fetched bundle is available.
+func NewPolicyNotProgrammedSignatureVerificationFailed(errMsg string) Condition {
+	return Condition{
+		Type:    string(WAFProgrammedConditionType),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonSignatureVerificationFailed),
+		Message: fmt.Sprintf("Bundle was not deployed: %s", errMsg),
+	}
+}
+
+// NewPolicyProgrammedSignatureVerificationFailed returns a Condition that indicates a new bundle failed
+// signature verification and was not deployed, while the previously fetched bundle keeps the policy active.
+// bundleDescription is a human-readable label, e.g. "policy bundle" or "security log bundle (profile: default)".
+func NewPolicyProgrammedSignatureVerificationFailed(bundleDescription, errMsg string) Condition {
+	return Condition{
+		Type:   string(WAFProgrammedConditionType),
+		Status: metav1.ConditionTrue,
+		Reason: string(PolicyReasonSignatureVerificationFailed),
+		Message: fmt.Sprintf(
+			"%s was not deployed; using previously fetched bundle: %s", bundleDescription, errMsg,
+		),
+	}
+}
+
 // NewPolicyProgrammedBundleUpdated returns a Condition that indicates polling detected a changed
 // bundle and dispatched it to target deployments.
 // bundleDescription is a human-readable label, e.g. "policy bundle" or "security log bundle (profile: default)".
@@ -1752,6 +1889,22 @@ func NewPolicyProgrammedBundleUpdated(bundleDescription, checksum string, update
 	}
 }
 
+// NewPolicyProgrammedBundleRolledBack returns a Condition that indicates a changed bundle failed to apply
+// during a staged rollout and was rolled back. liveChecksum is the checksum of the bundle that is live on
+// the data plane after the rollback.
+// bundleDescription is a human-readable label, e.g. "policy bundle" or "security log bundle (profile: default)".
+func NewPolicyProgrammedBundleRolledBack(bundleDescription, failedChecksum, liveChecksum, errMsg string) Condition {
+	return Condition{
+		Type:   string(WAFProgrammedConditionType),
+		Status: metav1.ConditionTrue,
+		Reason: string(PolicyReasonBundleRolledBack),
+		Message: fmt.Sprintf(
+			"%s (checksum: %s) was rolled back; live checksum: %s: %s",
+			bundleDescription, failedChecksum, liveChecksum, errMsg,
+		),
+	}
+}
+
 // NewPolicyProgrammedStaleBundleWarning returns a Condition that indicates a bundle fetch failed
 // but the previously fetched bundle is being used to keep the policy active on the data plane.
 // bundleDescription is a human-readable label, e.g. "policy bundle" or "security log bundle (profile: default)".
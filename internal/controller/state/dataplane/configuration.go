@@ -22,12 +22,14 @@ import (
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/ngfsort"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/configmaps"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
@@ -39,6 +41,8 @@ const (
 	DefaultWorkerProcesses         = "auto"
 	DefaultNginxReadinessProbePort = int32(8081)
 	DefaultNginxReadinessProbePath = "/readyz"
+	// ZoneSyncPort is the port that nginx replicas use to synchronize their shared memory zones.
+	ZoneSyncPort = int32(12345)
 	// DefaultLogFormatName is used when user provides custom access_log format.
 	DefaultLogFormatName = "ngf_user_defined_log_format"
 	// DefaultAccessLogPath is the default path for the access log.
@@ -57,11 +61,11 @@ const (
 		`"http_user_agent":"$http_user_agent"` +
 		`}`
 	// InternalRLPAnnotationKey is the annotation key used to mark internally generated
-	// RateLimitPolicies. These policies are created when a RateLimitPolicy targets a route and not
-	// the Gateway itself; in this situation we need an additional policy to generate the http context
+	// RateLimitPolicies, ConnectionLimitPolicies and CachePolicies. These policies are created when a policy targets
+	// a route and not the Gateway itself; in this situation we need an additional policy to generate the http context
 	// configuration.
 	InternalRLPAnnotationKey = "nginx.org/internal-annotation-http-context-only"
-	// InternalRLPAnnotationValue is the annotation value used to mark internally generated RateLimitPolicies.
+	// InternalRLPAnnotationValue is the annotation value used to mark internally generated policies.
 	InternalRLPAnnotationValue = "true"
 	crlBundleIDPrefix          = "crl_bundle"
 )
@@ -88,13 +92,26 @@ func BuildConfiguration(
 	// Get SnippetsFilters that are specifically referenced by routes attached to this gateway
 	gatewaySnippetsFilters := gateway.GetReferencedSnippetsFilters(g.Routes, g.SnippetsFilters)
 
-	// Get all RateLimitPolicies that target routes attached to this Gateway, excluding
-	// policies that are attached directly to the Gateway
-	gatewayRateLimitPolicies := gateway.GetReferencedRateLimitPolicies(g.Routes, g.NGFPolicies)
+	// Get all RateLimitPolicies, ConnectionLimitPolicies, CachePolicies and ObservabilityPolicies that target
+	// routes attached to this Gateway, excluding policies that are attached directly to the Gateway
+	gatewayZonePolicies := gateway.GetReferencedRateLimitPolicies(g.Routes, g.NGFPolicies)
+	for _, pols := range []map[graph.PolicyKey]*graph.Policy{
+		gateway.GetReferencedConnectionLimitPolicies(g.Routes, g.NGFPolicies),
+		gateway.GetReferencedCachePolicies(g.Routes, g.NGFPolicies),
+		gateway.GetReferencedObservabilityPolicies(g.Routes, g.NGFPolicies),
+	} {
+		if len(pols) == 0 {
+			continue
+		}
+		if gatewayZonePolicies == nil {
+			gatewayZonePolicies = make(map[graph.PolicyKey]*graph.Policy, len(pols))
+		}
+		maps.Copy(gatewayZonePolicies, pols)
+	}
 
-	baseHTTPConfig := buildBaseHTTPConfig(gateway, gatewaySnippetsFilters, gatewayRateLimitPolicies, clusterIPFamily)
+	baseHTTPConfig := buildBaseHTTPConfig(gateway, gatewaySnippetsFilters, gatewayZonePolicies, clusterIPFamily)
 	baseHTTPConfig.AuthZConfigs = buildAuthZConfigs(g.AuthenticationFilters)
-	baseStreamConfig := buildBaseStreamConfig(gateway)
+	baseStreamConfig := buildBaseStreamConfig(gateway, plus)
 
 	httpServers, sslServers, sslListenerHostnames, extAuthCertBundleIDs := buildServers(
 		gateway,
@@ -142,6 +159,11 @@ func BuildConfiguration(
 		refCertBundles,
 	))
 
+	telemetry := buildTelemetry(g, gateway)
+	if telemetry.CACertBundleID != "" && telemetry.CACertData != nil {
+		certBundles[telemetry.CACertBundleID] = telemetry.CACertData
+	}
+
 	config := Configuration{
 		HTTPServers:   httpServers,
 		SSLServers:    sslServers,
@@ -160,7 +182,7 @@ func BuildConfiguration(
 		BackendGroups:        backendGroups,
 		SSLKeyPairs:          buildSSLKeyPairs(g.ReferencedSecrets, gateway),
 		AuthSecrets:          buildAuthSecrets(g.AuthenticationFilters, g.ReferencedSecrets),
-		Telemetry:            buildTelemetry(g, gateway),
+		Telemetry:            telemetry,
 		BaseHTTPConfig:       baseHTTPConfig,
 		BaseStreamConfig:     baseStreamConfig,
 		Logging:              buildLogging(gateway),
@@ -338,7 +360,10 @@ func buildL4Servers(logger logr.Logger, gateway *graph.Gateway, protocol v1.Prot
 			continue
 		}
 
-		servers = append(servers, *oldest.withPort(l.Source.Port))
+		server := oldest.withPort(l.Source.Port)
+		server.Policies = slices.Concat(buildPolicies(gateway, l.Policies), buildPolicies(gateway, oldest.policies))
+
+		servers = append(servers, *server)
 	}
 
 	if len(servers) == 0 {
@@ -358,6 +383,7 @@ func buildL4Servers(logger logr.Logger, gateway *graph.Gateway, protocol v1.Prot
 type l4RouteUpstreams struct {
 	source    client.Object
 	upstreams []Layer4Upstream
+	policies  []*graph.Policy
 }
 
 func (u *l4RouteUpstreams) withPort(port v1.PortNumber) *Layer4VirtualServer {
@@ -420,6 +446,7 @@ func oldestValidL4Route(
 		candidate := &l4RouteUpstreams{
 			source:    r.Source,
 			upstreams: upstreams,
+			policies:  r.Policies,
 		}
 
 		if oldest == nil || ngfsort.LessClientObject(candidate.source, oldest.source) {
@@ -484,6 +511,11 @@ func buildStreamUpstreams(
 
 				var errMsg string
 
+				var uspSettings upstreamsettings.UpstreamSettings
+				if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
+					uspSettings = upstreamsettings.Processor{}.Process(buildPolicies(gateway, graphSvc.Policies))
+				}
+
 				// Use resolveUpstreamEndpoints to handle both regular and ExternalName services
 				eps, err := resolveUpstreamEndpoints(
 					ctx,
@@ -498,9 +530,10 @@ func buildStreamUpstreams(
 				}
 
 				uniqueUpstreams[upstreamName] = Upstream{
-					Name:      upstreamName,
-					Endpoints: eps,
-					ErrorMsg:  errMsg,
+					Name:             upstreamName,
+					Endpoints:        eps,
+					ErrorMsg:         errMsg,
+					UpstreamSettings: uspSettings,
 				}
 			}
 		}
@@ -1429,16 +1462,26 @@ func buildServers(
 	pols := buildPolicies(gateway, gateway.Policies)
 
 	for i := range httpServers {
-		httpServers[i].Policies = pols
+		httpServers[i].Policies = mergeServerPolicies(pols, httpServers[i].Policies)
 	}
 
 	for i := range sslServers {
-		sslServers[i].Policies = pols
+		sslServers[i].Policies = mergeServerPolicies(pols, sslServers[i].Policies)
 	}
 
 	return httpServers, sslServers, sslListenerHostnames, extAuthCertBundleIDs
 }
 
+// mergeServerPolicies returns the policies of the Gateway followed by the policies of the Listener
+// that a server is built from.
+func mergeServerPolicies(gatewayPolicies, listenerPolicies []policies.Policy) []policies.Policy {
+	if len(listenerPolicies) == 0 {
+		return gatewayPolicies
+	}
+
+	return slices.Concat(gatewayPolicies, listenerPolicies)
+}
+
 // portPathRules keeps track of hostPathRules per port.
 type portPathRules map[v1.PortNumber]*hostPathRules
 
@@ -1465,6 +1508,7 @@ type pathAndType struct {
 type hostPathRules struct {
 	rulesPerHost     map[string]map[pathAndType]PathRule
 	listenersForHost map[string]*graph.Listener
+	listenerPolicies map[*graph.Listener][]policies.Policy
 	httpsListeners   []*graph.Listener
 	port             int32
 	listenersExist   bool
@@ -1474,6 +1518,7 @@ func newHostPathRules() *hostPathRules {
 	return &hostPathRules{
 		rulesPerHost:     make(map[string]map[pathAndType]PathRule),
 		listenersForHost: make(map[string]*graph.Listener),
+		listenerPolicies: make(map[*graph.Listener][]policies.Policy),
 		httpsListeners:   make([]*graph.Listener, 0),
 	}
 }
@@ -1488,6 +1533,10 @@ func (hpr *hostPathRules) upsertListener(
 	hpr.listenersExist = true
 	hpr.port = l.Source.Port
 
+	if pols := buildPolicies(gateway, l.Policies); len(pols) > 0 {
+		hpr.listenerPolicies[l] = pols
+	}
+
 	if l.Source.Protocol == v1.HTTPSProtocolType {
 		hpr.httpsListeners = append(hpr.httpsListeners, l)
 	}
@@ -1544,6 +1593,8 @@ func (hpr *hostPathRules) upsertRoute(
 		}
 	}
 
+	wafRoutePols := buildGatewayWAFRoutePolicies(gateway, route, routeNsName)
+
 	for idx, rule := range route.Spec.Rules {
 		if !rule.ValidMatches {
 			continue
@@ -1566,7 +1617,8 @@ func (hpr *hostPathRules) upsertRoute(
 			}
 		}
 
-		pols := buildPolicies(gateway, route.Policies)
+		pols := slices.Concat(buildPolicies(gateway, route.Policies), wafRoutePols)
+		rulePols := buildPolicies(gateway, rule.Policies)
 
 		for _, h := range hostnames {
 			for _, m := range rule.Matches {
@@ -1600,6 +1652,9 @@ func (hpr *hostPathRules) upsertRoute(
 					Source:       objectSrc,
 					BackendGroup: backendGroup,
 					Filters:      filters,
+					Timeouts:     convertRouteTimeouts(rule.Timeouts),
+					Retry:        convertRouteRetry(rule.Retry),
+					Policies:     rulePols,
 					Match:        convertMatch(m),
 				})
 
@@ -1624,6 +1679,8 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			panic(fmt.Sprintf("no listener found for hostname: %s", h))
 		}
 
+		s.Policies = hpr.listenerPolicies[l]
+
 		if len(l.ResolvedSecrets) > 0 {
 			s.SSL = buildSSL(l)
 		}
@@ -1656,6 +1713,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			s := VirtualServer{
 				Hostname: hostname,
 				Port:     hpr.port,
+				Policies: hpr.listenerPolicies[l],
 			}
 
 			if len(l.ResolvedSecrets) > 0 {
@@ -1863,9 +1921,11 @@ func buildUpstream(
 
 	var upstreamPolicies []policies.Policy
 	var uspSettings upstreamsettings.UpstreamSettings
+	var healthCheck healthcheck.HealthCheck
 	if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
 		upstreamPolicies = buildPolicies(gateway, graphSvc.Policies)
 		uspSettings = upstreamsettings.Processor{}.Process(upstreamPolicies)
+		healthCheck = healthcheck.Processor{}.Process(upstreamPolicies)
 	}
 
 	// The NginxProxy setting provides the default; a UseClusterIP value set in an
@@ -1906,6 +1966,7 @@ func buildUpstream(
 		ErrorMsg:           errMsg,
 		Policies:           upstreamPolicies,
 		UpstreamSettings:   uspSettings,
+		HealthCheck:        healthCheck,
 		SessionPersistence: sp,
 		StateFileKey:       br.BaseServicePortKey(),
 	}
@@ -1950,7 +2011,7 @@ func createHTTPFilters(
 		case graph.FilterResponseHeaderModifier:
 			result.addResponseHeaderModifier(f.ResponseHeaderModifier)
 		case graph.FilterExtensionRef:
-			result.addExtensionRef(f.ResolvedExtensionRef, referencedSecrets)
+			result.addExtensionRef(f.ResolvedExtensionRef, referencedSecrets, backendRefs, gwNsName)
 		case graph.FilterCORS:
 			result.addCORS(f.CORS)
 		case graph.FilterExternalAuth:
@@ -2003,6 +2064,8 @@ func (hf *HTTPFilters) addResponseHeaderModifier(modifier *v1.HTTPHeaderFilter)
 func (hf *HTTPFilters) addExtensionRef(
 	ref *graph.ExtensionRefFilter,
 	referencedSecrets map[types.NamespacedName]*secrets.Secret,
+	backendRefs []graph.BackendRef,
+	gwNsName types.NamespacedName,
 ) {
 	if ref == nil {
 		return
@@ -2020,6 +2083,14 @@ func (hf *HTTPFilters) addExtensionRef(
 			referencedSecrets,
 		)
 	}
+	if ref.CanaryFilter != nil && ref.CanaryFilter.Valid && hf.CanaryFilter == nil {
+		// using the first filter
+		hf.CanaryFilter = convertCanaryFilter(ref.CanaryFilter, backendRefs, gwNsName)
+	}
+	if ref.MirrorFilter != nil && ref.MirrorFilter.Valid && hf.MirrorFilter == nil {
+		// using the first filter
+		hf.MirrorFilter = convertMirrorFilter(ref.MirrorFilter)
+	}
 }
 
 func (hf *HTTPFilters) addCORS(cors *v1.HTTPCORSFilter) {
@@ -2162,7 +2233,8 @@ func telemetryEnabled(gw *graph.Gateway) bool {
 		return false
 	}
 
-	return true
+	// the exporter can't authenticate to or verify the endpoint without its Secrets
+	return !gw.TelemetryExporterRefsInvalid
 }
 
 // buildTelemetry generates the Otel configuration.
@@ -2192,6 +2264,8 @@ func buildTelemetry(g *graph.Graph, gateway *graph.Gateway) Telemetry {
 		tel.Interval = string(*telemetry.Exporter.Interval)
 	}
 
+	setTelemetryExporterSecrets(&tel, telemetry.Exporter, gateway.Source.Namespace, g.ReferencedSecrets)
+
 	tel.SpanAttributes = setSpanAttributes(telemetry.SpanAttributes)
 
 	// FIXME(sberman): https://github.com/nginx/nginx-gateway-fabric/issues/2038
@@ -2215,6 +2289,38 @@ func buildTelemetry(g *graph.Graph, gateway *graph.Gateway) Telemetry {
 	return tel
 }
 
+// setTelemetryExporterSecrets sets the TLS settings and the headers of the exporter, which are read from Secrets
+// in the namespace of the Gateway. The graph has already validated the Secrets.
+func setTelemetryExporterSecrets(
+	tel *Telemetry,
+	exporter *ngfAPIv1alpha2.TelemetryExporter,
+	namespace string,
+	referencedSecrets map[types.NamespacedName]*secrets.Secret,
+) {
+	if exporter.TLS != nil {
+		endpoint := strings.TrimPrefix(tel.Endpoint, "http://")
+		tel.Endpoint = "https://" + strings.TrimPrefix(endpoint, "https://")
+
+		if exporter.TLS.CACertificateRef != nil {
+			nsName := types.NamespacedName{Namespace: namespace, Name: exporter.TLS.CACertificateRef.Name}
+			if secret, exists := referencedSecrets[nsName]; exists && secret.Source != nil {
+				tel.CACertBundleID = generateCertBundleID(nsName)
+				tel.CACertData = secret.Source.Data[secrets.CAKey]
+			}
+		}
+	}
+
+	for _, header := range exporter.Headers {
+		nsName := types.NamespacedName{Namespace: namespace, Name: header.SecretRef.Name}
+		if secret, exists := referencedSecrets[nsName]; exists && secret.Source != nil {
+			tel.Headers = append(tel.Headers, HTTPHeader{
+				Name:  header.Name,
+				Value: strings.TrimSpace(string(secret.Source.Data[secrets.HeaderValueKey])),
+			})
+		}
+	}
+}
+
 func setSpanAttributes(spanAttributes []ngfAPIv1alpha1.SpanAttribute) []SpanAttribute {
 	spanAttrs := make([]SpanAttribute, 0, len(spanAttributes))
 	for _, spanAttr := range spanAttributes {
@@ -2238,7 +2344,7 @@ func CreateRatioVarName(ratio int32) string {
 func buildBaseHTTPConfig(
 	gateway *graph.Gateway,
 	gatewaySnippetsFilters map[types.NamespacedName]*graph.SnippetsFilter,
-	gatewayRateLimitPolicies map[graph.PolicyKey]*graph.Policy,
+	gatewayZonePolicies map[graph.PolicyKey]*graph.Policy,
 	clusterIPFamily ngfAPIv1alpha2.IPFamilyType,
 ) BaseHTTPConfig {
 	baseConfig := BaseHTTPConfig{
@@ -2249,13 +2355,14 @@ func buildBaseHTTPConfig(
 		Snippets: buildSnippetsForContext(gatewaySnippetsFilters, ngfAPIv1alpha1.NginxContextHTTP),
 	}
 
-	// Create HTTP context policies for route-targeting RateLimitPolicies
-	// For RateLimitPolicies that target routes that aren't attached to the Gateway,
-	// these policies need to create the limit_req_zone directive at the http context.
-	// To achieve this, we create a modified copy of the RateLimitPolicy with an annotation
+	// Create HTTP context policies for route-targeting RateLimitPolicies, ConnectionLimitPolicies and CachePolicies
+	// For policies that target routes that aren't attached to the Gateway,
+	// these policies need to create the limit_req_zone, limit_conn_zone or proxy_cache_path directive
+	// at the http context.
+	// To achieve this, we create a modified copy of the policy with an annotation
 	// indicating it's for HTTP context use only and attach it to the base HTTP config.
-	httpContextRateLimitPolicies := buildHTTPContextRateLimitPolicies(gatewayRateLimitPolicies)
-	baseConfig.Policies = append(baseConfig.Policies, httpContextRateLimitPolicies...)
+	httpContextPolicies := buildHTTPContextPolicies(gatewayZonePolicies)
+	baseConfig.Policies = append(baseConfig.Policies, httpContextPolicies...)
 
 	if gateway.Valid && gateway.SecretRef != nil {
 		baseConfig.GatewaySecretID = generateSSLKeyPairID(*gateway.SecretRef)
@@ -2318,56 +2425,67 @@ func buildDisableBaseProxySetHeaders(np *graph.EffectiveNginxProxy) []string {
 	return disabledHeaders
 }
 
-// buildHTTPContextRateLimitPolicies creates HTTP context versions of RateLimitPolicies that target routes.
-// These policies are modified copies with an annotation to indicate they're for HTTP context use only.
-func buildHTTPContextRateLimitPolicies(gatewayRateLimitPolicies map[graph.PolicyKey]*graph.Policy) []policies.Policy {
-	if len(gatewayRateLimitPolicies) == 0 {
+// buildHTTPContextPolicies creates HTTP context versions of RateLimitPolicies, ConnectionLimitPolicies and
+// CachePolicies that target routes. These policies are modified copies with an annotation to indicate they're for HTTP
+// context use only.
+func buildHTTPContextPolicies(gatewayZonePolicies map[graph.PolicyKey]*graph.Policy) []policies.Policy {
+	if len(gatewayZonePolicies) == 0 {
 		return nil
 	}
 
-	httpContextRateLimitPolicies := make([]policies.Policy, 0, len(gatewayRateLimitPolicies))
+	httpContextPolicies := make([]policies.Policy, 0, len(gatewayZonePolicies))
 
-	for _, graphPolicy := range gatewayRateLimitPolicies {
+	for _, graphPolicy := range gatewayZonePolicies {
 		if graphPolicy == nil || !graphPolicy.Valid {
 			continue
 		}
 
-		// Extract the actual RateLimitPolicy from the graph.Policy wrapper
-		rateLimitPolicy, ok := graphPolicy.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
-		if !ok {
+		// Create a deep copy of the policy from the graph.Policy wrapper
+		var httpContextPolicy policies.Policy
+		switch pol := graphPolicy.Source.(type) {
+		case *ngfAPIv1alpha1.RateLimitPolicy:
+			httpContextPolicy = pol.DeepCopy()
+		case *ngfAPIv1alpha1.ConnectionLimitPolicy:
+			httpContextPolicy = pol.DeepCopy()
+		case *ngfAPIv1alpha1.CachePolicy:
+			httpContextPolicy = pol.DeepCopy()
+		case *ngfAPIv1alpha2.ObservabilityPolicy:
+			// Only the access logs of ObservabilityPolicies need configuration in the http context.
+			if pol.Spec.AccessLog == nil {
+				continue
+			}
+			httpContextPolicy = pol.DeepCopy()
+		default:
 			continue
 		}
 
-		// Create a deep copy of the RateLimitPolicy
-		httpContextPolicy := rateLimitPolicy.DeepCopy()
-
 		// Add a marker annotation to identify this as a fake HTTP context policy
-		if httpContextPolicy.Annotations == nil {
-			httpContextPolicy.Annotations = make(map[string]string)
+		annotations := httpContextPolicy.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
 		}
-		httpContextPolicy.Annotations[InternalRLPAnnotationKey] = InternalRLPAnnotationValue
+		annotations[InternalRLPAnnotationKey] = InternalRLPAnnotationValue
+		httpContextPolicy.SetAnnotations(annotations)
 
-		// Convert to policies.Policy interface and add to the list
-		httpContextRateLimitPolicies = append(httpContextRateLimitPolicies, httpContextPolicy)
+		httpContextPolicies = append(httpContextPolicies, httpContextPolicy)
 	}
 
 	// Sort for deterministic ordering
-	sort.Slice(httpContextRateLimitPolicies, func(i, j int) bool {
-		policyI, okI := httpContextRateLimitPolicies[i].(*ngfAPIv1alpha1.RateLimitPolicy)
-		policyJ, okJ := httpContextRateLimitPolicies[j].(*ngfAPIv1alpha1.RateLimitPolicy)
+	sort.Slice(httpContextPolicies, func(i, j int) bool {
+		policyI, policyJ := httpContextPolicies[i], httpContextPolicies[j]
 
-		if !okI || !okJ {
-			// If type cast fails, fall back to comparing by string representation
-			return fmt.Sprintf("%v", httpContextRateLimitPolicies[i]) < fmt.Sprintf("%v", httpContextRateLimitPolicies[j])
+		kindI, kindJ := fmt.Sprintf("%T", policyI), fmt.Sprintf("%T", policyJ)
+		if kindI != kindJ {
+			return kindI < kindJ
 		}
 
-		if policyI.Namespace != policyJ.Namespace {
-			return policyI.Namespace < policyJ.Namespace
+		if policyI.GetNamespace() != policyJ.GetNamespace() {
+			return policyI.GetNamespace() < policyJ.GetNamespace()
 		}
-		return policyI.Name < policyJ.Name
+		return policyI.GetName() < policyJ.GetName()
 	})
 
-	return httpContextRateLimitPolicies
+	return httpContextPolicies
 }
 
 func GetNginxReadinessProbePort(np *graph.EffectiveNginxProxy) int32 {
@@ -2405,8 +2523,14 @@ func GetNginxReadinessProbePath(np *graph.EffectiveNginxProxy) string {
 }
 
 // buildBaseStreamConfig generates the base stream context config that should be applied to all stream servers.
-func buildBaseStreamConfig(gateway *graph.Gateway) BaseStreamConfig {
-	baseConfig := BaseStreamConfig{}
+func buildBaseStreamConfig(gateway *graph.Gateway, plus bool) BaseStreamConfig {
+	baseConfig := BaseStreamConfig{
+		Policies: buildPolicies(gateway, gateway.Policies),
+	}
+
+	if plus && gateway.ZoneSync {
+		baseConfig.ZoneSync = buildZoneSyncConfig(gateway)
+	}
 
 	// safe to access EffectiveNginxProxy since we only call this function when the Gateway is not nil.
 	np := gateway.EffectiveNginxProxy
@@ -2420,6 +2544,19 @@ func buildBaseStreamConfig(gateway *graph.Gateway) BaseStreamConfig {
 	return baseConfig
 }
 
+// buildZoneSyncConfig builds the zone synchronization config for the nginx replicas of the Gateway.
+// The replicas discover each other through the headless Service created by the provisioner.
+func buildZoneSyncConfig(gateway *graph.Gateway) *ZoneSyncConfig {
+	return &ZoneSyncConfig{
+		Service: fmt.Sprintf(
+			"%s.%s.svc",
+			controller.CreateZoneSyncServiceName(gateway.DeploymentName.Name),
+			gateway.DeploymentName.Namespace,
+		),
+		Port: ZoneSyncPort,
+	}
+}
+
 func buildRewriteClientIPConfig(rewriteClientIPConfig *ngfAPIv1alpha2.RewriteClientIP) RewriteClientIPSettings {
 	var rewriteClientIPSettings RewriteClientIPSettings
 	if rewriteClientIPConfig != nil {
@@ -2509,6 +2646,35 @@ func buildPolicies(gateway *graph.Gateway, graphPolicies []*graph.Policy) []poli
 	return finalPolicies
 }
 
+// buildGatewayWAFRoutePolicies returns the WAFPolicies of the Gateway that override the security logs of the
+// HTTPRoute in spec.routeSecurityLogs. They are applied to the locations of the HTTPRoute in addition to the server,
+// unless the HTTPRoute has a WAFPolicy of its own, which takes precedence over the WAFPolicies of the Gateway.
+func buildGatewayWAFRoutePolicies(
+	gateway *graph.Gateway,
+	route *graph.L7Route,
+	routeNsName types.NamespacedName,
+) []policies.Policy {
+	if route.RouteType != graph.RouteTypeHTTP {
+		return nil
+	}
+
+	for _, pol := range route.Policies {
+		if _, ok := pol.Source.(*ngfAPIv1alpha1.WAFPolicy); ok {
+			return nil
+		}
+	}
+
+	var wafPols []policies.Policy
+	for _, pol := range buildPolicies(gateway, gateway.Policies) {
+		wp, ok := pol.(*ngfAPIv1alpha1.WAFPolicy)
+		if ok && graph.WAFRouteSecurityLogs(wp, routeNsName) != nil {
+			wafPols = append(wafPols, wp)
+		}
+	}
+
+	return wafPols
+}
+
 func convertAddresses(addresses []ngfAPIv1alpha2.RewriteClientIPAddress) []string {
 	trustedAddresses := make([]string, len(addresses))
 	for i, addr := range addresses {
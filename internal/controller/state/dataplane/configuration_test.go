@@ -23,7 +23,9 @@ import (
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
@@ -456,6 +458,11 @@ func TestBuildConfiguration(t *testing.T) {
 		Valid:  true,
 	}
 
+	listenerPolicy := &graph.Policy{
+		Source: createFakePolicy("attach-listener", "PearPolicy"),
+		Valid:  true,
+	}
+
 	invalidPolicy := &graph.Policy{
 		Source: createFakePolicy("invalid", "LimePolicy"),
 		Valid:  false,
@@ -955,6 +962,16 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 	)
 
+	hrWithRulePolicy, expHRWithRulePolicyGroups, l7RouteWithRulePolicy := createTestResources(
+		"hr-with-rule-policy",
+		"rule-policy.com",
+		"listener-80-1",
+		pathAndType{
+			path:     "/",
+			pathType: prefix,
+		},
+	)
+
 	hrAdvancedRouteWithPolicyAndHeaderMatch,
 		groupsHRAdvancedWithHeaderMatch,
 		routeHRAdvancedWithHeaderMatch := createTestResources(
@@ -2465,6 +2482,62 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "Simple Gateway and HTTPRoute with policies attached",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				gw := g.Gateways[gatewayNsName]
+				gw.Listeners = append(gw.Listeners, []*graph.Listener{
+					{
+						Name:        "listener-80-1",
+						GatewayName: gatewayNsName,
+						Source:      listener80,
+						Valid:       true,
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							graph.CreateRouteKey(hrWithPolicy): l7RouteWithPolicy,
+						},
+						Policies: []*graph.Policy{listenerPolicy, invalidPolicy},
+					},
+				}...)
+				gw.Policies = []*graph.Policy{gwPolicy1}
+				g.Routes = map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hrWithPolicy): l7RouteWithPolicy,
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.BaseHTTPConfig.Policies = []policies.Policy{gwPolicy1.Source}
+				conf.SSLServers = []VirtualServer{}
+				conf.HTTPServers = []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+						Policies:  []policies.Policy{gwPolicy1.Source},
+					},
+					{
+						Hostname: "policy.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrWithPolicy.ObjectMeta,
+										BackendGroup: expHRWithPolicyGroups[0],
+									},
+								},
+								Policies: []policies.Policy{hrPolicy1.Source},
+							},
+						},
+						Port:     80,
+						Policies: []policies.Policy{gwPolicy1.Source, listenerPolicy.Source},
+					},
+				}
+				conf.Upstreams = []Upstream{fooUpstream}
+				conf.BackendGroups = []BackendGroup{expHRWithPolicyGroups[0]}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				return conf
+			}),
+			msg: "Simple Gateway and HTTPRoute with policies attached to the Gateway and Listener",
+		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				gw := g.Gateways[gatewayNsName]
@@ -2534,6 +2607,60 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "Gateway and HTTPRoute with policies attached with advanced routing",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				gw := g.Gateways[gatewayNsName]
+				gw.Listeners = append(gw.Listeners, []*graph.Listener{
+					{
+						Name:        "listener-80-1",
+						GatewayName: gatewayNsName,
+						Source:      listener80,
+						Valid:       true,
+						Routes: map[graph.RouteKey]*graph.L7Route{
+							graph.CreateRouteKey(hrWithRulePolicy): l7RouteWithRulePolicy,
+						},
+					},
+				}...)
+				l7RouteWithRulePolicy.Policies = []*graph.Policy{hrPolicy1}
+				l7RouteWithRulePolicy.Spec.Rules[0].Policies = []*graph.Policy{hrPolicy2, invalidPolicy}
+				g.Routes = map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hrWithRulePolicy): l7RouteWithRulePolicy,
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.HTTPServers = []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "rule-policy.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrWithRulePolicy.ObjectMeta,
+										BackendGroup: expHRWithRulePolicyGroups[0],
+										Policies:     []policies.Policy{hrPolicy2.Source},
+									},
+								},
+								Policies: []policies.Policy{hrPolicy1.Source},
+							},
+						},
+						Port: 80,
+					},
+				}
+				conf.Upstreams = []Upstream{fooUpstream}
+				conf.BackendGroups = []BackendGroup{expHRWithRulePolicyGroups[0]}
+				return conf
+			}),
+			msg: "HTTPRoute with a policy attached to a rule",
+		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				gw := g.Gateways[gatewayNsName]
@@ -2558,11 +2685,13 @@ func TestBuildConfiguration(t *testing.T) {
 									},
 								},
 							},
-							TargetRefs: []v1.LocalPolicyTargetReference{
+							TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 								{
-									Group: "gateway.networking.k8s.io",
-									Kind:  kinds.Gateway,
-									Name:  "gateway",
+									LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+										Group: "gateway.networking.k8s.io",
+										Kind:  kinds.Gateway,
+										Name:  "gateway",
+									},
 								},
 							},
 						},
@@ -2600,11 +2729,13 @@ func TestBuildConfiguration(t *testing.T) {
 									},
 								},
 							},
-							TargetRefs: []v1.LocalPolicyTargetReference{
+							TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 								{
-									Group: "gateway.networking.k8s.io",
-									Kind:  "HTTPRoute",
-									Name:  "hr-1",
+									LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+										Group: "gateway.networking.k8s.io",
+										Kind:  "HTTPRoute",
+										Name:  "hr-1",
+									},
 								},
 							},
 						},
@@ -2642,11 +2773,13 @@ func TestBuildConfiguration(t *testing.T) {
 									},
 								},
 							},
-							TargetRefs: []v1.LocalPolicyTargetReference{
+							TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 								{
-									Group: "gateway.networking.k8s.io",
-									Kind:  "HTTPRoute",
-									Name:  "unrelated-hr",
+									LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+										Group: "gateway.networking.k8s.io",
+										Kind:  "HTTPRoute",
+										Name:  "unrelated-hr",
+									},
 								},
 							},
 						},
@@ -2739,11 +2872,13 @@ func TestBuildConfiguration(t *testing.T) {
 								},
 							},
 						},
-						TargetRefs: []v1.LocalPolicyTargetReference{
+						TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 							{
-								Group: "gateway.networking.k8s.io",
-								Kind:  kinds.Gateway,
-								Name:  "gateway",
+								LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+									Group: "gateway.networking.k8s.io",
+									Kind:  kinds.Gateway,
+									Name:  "gateway",
+								},
 							},
 						},
 					},
@@ -2771,11 +2906,13 @@ func TestBuildConfiguration(t *testing.T) {
 								},
 							},
 						},
-						TargetRefs: []v1.LocalPolicyTargetReference{
+						TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
 							{
-								Group: "gateway.networking.k8s.io",
-								Kind:  "HTTPRoute",
-								Name:  "hr-1",
+								LocalPolicyTargetReference: v1.LocalPolicyTargetReference{
+									Group: "gateway.networking.k8s.io",
+									Kind:  "HTTPRoute",
+									Name:  "hr-1",
+								},
 							},
 						},
 					},
@@ -4447,6 +4584,30 @@ func TestBuildTelemetry(t *testing.T) {
 		},
 	}
 
+	telemetryWithSecrets := &graph.EffectiveNginxProxy{
+		Telemetry: &ngfAPIv1alpha2.Telemetry{
+			Exporter: &ngfAPIv1alpha2.TelemetryExporter{
+				Endpoint:   helpers.GetPointer("http://my-otel.svc:4563"),
+				BatchSize:  helpers.GetPointer(int32(512)),
+				BatchCount: helpers.GetPointer(int32(4)),
+				Interval:   helpers.GetPointer(ngfAPIv1alpha1.Duration("5s")),
+				TLS: &ngfAPIv1alpha2.ExporterTLS{
+					CACertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: "otel-ca"},
+				},
+				Headers: []ngfAPIv1alpha2.ExporterHeader{
+					{
+						Name:      "Authorization",
+						SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: "otel-auth"},
+					},
+				},
+			},
+			ServiceName: helpers.GetPointer("my-svc"),
+			SpanAttributes: []ngfAPIv1alpha1.SpanAttribute{
+				{Key: "key", Value: "value"},
+			},
+		},
+	}
+
 	createTelemetry := func() Telemetry {
 		return Telemetry{
 			Endpoint:    "my-otel.svc:4563",
@@ -4705,6 +4866,59 @@ func TestBuildTelemetry(t *testing.T) {
 			expTelemetry: createTelemetry(),
 			msg:          "Telemetry configured with zero observability policy ratio",
 		},
+		{
+			g: &graph.Graph{
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
+						},
+						EffectiveNginxProxy: telemetryWithSecrets,
+					},
+				},
+				ReferencedSecrets: map[types.NamespacedName]*secrets.Secret{
+					{Namespace: "ns", Name: "otel-ca"}: {
+						Source: &apiv1.Secret{
+							Data: map[string][]byte{secrets.CAKey: []byte("ca-data")},
+						},
+					},
+					{Namespace: "ns", Name: "otel-auth"}: {
+						Source: &apiv1.Secret{
+							Data: map[string][]byte{secrets.HeaderValueKey: []byte("Bearer token\n")},
+						},
+					},
+				},
+			},
+			expTelemetry: createModifiedTelemetry(func(t Telemetry) Telemetry {
+				t.Endpoint = "https://my-otel.svc:4563"
+				t.CACertBundleID = "cert_bundle_ns_otel-ca"
+				t.CACertData = []byte("ca-data")
+				t.Headers = []HTTPHeader{{Name: "Authorization", Value: "Bearer token"}}
+				return t
+			}),
+			msg: "Telemetry configured with TLS and headers",
+		},
+		{
+			g: &graph.Graph{
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
+						},
+						EffectiveNginxProxy:          telemetryWithSecrets,
+						TelemetryExporterRefsInvalid: true,
+					},
+				},
+			},
+			expTelemetry: Telemetry{},
+			msg:          "Telemetry disabled because of invalid exporter Secrets",
+		},
 	}
 
 	for _, tc := range tests {
@@ -4720,6 +4934,46 @@ func TestBuildTelemetry(t *testing.T) {
 	}
 }
 
+func TestSetTelemetryExporterSecretsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		expEndpoint string
+	}{
+		{
+			name:        "http scheme",
+			endpoint:    "http://my-otel.svc:4563",
+			expEndpoint: "https://my-otel.svc:4563",
+		},
+		{
+			name:        "https scheme",
+			endpoint:    "https://my-otel.svc:4563",
+			expEndpoint: "https://my-otel.svc:4563",
+		},
+		{
+			name:        "no scheme",
+			endpoint:    "my-otel.svc:4563",
+			expEndpoint: "https://my-otel.svc:4563",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			tel := &Telemetry{Endpoint: test.endpoint}
+			exporter := &ngfAPIv1alpha2.TelemetryExporter{TLS: &ngfAPIv1alpha2.ExporterTLS{}}
+
+			setTelemetryExporterSecrets(tel, exporter, "ns", nil)
+
+			g.Expect(tel.Endpoint).To(Equal(test.expEndpoint))
+		})
+	}
+}
+
 func TestBuildPolicies(t *testing.T) {
 	t.Parallel()
 	getPolicy := func(kind, name string) policies.Policy {
@@ -4858,6 +5112,71 @@ func TestBuildPolicies(t *testing.T) {
 	}
 }
 
+func TestBuildGatewayWAFRoutePolicies(t *testing.T) {
+	t.Parallel()
+
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "coffee"}
+
+	overridingPolicy := &ngfAPIv1alpha1.WAFPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "overriding", Namespace: "test"},
+		Spec: ngfAPIv1alpha1.WAFPolicySpec{
+			RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{{Name: "coffee"}},
+		},
+	}
+	otherPolicy := &ngfAPIv1alpha1.WAFPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"},
+		Spec: ngfAPIv1alpha1.WAFPolicySpec{
+			RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{{Name: "tea"}},
+		},
+	}
+
+	gateway := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "test"},
+		},
+		Policies: []*graph.Policy{
+			{Source: overridingPolicy, Valid: true},
+			{Source: otherPolicy, Valid: true},
+			{Source: &ngfAPIv1alpha2.ObservabilityPolicy{}, Valid: true},
+		},
+	}
+
+	tests := []struct {
+		route       *graph.L7Route
+		name        string
+		expPolicies []policies.Policy
+	}{
+		{
+			name:        "HTTPRoute that is overridden",
+			route:       &graph.L7Route{RouteType: graph.RouteTypeHTTP},
+			expPolicies: []policies.Policy{overridingPolicy},
+		},
+		{
+			name:  "GRPCRoute",
+			route: &graph.L7Route{RouteType: graph.RouteTypeGRPC},
+		},
+		{
+			name: "HTTPRoute with its own WAFPolicy",
+			route: &graph.L7Route{
+				RouteType: graph.RouteTypeHTTP,
+				Policies: []*graph.Policy{
+					{Source: &ngfAPIv1alpha1.WAFPolicy{}, Valid: true},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pols := buildGatewayWAFRoutePolicies(gateway, test.route, routeNsName)
+			g.Expect(pols).To(Equal(test.expPolicies))
+		})
+	}
+}
+
 func TestCreateRatioVarName(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -5656,7 +5975,12 @@ func TestBuildStreamUpstreams(t *testing.T) {
 							Namespace: "default",
 							Name:      "usp-use-cluster-ip",
 						},
-						Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{UseClusterIP: helpers.GetPointer(true)},
+						Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+							UseClusterIP:        helpers.GetPointer(true),
+							ZoneSize:            helpers.GetPointer[ngfAPIv1alpha1.Size]("2m"),
+							LoadBalancingMethod: helpers.GetPointer(ngfAPIv1alpha1.LoadBalancingTypeHashConsistent),
+							HashMethodKey:       helpers.GetPointer[ngfAPIv1alpha1.HashMethodKey]("$remote_addr"),
+						},
 					},
 					Valid: true,
 				},
@@ -5679,6 +6003,12 @@ func TestBuildStreamUpstreams(t *testing.T) {
 		{
 			Name:      "default_cluster-app_8443",
 			Endpoints: fakeEndpoints,
+			UpstreamSettings: upstreamsettings.UpstreamSettings{
+				UseClusterIP:        helpers.GetPointer(true),
+				ZoneSize:            "2m",
+				LoadBalancingMethod: string(ngfAPIv1alpha1.LoadBalancingTypeHashConsistent),
+				HashMethodKey:       "$remote_addr",
+			},
 		},
 		{
 			Name: "default_external-app_443",
@@ -5705,6 +6035,20 @@ func TestBuildL4Servers(t *testing.T) {
 
 	baseTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
 
+	clp := &ngfAPIv1alpha1.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "tcp-conn-limit",
+		},
+	}
+
+	acp := &ngfAPIv1alpha1.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "tcp-access-control",
+		},
+	}
+
 	createL4Route := func(name string, valid bool, backendRefs []graph.BackendRef) *graph.L4Route {
 		return &graph.L4Route{
 			Valid: valid,
@@ -6343,6 +6687,63 @@ func TestBuildL4Servers(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "TCP listener and route with policies",
+			gateway: &graph.Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test",
+						Name:      "gateway",
+					},
+				},
+				Listeners: []*graph.Listener{
+					{
+						Name:  "tcp-listener",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TCPProtocolType,
+							Port:     8080,
+						},
+						Policies: []*graph.Policy{{Source: acp, Valid: true}},
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							{NamespacedName: types.NamespacedName{Namespace: "default", Name: "tcp-route"}}: func() *graph.L4Route {
+								route := createL4Route(
+									"tcp-route",
+									true,
+									[]graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: types.NamespacedName{Namespace: "default", Name: "svc"},
+											ServicePort: apiv1.ServicePort{
+												Name: "tcp",
+												Port: 8080,
+											},
+											Weight: 1,
+										},
+									},
+								)
+								route.Policies = []*graph.Policy{
+									{Source: clp, Valid: true},
+									{Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{}, Valid: false},
+								}
+								return route
+							}(),
+						},
+					},
+				},
+			},
+			protocol: v1.TCPProtocolType,
+			expectedServers: []Layer4VirtualServer{
+				{
+					Hostname: "",
+					Port:     8080,
+					Upstreams: []Layer4Upstream{
+						{Name: "default_svc_8080", Weight: 1},
+					},
+					Policies: []policies.Policy{acp, clp},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -9010,6 +9411,148 @@ func TestBuildDNSResolverConfig(t *testing.T) {
 	}
 }
 
+func TestBuildBaseStreamConfig_ZoneSync(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected *ZoneSyncConfig
+		name     string
+		zoneSync bool
+		plus     bool
+	}{
+		{
+			name:     "zone sync on plus",
+			zoneSync: true,
+			plus:     true,
+			expected: &ZoneSyncConfig{
+				Service: "gateway-nginx-zone-sync.test.svc",
+				Port:    ZoneSyncPort,
+			},
+		},
+		{
+			name:     "zone sync on oss",
+			zoneSync: true,
+		},
+		{
+			name: "zone sync not needed",
+			plus: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gateway := &graph.Gateway{
+				DeploymentName: types.NamespacedName{Namespace: "test", Name: "gateway-nginx"},
+				ZoneSync:       test.zoneSync,
+			}
+
+			result := buildBaseStreamConfig(gateway, test.plus)
+			g.Expect(result.ZoneSync).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildBaseStreamConfig_Policies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	clp := &ngfAPIv1alpha1.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway-conn-limit",
+		},
+	}
+
+	gateway := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+		Policies: []*graph.Policy{
+			{Source: clp, Valid: true},
+			{Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{}, Valid: false},
+		},
+	}
+
+	result := buildBaseStreamConfig(gateway, false)
+	g.Expect(result.Policies).To(Equal([]policies.Policy{clp}))
+}
+
+func TestBuildHTTPContextPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-rate-limit"},
+	}
+	clp := &ngfAPIv1alpha1.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-conn-limit"},
+	}
+	cp := &ngfAPIv1alpha1.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-cache"},
+	}
+	invalidCLP := &ngfAPIv1alpha1.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid"},
+	}
+	obs := &ngfAPIv1alpha2.ObservabilityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-access-log"},
+		Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+			AccessLog: &ngfAPIv1alpha2.AccessLog{},
+		},
+	}
+
+	zonePolicies := map[graph.PolicyKey]*graph.Policy{
+		{NsName: types.NamespacedName{Namespace: "test", Name: "route-rate-limit"}}: {Source: rlp, Valid: true},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "route-conn-limit"}}: {Source: clp, Valid: true},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "route-cache"}}:      {Source: cp, Valid: true},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "invalid"}}:          {Source: invalidCLP},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "route-access-log"}}: {Source: obs, Valid: true},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "other"}}: {
+			Source: &ngfAPIv1alpha2.ObservabilityPolicy{},
+			Valid:  true,
+		},
+	}
+
+	result := buildHTTPContextPolicies(zonePolicies)
+	// the ObservabilityPolicy without an access log is skipped
+	g.Expect(result).To(HaveLen(4))
+
+	expAnnotations := map[string]string{InternalRLPAnnotationKey: InternalRLPAnnotationValue}
+
+	httpCP, ok := result[0].(*ngfAPIv1alpha1.CachePolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(httpCP.Name).To(Equal("route-cache"))
+	g.Expect(httpCP.Annotations).To(Equal(expAnnotations))
+
+	httpCLP, ok := result[1].(*ngfAPIv1alpha1.ConnectionLimitPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(httpCLP.Name).To(Equal("route-conn-limit"))
+	g.Expect(httpCLP.Annotations).To(Equal(expAnnotations))
+
+	httpRLP, ok := result[2].(*ngfAPIv1alpha1.RateLimitPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(httpRLP.Name).To(Equal("route-rate-limit"))
+	g.Expect(httpRLP.Annotations).To(Equal(expAnnotations))
+
+	httpObs, ok := result[3].(*ngfAPIv1alpha2.ObservabilityPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(httpObs.Name).To(Equal("route-access-log"))
+	g.Expect(httpObs.Annotations).To(Equal(expAnnotations))
+
+	// the original policies are not modified
+	g.Expect(rlp.Annotations).To(BeNil())
+	g.Expect(clp.Annotations).To(BeNil())
+	g.Expect(cp.Annotations).To(BeNil())
+	g.Expect(obs.Annotations).To(BeNil())
+
+	g.Expect(buildHTTPContextPolicies(nil)).To(BeNil())
+}
+
 func TestBuildDisableBaseProxySetHeaders(t *testing.T) {
 	t.Parallel()
 
@@ -12073,6 +12616,81 @@ func TestBuildUpstreamsWithClusterIP(t *testing.T) {
 	}))
 }
 
+func TestBuildUpstreamsWithHealthCheck(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	svcKey := types.NamespacedName{Namespace: "default", Name: "my-svc"}
+
+	hcp := &ngfAPIv1alpha1.HealthCheckPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hcp"},
+		Spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+			Path:     helpers.GetPointer("/healthz"),
+			Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+		},
+	}
+
+	gateway := &graph.Gateway{
+		Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"}},
+		Listeners: []*graph.Listener{
+			{
+				Valid: true,
+				Source: v1.Listener{
+					Protocol: v1.HTTPProtocolType,
+					Port:     80,
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					{NamespacedName: types.NamespacedName{Namespace: "default", Name: "route"}}: {
+						Valid: true,
+						Spec: graph.L7RouteSpec{
+							Rules: []graph.RouteRule{
+								{
+									ValidMatches: true,
+									Filters:      graph.RouteRuleFilters{Valid: true},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:       true,
+											SvcNsName:   svcKey,
+											ServicePort: apiv1.ServicePort{Port: 80},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	referencedServices := map[types.NamespacedName]*graph.ReferencedService{
+		svcKey: {
+			Policies: []*graph.Policy{
+				{
+					Source: hcp,
+					Valid:  true,
+				},
+			},
+			GatewayNsNames: map[types.NamespacedName]struct{}{
+				{Name: "gw", Namespace: "default"}: {},
+			},
+		},
+	}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveReturns([]resolver.Endpoint{{Address: "10.0.0.1", Port: 80}}, nil)
+
+	upstreams := buildUpstreams(t.Context(), logr.Discard(), gateway, fakeResolver, referencedServices)
+
+	g.Expect(upstreams).To(HaveLen(1))
+	g.Expect(upstreams[0].Policies).To(ConsistOf(hcp))
+	g.Expect(upstreams[0].HealthCheck).To(Equal(healthcheck.HealthCheck{
+		Enabled:  true,
+		Path:     "/healthz",
+		Interval: "10s",
+	}))
+}
+
 func TestBuildUpstreamsUseClusterIPPrecedence(t *testing.T) {
 	t.Parallel()
 
@@ -195,6 +195,80 @@ func convertSnippetsFilter(filter *graph.SnippetsFilter) SnippetsFilter {
 	return result
 }
 
+func convertMirrorFilter(filter *graph.MirrorFilter) *MirrorFilter {
+	spec := filter.Source.Spec
+	result := &MirrorFilter{}
+
+	if spec.Timeout != nil {
+		result.Timeout = string(*spec.Timeout)
+	}
+
+	if spec.MaxBodySize != nil {
+		result.MaxBodySize = string(*spec.MaxBodySize)
+	}
+
+	if spec.StripAuthHeaders != nil {
+		result.StripAuthHeaders = *spec.StripAuthHeaders
+	}
+
+	if spec.StripCookies != nil {
+		result.StripCookies = *spec.StripCookies
+	}
+
+	return result
+}
+
+// convertCanaryFilter converts a CanaryFilter. The backend of each override is resolved to the upstream of the
+// matching backendRef of the rule.
+func convertCanaryFilter(
+	filter *graph.CanaryFilter,
+	backendRefs []graph.BackendRef,
+	gwNsName types.NamespacedName,
+) *CanaryFilter {
+	overrides := make([]CanaryOverride, 0, len(filter.Source.Spec.Overrides))
+
+	for _, override := range filter.Source.Spec.Overrides {
+		overrides = append(overrides, CanaryOverride{
+			Type:         CanaryOverrideType(override.Type),
+			Name:         override.Name,
+			Value:        override.Value,
+			UpstreamName: findCanaryUpstreamName(override.BackendRef, backendRefs, gwNsName),
+		})
+	}
+
+	return &CanaryFilter{Overrides: overrides}
+}
+
+// findCanaryUpstreamName returns the upstream name of the first backendRef of the rule that matches the
+// CanaryBackendRef. It returns an empty string if there is no such backendRef or if the backendRef is invalid.
+func findCanaryUpstreamName(
+	ref ngfAPI.CanaryBackendRef,
+	backendRefs []graph.BackendRef,
+	gwNsName types.NamespacedName,
+) string {
+	for _, br := range backendRefs {
+		if br.IsMirrorBackend || br.IsExternalAuthBackend || br.IsInferencePool {
+			continue
+		}
+
+		if br.SvcNsName.Name != string(ref.Name) {
+			continue
+		}
+
+		if ref.Port != nil && br.ServicePort.Port != *ref.Port {
+			continue
+		}
+
+		if _, invalid := br.InvalidForGateways[gwNsName]; invalid {
+			return ""
+		}
+
+		return br.ServicePortReference()
+	}
+
+	return ""
+}
+
 func convertAuthenticationFilter(
 	filter *graph.AuthenticationFilter,
 	referencedSecrets map[types.NamespacedName]*secrets.Secret,
@@ -472,6 +546,28 @@ func convertHTTPCORSFilter(filter *v1.HTTPCORSFilter) *HTTPCORSFilter {
 	return result
 }
 
+func convertRouteTimeouts(timeouts *graph.RouteTimeouts) *HTTPTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &HTTPTimeouts{
+		Request:        timeouts.Request,
+		BackendRequest: timeouts.BackendRequest,
+	}
+}
+
+func convertRouteRetry(retry *graph.RouteRetry) *HTTPRetry {
+	if retry == nil {
+		return nil
+	}
+
+	return &HTTPRetry{
+		Attempts: retry.Attempts,
+		Codes:    retry.Codes,
+	}
+}
+
 func setOIDCCACert(
 	oidc *OIDCProvider,
 	refs []ngfAPI.LocalObjectReference,
@@ -10,6 +10,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -1791,6 +1792,165 @@ func TestConvertAuthenticationFilter(t *testing.T) {
 	}
 }
 
+func TestConvertMirrorFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter   *graph.MirrorFilter
+		expected *MirrorFilter
+		name     string
+	}{
+		{
+			name: "all fields set",
+			filter: &graph.MirrorFilter{
+				Source: &ngfAPIv1alpha1.MirrorFilter{
+					Spec: ngfAPIv1alpha1.MirrorFilterSpec{
+						Timeout:          helpers.GetPointer[ngfAPIv1alpha1.Duration]("500ms"),
+						MaxBodySize:      helpers.GetPointer[ngfAPIv1alpha1.Size]("1m"),
+						StripAuthHeaders: helpers.GetPointer(true),
+						StripCookies:     helpers.GetPointer(true),
+					},
+				},
+				Valid: true,
+			},
+			expected: &MirrorFilter{
+				Timeout:          "500ms",
+				MaxBodySize:      "1m",
+				StripAuthHeaders: true,
+				StripCookies:     true,
+			},
+		},
+		{
+			name: "only timeout set",
+			filter: &graph.MirrorFilter{
+				Source: &ngfAPIv1alpha1.MirrorFilter{
+					Spec: ngfAPIv1alpha1.MirrorFilterSpec{
+						Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1s"),
+					},
+				},
+				Valid: true,
+			},
+			expected: &MirrorFilter{
+				Timeout: "1s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertMirrorFilter(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertCanaryFilter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	backendRefs := []graph.BackendRef{
+		{
+			SvcNsName:       types.NamespacedName{Namespace: "test", Name: "mirror"},
+			ServicePort:     apiv1.ServicePort{Port: 80},
+			Valid:           true,
+			IsMirrorBackend: true,
+		},
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "v1"},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Valid:       true,
+		},
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "v2"},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Valid:       true,
+		},
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "v2"},
+			ServicePort: apiv1.ServicePort{Port: 8080},
+			Valid:       true,
+		},
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "v3"},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Valid:       true,
+			InvalidForGateways: map[types.NamespacedName]conditions.Condition{
+				gwNsName: conditions.NewRouteBackendRefUnsupportedValue("unsupported"),
+			},
+		},
+	}
+
+	filter := &graph.CanaryFilter{
+		Source: &ngfAPIv1alpha1.CanaryFilter{
+			Spec: ngfAPIv1alpha1.CanaryFilterSpec{
+				Overrides: []ngfAPIv1alpha1.CanaryOverride{
+					{
+						Type:       ngfAPIv1alpha1.CanaryOverrideTypeHeader,
+						Name:       "X-Canary",
+						Value:      "always",
+						BackendRef: ngfAPIv1alpha1.CanaryBackendRef{Name: "v2"},
+					},
+					{
+						Type:  ngfAPIv1alpha1.CanaryOverrideTypeCookie,
+						Name:  "canary",
+						Value: "port",
+						BackendRef: ngfAPIv1alpha1.CanaryBackendRef{
+							Name: "v2",
+							Port: helpers.GetPointer[v1.PortNumber](8080),
+						},
+					},
+					{
+						Type:       ngfAPIv1alpha1.CanaryOverrideTypeHeader,
+						Name:       "X-Mirror",
+						Value:      "1",
+						BackendRef: ngfAPIv1alpha1.CanaryBackendRef{Name: "mirror"},
+					},
+					{
+						Type:       ngfAPIv1alpha1.CanaryOverrideTypeHeader,
+						Name:       "X-Invalid",
+						Value:      "1",
+						BackendRef: ngfAPIv1alpha1.CanaryBackendRef{Name: "v3"},
+					},
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	expected := &CanaryFilter{
+		Overrides: []CanaryOverride{
+			{
+				Type:         CanaryOverrideTypeHeader,
+				Name:         "X-Canary",
+				Value:        "always",
+				UpstreamName: "test_v2_80",
+			},
+			{
+				Type:         CanaryOverrideTypeCookie,
+				Name:         "canary",
+				Value:        "port",
+				UpstreamName: "test_v2_8080",
+			},
+			{
+				Type:  CanaryOverrideTypeHeader,
+				Name:  "X-Mirror",
+				Value: "1",
+			},
+			{
+				Type:  CanaryOverrideTypeHeader,
+				Name:  "X-Invalid",
+				Value: "1",
+			},
+		},
+	}
+
+	g.Expect(convertCanaryFilter(filter, backendRefs, gwNsName)).To(Equal(expected))
+}
+
 func TestConvertHTTPCORSFilter(t *testing.T) {
 	t.Parallel()
 
@@ -2116,3 +2276,75 @@ func TestConvertWAFBundles(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertRouteTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *graph.RouteTimeouts
+		expected *HTTPTimeouts
+		name     string
+	}{
+		{
+			name:     "nil timeouts",
+			timeouts: nil,
+			expected: nil,
+		},
+		{
+			name: "all timeouts",
+			timeouts: &graph.RouteTimeouts{
+				Request:        "10s",
+				BackendRequest: "0s",
+			},
+			expected: &HTTPTimeouts{
+				Request:        "10s",
+				BackendRequest: "0s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertRouteTimeouts(test.timeouts)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertRouteRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		retry    *graph.RouteRetry
+		expected *HTTPRetry
+		name     string
+	}{
+		{
+			name:     "nil retry",
+			retry:    nil,
+			expected: nil,
+		},
+		{
+			name: "attempts and codes",
+			retry: &graph.RouteRetry{
+				Attempts: helpers.GetPointer(2),
+				Codes:    []int{502, 503},
+			},
+			expected: &HTTPRetry{
+				Attempts: helpers.GetPointer(2),
+				Codes:    []int{502, 503},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertRouteRetry(test.retry)).To(Equal(test.expected))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/healthcheck"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
@@ -186,6 +187,8 @@ type Layer4VirtualServer struct {
 	Hostname string
 	// Upstreams holds upstreams with weights. For single backend cases, the list contains one entry.
 	Upstreams []Layer4Upstream
+	// Policies holds the policies attached to the Listener and the Route of the server.
+	Policies []policies.Policy
 	// Port is the port of the server.
 	Port int32
 	// IsDefault refers to whether this server is created for the default listener hostname.
@@ -201,6 +204,8 @@ func (l4vs Layer4VirtualServer) NeedsWeightDistribution() bool {
 type Upstream struct {
 	// UpstreamSettings holds the processed settings from UpstreamSettingsPolicy for this upstream.
 	UpstreamSettings upstreamsettings.UpstreamSettings
+	// HealthCheck holds the processed settings from HealthCheckPolicy for this upstream.
+	HealthCheck healthcheck.HealthCheck
 	// SessionPersistence holds the session persistence configuration for the upstream.
 	SessionPersistence SessionPersistenceConfig
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
@@ -314,8 +319,53 @@ type HTTPFilters struct {
 	RequestMirrors []*HTTPRequestMirrorFilter
 	// SnippetsFilters holds snippets filter configurations.
 	SnippetsFilters []SnippetsFilter
+	// CanaryFilter holds the backend overrides of a CanaryFilter.
+	CanaryFilter *CanaryFilter
+	// MirrorFilter holds the settings of the mirrored requests.
+	MirrorFilter *MirrorFilter
+}
+
+// MirrorFilter holds the settings of the requests mirrored by the RequestMirror filters of a rule.
+type MirrorFilter struct {
+	// Timeout is the timeout for connecting, reading, and sending to the mirror backend.
+	Timeout string
+	// MaxBodySize is the maximum size of the body of a mirrored request.
+	MaxBodySize string
+	// StripAuthHeaders removes the authentication headers from the mirrored requests.
+	StripAuthHeaders bool
+	// StripCookies removes the Cookie header from the mirrored requests.
+	StripCookies bool
+}
+
+// CanaryFilter holds the backend overrides of a CanaryFilter.
+type CanaryFilter struct {
+	// Overrides are the backend overrides in the order of precedence.
+	Overrides []CanaryOverride
+}
+
+// CanaryOverride sends the requests that match a header or cookie value to an upstream.
+type CanaryOverride struct {
+	// Type is the type of the request attribute to match.
+	Type CanaryOverrideType
+	// Name is the name of the header or cookie.
+	Name string
+	// Value is the value of the header or cookie.
+	Value string
+	// UpstreamName is the name of the upstream of the backend.
+	// It is empty if the backend is not a valid backendRef of the rule.
+	UpstreamName string
 }
 
+// CanaryOverrideType is the type of the request attribute matched by a CanaryOverride.
+type CanaryOverrideType string
+
+const (
+	// CanaryOverrideTypeHeader matches the value of a request header.
+	CanaryOverrideTypeHeader CanaryOverrideType = "Header"
+	// CanaryOverrideTypeCookie matches the value of a request cookie.
+	CanaryOverrideTypeCookie CanaryOverrideType = "Cookie"
+)
+
 // SnippetsFilter holds the location and server snippets in a SnippetsFilter.
 // The main and http snippets are stored separately in Configuration.MainSnippets and BaseHTTPConfig.Snippets.
 type SnippetsFilter struct {
@@ -631,14 +681,40 @@ type HTTPQueryParamMatch struct {
 type MatchRule struct {
 	// Filters holds the filters for the MatchRule.
 	Filters HTTPFilters
+	// Timeouts holds the timeouts for the MatchRule.
+	Timeouts *HTTPTimeouts
+	// Retry holds the retry configuration for the MatchRule.
+	Retry *HTTPRetry
 	// Source is the ObjectMeta of the resource that includes the rule.
 	Source *metav1.ObjectMeta
 	// Match holds the match for the rule.
 	Match Match
+	// Policies holds the policies that target the rule of the MatchRule by name.
+	// Policies that target the whole route are held by the PathRule.
+	Policies []policies.Policy
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
 }
 
+// HTTPTimeouts holds the timeouts of a routing rule in the NGINX duration format.
+// An empty value means the timeout is not set, and a value of "0s" means the timeout is disabled.
+type HTTPTimeouts struct {
+	// Request is the maximum duration for NGINX to respond to a request, including any retries.
+	Request string
+	// BackendRequest is the maximum duration of an individual request from NGINX to a backend.
+	BackendRequest string
+}
+
+// HTTPRetry holds the retry configuration of a routing rule.
+type HTTPRetry struct {
+	// Attempts is the maximum number of times a request to a backend is retried.
+	// If nil, the number of attempts is only limited by the number of backend endpoints.
+	// Zero disables the retries.
+	Attempts *int
+	// Codes are the HTTP response status codes for which a request to a backend is retried.
+	Codes []int
+}
+
 // Match represents a match for a routing rule which consist of matches against various HTTP request attributes.
 type Match struct {
 	// Method matches against the HTTP method.
@@ -722,11 +798,19 @@ type VerifyTLS struct {
 // Telemetry represents global Otel configuration for the dataplane.
 type Telemetry struct {
 	// Endpoint specifies the address of OTLP/gRPC endpoint that will accept telemetry data.
+	// It has the https scheme when TLS is enabled.
 	Endpoint string
 	// ServiceName is the “service.name” attribute of the OTel resource.
 	ServiceName string
 	// Interval specifies the export interval.
 	Interval string
+	// CACertBundleID is the ID of the CA certificate bundle that verifies the certificate of the endpoint.
+	// Empty if the system CA certificates are used.
+	CACertBundleID CertBundleID
+	// CACertData is the raw PEM bytes of the CA certificates.
+	CACertData []byte
+	// Headers are the headers that are added to each export request.
+	Headers []HTTPHeader
 	// Ratios is a list of tracing sampling ratios.
 	Ratios []Ratio
 	// SpanAttributes are global custom key/value attributes that are added to each span.
@@ -781,6 +865,20 @@ type BaseHTTPConfig struct {
 type BaseStreamConfig struct {
 	// DNSResolver specifies the DNS resolver configuration for ExternalName services.
 	DNSResolver *DNSResolverConfig
+	// ZoneSync specifies the zone synchronization configuration between the nginx replicas of the Gateway.
+	// Only set when running NGINX Plus and a zone needs to be synchronized.
+	ZoneSync *ZoneSyncConfig
+	// Policies holds the policies attached to the Gateway that apply to all stream servers.
+	Policies []policies.Policy
+}
+
+// ZoneSyncConfig defines the configuration for synchronizing shared memory zones between nginx replicas.
+type ZoneSyncConfig struct {
+	// Service is the DNS name of the headless Service that resolves to all nginx replicas of the Gateway,
+	// without the cluster domain.
+	Service string
+	// Port is the port that the zone synchronization server listens on.
+	Port int32
 }
 
 // RewriteClientIPSettings defines configuration for rewriting the client IP to the original client's IP.
@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+var (
+	// canaryHeaderNameRegexp matches header names that can be converted to an NGINX $http_ variable.
+	canaryHeaderNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	// canaryCookieNameRegexp matches cookie names that can be used in an NGINX $cookie_ variable.
+	canaryCookieNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	// canaryValueRegexp matches values that can be used as a key of an NGINX map without quoting or escaping.
+	canaryValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:/=+-]+$`)
+)
+
+// canaryReservedValues are the special parameters of the NGINX map directive, which cannot be used as values.
+var canaryReservedValues = []string{"default", "hostnames", "include", "volatile"}
+
+// CanaryFilter represents a ngfAPI.CanaryFilter.
+type CanaryFilter struct {
+	// Source is the CanaryFilter.
+	Source *ngfAPI.CanaryFilter
+	// Conditions define the conditions to be reported in the status of the CanaryFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the CanaryFilter is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the CanaryFilter is referenced by a Route.
+	Referenced bool
+}
+
+// getCanaryFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a CanaryFilter in the given namespace.
+// If the CanaryFilter exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getCanaryFilterResolverForNamespace(
+	canaryFilters map[types.NamespacedName]*CanaryFilter,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(canaryFilters) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.CanaryFilter {
+			return nil
+		}
+
+		cf := canaryFilters[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if cf == nil {
+			return nil
+		}
+
+		cf.Referenced = true
+
+		return &ExtensionRefFilter{CanaryFilter: cf, Valid: cf.Valid}
+	}
+}
+
+// isCanaryFilterRef returns true if the ExtensionRef references a CanaryFilter.
+// CanaryFilters are removed from the rules of mirror routes, since mirror routes have a single backend.
+func isCanaryFilterRef(ref *v1.LocalObjectReference) bool {
+	return ref != nil && ref.Group == ngfAPI.GroupName && ref.Kind == kinds.CanaryFilter
+}
+
+func processCanaryFilters(
+	canaryFilters map[types.NamespacedName]*ngfAPI.CanaryFilter,
+) map[types.NamespacedName]*CanaryFilter {
+	if len(canaryFilters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*CanaryFilter, len(canaryFilters))
+
+	for nsname, cf := range canaryFilters {
+		if cond := validateCanaryFilter(cf); cond != nil {
+			processed[nsname] = &CanaryFilter{
+				Source:     cf,
+				Conditions: []conditions.Condition{*cond},
+				Valid:      false,
+			}
+
+			continue
+		}
+
+		processed[nsname] = &CanaryFilter{
+			Source: cf,
+			Valid:  true,
+		}
+	}
+
+	return processed
+}
+
+func validateCanaryFilter(filter *ngfAPI.CanaryFilter) *conditions.Condition {
+	var allErrs field.ErrorList
+	overridesPath := field.NewPath("spec.overrides")
+
+	if len(filter.Spec.Overrides) == 0 {
+		cond := conditions.NewCanaryFilterInvalid(
+			field.Required(overridesPath, "at least one override must be provided").Error(),
+		)
+		return &cond
+	}
+
+	for i, override := range filter.Spec.Overrides {
+		overridePath := overridesPath.Index(i)
+		namePath := overridePath.Child("name")
+
+		switch override.Type {
+		case ngfAPI.CanaryOverrideTypeHeader:
+			if !canaryHeaderNameRegexp.MatchString(override.Name) {
+				allErrs = append(allErrs, field.Invalid(
+					namePath,
+					override.Name,
+					"header name must only contain alphanumeric characters or '-'",
+				))
+			}
+		case ngfAPI.CanaryOverrideTypeCookie:
+			if !canaryCookieNameRegexp.MatchString(override.Name) {
+				allErrs = append(allErrs, field.Invalid(
+					namePath,
+					override.Name,
+					"cookie name must only contain alphanumeric characters or '_'",
+				))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				overridePath.Child("type"),
+				override.Type,
+				[]ngfAPI.CanaryOverrideType{ngfAPI.CanaryOverrideTypeHeader, ngfAPI.CanaryOverrideTypeCookie},
+			))
+		}
+
+		valuePath := overridePath.Child("value")
+
+		if !canaryValueRegexp.MatchString(override.Value) {
+			allErrs = append(allErrs, field.Invalid(
+				valuePath,
+				override.Value,
+				"value must only contain alphanumeric characters or '.', '_', ':', '/', '=', '+', '-'",
+			))
+		}
+
+		if slices.Contains(canaryReservedValues, override.Value) {
+			allErrs = append(allErrs, field.Invalid(valuePath, override.Value, "value is reserved"))
+		}
+
+		if override.BackendRef.Name == "" {
+			allErrs = append(allErrs, field.Required(overridePath.Child("backendRef", "name"), "name cannot be empty"))
+		}
+	}
+
+	if allErrs != nil {
+		cond := conditions.NewCanaryFilterInvalid(allErrs.ToAggregate().Error())
+		return &cond
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+func createCanaryFilter(overrides ...ngfAPI.CanaryOverride) *ngfAPI.CanaryFilter {
+	return &ngfAPI.CanaryFilter{
+		Spec: ngfAPI.CanaryFilterSpec{
+			Overrides: overrides,
+		},
+	}
+}
+
+func TestProcessCanaryFilters(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	validFilter := createCanaryFilter(ngfAPI.CanaryOverride{
+		Type:       ngfAPI.CanaryOverrideTypeCookie,
+		Name:       "canary",
+		Value:      "1",
+		BackendRef: ngfAPI.CanaryBackendRef{Name: "svc-v2"},
+	})
+	invalidFilter := createCanaryFilter()
+
+	tests := []struct {
+		canaryFilters map[types.NamespacedName]*ngfAPI.CanaryFilter
+		expProcessed  map[types.NamespacedName]*CanaryFilter
+		msg           string
+	}{
+		{
+			msg:           "no canary filters",
+			canaryFilters: nil,
+			expProcessed:  nil,
+		},
+		{
+			msg: "valid and invalid canary filters",
+			canaryFilters: map[types.NamespacedName]*ngfAPI.CanaryFilter{
+				validNsName:   validFilter,
+				invalidNsName: invalidFilter,
+			},
+			expProcessed: map[types.NamespacedName]*CanaryFilter{
+				validNsName: {
+					Source: validFilter,
+					Valid:  true,
+				},
+				invalidNsName: {
+					Source: invalidFilter,
+					Conditions: []conditions.Condition{
+						conditions.NewCanaryFilterInvalid(
+							"spec.overrides: Required value: at least one override must be provided",
+						),
+					},
+					Valid: false,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(processCanaryFilters(test.canaryFilters)).To(Equal(test.expProcessed))
+		})
+	}
+}
+
+func TestValidateCanaryFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter  *ngfAPI.CanaryFilter
+		expCond *conditions.Condition
+		msg     string
+	}{
+		{
+			msg: "valid header and cookie overrides",
+			filter: createCanaryFilter(
+				ngfAPI.CanaryOverride{
+					Type:       ngfAPI.CanaryOverrideTypeHeader,
+					Name:       "X-Canary",
+					Value:      "always",
+					BackendRef: ngfAPI.CanaryBackendRef{Name: "svc-v2"},
+				},
+				ngfAPI.CanaryOverride{
+					Type:       ngfAPI.CanaryOverrideTypeCookie,
+					Name:       "canary_user",
+					Value:      "beta-1",
+					BackendRef: ngfAPI.CanaryBackendRef{Name: "svc-v1"},
+				},
+			),
+		},
+		{
+			msg: "invalid names",
+			filter: createCanaryFilter(
+				ngfAPI.CanaryOverride{
+					Type:       ngfAPI.CanaryOverrideTypeHeader,
+					Name:       "X_Canary",
+					Value:      "1",
+					BackendRef: ngfAPI.CanaryBackendRef{Name: "svc"},
+				},
+				ngfAPI.CanaryOverride{
+					Type:       ngfAPI.CanaryOverrideTypeCookie,
+					Name:       "canary-user",
+					Value:      "1",
+					BackendRef: ngfAPI.CanaryBackendRef{Name: "svc"},
+				},
+			),
+			expCond: &conditions.Condition{
+				Message: "[spec.overrides[0].name: Invalid value: \"X_Canary\": header name must only contain " +
+					"alphanumeric characters or '-', spec.overrides[1].name: Invalid value: \"canary-user\": " +
+					"cookie name must only contain alphanumeric characters or '_']",
+			},
+		},
+		{
+			msg: "invalid type, values and backendRef",
+			filter: createCanaryFilter(
+				ngfAPI.CanaryOverride{
+					Type:  "Query",
+					Name:  "canary",
+					Value: "a b",
+				},
+				ngfAPI.CanaryOverride{
+					Type:       ngfAPI.CanaryOverrideTypeCookie,
+					Name:       "canary",
+					Value:      "default",
+					BackendRef: ngfAPI.CanaryBackendRef{Name: "svc"},
+				},
+			),
+			expCond: &conditions.Condition{
+				Message: "[spec.overrides[0].type: Unsupported value: \"Query\": supported values: \"Header\", " +
+					"\"Cookie\", spec.overrides[0].value: Invalid value: \"a b\": value must only contain " +
+					"alphanumeric characters or '.', '_', ':', '/', '=', '+', '-', " +
+					"spec.overrides[0].backendRef.name: Required value: name cannot be empty, " +
+					"spec.overrides[1].value: Invalid value: \"default\": value is reserved]",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cond := validateCanaryFilter(test.filter)
+			if test.expCond == nil {
+				g.Expect(cond).To(BeNil())
+				return
+			}
+
+			expCond := conditions.NewCanaryFilterInvalid(test.expCond.Message)
+			g.Expect(cond).To(Equal(&expCond))
+		})
+	}
+}
+
+func TestGetCanaryFilterResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	createCanaryFilterMap := func() map[types.NamespacedName]*CanaryFilter {
+		return map[types.NamespacedName]*CanaryFilter{
+			validNsName:   {Valid: true},
+			invalidNsName: {Valid: false},
+		}
+	}
+
+	tests := []struct {
+		canaryFilterMap    map[types.NamespacedName]*CanaryFilter
+		extRef             v1.LocalObjectReference
+		name               string
+		resolveInNamespace string
+		expResolve         bool
+		expValid           bool
+	}{
+		{
+			name: "no canary filters",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			resolveInNamespace: "test",
+		},
+		{
+			name: "invalid kind",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.SnippetsFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			canaryFilterMap:    createCanaryFilterMap(),
+			resolveInNamespace: "test",
+		},
+		{
+			name: "canary filter in other namespace",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			canaryFilterMap:    createCanaryFilterMap(),
+			resolveInNamespace: "other",
+		},
+		{
+			name: "valid canary filter",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			canaryFilterMap:    createCanaryFilterMap(),
+			resolveInNamespace: "test",
+			expResolve:         true,
+			expValid:           true,
+		},
+		{
+			name: "invalid canary filter",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  v1.ObjectName(invalidNsName.Name),
+			},
+			canaryFilterMap:    createCanaryFilterMap(),
+			resolveInNamespace: "test",
+			expResolve:         true,
+			expValid:           false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getCanaryFilterResolverForNamespace(test.canaryFilterMap, test.resolveInNamespace)
+			extRefFilter := resolve(test.extRef)
+
+			if !test.expResolve {
+				g.Expect(extRefFilter).To(BeNil())
+				return
+			}
+
+			g.Expect(extRefFilter).ToNot(BeNil())
+			g.Expect(extRefFilter.CanaryFilter).ToNot(BeNil())
+			g.Expect(extRefFilter.CanaryFilter.Referenced).To(BeTrue())
+			g.Expect(extRefFilter.Valid).To(Equal(test.expValid))
+		})
+	}
+}
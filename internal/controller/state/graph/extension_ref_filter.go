@@ -18,6 +18,12 @@ type ExtensionRefFilter struct {
 	// AuthenticationFilter contains the AuthenticationFilter.
 	// Will be non-nil if the Ref.Kind is AuthenticationFilter and the AuthenticationFilter exists.
 	AuthenticationFilter *AuthenticationFilter
+	// CanaryFilter contains the CanaryFilter.
+	// Will be non-nil if the Ref.Kind is CanaryFilter and the CanaryFilter exists.
+	CanaryFilter *CanaryFilter
+	// MirrorFilter contains the MirrorFilter.
+	// Will be non-nil if the Ref.Kind is MirrorFilter and the MirrorFilter exists.
+	MirrorFilter *MirrorFilter
 	// Valid indicates whether the filter is valid.
 	Valid bool
 }
@@ -46,12 +52,14 @@ func validateExtensionRefFilter(ref *v1.LocalObjectReference, path *field.Path)
 	switch ref.Kind {
 	case kinds.SnippetsFilter:
 	case kinds.AuthenticationFilter:
+	case kinds.CanaryFilter:
+	case kinds.MirrorFilter:
 	default:
 		allErrs = append(allErrs,
 			field.NotSupported(
 				extRefPath,
 				ref.Kind,
-				[]string{kinds.SnippetsFilter, kinds.AuthenticationFilter}),
+				[]string{kinds.SnippetsFilter, kinds.AuthenticationFilter, kinds.CanaryFilter, kinds.MirrorFilter}),
 		)
 	}
 
@@ -63,8 +71,10 @@ func buildExtRefFilterResolvers(
 	namespace string,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
 	authenticationFilters map[types.NamespacedName]*AuthenticationFilter,
+	canaryFilters map[types.NamespacedName]*CanaryFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 ) map[string]resolveExtRefFilter {
-	resolvers := make(map[string]resolveExtRefFilter, 2)
+	resolvers := make(map[string]resolveExtRefFilter, 4)
 
 	resolvers[kinds.SnippetsFilter] = getSnippetsFilterResolverForNamespace(
 		snippetsFilters,
@@ -76,5 +86,15 @@ func buildExtRefFilterResolvers(
 		namespace,
 	)
 
+	resolvers[kinds.CanaryFilter] = getCanaryFilterResolverForNamespace(
+		canaryFilters,
+		namespace,
+	)
+
+	resolvers[kinds.MirrorFilter] = getMirrorFilterResolverForNamespace(
+		mirrorFilters,
+		namespace,
+	)
+
 	return resolvers
 }
@@ -39,7 +39,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 				`test.extensionRef: Unsupported value: ""`,
 				`supported values: "gateway.nginx.org"`,
 				`test.extensionRef: Unsupported value: ""`,
-				`supported values: "SnippetsFilter", "AuthenticationFilter"`,
+				`supported values: "SnippetsFilter", "AuthenticationFilter", "CanaryFilter", "MirrorFilter"`,
 			},
 		},
 		{
@@ -76,7 +76,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 			expErrCount: 1,
 			errSubString: []string{
 				`test.extensionRef: Unsupported value: "unsupported"`,
-				`supported values: "SnippetsFilter", "AuthenticationFilter"`,
+				`supported values: "SnippetsFilter", "AuthenticationFilter", "CanaryFilter", "MirrorFilter"`,
 			},
 		},
 		{
@@ -88,6 +88,24 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 			},
 			expErrCount: 0,
 		},
+		{
+			name: "valid canary filter ref",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+			},
+			expErrCount: 0,
+		},
+		{
+			name: "valid mirror filter ref",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+			},
+			expErrCount: 0,
+		},
 	}
 
 	for _, test := range tests {
@@ -118,11 +136,19 @@ func TestBuildExtRefFilterResolvers(t *testing.T) {
 	authenticationFilters := map[types.NamespacedName]*AuthenticationFilter{
 		{Namespace: "default", Name: "auth1"}: {},
 	}
+	canaryFilters := map[types.NamespacedName]*CanaryFilter{
+		{Namespace: "default", Name: "canary1"}: {},
+	}
+	mirrorFilters := map[types.NamespacedName]*MirrorFilter{
+		{Namespace: "default", Name: "mirror1"}: {},
+	}
 
 	resolvers := buildExtRefFilterResolvers(
 		"default",
 		snippetsFilters,
 		authenticationFilters,
+		canaryFilters,
+		mirrorFilters,
 	)
 
 	tests := []struct {
@@ -145,6 +171,22 @@ func TestBuildExtRefFilterResolvers(t *testing.T) {
 				Kind:  kinds.AuthenticationFilter,
 			},
 		},
+		{
+			name: "canary filter resolver",
+			ref: v1.LocalObjectReference{
+				Name:  "canary1",
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+			},
+		},
+		{
+			name: "mirror filter resolver",
+			ref: v1.LocalObjectReference{
+				Name:  "mirror1",
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -170,6 +212,14 @@ func TestBuildExtRefFilterResolvers(t *testing.T) {
 				if result.AuthenticationFilter == nil {
 					t.Fatalf("expected non-nil AuthenticationFilter in ExtensionRefFilter")
 				}
+			case kinds.CanaryFilter:
+				if result.CanaryFilter == nil {
+					t.Fatalf("expected non-nil CanaryFilter in ExtensionRefFilter")
+				}
+			case kinds.MirrorFilter:
+				if result.MirrorFilter == nil {
+					t.Fatalf("expected non-nil MirrorFilter in ExtensionRefFilter")
+				}
 			}
 		})
 	}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -9,8 +10,10 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -50,6 +53,12 @@ type Gateway struct {
 	Policies []*Policy
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
+	// ZoneSync indicates whether the nginx replicas of the Gateway need to synchronize their shared memory
+	// zones. This is the case when a valid RateLimitPolicy with global rules applies to the Gateway.
+	ZoneSync bool
+	// TelemetryExporterRefsInvalid indicates whether the Secrets referenced by the tracing exporter of the
+	// EffectiveNginxProxy are invalid. If so, the traces are not exported.
+	TelemetryExporterRefsInvalid bool
 }
 
 // processGateways determines which Gateway resources belong to NGF (determined by the Gateway GatewayClassName field).
@@ -105,6 +114,19 @@ func buildGateways(
 
 		conds, valid, secretRefNsName := validateGateway(gw, gc, np, resourceResolver, refGrantResolver)
 
+		telemetryExporterRefsInvalid := false
+		if msg := validateTelemetryExporterRefs(gw.Namespace, effectiveNginxProxy, resourceResolver); msg != "" {
+			conds = append(conds, conditions.NewGatewayRefInvalid(msg))
+			telemetryExporterRefsInvalid = true
+		}
+
+		// The NGINX Pods are provisioned without the metrics exporter when its Secrets are invalid,
+		// because the Pods couldn't mount them.
+		if msg := validateMetricsExporterRefs(gw.Namespace, effectiveNginxProxy, resourceResolver); msg != "" {
+			conds = append(conds, conditions.NewGatewayRefInvalid(msg))
+			effectiveNginxProxy.Telemetry.MetricsExporter = nil
+		}
+
 		protectedPorts := buildProtectedPorts(effectiveNginxProxy)
 
 		deploymentName := types.NamespacedName{
@@ -139,6 +161,7 @@ func buildGateways(
 				ListenerNamespaces:  listenerNamespaces,
 				ListenerFactory:     newListenerConfiguratorFactory(gw, resourceResolver, refGrantResolver, protectedPorts),
 			}
+			gateway.TelemetryExporterRefsInvalid = telemetryExporterRefsInvalid
 			gateway.Listeners = buildListeners(gateway, gw.Spec.Listeners, gwNsName, types.NamespacedName{})
 			builtGateways[gwNsName] = gateway
 		}
@@ -267,6 +290,108 @@ func validateGatewayTLSBackend(
 	return conditions.Condition{}, secretNsName
 }
 
+// validateTelemetryExporterRefs resolves the Secrets referenced by the tracing exporter of the NginxProxy,
+// which are in the namespace of the Gateway. Resolving the Secrets makes the Graph track their changes.
+// It returns an error message if any Secret is invalid.
+func validateTelemetryExporterRefs(
+	gwNamespace string,
+	np *EffectiveNginxProxy,
+	resourceResolver resolver.Resolver,
+) string {
+	if !telemetryEnabledForNginxProxy(np) {
+		return ""
+	}
+
+	exporter := np.Telemetry.Exporter
+	allErrs := resolveExporterRefs(
+		field.NewPath("spec.telemetry.exporter"),
+		gwNamespace,
+		exporter.Headers,
+		exporter.TLS,
+		resourceResolver,
+	)
+
+	if len(allErrs) == 0 {
+		return ""
+	}
+
+	return helpers.CapitalizeString(allErrs.ToAggregate().Error())
+}
+
+// validateMetricsExporterRefs resolves the Secrets referenced by the metrics exporter of the NginxProxy,
+// which are in the namespace of the Gateway. The Secrets are mounted into the NGINX Pods, so an invalid Secret
+// would keep the Pods from starting. It returns an error message if any Secret is invalid.
+func validateMetricsExporterRefs(
+	gwNamespace string,
+	np *EffectiveNginxProxy,
+	resourceResolver resolver.Resolver,
+) string {
+	if !metricsExporterEnabledForNginxProxy(np) {
+		return ""
+	}
+
+	exporter := np.Telemetry.MetricsExporter
+	allErrs := resolveExporterRefs(
+		field.NewPath("spec.telemetry.metricsExporter"),
+		gwNamespace,
+		exporter.Headers,
+		exporter.TLS,
+		resourceResolver,
+	)
+
+	if len(allErrs) == 0 {
+		return ""
+	}
+
+	return helpers.CapitalizeString(allErrs.ToAggregate().Error())
+}
+
+// resolveExporterRefs resolves the header and TLS Secrets of an OpenTelemetry exporter.
+func resolveExporterRefs(
+	path *field.Path,
+	gwNamespace string,
+	headers []ngfAPIv1alpha2.ExporterHeader,
+	tls *ngfAPIv1alpha2.ExporterTLS,
+	resourceResolver resolver.Resolver,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, header := range headers {
+		nsName := types.NamespacedName{Namespace: gwNamespace, Name: header.SecretRef.Name}
+		if err := resourceResolver.Resolve(
+			resolver.ResourceTypeSecret,
+			nsName,
+			resolver.WithExpectedSecretKey(secrets.HeaderValueKey),
+		); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("headers").Index(i).Child("secretRef"), nsName, err.Error()))
+		}
+	}
+
+	if tls == nil {
+		return allErrs
+	}
+
+	if tls.CACertificateRef != nil {
+		nsName := types.NamespacedName{Namespace: gwNamespace, Name: tls.CACertificateRef.Name}
+		if err := resourceResolver.Resolve(
+			resolver.ResourceTypeSecret,
+			nsName,
+			resolver.WithExpectedSecretKey(secrets.CAKey),
+		); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("tls", "caCertificateRef"), nsName, err.Error()))
+		}
+	}
+
+	if tls.ClientCertificateRef != nil {
+		nsName := types.NamespacedName{Namespace: gwNamespace, Name: tls.ClientCertificateRef.Name}
+		if err := resourceResolver.Resolve(resolver.ResourceTypeSecret, nsName); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("tls", "clientCertificateRef"), nsName, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateGateway(
 	gw *v1.Gateway,
 	gc *GatewayClass,
@@ -347,18 +472,53 @@ func (g *Gateway) GetReferencedSnippetsFilters(
 
 // GetReferencedRateLimitPolicies returns all RateLimitPolicies that target routes attached to this Gateway.
 // RateLimitPolicies that target the Gateway directly are excluded.
+func (g *Gateway) GetReferencedRateLimitPolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.RateLimitPolicy)
+}
+
+// GetReferencedConnectionLimitPolicies returns all ConnectionLimitPolicies that target HTTP routes attached
+// to this Gateway. ConnectionLimitPolicies that target the Gateway directly are excluded.
+func (g *Gateway) GetReferencedConnectionLimitPolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.ConnectionLimitPolicy)
+}
+
+// GetReferencedCachePolicies returns all CachePolicies that target HTTPRoutes attached to this Gateway.
+func (g *Gateway) GetReferencedCachePolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.CachePolicy)
+}
+
+// GetReferencedObservabilityPolicies returns all ObservabilityPolicies that target routes attached to this Gateway.
+func (g *Gateway) GetReferencedObservabilityPolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.ObservabilityPolicy)
+}
+
+// getReferencedRoutePolicies returns all policies of the kind that target routes attached to this Gateway.
+// Policies that target the Gateway directly are excluded.
 //
 //nolint:gocyclo // complexity is acceptable for this function
-func (g *Gateway) GetReferencedRateLimitPolicies(
+func (g *Gateway) getReferencedRoutePolicies(
 	routes map[RouteKey]*L7Route,
 	allPolicies map[PolicyKey]*Policy,
+	policyKind string,
 ) map[PolicyKey]*Policy {
 	if len(allPolicies) == 0 {
 		return nil
 	}
 
 	gatewayNsName := client.ObjectKeyFromObject(g.Source)
-	referencedRateLimitPolicies := make(map[PolicyKey]*Policy)
+	referencedPolicies := make(map[PolicyKey]*Policy)
 
 	// Create a lookup map of routes attached to this gateway for efficient checking
 	attachedRoutes := make(map[types.NamespacedName]struct{})
@@ -377,7 +537,7 @@ func (g *Gateway) GetReferencedRateLimitPolicies(
 			continue
 		}
 
-		if !policy.Valid || policyKey.GVK.Kind != kinds.RateLimitPolicy {
+		if !policy.Valid || policyKey.GVK.Kind != policyKind {
 			continue
 		}
 
@@ -402,15 +562,64 @@ func (g *Gateway) GetReferencedRateLimitPolicies(
 
 		// Only include policies that target attached routes but NOT the gateway
 		if targetsAttachedRoute && !targetsGateway {
-			referencedRateLimitPolicies[policyKey] = policy
+			referencedPolicies[policyKey] = policy
 		}
 	}
 
-	if len(referencedRateLimitPolicies) == 0 {
+	if len(referencedPolicies) == 0 {
 		return nil
 	}
 
-	return referencedRateLimitPolicies
+	return referencedPolicies
+}
+
+// setZoneSyncForGateways determines which Gateways need zone synchronization between their nginx replicas.
+func setZoneSyncForGateways(
+	gws map[types.NamespacedName]*Gateway,
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) {
+	for _, gw := range gws {
+		if gw == nil || !gw.Valid || gw.Source == nil {
+			continue
+		}
+
+		gwNsName := client.ObjectKeyFromObject(gw.Source)
+		hasGlobalRules := func(policy *Policy) bool {
+			return hasGlobalRateLimitRules(policy, gwNsName)
+		}
+
+		gw.ZoneSync = slices.ContainsFunc(gw.Policies, hasGlobalRules)
+		if gw.ZoneSync {
+			continue
+		}
+
+		for _, policy := range gw.GetReferencedRateLimitPolicies(routes, allPolicies) {
+			if hasGlobalRules(policy) {
+				gw.ZoneSync = true
+				break
+			}
+		}
+	}
+}
+
+// hasGlobalRateLimitRules returns whether the Policy is a RateLimitPolicy with global rules
+// that is valid for the Gateway.
+func hasGlobalRateLimitRules(policy *Policy, gwNsName types.NamespacedName) bool {
+	if !policy.Valid {
+		return false
+	}
+
+	if _, invalid := policy.InvalidForGateways[gwNsName]; invalid {
+		return false
+	}
+
+	rlp, ok := policy.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
+	if !ok || rlp.Spec.RateLimit == nil || rlp.Spec.RateLimit.Global == nil {
+		return false
+	}
+
+	return len(rlp.Spec.RateLimit.Global.Rules) > 0
 }
 
 // isRouteAttachedToGateway checks if the given route is attached to this gateway.
@@ -87,6 +87,8 @@ type Listener struct {
 	ResolvedSecrets []types.NamespacedName
 	// Conditions holds the conditions of the Listener.
 	Conditions []conditions.Condition
+	// Policies holds the policies that target the Listener by its sectionName.
+	Policies []*Policy
 	// SupportedKinds is the list of RouteGroupKinds allowed by the listener.
 	SupportedKinds []v1.RouteGroupKind
 	// Valid shows whether the Listener is valid.
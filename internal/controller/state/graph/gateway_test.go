@@ -17,6 +17,7 @@ import (
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -2111,6 +2112,276 @@ func TestValidateGatewayParametersRef(t *testing.T) {
 	}
 }
 
+func TestValidateTelemetryExporterRefs(t *testing.T) {
+	t.Parallel()
+
+	createSecret := func(name, key string, value []byte) *apiv1.Secret {
+		return &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Data:       map[string][]byte{key: value},
+			Type:       apiv1.SecretTypeOpaque,
+		}
+	}
+
+	clusterSecrets := map[types.NamespacedName]*apiv1.Secret{
+		{Namespace: "test", Name: "auth"}:       createSecret("auth", secrets.HeaderValueKey, []byte("Bearer token\n")),
+		{Namespace: "test", Name: "bad-auth"}:   createSecret("bad-auth", secrets.HeaderValueKey, []byte(`"token"`)),
+		{Namespace: "test", Name: "ca"}:         createSecret("ca", secrets.CAKey, testCert),
+		{Namespace: "test", Name: "missing-ca"}: createSecret("missing-ca", "other", []byte("data")),
+	}
+
+	createNginxProxy := func(headerSecret, caSecret string) *EffectiveNginxProxy {
+		return &EffectiveNginxProxy{
+			Telemetry: &ngfAPIv1alpha2.Telemetry{
+				Exporter: &ngfAPIv1alpha2.TelemetryExporter{
+					Endpoint: helpers.GetPointer("collector:4317"),
+					TLS: &ngfAPIv1alpha2.ExporterTLS{
+						CACertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: caSecret},
+					},
+					Headers: []ngfAPIv1alpha2.ExporterHeader{
+						{Name: "Authorization", SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: headerSecret}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		np           *EffectiveNginxProxy
+		name         string
+		expErrSubstr []string
+		expSecrets   []types.NamespacedName
+	}{
+		{
+			name: "telemetry not enabled",
+			np:   &EffectiveNginxProxy{},
+		},
+		{
+			name: "valid secrets",
+			np:   createNginxProxy("auth", "ca"),
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "auth"},
+				{Namespace: "test", Name: "ca"},
+			},
+		},
+		{
+			name: "invalid secrets",
+			np:   createNginxProxy("bad-auth", "missing-ca"),
+			expErrSubstr: []string{
+				"spec.telemetry.exporter.headers[0].secretRef: Invalid value",
+				"must not contain double quotes",
+				"spec.telemetry.exporter.tls.caCertificateRef: Invalid value",
+				`does not contain the expected key "ca.crt"`,
+			},
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "bad-auth"},
+				{Namespace: "test", Name: "missing-ca"},
+			},
+		},
+		{
+			name: "secrets do not exist",
+			np:   createNginxProxy("nonexistent-auth", "nonexistent-ca"),
+			expErrSubstr: []string{
+				"Secret test/nonexistent-auth does not exist",
+				"Secret test/nonexistent-ca does not exist",
+			},
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "nonexistent-auth"},
+				{Namespace: "test", Name: "nonexistent-ca"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resourceResolver := newResourceResolver(ClusterState{Secrets: clusterSecrets})
+
+			msg := validateTelemetryExporterRefs("test", test.np, resourceResolver)
+			if len(test.expErrSubstr) == 0 {
+				g.Expect(msg).To(BeEmpty())
+			}
+			for _, substr := range test.expErrSubstr {
+				g.Expect(msg).To(ContainSubstring(substr))
+			}
+
+			// resolved Secrets are tracked by the Graph, so that their changes are applied
+			resolvedSecrets := resourceResolver.GetSecrets()
+			g.Expect(resolvedSecrets).To(HaveLen(len(test.expSecrets)))
+			for _, nsName := range test.expSecrets {
+				g.Expect(resolvedSecrets).To(HaveKey(nsName))
+			}
+		})
+	}
+}
+
+func TestValidateMetricsExporterRefs(t *testing.T) {
+	t.Parallel()
+
+	clusterSecrets := map[types.NamespacedName]*apiv1.Secret{
+		client.ObjectKeyFromObject(secretSameNs): secretSameNs,
+		{Namespace: "test", Name: "auth"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"},
+			Data:       map[string][]byte{secrets.HeaderValueKey: []byte("Bearer token")},
+			Type:       apiv1.SecretTypeOpaque,
+		},
+		{Namespace: "test", Name: "other-key"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other-key"},
+			Data:       map[string][]byte{"token": []byte("Bearer token")},
+			Type:       apiv1.SecretTypeOpaque,
+		},
+	}
+
+	createNginxProxy := func(headerSecret, clientSecret string) *EffectiveNginxProxy {
+		return &EffectiveNginxProxy{
+			Telemetry: &ngfAPIv1alpha2.Telemetry{
+				MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{
+					Endpoint: "collector:4317",
+					TLS: &ngfAPIv1alpha2.ExporterTLS{
+						ClientCertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: clientSecret},
+					},
+					Headers: []ngfAPIv1alpha2.ExporterHeader{
+						{Name: "Authorization", SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: headerSecret}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		np           *EffectiveNginxProxy
+		name         string
+		expErrSubstr []string
+		expSecrets   []types.NamespacedName
+	}{
+		{
+			name: "metrics exporter not enabled",
+			np:   &EffectiveNginxProxy{},
+		},
+		{
+			name: "valid secrets",
+			np:   createNginxProxy("auth", "secret"),
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "auth"},
+				{Namespace: "test", Name: "secret"},
+			},
+		},
+		{
+			name: "invalid secrets",
+			np:   createNginxProxy("other-key", "auth"),
+			expErrSubstr: []string{
+				"spec.telemetry.metricsExporter.headers[0].secretRef: Invalid value",
+				`does not contain the expected key "header-value"`,
+				"spec.telemetry.metricsExporter.tls.clientCertificateRef: Invalid value",
+			},
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "other-key"},
+				{Namespace: "test", Name: "auth"},
+			},
+		},
+		{
+			name: "secrets do not exist",
+			np:   createNginxProxy("nonexistent-auth", "nonexistent-client"),
+			expErrSubstr: []string{
+				"Secret test/nonexistent-auth does not exist",
+				"Secret test/nonexistent-client does not exist",
+			},
+			expSecrets: []types.NamespacedName{
+				{Namespace: "test", Name: "nonexistent-auth"},
+				{Namespace: "test", Name: "nonexistent-client"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resourceResolver := newResourceResolver(ClusterState{Secrets: clusterSecrets})
+
+			msg := validateMetricsExporterRefs("test", test.np, resourceResolver)
+			if len(test.expErrSubstr) == 0 {
+				g.Expect(msg).To(BeEmpty())
+			}
+			for _, substr := range test.expErrSubstr {
+				g.Expect(msg).To(ContainSubstring(substr))
+			}
+
+			resolvedSecrets := resourceResolver.GetSecrets()
+			g.Expect(resolvedSecrets).To(HaveLen(len(test.expSecrets)))
+			for _, nsName := range test.expSecrets {
+				g.Expect(resolvedSecrets).To(HaveKey(nsName))
+			}
+		})
+	}
+}
+
+func TestBuildGatewaysMetricsExporterRefsInvalid(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	npNsName := types.NamespacedName{Namespace: "test", Name: "nginx-proxy"}
+
+	gws := map[types.NamespacedName]*v1.Gateway{
+		gwNsName: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+			Spec: v1.GatewaySpec{
+				Infrastructure: &v1.GatewayInfrastructure{
+					ParametersRef: &v1.LocalParametersReference{
+						Group: ngfAPIv1alpha2.GroupName,
+						Kind:  kinds.NginxProxy,
+						Name:  npNsName.Name,
+					},
+				},
+			},
+		},
+	}
+
+	nps := map[types.NamespacedName]*NginxProxy{
+		npNsName: {
+			Source: &ngfAPIv1alpha2.NginxProxy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: npNsName.Namespace, Name: npNsName.Name},
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Telemetry: &ngfAPIv1alpha2.Telemetry{
+						MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{
+							Endpoint: "collector:4317",
+							Headers: []ngfAPIv1alpha2.ExporterHeader{
+								{Name: "Authorization", SecretRef: ngfAPIv1alpha1.LocalObjectReference{Name: "missing"}},
+							},
+						},
+					},
+				},
+			},
+			Valid: true,
+		},
+	}
+
+	resourceResolver := newResourceResolver(ClusterState{})
+	gateways := buildGateways(
+		gws,
+		resourceResolver,
+		&GatewayClass{Valid: true},
+		newReferenceGrantResolver(nil),
+		nps,
+	)
+
+	gw := gateways[gwNsName]
+	g.Expect(gw).ToNot(BeNil())
+	g.Expect(gw.Valid).To(BeTrue())
+
+	// The metrics exporter is removed, so that the NGINX Pods don't mount the missing Secret.
+	g.Expect(gw.EffectiveNginxProxy.Telemetry.MetricsExporter).To(BeNil())
+	g.Expect(nps[npNsName].Source.Spec.Telemetry.MetricsExporter).ToNot(BeNil())
+	g.Expect(gw.Conditions).To(ContainElement(conditions.NewGatewayRefInvalid(
+		"Spec.telemetry.metricsExporter.headers[0].secretRef: Invalid value: " +
+			"{\"Namespace\":\"test\",\"Name\":\"missing\"}: Secret test/missing does not exist",
+	)))
+}
+
 func TestGetReferencedSnippetsFilters(t *testing.T) {
 	t.Parallel()
 
@@ -2404,6 +2675,54 @@ func TestGetReferencedRateLimitPolicies(t *testing.T) {
 		},
 	}
 
+	clp := &Policy{
+		Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "app1",
+				Name:      "app1-conn-limit",
+			},
+		},
+		Valid: true,
+		TargetRefs: []PolicyTargetRef{
+			{
+				Kind:   kinds.HTTPRoute,
+				Nsname: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+			},
+		},
+	}
+
+	cp := &Policy{
+		Source: &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "app1",
+				Name:      "app1-cache",
+			},
+		},
+		Valid: true,
+		TargetRefs: []PolicyTargetRef{
+			{
+				Kind:   kinds.HTTPRoute,
+				Nsname: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+			},
+		},
+	}
+
+	obs := &Policy{
+		Source: &ngfAPIv1alpha2.ObservabilityPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "app1",
+				Name:      "app1-observability",
+			},
+		},
+		Valid: true,
+		TargetRefs: []PolicyTargetRef{
+			{
+				Kind:   kinds.HTTPRoute,
+				Nsname: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+			},
+		},
+	}
+
 	rlpNotAttachedRoute := &Policy{
 		Source: &ngfAPIv1alpha1.RateLimitPolicy{
 			ObjectMeta: metav1.ObjectMeta{
@@ -2655,6 +2974,18 @@ func TestGetReferencedRateLimitPolicies(t *testing.T) {
 			NsName: types.NamespacedName{Namespace: "app8", Name: "listenerset-route-rate-limit"},
 			GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
 		}: rlpListenerSetRoute,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-conn-limit"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+		}: clp,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-cache"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}: cp,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-observability"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ObservabilityPolicy},
+		}: obs,
 	}
 
 	g := NewWithT(t)
@@ -2681,6 +3012,33 @@ func TestGetReferencedRateLimitPolicies(t *testing.T) {
 
 	g.Expect(result).To(Equal(expectedResult))
 
+	// ConnectionLimitPolicies are looked up separately.
+	clpResult := gw.GetReferencedConnectionLimitPolicies(routes, allPolicies)
+	g.Expect(clpResult).To(Equal(map[PolicyKey]*Policy{
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-conn-limit"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+		}: clp,
+	}))
+
+	// CachePolicies are looked up separately.
+	cpResult := gw.GetReferencedCachePolicies(routes, allPolicies)
+	g.Expect(cpResult).To(Equal(map[PolicyKey]*Policy{
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-cache"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}: cp,
+	}))
+
+	// ObservabilityPolicies are looked up separately.
+	obsResult := gw.GetReferencedObservabilityPolicies(routes, allPolicies)
+	g.Expect(obsResult).To(Equal(map[PolicyKey]*Policy{
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "app1-observability"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ObservabilityPolicy},
+		}: obs,
+	}))
+
 	// Test with no routes
 	emptyResult := gw.GetReferencedRateLimitPolicies(map[RouteKey]*L7Route{}, allPolicies)
 	g.Expect(emptyResult).To(BeEmpty())
@@ -2702,6 +3060,142 @@ func TestGetReferencedRateLimitPolicies(t *testing.T) {
 	g.Expect(noAttachedResult).To(BeEmpty())
 }
 
+func TestSetZoneSyncForGateways(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createPolicy := func(name string, global bool, targetRef PolicyTargetRef) *Policy {
+		rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      name,
+			},
+			Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+				RateLimit: &ngfAPIv1alpha1.RateLimit{
+					Local: &ngfAPIv1alpha1.LocalRateLimit{
+						Rules: []ngfAPIv1alpha1.RateLimitRule{{Rate: "10r/s"}},
+					},
+				},
+			},
+		}
+
+		if global {
+			rlp.Spec.RateLimit.Global = &ngfAPIv1alpha1.GlobalRateLimit{
+				Rules: []ngfAPIv1alpha1.RateLimitRule{{Rate: "10r/s"}},
+			}
+		}
+
+		return &Policy{
+			Source:     rlp,
+			Valid:      true,
+			TargetRefs: []PolicyTargetRef{targetRef},
+		}
+	}
+
+	gatewayTargetRef := PolicyTargetRef{Kind: kinds.Gateway, Nsname: gwNsName}
+	routeTargetRef := PolicyTargetRef{
+		Kind:   kinds.HTTPRoute,
+		Nsname: types.NamespacedName{Namespace: "test", Name: "route"},
+	}
+
+	routes := map[RouteKey]*L7Route{
+		{NamespacedName: routeTargetRef.Nsname, RouteType: RouteTypeHTTP}: {
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "route",
+				},
+			},
+			Valid: true,
+			ParentRefs: []ParentRef{
+				{
+					Kind:           kinds.Gateway,
+					NamespacedName: gwNsName,
+				},
+			},
+		},
+	}
+
+	invalidGlobalPolicy := createPolicy("invalid", true, gatewayTargetRef)
+	invalidGlobalPolicy.Valid = false
+
+	invalidForGatewayPolicy := createPolicy("invalid-for-gateway", true, routeTargetRef)
+	invalidForGatewayPolicy.InvalidForGateways = map[types.NamespacedName]struct{}{gwNsName: {}}
+
+	tests := []struct {
+		gwPolicies    []*Policy
+		routePolicies []*Policy
+		name          string
+		gwValid       bool
+		expZoneSync   bool
+	}{
+		{
+			name:    "no policies",
+			gwValid: true,
+		},
+		{
+			name:          "local rules only",
+			gwPolicies:    []*Policy{createPolicy("gw-local", false, gatewayTargetRef)},
+			routePolicies: []*Policy{createPolicy("route-local", false, routeTargetRef)},
+			gwValid:       true,
+		},
+		{
+			name:        "global rules on gateway",
+			gwPolicies:  []*Policy{createPolicy("gw-global", true, gatewayTargetRef)},
+			gwValid:     true,
+			expZoneSync: true,
+		},
+		{
+			name:          "global rules on route",
+			routePolicies: []*Policy{createPolicy("route-global", true, routeTargetRef)},
+			gwValid:       true,
+			expZoneSync:   true,
+		},
+		{
+			name:          "invalid global policies",
+			gwPolicies:    []*Policy{invalidGlobalPolicy},
+			routePolicies: []*Policy{invalidForGatewayPolicy},
+			gwValid:       true,
+		},
+		{
+			name:       "invalid gateway",
+			gwPolicies: []*Policy{createPolicy("gw-global", true, gatewayTargetRef)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gw := &Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: gwNsName.Namespace,
+						Name:      gwNsName.Name,
+					},
+				},
+				Policies: test.gwPolicies,
+				Valid:    test.gwValid,
+			}
+
+			allPolicies := make(map[PolicyKey]*Policy)
+			for _, policy := range append(test.gwPolicies, test.routePolicies...) {
+				key := PolicyKey{
+					NsName: client.ObjectKeyFromObject(policy.Source),
+					GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+				}
+				allPolicies[key] = policy
+			}
+
+			setZoneSyncForGateways(map[types.NamespacedName]*Gateway{gwNsName: gw}, routes, allPolicies)
+
+			g.Expect(gw.ZoneSync).To(Equal(test.expZoneSync))
+		})
+	}
+}
+
 func TestValidateUnsupportedGatewayFields(t *testing.T) {
 	t.Parallel()
 
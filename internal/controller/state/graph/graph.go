@@ -51,6 +51,8 @@ type ClusterState struct {
 	NGFPolicies           map[PolicyKey]policies.Policy
 	SnippetsFilters       map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter
 	AuthenticationFilters map[types.NamespacedName]*ngfAPIv1alpha1.AuthenticationFilter
+	CanaryFilters         map[types.NamespacedName]*ngfAPIv1alpha1.CanaryFilter
+	MirrorFilters         map[types.NamespacedName]*ngfAPIv1alpha1.MirrorFilter
 	InferencePools        map[types.NamespacedName]*inference.InferencePool
 	ListenerSets          map[types.NamespacedName]*gatewayv1.ListenerSet
 	APPolicies            map[types.NamespacedName]*unstructured.Unstructured
@@ -99,15 +101,22 @@ type Graph struct {
 	ReferencedAPPolicies map[types.NamespacedName]*unstructured.Unstructured
 	// ReferencedAPLogConfs includes APLogConf resources referenced by WAFPolicy resources.
 	ReferencedAPLogConfs map[types.NamespacedName]*unstructured.Unstructured
-	// ReferencedWAFSecrets includes Secrets referenced by WAFPolicy (auth and TLS CA).
+	// ReferencedWAFSecrets includes Secrets referenced by WAFPolicy (auth, TLS CA, and signing keys).
 	// Similar to ReferencedSecrets, it includes entries for Secrets that do not exist in the cluster.
 	// We need such entries so that we can query the Graph to determine if a Secret is referenced
 	// by a WAFPolicy, including the case when the Secret is newly created.
 	ReferencedWAFSecrets map[types.NamespacedName]*v1.Secret
+	// ReferencedWAFConfigMaps includes ConfigMaps referenced by WAFPolicy (signing keys).
+	// Like ReferencedWAFSecrets, it includes entries for ConfigMaps that do not exist in the cluster.
+	ReferencedWAFConfigMaps map[types.NamespacedName]*v1.ConfigMap
 	// SnippetsFilters holds all the SnippetsFilters.
 	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
 	// AuthenticationFilters holds all the AuthenticationFilters.
 	AuthenticationFilters map[types.NamespacedName]*AuthenticationFilter
+	// CanaryFilters holds all the CanaryFilters.
+	CanaryFilters map[types.NamespacedName]*CanaryFilter
+	// MirrorFilters holds all the MirrorFilters.
+	MirrorFilters map[types.NamespacedName]*MirrorFilter
 	// ExternalLoadBalancers holds all the processed ExternalLoadBalancer resources.
 	ExternalLoadBalancers map[types.NamespacedName]*ExternalLoadBalancer
 	// ListenerSets holds all the ListenerSets.
@@ -173,6 +182,7 @@ func cloneListeners(src []*Listener) []*Listener {
 		listenerCopy.CACertificateRefs = slices.Clone(listener.CACertificateRefs)
 		listenerCopy.ResolvedSecrets = slices.Clone(listener.ResolvedSecrets)
 		listenerCopy.SupportedKinds = slices.Clone(listener.SupportedKinds)
+		listenerCopy.Policies = slices.Clone(listener.Policies)
 		cloned = append(cloned, &listenerCopy)
 	}
 
@@ -232,7 +242,8 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		return exists || plusSecretExists || wafAuthSecretExists || plmSecretExists
 	case *v1.ConfigMap:
 		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, wafSigningKeyExists := g.ReferencedWAFConfigMaps[nsname]
+		return exists || wafSigningKeyExists
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -409,6 +420,10 @@ func BuildGraph(
 		validators.GenericValidator,
 		featureFlags.Plus,
 	)
+
+	processedCanaryFilters := processCanaryFilters(state.CanaryFilters)
+	processedMirrorFilters := processMirrorFilters(state.MirrorFilters, validators.GenericValidator)
+
 	routes := buildRoutesForGateways(
 		validators.HTTPFieldsValidator,
 		state.HTTPRoutes,
@@ -416,6 +431,8 @@ func BuildGraph(
 		gws,
 		processedSnippetsFilters,
 		processedAuthenticationFilters,
+		processedCanaryFilters,
+		processedMirrorFilters,
 		state.InferencePools,
 		featureFlags,
 		listenerSets,
@@ -463,6 +480,7 @@ func BuildGraph(
 			Fetcher:            wafFetcher,
 			PLMFetcher:         plmFetcher,
 			Secrets:            state.Secrets,
+			ConfigMaps:         state.ConfigMaps,
 			PreviousBundles:    previousWAFBundles,
 			APPolicies:         state.APPolicies,
 			APLogConfs:         state.APLogConfs,
@@ -478,13 +496,14 @@ func BuildGraph(
 		state.NGFPolicies,
 		validators.PolicyValidator,
 		routes,
+		l4routes,
 		referencedServices,
 		gws,
 		wafInput,
 	)
 
 	// add status conditions to each targetRef based on the policies that affect them.
-	addPolicyAffectedStatusToTargetRefs(processedPolicies, routes, gws)
+	addPolicyAffectedStatusToTargetRefs(processedPolicies, routes, l4routes, gws)
 
 	setPlusSecretContent(state.Secrets, plusSecrets)
 
@@ -492,11 +511,13 @@ func BuildGraph(
 	var referencedAPPolicies map[types.NamespacedName]*unstructured.Unstructured
 	var referencedAPLogConfs map[types.NamespacedName]*unstructured.Unstructured
 	var referencedWAFAuthSecrets map[types.NamespacedName]*v1.Secret
+	var referencedWAFConfigMaps map[types.NamespacedName]*v1.ConfigMap
 	if wafOutput != nil {
 		referencedWAFBundles = wafOutput.Bundles
 		referencedAPPolicies = wafOutput.ReferencedAPPolicies
 		referencedAPLogConfs = wafOutput.ReferencedAPLogConfs
 		referencedWAFAuthSecrets = wafOutput.ReferencedWAFSecrets
+		referencedWAFConfigMaps = wafOutput.ReferencedWAFConfigMaps
 	}
 
 	g := &Graph{
@@ -515,6 +536,8 @@ func BuildGraph(
 		NGFPolicies:                processedPolicies,
 		SnippetsFilters:            processedSnippetsFilters,
 		AuthenticationFilters:      processedAuthenticationFilters,
+		CanaryFilters:              processedCanaryFilters,
+		MirrorFilters:              processedMirrorFilters,
 		ExternalLoadBalancers:      processedExternalLoadBalancers,
 		ListenerSets:               listenerSets,
 		PlusSecrets:                plusSecrets,
@@ -523,10 +546,13 @@ func BuildGraph(
 		ReferencedAPPolicies:       referencedAPPolicies,
 		ReferencedAPLogConfs:       referencedAPLogConfs,
 		ReferencedWAFSecrets:       referencedWAFAuthSecrets,
+		ReferencedWAFConfigMaps:    referencedWAFConfigMaps,
 	}
 
 	g.attachPolicies(validators.PolicyValidator, controllerName, logger)
+	setZoneSyncForGateways(g.Gateways, g.Routes, g.NGFPolicies)
 	validateExternalAuthConflicts(routes)
+	validateTimeoutsConflicts(routes)
 
 	return g
 }
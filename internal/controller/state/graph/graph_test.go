@@ -2314,7 +2314,8 @@ func TestBuildGraph(t *testing.T) {
 					GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: testNs, Name: "gateway-1"}: {}},
 				},
 				client.ObjectKeyFromObject(svc1): {
-					GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: testNs, Name: "gateway-1"}: {}},
+					GatewayNsNames:   map[types.NamespacedName]struct{}{{Namespace: testNs, Name: "gateway-1"}: {}},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: testNs, Name: "gateway-1"}: {}},
 				},
 				client.ObjectKeyFromObject(inferenceSvc): {
 					GatewayNsNames: map[types.NamespacedName]struct{}{{Namespace: testNs, Name: "gateway-1"}: {}},
@@ -2681,6 +2682,12 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	wafSigningKeyConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "waf-signing-key",
+		},
+	}
 	sameNamespaceDifferentNameConfigMap := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: testNs,
@@ -2764,6 +2771,9 @@ func TestIsReferenced(t *testing.T) {
 		ReferencedAPLogConfs: map[types.NamespacedName]*unstructured.Unstructured{
 			client.ObjectKeyFromObject(apLogConfReferenced): apLogConfReferenced,
 		},
+		ReferencedWAFConfigMaps: map[types.NamespacedName]*v1.ConfigMap{
+			client.ObjectKeyFromObject(wafSigningKeyConfigMap): wafSigningKeyConfigMap,
+		},
 	}
 
 	tests := []struct {
@@ -2899,6 +2909,12 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: true,
 		},
+		{
+			name:     "ConfigMap referenced by a WAFPolicy as a signing key is referenced",
+			resource: wafSigningKeyConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 		{
 			name:     "ConfigMap not in ReferencedConfigMaps with same Namespace and different Name is not referenced",
 			resource: sameNamespaceDifferentNameConfigMap,
@@ -22,6 +22,8 @@ func buildGRPCRoute(
 	gws map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
 	authenticationFilters map[types.NamespacedName]*AuthenticationFilter,
+	canaryFilters map[types.NamespacedName]*CanaryFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 	featureFlags FeatureFlags,
 	listenerSets map[types.NamespacedName]*ListenerSet,
 ) *L7Route {
@@ -59,6 +61,8 @@ func buildGRPCRoute(
 		r.Source.GetNamespace(),
 		snippetsFilters,
 		authenticationFilters,
+		canaryFilters,
+		mirrorFilters,
 	)
 
 	grpcRouteNsName := types.NamespacedName{
@@ -87,6 +91,7 @@ func buildGRPCMirrorRoutes(
 	route *v1.GRPCRoute,
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 	featureFlags FeatureFlags,
 	listenerSets map[types.NamespacedName]*ListenerSet,
 ) {
@@ -125,7 +130,9 @@ func buildGRPCMirrorRoutes(
 					tmpMirrorRoute,
 					gateways,
 					snippetsFilters,
-					nil,
+					nil, // Mirror routes can't use NGINX auth directives.
+					nil, // Mirror routes have a single backend, so there is nothing to override.
+					mirrorFilters,
 					featureFlags,
 					listenerSets,
 				)
@@ -169,9 +176,10 @@ func buildGRPCMirrorRouteRule(
 func removeGRPCMirrorFilters(filters []v1.GRPCRouteFilter) []v1.GRPCRouteFilter {
 	var newFilters []v1.GRPCRouteFilter
 	for _, filter := range filters {
-		if filter.Type != v1.GRPCRouteFilterRequestMirror {
-			newFilters = append(newFilters, filter)
+		if filter.Type == v1.GRPCRouteFilterRequestMirror || isCanaryFilterRef(filter.ExtensionRef) {
+			continue
 		}
+		newFilters = append(newFilters, filter)
 	}
 	return newFilters
 }
@@ -212,6 +220,11 @@ func processGRPCRouteRule(
 
 	errors = errors.append(filterErrors)
 
+	if mirrorErrs := validateGRPCMirrorFilters(routeFilters.Filters, rulePath.Child("filters")); len(mirrorErrs) > 0 {
+		errors.invalid = append(errors.invalid, mirrorErrs...)
+		routeFilters.Valid = false
+	}
+
 	var sp *SessionPersistenceConfig
 	if specRule.SessionPersistence != nil {
 		spConfig, spErrors := processSessionPersistenceConfig(
@@ -268,6 +281,7 @@ func processGRPCRouteRule(
 	}
 
 	return RouteRule{
+		Name:             getSectionName(specRule.Name),
 		ValidMatches:     validMatches,
 		Matches:          ConvertGRPCMatches(specRule.Matches),
 		Filters:          routeFilters,
@@ -308,6 +322,8 @@ func processGRPCRouteRules(
 		rules[ruleIdx] = rr
 	}
 
+	allRulesErrors.warn = append(allRulesErrors.warn, validateRuleNames(rules)...)
+
 	conds = make([]conditions.Condition, 0, 2)
 	valid = true
 
@@ -510,13 +526,6 @@ func checkForUnsupportedGRPCFields(
 ) field.ErrorList {
 	var ruleErrors field.ErrorList
 
-	if rule.Name != nil {
-		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("name"),
-			"Name",
-		))
-	}
-
 	if !featureFlags.Plus && rule.SessionPersistence != nil {
 		ruleErrors = append(ruleErrors, field.Forbidden(
 			rulePath.Child("sessionPersistence"),
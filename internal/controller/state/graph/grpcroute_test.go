@@ -445,6 +445,8 @@ func TestBuildGRPCRoutes(t *testing.T) {
 				snippetsFilters,
 				authenticationFilters,
 				nil,
+				nil,
+				nil,
 				FeatureFlags{
 					Plus:         true,
 					Experimental: true,
@@ -615,14 +617,14 @@ func TestBuildGRPCRoute(t *testing.T) {
 		[]v1.GRPCRouteRule{methodMatchRule, headersMatchInvalid},
 	)
 
-	grValidWithUnsupportedField := createGRPCRoute(
-		"gr-valid-unsupported",
+	grValidWithNamedRule := createGRPCRoute(
+		"gr-valid-named-rule",
 		gatewayNsName.Name,
 		"example.com",
 		v1.Kind(kinds.Gateway),
 		[]v1.GRPCRouteRule{
 			{
-				Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
+				Name: helpers.GetPointer[v1.SectionName]("named-rule"),
 				Matches: []v1.GRPCRouteMatch{
 					{
 						Method: &v1.GRPCMethodMatch{
@@ -636,14 +638,14 @@ func TestBuildGRPCRoute(t *testing.T) {
 		},
 	)
 
-	grInvalidWithUnsupportedField := createGRPCRoute(
-		"gr-invalid-unsupported",
+	grInvalidWithNamedRule := createGRPCRoute(
+		"gr-invalid-named-rule",
 		gatewayNsName.Name,
 		"example.com",
 		v1.Kind(kinds.Gateway),
 		[]v1.GRPCRouteRule{
 			{
-				Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
+				Name: helpers.GetPointer[v1.SectionName]("named-rule"),
 				Matches: []v1.GRPCRouteMatch{
 					{
 						Method: &v1.GRPCMethodMatch{
@@ -1913,15 +1915,15 @@ func TestBuildGRPCRoute(t *testing.T) {
 		},
 		{
 			validator: createAllValidValidator(),
-			gr:        grValidWithUnsupportedField,
+			gr:        grValidWithNamedRule,
 			expected: &L7Route{
 				RouteType: RouteTypeGRPC,
-				Source:    grValidWithUnsupportedField,
+				Source:    grValidWithNamedRule,
 				ParentRefs: []ParentRef{
 					{
 						Idx:                 0,
 						EffectiveNginxProxy: gw.EffectiveNginxProxy,
-						SectionName:         grValidWithUnsupportedField.Spec.ParentRefs[0].SectionName,
+						SectionName:         grValidWithNamedRule.Spec.ParentRefs[0].SectionName,
 						Kind:                v1.Kind(kinds.Gateway),
 						NamespacedName:      gatewayNsName,
 						GatewayNsName:       gatewayNsName,
@@ -1930,36 +1932,34 @@ func TestBuildGRPCRoute(t *testing.T) {
 				Valid:      true,
 				Attachable: true,
 				Spec: L7RouteSpec{
-					Hostnames: grValidWithUnsupportedField.Spec.Hostnames,
+					Hostnames: grValidWithNamedRule.Spec.Hostnames,
 					Rules: []RouteRule{
 						{
+							Name:         "named-rule",
 							ValidMatches: true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
 							},
-							Matches:          ConvertGRPCMatches(grValidWithUnsupportedField.Spec.Rules[0].Matches),
+							Matches:          ConvertGRPCMatches(grValidWithNamedRule.Spec.Rules[0].Matches),
 							RouteBackendRefs: []RouteBackendRef{},
 						},
 					},
 				},
-				Conditions: []conditions.Condition{
-					conditions.NewRouteAcceptedUnsupportedField("spec.rules[0].name: Forbidden: Name"),
-				},
 			},
-			name: "valid route with unsupported field",
+			name: "valid route with named rule",
 		},
 		{
 			validator: createAllValidValidator(),
-			gr:        grInvalidWithUnsupportedField,
+			gr:        grInvalidWithNamedRule,
 			expected: &L7Route{
 				RouteType: RouteTypeGRPC,
-				Source:    grInvalidWithUnsupportedField,
+				Source:    grInvalidWithNamedRule,
 				ParentRefs: []ParentRef{
 					{
 						Idx:                 0,
 						EffectiveNginxProxy: gw.EffectiveNginxProxy,
-						SectionName:         grInvalidWithUnsupportedField.Spec.ParentRefs[0].SectionName,
+						SectionName:         grInvalidWithNamedRule.Spec.ParentRefs[0].SectionName,
 						Kind:                v1.Kind(kinds.Gateway),
 						NamespacedName:      gatewayNsName,
 						GatewayNsName:       gatewayNsName,
@@ -1968,28 +1968,28 @@ func TestBuildGRPCRoute(t *testing.T) {
 				Valid:      false,
 				Attachable: true,
 				Spec: L7RouteSpec{
-					Hostnames: grInvalidWithUnsupportedField.Spec.Hostnames,
+					Hostnames: grInvalidWithNamedRule.Spec.Hostnames,
 					Rules: []RouteRule{
 						{
+							Name:         "named-rule",
 							ValidMatches: false,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
 							},
-							Matches:          ConvertGRPCMatches(grInvalidWithUnsupportedField.Spec.Rules[0].Matches),
+							Matches:          ConvertGRPCMatches(grInvalidWithNamedRule.Spec.Rules[0].Matches),
 							RouteBackendRefs: []RouteBackendRef{},
 						},
 					},
 				},
 				Conditions: []conditions.Condition{
-					conditions.NewRouteAcceptedUnsupportedField("spec.rules[0].name: Forbidden: Name"),
 					conditions.NewRouteUnsupportedValue(
 						"All rules are invalid: [spec.rules[0].matches[0].method.service: Required value: service is required, " +
 							"spec.rules[0].matches[0].method.method: Required value: method is required]",
 					),
 				},
 			},
-			name: "invalid route with unsupported field",
+			name: "invalid route with named rule",
 		},
 		{
 			validator: createDurationValidator(&durationSP),
@@ -2063,6 +2063,8 @@ func TestBuildGRPCRoute(t *testing.T) {
 				gws,
 				snippetsFilters,
 				authenticationFilters,
+				nil,
+				nil,
 				FeatureFlags{
 					Plus:         test.plus,
 					Experimental: test.experimental,
@@ -2300,11 +2302,13 @@ func TestBuildGRPCRouteWithMirrorRoutes(t *testing.T) {
 				test.gateways,
 				snippetsFilters,
 				nil,
+				nil,
+				nil,
 				featureFlags,
 				listenerSets,
 			)
 			g.Expect(l7route).NotTo(BeNil())
-			buildGRPCMirrorRoutes(routes, l7route, test.gr, test.gateways, snippetsFilters, featureFlags, listenerSets)
+			buildGRPCMirrorRoutes(routes, l7route, test.gr, test.gateways, snippetsFilters, nil, featureFlags, listenerSets)
 
 			obj, ok := expectedMirrorRoute.Source.(*v1.GRPCRoute)
 			g.Expect(ok).To(BeTrue())
@@ -2487,23 +2491,15 @@ func TestProcessGRPCRouteRule_UnsupportedFields(t *testing.T) {
 			specRule:       v1.GRPCRouteRule{}, // Empty rule, no unsupported fields
 			expectedErrors: 0,
 		},
-		{
-			name: "One unsupported field",
-			specRule: v1.GRPCRouteRule{
-				Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
-			},
-			expectedErrors: 1,
-		},
 		{
 			name: "Multiple unsupported fields",
 			specRule: v1.GRPCRouteRule{
-				Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
 				SessionPersistence: helpers.GetPointer(
 					v1.SessionPersistence{
 						Type: helpers.GetPointer(v1.SessionPersistenceType("unsupported-session-persistence")),
 					}),
 			},
-			expectedErrors: 3,
+			expectedErrors: 2,
 		},
 	}
 
@@ -2553,23 +2549,9 @@ func TestProcessGRPCRouteRules_UnsupportedFields(t *testing.T) {
 			expectedWarns: 0,
 		},
 		{
-			name: "One unsupported field",
+			name: "Session persistence unsupported with Plus disabled",
 			specRules: []v1.GRPCRouteRule{
 				{
-					Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
-				},
-			},
-			expectedValid: true,
-			expectedConds: []conditions.Condition{
-				conditions.NewRouteAcceptedUnsupportedField("spec.rules[0].name: Forbidden: Name"),
-			},
-			expectedWarns: 1,
-		},
-		{
-			name: "Multiple unsupported fields",
-			specRules: []v1.GRPCRouteRule{
-				{
-					Name: helpers.GetPointer[v1.SectionName]("unsupported-name"),
 					SessionPersistence: helpers.GetPointer(v1.SessionPersistence{
 						Type:        helpers.GetPointer(v1.CookieBasedSessionPersistence),
 						SessionName: helpers.GetPointer("session_id"),
@@ -2578,16 +2560,15 @@ func TestProcessGRPCRouteRules_UnsupportedFields(t *testing.T) {
 			},
 			expectedValid: true,
 			expectedConds: []conditions.Condition{
-				conditions.NewRouteAcceptedUnsupportedField(fmt.Sprintf("[spec.rules[0].name: Forbidden: Name, "+
-					"spec.rules[0].sessionPersistence: Forbidden: "+
+				conditions.NewRouteAcceptedUnsupportedField(fmt.Sprintf("spec.rules[0].sessionPersistence: Forbidden: "+
 					"%s"+
-					" OSS users can use `ip_hash` load balancing method via the UpstreamSettingsPolicy for session affinity.]",
+					" OSS users can use `ip_hash` load balancing method via the UpstreamSettingsPolicy for session affinity.",
 					spErrMsg,
 				)),
 			},
 			experimental:  true,
 			plusEnabled:   false,
-			expectedWarns: 2,
+			expectedWarns: 1,
 		},
 		{
 			name: "Session persistence unsupported with experimental disabled",
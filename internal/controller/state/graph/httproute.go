@@ -2,7 +2,9 @@ package graph
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -32,6 +34,8 @@ func buildHTTPRoute(
 	gws map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
 	authenticationFilters map[types.NamespacedName]*AuthenticationFilter,
+	canaryFilters map[types.NamespacedName]*CanaryFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 	inferencePools map[types.NamespacedName]*inference.InferencePool,
 	featureFlags FeatureFlags,
 	listenerSets map[types.NamespacedName]*ListenerSet,
@@ -71,6 +75,8 @@ func buildHTTPRoute(
 		r.Source.GetNamespace(),
 		snippetsFilters,
 		authenticationFilters,
+		canaryFilters,
+		mirrorFilters,
 	)
 
 	nsName := types.NamespacedName{
@@ -100,6 +106,7 @@ func buildHTTPMirrorRoutes(
 	route *v1.HTTPRoute,
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 	featureFlags FeatureFlags,
 	listenerSets map[types.NamespacedName]*ListenerSet,
 ) {
@@ -139,6 +146,8 @@ func buildHTTPMirrorRoutes(
 					gateways,
 					snippetsFilters,
 					nil, // Mirror routes can't use NGINX auth directives.
+					nil, // Mirror routes have a single backend, so there is nothing to override.
+					mirrorFilters,
 					nil,
 					featureFlags,
 					listenerSets,
@@ -183,9 +192,10 @@ func buildHTTPMirrorRouteRule(
 func removeHTTPMirrorFilters(filters []v1.HTTPRouteFilter) []v1.HTTPRouteFilter {
 	var newFilters []v1.HTTPRouteFilter
 	for _, filter := range filters {
-		if filter.Type != v1.HTTPRouteFilterRequestMirror {
-			newFilters = append(newFilters, filter)
+		if filter.Type == v1.HTTPRouteFilterRequestMirror || isCanaryFilterRef(filter.ExtensionRef) {
+			continue
 		}
+		newFilters = append(newFilters, filter)
 	}
 	return newFilters
 }
@@ -247,6 +257,20 @@ func processHTTPRouteRule(
 		}
 	}
 
+	var timeouts *RouteTimeouts
+	if specRule.Timeouts != nil {
+		var timeoutErrors routeRuleErrors
+		timeouts, timeoutErrors = processRouteTimeouts(specRule.Timeouts, rulePath.Child("timeouts"), validator)
+		errors = errors.append(timeoutErrors)
+	}
+
+	var retry *RouteRetry
+	if specRule.Retry != nil {
+		var retryErrors routeRuleErrors
+		retry, retryErrors = processRouteRetry(specRule.Retry, rulePath.Child("retry"), validator)
+		errors = errors.append(retryErrors)
+	}
+
 	backendRefs, backendRefErrors := getBackendRefs(specRule, routeNsName.Namespace, inferencePools, rulePath, sp)
 	errors = errors.append(backendRefErrors)
 
@@ -275,13 +299,141 @@ func processHTTPRouteRule(
 	}
 
 	return RouteRule{
+		Name:             getSectionName(specRule.Name),
 		ValidMatches:     validMatches,
 		Matches:          specRule.Matches,
 		Filters:          routeFilters,
 		RouteBackendRefs: backendRefs,
+		Timeouts:         timeouts,
+		Retry:            retry,
+	}, errors
+}
+
+// processRouteRetry validates the retry configuration of an HTTPRoute rule.
+// An invalid retry configuration is ignored and reported as a warning, because it doesn't prevent the rule
+// from routing traffic.
+func processRouteRetry(
+	retry *v1.HTTPRouteRetry,
+	retryPath *field.Path,
+	validator validation.HTTPFieldsValidator,
+) (*RouteRetry, routeRuleErrors) {
+	var errors routeRuleErrors
+
+	codes := make([]int, 0, len(retry.Codes))
+	for i, code := range retry.Codes {
+		if valid, supportedValues := validator.ValidateRetryStatusCode(int(code)); !valid {
+			errors.warn = append(errors.warn, field.NotSupported(retryPath.Child("codes").Index(i), code, supportedValues))
+			continue
+		}
+
+		codes = append(codes, int(code))
+	}
+
+	// Zero attempts disables the retries.
+	if retry.Attempts != nil && *retry.Attempts < 0 {
+		errors.warn = append(errors.warn, field.Invalid(retryPath.Child("attempts"), *retry.Attempts, "must not be negative"))
+	}
+
+	if len(errors.warn) > 0 {
+		errors.warn = append(errors.warn, field.Invalid(
+			retryPath,
+			retryPath.String(),
+			"retry is ignored because there are errors in the configuration",
+		))
+
+		return nil, errors
+	}
+
+	return &RouteRetry{
+		Attempts: retry.Attempts,
+		Codes:    codes,
 	}, errors
 }
 
+// processRouteTimeouts validates the timeouts of an HTTPRoute rule and converts them to the NGINX duration format.
+// Invalid timeouts are ignored and reported as warnings, because they don't prevent the rule from routing traffic.
+func processRouteTimeouts(
+	timeouts *v1.HTTPRouteTimeouts,
+	timeoutsPath *field.Path,
+	validator validation.HTTPFieldsValidator,
+) (*RouteTimeouts, routeRuleErrors) {
+	var (
+		errors routeRuleErrors
+		result RouteTimeouts
+	)
+
+	if timeouts.Request != nil {
+		request, err := convertRouteTimeout(*timeouts.Request, validator)
+		if err != nil {
+			errors.warn = append(errors.warn, field.Invalid(timeoutsPath.Child("request"), *timeouts.Request, err.Error()))
+		}
+		result.Request = request
+	}
+
+	if timeouts.BackendRequest != nil {
+		backendRequest, err := convertRouteTimeout(*timeouts.BackendRequest, validator)
+		if err != nil {
+			errors.warn = append(
+				errors.warn,
+				field.Invalid(timeoutsPath.Child("backendRequest"), *timeouts.BackendRequest, err.Error()),
+			)
+		}
+		result.BackendRequest = backendRequest
+	}
+
+	if len(errors.warn) == 0 && backendRequestExceedsRequest(timeouts) {
+		errors.warn = append(errors.warn, field.Invalid(
+			timeoutsPath.Child("backendRequest"),
+			*timeouts.BackendRequest,
+			"backendRequest timeout cannot be longer than request timeout",
+		))
+	}
+
+	if len(errors.warn) > 0 {
+		errors.warn = append(errors.warn, field.Invalid(
+			timeoutsPath,
+			timeoutsPath.String(),
+			"timeouts are ignored because there are errors in the configuration",
+		))
+
+		return nil, errors
+	}
+
+	if result.Request == "" && result.BackendRequest == "" {
+		return nil, errors
+	}
+
+	return &result, errors
+}
+
+// convertRouteTimeout converts a Gateway API duration into the NGINX duration format.
+// A zero duration disables the timeout and is converted to "0s".
+func convertRouteTimeout(duration v1.Duration, validator validation.HTTPFieldsValidator) (string, error) {
+	td, err := time.ParseDuration(string(duration))
+	if err != nil {
+		return "", fmt.Errorf("invalid duration: %w", err)
+	}
+
+	if td == 0 {
+		return "0s", nil
+	}
+
+	return validator.ValidateDuration(string(duration))
+}
+
+// backendRequestExceedsRequest returns true if both timeouts are enabled and the backendRequest timeout
+// is longer than the request timeout. The durations must be valid.
+func backendRequestExceedsRequest(timeouts *v1.HTTPRouteTimeouts) bool {
+	if timeouts.Request == nil || timeouts.BackendRequest == nil {
+		return false
+	}
+
+	request, _ := time.ParseDuration(string(*timeouts.Request))
+	backendRequest, _ := time.ParseDuration(string(*timeouts.BackendRequest))
+
+	return request != 0 && backendRequest > request
+}
+
 func getBackendRefs(
 	routeRule v1.HTTPRouteRule,
 	routeNamespace string,
@@ -379,6 +531,8 @@ func processHTTPRouteRules(
 		rules[ruleIdx] = rr
 	}
 
+	allRulesErrors.warn = append(allRulesErrors.warn, validateRuleNames(rules)...)
+
 	conds = make([]conditions.Condition, 0, 2)
 
 	valid = true
@@ -672,22 +826,10 @@ func checkForUnsupportedHTTPFields(
 ) field.ErrorList {
 	var ruleErrors field.ErrorList
 
-	if rule.Name != nil {
-		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("name"),
-			"Name",
-		))
-	}
-	if rule.Timeouts != nil {
+	if rule.Retry != nil && rule.Retry.Backoff != nil {
 		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("timeouts"),
-			"Timeouts",
-		))
-	}
-	if rule.Retry != nil {
-		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("retry"),
-			"Retry",
+			rulePath.Child("retry").Child("backoff"),
+			"Backoff is not supported, NGINX passes a failed request to the next backend without delay",
 		))
 	}
 
@@ -792,3 +934,31 @@ func validateExternalAuthConflicts(routes map[RouteKey]*L7Route) {
 		}
 	}
 }
+
+// validateTimeoutsConflicts checks all route rules for conflicts between rule timeouts and a ProxySettingsPolicy
+// that also sets timeouts on the same route. Both would emit the proxy timeout directives on the same location,
+// so the rule timeouts are ignored in favor of the policy.
+func validateTimeoutsConflicts(routes map[RouteKey]*L7Route) {
+	for _, route := range routes {
+		for ruleIdx, rule := range route.Spec.Rules {
+			if rule.Timeouts == nil {
+				continue
+			}
+
+			for _, pol := range slices.Concat(route.Policies, rule.Policies) {
+				psp, ok := pol.Source.(*ngfAPI.ProxySettingsPolicy)
+				if !pol.Valid || !ok || psp.Spec.Timeout == nil {
+					continue
+				}
+
+				route.Spec.Rules[ruleIdx].Timeouts = nil
+				msg := fmt.Sprintf(
+					"spec.rules[%d].timeouts: conflicts with ProxySettingsPolicy %s/%s timeout",
+					ruleIdx, psp.Namespace, psp.Name,
+				)
+				mergeOrAppendRouteCondition(route, conditions.NewRouteAcceptedUnsupportedField(msg))
+				break
+			}
+		}
+	}
+}
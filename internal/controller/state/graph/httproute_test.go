@@ -640,6 +640,8 @@ func TestBuildHTTPRoutes(t *testing.T) {
 				snippetsFilters,
 				authtenticationFilters,
 				nil,
+				nil,
+				nil,
 				FeatureFlags{
 					Plus:         true,
 					Experimental: true,
@@ -696,7 +698,9 @@ func TestBuildHTTPRoute(t *testing.T) {
 		gatewayv1.Kind(kinds.Gateway),
 		"/",
 	)
-	hrValidWithUnsupportedField.Spec.Rules[0].Name = helpers.GetPointer[gatewayv1.SectionName]("unsupported-name")
+	hrValidWithUnsupportedField.Spec.Rules[0].Retry = &gatewayv1.HTTPRouteRetry{
+		Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
+	}
 
 	sp := &gatewayv1.SessionPersistence{
 		SessionName:     helpers.GetPointer("http-route-session"),
@@ -1998,11 +2002,15 @@ func TestBuildHTTPRoute(t *testing.T) {
 							},
 							Matches:          hrValidWithUnsupportedField.Spec.Rules[0].Matches,
 							RouteBackendRefs: []RouteBackendRef{expRouteBackendRef},
+							Retry:            &RouteRetry{Codes: []int{}},
 						},
 					},
 				},
 				Conditions: []conditions.Condition{
-					conditions.NewRouteAcceptedUnsupportedField("spec.rules[0].name: Forbidden: Name"),
+					conditions.NewRouteAcceptedUnsupportedField(
+						"spec.rules[0].retry.backoff: Forbidden: Backoff is not supported, " +
+							"NGINX passes a failed request to the next backend without delay",
+					),
 				},
 			},
 			name: "valid route with unsupported field",
@@ -2159,6 +2167,8 @@ func TestBuildHTTPRoute(t *testing.T) {
 				gws,
 				snippetsFilters,
 				authenticationFilters,
+				nil,
+				nil,
 				inferencePools,
 				FeatureFlags{
 					Plus:         test.plus,
@@ -2358,12 +2368,14 @@ func TestBuildHTTPRouteWithMirrorRoutes(t *testing.T) {
 				snippetsFilters,
 				nil,
 				nil,
+				nil,
+				nil,
 				featureFlags,
 				listenerSets,
 			)
 			g.Expect(l7route).NotTo(BeNil())
 
-			buildHTTPMirrorRoutes(routes, l7route, test.hr, test.gateways, snippetsFilters, featureFlags, listenerSets)
+			buildHTTPMirrorRoutes(routes, l7route, test.hr, test.gateways, snippetsFilters, nil, featureFlags, listenerSets)
 
 			obj, ok := expectedMirrorRoute.Source.(*gatewayv1.HTTPRoute)
 			g.Expect(ok).To(BeTrue())
@@ -3096,23 +3108,23 @@ func TestUnsupportedFieldsErrors(t *testing.T) {
 		{
 			name: "One unsupported field",
 			specRule: gatewayv1.HTTPRouteRule{
-				Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
+				Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{
+					Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
+				}),
 			},
 			expectedErrors: 1,
 		},
 		{
 			name: "Multiple unsupported fields",
 			specRule: gatewayv1.HTTPRouteRule{
-				Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
-				Timeouts: helpers.GetPointer(gatewayv1.HTTPRouteTimeouts{
-					Request: (*gatewayv1.Duration)(helpers.GetPointer("unsupported-timeouts")),
+				Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{
+					Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
 				}),
-				Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
 				SessionPersistence: helpers.GetPointer(gatewayv1.SessionPersistence{
 					Type: helpers.GetPointer(gatewayv1.SessionPersistenceType("unsupported-session-persistence")),
 				}),
 			},
-			expectedErrors: 5,
+			expectedErrors: 3,
 		},
 	}
 
@@ -3168,12 +3180,17 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			name: "One unsupported field",
 			specRules: []gatewayv1.HTTPRouteRule{
 				{
-					Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
+					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{
+						Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
+					}),
 				},
 			},
 			expectedValid: true,
 			expectedConds: []conditions.Condition{
-				conditions.NewRouteAcceptedUnsupportedField("spec.rules[0].name: Forbidden: Name"),
+				conditions.NewRouteAcceptedUnsupportedField(
+					"spec.rules[0].retry.backoff: Forbidden: Backoff is not supported, " +
+						"NGINX passes a failed request to the next backend without delay",
+				),
 			},
 			expectedWarns: 1,
 		},
@@ -3181,11 +3198,9 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			name: "Multiple unsupported fields",
 			specRules: []gatewayv1.HTTPRouteRule{
 				{
-					Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
-					Timeouts: helpers.GetPointer(gatewayv1.HTTPRouteTimeouts{
-						Request: (*gatewayv1.Duration)(helpers.GetPointer("unsupported-timeouts")),
+					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{
+						Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
 					}),
-					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
 					SessionPersistence: helpers.GetPointer(gatewayv1.SessionPersistence{
 						Type:        helpers.GetPointer(gatewayv1.CookieBasedSessionPersistence),
 						SessionName: helpers.GetPointer("session_id"),
@@ -3195,8 +3210,8 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			expectedValid: true,
 			expectedConds: []conditions.Condition{
 				conditions.NewRouteAcceptedUnsupportedField(
-					fmt.Sprintf("[spec.rules[0].name: Forbidden: Name, spec.rules[0].timeouts: "+
-						"Forbidden: Timeouts, spec.rules[0].retry: Forbidden: Retry, "+
+					fmt.Sprintf("[spec.rules[0].retry.backoff: Forbidden: Backoff is not supported, "+
+						"NGINX passes a failed request to the next backend without delay, "+
 						"spec.rules[0].sessionPersistence: Forbidden: "+
 						"%s OSS users can use `ip_hash` load balancing method via the UpstreamSettingsPolicy for session affinity.]",
 						spErrMsg,
@@ -3204,7 +3219,7 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			},
 			experimental:  true,
 			plusEnabled:   false,
-			expectedWarns: 4,
+			expectedWarns: 2,
 		},
 		{
 			name: "Session persistence unsupported with experimental disabled",
@@ -3272,3 +3287,337 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessRouteTimeouts(t *testing.T) {
+	t.Parallel()
+
+	timeoutsPath := field.NewPath("spec").Child("rules").Index(0).Child("timeouts")
+
+	duration := func(d string) *gatewayv1.Duration {
+		return helpers.GetPointer(gatewayv1.Duration(d))
+	}
+
+	tests := []struct {
+		timeouts         *gatewayv1.HTTPRouteTimeouts
+		expTimeouts      *RouteTimeouts
+		name             string
+		expWarnings      int
+		validatorInvalid bool
+	}{
+		{
+			name: "request and backendRequest",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        duration("10s"),
+				BackendRequest: duration("2s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+		},
+		{
+			name: "request only",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request: duration("1m"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request: "1m",
+			},
+		},
+		{
+			name: "zero durations disable the timeouts",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        duration("0h0m"),
+				BackendRequest: duration("0s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request:        "0s",
+				BackendRequest: "0s",
+			},
+		},
+		{
+			name: "backendRequest longer than disabled request",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        duration("0s"),
+				BackendRequest: duration("30s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request:        "0s",
+				BackendRequest: "30s",
+			},
+		},
+		{
+			name:        "empty timeouts",
+			timeouts:    &gatewayv1.HTTPRouteTimeouts{},
+			expTimeouts: nil,
+		},
+		{
+			name: "backendRequest longer than request",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        duration("1s"),
+				BackendRequest: duration("2s"),
+			},
+			expTimeouts: nil,
+			expWarnings: 2,
+		},
+		{
+			name: "unparsable duration",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request: duration("invalid"),
+			},
+			expTimeouts: nil,
+			expWarnings: 2,
+		},
+		{
+			name: "duration rejected by validator",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				BackendRequest: duration("10s"),
+			},
+			validatorInvalid: true,
+			expTimeouts:      nil,
+			expWarnings:      2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakeHTTPFieldsValidator{}
+			validator.ValidateDurationStub = func(d string) (string, error) {
+				if test.validatorInvalid {
+					return "", errors.New("invalid duration")
+				}
+				return d, nil
+			}
+
+			timeouts, errs := processRouteTimeouts(test.timeouts, timeoutsPath, validator)
+
+			g.Expect(timeouts).To(Equal(test.expTimeouts))
+			g.Expect(errs.warn).To(HaveLen(test.expWarnings))
+			g.Expect(errs.invalid).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidateTimeoutsConflicts(t *testing.T) {
+	t.Parallel()
+
+	timeout := ngfAPI.Duration("10s")
+
+	createRoutes := func(policies []*Policy) map[RouteKey]*L7Route {
+		return map[RouteKey]*L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: "default", Name: "route1"}}: {
+				Spec: L7RouteSpec{
+					Rules: []RouteRule{
+						{
+							Timeouts: &RouteTimeouts{BackendRequest: "5s"},
+						},
+					},
+				},
+				Policies: policies,
+			},
+		}
+	}
+
+	createPolicy := func(spec ngfAPI.ProxySettingsPolicySpec, valid bool) *Policy {
+		return &Policy{
+			Source: &ngfAPI.ProxySettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "psp",
+				},
+				Spec: spec,
+			},
+			Valid: valid,
+		}
+	}
+
+	tests := []struct {
+		routes         map[RouteKey]*L7Route
+		name           string
+		expectTimeouts bool
+	}{
+		{
+			name:           "no policies",
+			routes:         createRoutes(nil),
+			expectTimeouts: true,
+		},
+		{
+			name: "ProxySettingsPolicy without timeout",
+			routes: createRoutes([]*Policy{
+				createPolicy(ngfAPI.ProxySettingsPolicySpec{}, true),
+			}),
+			expectTimeouts: true,
+		},
+		{
+			name: "invalid ProxySettingsPolicy with timeout",
+			routes: createRoutes([]*Policy{
+				createPolicy(ngfAPI.ProxySettingsPolicySpec{Timeout: &ngfAPI.ProxyTimeout{Read: &timeout}}, false),
+			}),
+			expectTimeouts: true,
+		},
+		{
+			name: "ProxySettingsPolicy with timeout",
+			routes: createRoutes([]*Policy{
+				createPolicy(ngfAPI.ProxySettingsPolicySpec{Timeout: &ngfAPI.ProxyTimeout{Read: &timeout}}, true),
+			}),
+			expectTimeouts: false,
+		},
+		{
+			name: "ProxySettingsPolicy with timeout attached to the rule",
+			routes: func() map[RouteKey]*L7Route {
+				routes := createRoutes(nil)
+				for _, route := range routes {
+					route.Spec.Rules[0].Policies = []*Policy{
+						createPolicy(ngfAPI.ProxySettingsPolicySpec{Timeout: &ngfAPI.ProxyTimeout{Read: &timeout}}, true),
+					}
+				}
+
+				return routes
+			}(),
+			expectTimeouts: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			validateTimeoutsConflicts(test.routes)
+
+			for _, route := range test.routes {
+				if test.expectTimeouts {
+					g.Expect(route.Spec.Rules[0].Timeouts).ToNot(BeNil())
+					g.Expect(route.Conditions).To(BeEmpty())
+				} else {
+					g.Expect(route.Spec.Rules[0].Timeouts).To(BeNil())
+					g.Expect(route.Conditions).To(ConsistOf(conditions.NewRouteAcceptedUnsupportedField(
+						"spec.rules[0].timeouts: conflicts with ProxySettingsPolicy default/psp timeout",
+					)))
+				}
+			}
+		})
+	}
+}
+
+func TestProcessRouteRetry(t *testing.T) {
+	t.Parallel()
+
+	retryPath := field.NewPath("spec").Child("rules").Index(0).Child("retry")
+
+	tests := []struct {
+		retry       *gatewayv1.HTTPRouteRetry
+		expRetry    *RouteRetry
+		name        string
+		expWarnings int
+	}{
+		{
+			name: "codes and attempts",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Codes:    []gatewayv1.HTTPRouteRetryStatusCode{502, 503},
+				Attempts: helpers.GetPointer(2),
+			},
+			expRetry: &RouteRetry{
+				Codes:    []int{502, 503},
+				Attempts: helpers.GetPointer(2),
+			},
+		},
+		{
+			name:  "empty retry",
+			retry: &gatewayv1.HTTPRouteRetry{},
+			expRetry: &RouteRetry{
+				Codes: []int{},
+			},
+		},
+		{
+			name: "unsupported code",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Codes: []gatewayv1.HTTPRouteRetryStatusCode{502, 501},
+			},
+			expRetry:    nil,
+			expWarnings: 2,
+		},
+		{
+			name: "zero attempts",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Attempts: helpers.GetPointer(0),
+			},
+			expRetry: &RouteRetry{
+				Attempts: helpers.GetPointer(0),
+				Codes:    []int{},
+			},
+		},
+		{
+			name: "invalid attempts",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Attempts: helpers.GetPointer(-1),
+			},
+			expRetry:    nil,
+			expWarnings: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakeHTTPFieldsValidator{}
+			validator.ValidateRetryStatusCodeStub = func(code int) (bool, []string) {
+				return code != 501, []string{"502"}
+			}
+
+			retry, errs := processRouteRetry(test.retry, retryPath, validator)
+
+			g.Expect(retry).To(Equal(test.expRetry))
+			g.Expect(errs.warn).To(HaveLen(test.expWarnings))
+			g.Expect(errs.invalid).To(BeEmpty())
+		})
+	}
+}
+
+func TestRemoveHTTPMirrorFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	snippetsFilter := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gatewayv1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.SnippetsFilter,
+			Name:  "snippets",
+		},
+	}
+	mirrorFilter := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gatewayv1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.MirrorFilter,
+			Name:  "mirror",
+		},
+	}
+
+	filters := []gatewayv1.HTTPRouteFilter{
+		{
+			Type: gatewayv1.HTTPRouteFilterRequestMirror,
+			RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				BackendRef: gatewayv1.BackendObjectReference{Name: "mirror-backend"},
+			},
+		},
+		snippetsFilter,
+		{
+			Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gatewayv1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  "canary",
+			},
+		},
+		mirrorFilter,
+	}
+
+	g.Expect(removeHTTPMirrorFilters(filters)).To(Equal([]gatewayv1.HTTPRouteFilter{snippetsFilter, mirrorFilter}))
+}
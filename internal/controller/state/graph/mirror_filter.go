@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// MirrorFilter represents a ngfAPI.MirrorFilter.
+type MirrorFilter struct {
+	// Source is the MirrorFilter.
+	Source *ngfAPI.MirrorFilter
+	// Conditions define the conditions to be reported in the status of the MirrorFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the MirrorFilter is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the MirrorFilter is referenced by a Route.
+	Referenced bool
+}
+
+// getMirrorFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a MirrorFilter in the given namespace.
+// If the MirrorFilter exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getMirrorFilterResolverForNamespace(
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(mirrorFilters) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.MirrorFilter {
+			return nil
+		}
+
+		mf := mirrorFilters[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if mf == nil {
+			return nil
+		}
+
+		mf.Referenced = true
+
+		return &ExtensionRefFilter{MirrorFilter: mf, Valid: mf.Valid}
+	}
+}
+
+func processMirrorFilters(
+	mirrorFilters map[types.NamespacedName]*ngfAPI.MirrorFilter,
+	genericValidator validation.GenericValidator,
+) map[types.NamespacedName]*MirrorFilter {
+	if len(mirrorFilters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*MirrorFilter, len(mirrorFilters))
+
+	for nsname, mf := range mirrorFilters {
+		if cond := validateMirrorFilter(mf, genericValidator); cond != nil {
+			processed[nsname] = &MirrorFilter{
+				Source:     mf,
+				Conditions: []conditions.Condition{*cond},
+				Valid:      false,
+			}
+
+			continue
+		}
+
+		processed[nsname] = &MirrorFilter{
+			Source: mf,
+			Valid:  true,
+		}
+	}
+
+	return processed
+}
+
+func validateMirrorFilter(
+	filter *ngfAPI.MirrorFilter,
+	genericValidator validation.GenericValidator,
+) *conditions.Condition {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if filter.Spec.Timeout != nil {
+		if err := genericValidator.ValidateNginxDuration(string(*filter.Spec.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("timeout"), *filter.Spec.Timeout, err.Error()))
+		}
+	}
+
+	if filter.Spec.MaxBodySize != nil {
+		if err := genericValidator.ValidateNginxSize(string(*filter.Spec.MaxBodySize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("maxBodySize"), *filter.Spec.MaxBodySize, err.Error()))
+		}
+	}
+
+	if allErrs != nil {
+		cond := conditions.NewMirrorFilterInvalid(allErrs.ToAggregate().Error())
+		return &cond
+	}
+
+	return nil
+}
+
+// validateGRPCMirrorFilters validates the MirrorFilters that are referenced by the filters of a GRPCRoute rule.
+// MaxBodySize is not supported, since gRPC requests don't have a Content-Length and would never be mirrored.
+func validateGRPCMirrorFilters(filters []Filter, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, f := range filters {
+		if f.ResolvedExtensionRef == nil || f.ResolvedExtensionRef.MirrorFilter == nil {
+			continue
+		}
+
+		if f.ResolvedExtensionRef.MirrorFilter.Source.Spec.MaxBodySize != nil {
+			allErrs = append(allErrs, field.Invalid(
+				path.Index(i).Child("extensionRef"),
+				f.ExtensionRef,
+				"MirrorFilter maxBodySize is not supported for GRPCRoutes",
+			))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,262 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation/validationfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+func TestProcessMirrorFilters(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	validFilter := &ngfAPI.MirrorFilter{
+		Spec: ngfAPI.MirrorFilterSpec{
+			Timeout:          helpers.GetPointer[ngfAPI.Duration]("1s"),
+			StripAuthHeaders: helpers.GetPointer(true),
+		},
+	}
+	invalidFilter := &ngfAPI.MirrorFilter{
+		Spec: ngfAPI.MirrorFilterSpec{
+			MaxBodySize: helpers.GetPointer[ngfAPI.Size]("invalid"),
+		},
+	}
+
+	genericValidator := &validationfakes.FakeGenericValidator{}
+	genericValidator.ValidateNginxSizeReturns(errors.New("invalid size"))
+
+	tests := []struct {
+		mirrorFilters map[types.NamespacedName]*ngfAPI.MirrorFilter
+		expProcessed  map[types.NamespacedName]*MirrorFilter
+		msg           string
+	}{
+		{
+			msg:           "no mirror filters",
+			mirrorFilters: nil,
+			expProcessed:  nil,
+		},
+		{
+			msg: "valid and invalid mirror filters",
+			mirrorFilters: map[types.NamespacedName]*ngfAPI.MirrorFilter{
+				validNsName:   validFilter,
+				invalidNsName: invalidFilter,
+			},
+			expProcessed: map[types.NamespacedName]*MirrorFilter{
+				validNsName: {
+					Source: validFilter,
+					Valid:  true,
+				},
+				invalidNsName: {
+					Source: invalidFilter,
+					Conditions: []conditions.Condition{
+						conditions.NewMirrorFilterInvalid(
+							"spec.maxBodySize: Invalid value: \"invalid\": invalid size",
+						),
+					},
+					Valid: false,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(processMirrorFilters(test.mirrorFilters, genericValidator)).To(Equal(test.expProcessed))
+		})
+	}
+}
+
+func TestValidateMirrorFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := &ngfAPI.MirrorFilter{
+		Spec: ngfAPI.MirrorFilterSpec{
+			Timeout:     helpers.GetPointer[ngfAPI.Duration]("1s"),
+			MaxBodySize: helpers.GetPointer[ngfAPI.Size]("1m"),
+		},
+	}
+
+	tests := []struct {
+		genericValidator *validationfakes.FakeGenericValidator
+		expCond          *conditions.Condition
+		msg              string
+	}{
+		{
+			msg:              "valid",
+			genericValidator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			msg: "invalid timeout and max body size",
+			genericValidator: func() *validationfakes.FakeGenericValidator {
+				v := &validationfakes.FakeGenericValidator{}
+				v.ValidateNginxDurationReturns(errors.New("invalid duration"))
+				v.ValidateNginxSizeReturns(errors.New("invalid size"))
+				return v
+			}(),
+			expCond: helpers.GetPointer(conditions.NewMirrorFilterInvalid(
+				"[spec.timeout: Invalid value: \"1s\": invalid duration, " +
+					"spec.maxBodySize: Invalid value: \"1m\": invalid size]",
+			)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(validateMirrorFilter(filter, test.genericValidator)).To(Equal(test.expCond))
+		})
+	}
+}
+
+func TestGetMirrorFilterResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	createMirrorFilterMap := func() map[types.NamespacedName]*MirrorFilter {
+		return map[types.NamespacedName]*MirrorFilter{
+			validNsName:   {Valid: true},
+			invalidNsName: {Valid: false},
+		}
+	}
+
+	tests := []struct {
+		mirrorFilterMap    map[types.NamespacedName]*MirrorFilter
+		extRef             v1.LocalObjectReference
+		name               string
+		resolveInNamespace string
+		expResolve         bool
+		expValid           bool
+	}{
+		{
+			name: "no mirror filters",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			resolveInNamespace: "test",
+		},
+		{
+			name: "invalid kind",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.CanaryFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			mirrorFilterMap:    createMirrorFilterMap(),
+			resolveInNamespace: "test",
+		},
+		{
+			name: "mirror filter in other namespace",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			mirrorFilterMap:    createMirrorFilterMap(),
+			resolveInNamespace: "other",
+		},
+		{
+			name: "valid mirror filter",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+				Name:  v1.ObjectName(validNsName.Name),
+			},
+			mirrorFilterMap:    createMirrorFilterMap(),
+			resolveInNamespace: "test",
+			expResolve:         true,
+			expValid:           true,
+		},
+		{
+			name: "invalid mirror filter",
+			extRef: v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.MirrorFilter,
+				Name:  v1.ObjectName(invalidNsName.Name),
+			},
+			mirrorFilterMap:    createMirrorFilterMap(),
+			resolveInNamespace: "test",
+			expResolve:         true,
+			expValid:           false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getMirrorFilterResolverForNamespace(test.mirrorFilterMap, test.resolveInNamespace)
+			extRefFilter := resolve(test.extRef)
+
+			if !test.expResolve {
+				g.Expect(extRefFilter).To(BeNil())
+				return
+			}
+
+			g.Expect(extRefFilter).ToNot(BeNil())
+			g.Expect(extRefFilter.MirrorFilter).ToNot(BeNil())
+			g.Expect(extRefFilter.MirrorFilter.Referenced).To(BeTrue())
+			g.Expect(extRefFilter.Valid).To(Equal(test.expValid))
+		})
+	}
+}
+
+func TestValidateGRPCMirrorFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	extRef := &v1.LocalObjectReference{
+		Group: ngfAPI.GroupName,
+		Kind:  kinds.MirrorFilter,
+		Name:  "mirror",
+	}
+
+	createFilter := func(spec ngfAPI.MirrorFilterSpec) Filter {
+		return Filter{
+			RouteType:    RouteTypeGRPC,
+			FilterType:   FilterExtensionRef,
+			ExtensionRef: extRef,
+			ResolvedExtensionRef: &ExtensionRefFilter{
+				MirrorFilter: &MirrorFilter{Source: &ngfAPI.MirrorFilter{Spec: spec}, Valid: true},
+				Valid:        true,
+			},
+		}
+	}
+
+	filters := []Filter{
+		{RouteType: RouteTypeGRPC, FilterType: FilterRequestMirror},
+		createFilter(ngfAPI.MirrorFilterSpec{Timeout: helpers.GetPointer[ngfAPI.Duration]("1s")}),
+		createFilter(ngfAPI.MirrorFilterSpec{MaxBodySize: helpers.GetPointer[ngfAPI.Size]("1m")}),
+	}
+
+	path := field.NewPath("spec").Child("rules").Index(0).Child("filters")
+
+	g.Expect(validateGRPCMirrorFilters(filters, path)).To(Equal(field.ErrorList{
+		field.Invalid(
+			path.Index(2).Child("extensionRef"),
+			extRef,
+			"MirrorFilter maxBodySize is not supported for GRPCRoutes",
+		),
+	}))
+}
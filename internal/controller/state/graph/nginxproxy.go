@@ -193,6 +193,11 @@ func telemetryEnabledForNginxProxy(np *EffectiveNginxProxy) bool {
 	return true
 }
 
+// metricsExporterEnabledForNginxProxy returns whether the OTLP metrics exporter of the NGINX agent is configured.
+func metricsExporterEnabledForNginxProxy(np *EffectiveNginxProxy) bool {
+	return np != nil && np.Telemetry != nil && np.Telemetry.MetricsExporter != nil
+}
+
 // MetricsEnabledForNginxProxy returns whether metrics is enabled, and the associated port if specified.
 // By default, metrics are enabled.
 func MetricsEnabledForNginxProxy(np *EffectiveNginxProxy) (*int32, bool) {
@@ -342,6 +347,14 @@ func validateNginxProxy(
 			}
 		}
 
+		if telemetry.MetricsExporter != nil && telemetry.MetricsExporter.BatchTimeout != nil {
+			timeout := *telemetry.MetricsExporter.BatchTimeout
+			if err := validator.ValidateNginxDuration(string(timeout)); err != nil {
+				timeoutPath := telPath.Child("metricsExporter").Child("batchTimeout")
+				allErrs = append(allErrs, field.Invalid(timeoutPath, timeout, err.Error()))
+			}
+		}
+
 		if telemetry.SpanAttributes != nil {
 			spanAttrPath := telPath.Child("spanAttributes")
 			for _, spanAttr := range telemetry.SpanAttributes {
@@ -466,6 +466,48 @@ func TestTelemetryEnabledForNginxProxy(t *testing.T) {
 	}
 }
 
+func TestMetricsExporterEnabledForNginxProxy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ep      *EffectiveNginxProxy
+		name    string
+		enabled bool
+	}{
+		{
+			name: "effective nginx proxy is nil",
+		},
+		{
+			name: "telemetry struct is nil",
+			ep:   &EffectiveNginxProxy{},
+		},
+		{
+			name: "metrics exporter is nil",
+			ep: &EffectiveNginxProxy{
+				Telemetry: &ngfAPIv1alpha2.Telemetry{},
+			},
+		},
+		{
+			name: "metrics exporter is configured",
+			ep: &EffectiveNginxProxy{
+				Telemetry: &ngfAPIv1alpha2.Telemetry{
+					MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{Endpoint: "collector:4317"},
+				},
+			},
+			enabled: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(metricsExporterEnabledForNginxProxy(test.ep)).To(Equal(test.enabled))
+		})
+	}
+}
+
 func TestMetricsEnabledForNginxProxy(t *testing.T) {
 	t.Parallel()
 
@@ -1150,6 +1192,24 @@ func TestValidateNginxProxy(t *testing.T) {
 			expErrSubstring: "telemetry.exporter.interval",
 			expectErrCount:  1,
 		},
+		{
+			name:      "invalid metrics exporter batch timeout",
+			validator: createInvalidValidator(),
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Telemetry: &ngfAPIv1alpha2.Telemetry{
+						MetricsExporter: &ngfAPIv1alpha2.MetricsExporter{
+							Endpoint: "my-endpoint:4317",
+							BatchTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration](
+								"my-timeout",
+							), // any value is invalid by the validator
+						},
+					},
+				},
+			},
+			expErrSubstring: "telemetry.metricsExporter.batchTimeout",
+			expectErrCount:  1,
+		},
 		{
 			name:      "invalid spanAttributes",
 			validator: createInvalidValidator(),
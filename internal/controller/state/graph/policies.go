@@ -21,6 +21,7 @@ import (
 	wafv1 "github.com/nginx/nginx-gateway-fabric/v2/apis/waf/v1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/ngfsort"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
@@ -63,6 +64,9 @@ type PolicyWAFState struct {
 	ResolvedAuth *fetch.BundleAuth
 	// ResolvedTLSCA contains the resolved TLS CA certificate data for WAF bundle fetching.
 	ResolvedTLSCA []byte
+	// ResolvedSigningKeys contains the resolved signature verification keys, keyed by the bundle they verify.
+	// Only bundles with signature verification configured have an entry.
+	ResolvedSigningKeys map[WAFBundleKey][]byte
 	// BundlePending is true when the policy's bundle has never been successfully fetched
 	// (cold-miss on startup or after all retries are exhausted with no previous bundle).
 	// The Gateway config push is withheld until this is resolved to maintain fail-closed posture.
@@ -85,6 +89,9 @@ type PolicyTargetRef struct {
 	Group v1.Group
 	// Nsname is the NamespacedName of the object.
 	Nsname types.NamespacedName
+	// SectionName is the name of the section of the object. For HTTPRoutes and GRPCRoutes, it is the name of a rule.
+	// If nil, the Policy targets the whole object.
+	SectionName *v1.SectionName
 }
 
 // PolicyKey is a unique identifier for an NGF Policy.
@@ -178,10 +185,52 @@ func LogBundleDescription(src *ngfAPIv1alpha1.LogSource) string {
 	}
 }
 
+// WAFSecurityLogs returns all SecurityLog entries of a WAFPolicy: the entries of spec.securityLogs followed by
+// the entries of spec.routeSecurityLogs. It is used to fetch and poll the log profile bundles of every entry.
+func WAFSecurityLogs(spec ngfAPIv1alpha1.WAFPolicySpec) []ngfAPIv1alpha1.WAFSecurityLog {
+	if len(spec.RouteSecurityLogs) == 0 {
+		return spec.SecurityLogs
+	}
+
+	secLogs := slices.Clone(spec.SecurityLogs)
+	for _, routeLogs := range spec.RouteSecurityLogs {
+		secLogs = append(secLogs, routeLogs.SecurityLogs...)
+	}
+
+	return secLogs
+}
+
+// WAFRouteSecurityLogs returns the first spec.routeSecurityLogs entry of a WAFPolicy that matches the HTTPRoute,
+// or nil if there is none.
+func WAFRouteSecurityLogs(
+	wp *ngfAPIv1alpha1.WAFPolicy,
+	routeNsName types.NamespacedName,
+) *ngfAPIv1alpha1.WAFRouteSecurityLogs {
+	for i, routeLogs := range wp.Spec.RouteSecurityLogs {
+		ns := wp.Namespace
+		if routeLogs.Namespace != nil {
+			ns = string(*routeLogs.Namespace)
+		}
+
+		if ns == routeNsName.Namespace && string(routeLogs.Name) == routeNsName.Name {
+			return &wp.Spec.RouteSecurityLogs[i]
+		}
+	}
+
+	return nil
+}
+
 // WAFBundleData contains the fetched WAF bundle content.
 type WAFBundleData struct {
 	Checksum string
-	Data     []byte
+	// RejectedChecksum is the checksum of a newer bundle that failed to apply during a canary rollout and was
+	// rolled back. It is only set on the previous bundles supplied by the WAF polling manager, in which case
+	// Data and Checksum hold the last good bundle, or are empty if no bundle was ever deployed.
+	RejectedChecksum string
+	Data             []byte
+	// Signature is the detached signature that Data was verified against, if the bundle source has
+	// signature verification configured. It allows bundles restored from the bundle cache to be verified again.
+	Signature []byte
 }
 
 // PLMRole identifies the role of a Kubernetes Secret in PLM S3 storage authentication.
@@ -200,6 +249,7 @@ const (
 	gatewayGroupKind = v1.GroupName + "/" + kinds.Gateway
 	hrGroupKind      = v1.GroupName + "/" + kinds.HTTPRoute
 	grpcGroupKind    = v1.GroupName + "/" + kinds.GRPCRoute
+	tcpGroupKind     = v1.GroupName + "/" + kinds.TCPRoute
 	serviceGroupKind = "core" + "/" + kinds.Service
 	// plmDefaultAccessKeyID is the fixed S3 access key ID configured by the SeaweedFS operator.
 	plmDefaultAccessKeyID = "adminKey"
@@ -267,7 +317,14 @@ func (g *Graph) attachPolicies(validator validation.PolicyValidator, ctlrName st
 					continue
 				}
 
-				attachPolicyToRoute(policy, route, validator, ctlrName, logger)
+				attachPolicyToRoute(policy, ref, route, g.Routes, validator, ctlrName, logger)
+			case kinds.TCPRoute:
+				route, exists := g.L4Routes[l4RouteKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				attachPolicyToL4Route(policy, route, validator, ctlrName, logger)
 			case kinds.Service:
 				svc, exists := g.ReferencedServices[ref.Nsname]
 				if !exists {
@@ -311,6 +368,13 @@ func attachPolicyToService(
 			continue
 		}
 
+		if msg := streamHashMethodKeyError(policy, svc, gwNsName); msg != "" {
+			// The policy is invalid for the Gateway, even if it was attached through another target Service.
+			policy.InvalidForGateways[gwNsName] = struct{}{}
+			setAncestorConditions(policy, ancestor.Ancestor, conditions.NewPolicyInvalid(msg), ctlrName)
+			continue
+		}
+
 		if ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
 			// Ancestor already exists, but we should still consider this gateway as attached
 			attachedToAnyGateway = true
@@ -356,9 +420,61 @@ func attachPolicyToService(
 	}
 }
 
+// streamHashMethodKeyError returns an error message if the policy configures a hash key that uses variables
+// only available to HTTP traffic, and the Service is a backend of a Layer 4 Route of the Gateway.
+// NGINX fails to load a stream configuration that references such variables.
+func streamHashMethodKeyError(policy *Policy, svc *ReferencedService, gwNsName types.NamespacedName) string {
+	if _, ok := svc.L4GatewayNsNames[gwNsName]; !ok {
+		return ""
+	}
+
+	usp, ok := policy.Source.(*ngfAPIv1alpha1.UpstreamSettingsPolicy)
+	if !ok || usp.Spec.HashMethodKey == nil || usp.Spec.LoadBalancingMethod == nil {
+		return ""
+	}
+
+	switch *usp.Spec.LoadBalancingMethod {
+	case ngfAPIv1alpha1.LoadBalancingTypeHash, ngfAPIv1alpha1.LoadBalancingTypeHashConsistent:
+	default:
+		return ""
+	}
+
+	if shared.IsStreamSafe(string(*usp.Spec.HashMethodKey)) {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"The hashMethodKey %q uses variables that are only available to HTTP traffic, but the Service is a backend "+
+			"of a TCPRoute, TLSRoute or UDPRoute",
+		*usp.Spec.HashMethodKey,
+	)
+}
+
+// setAncestorConditions sets the conditions of the ancestor of the policy, adding the ancestor if the policy
+// doesn't have it yet and the ancestor limit is not reached.
+func setAncestorConditions(policy *Policy, ref v1.ParentReference, cond conditions.Condition, ctlrName string) {
+	for i := range policy.Ancestors {
+		if parentRefEqual(policy.Ancestors[i].Ancestor, ref) {
+			policy.Ancestors[i].Conditions = []conditions.Condition{cond}
+			return
+		}
+	}
+
+	if ngfPolicyAncestorsFull(policy, ctlrName) {
+		return
+	}
+
+	policy.Ancestors = append(policy.Ancestors, PolicyAncestor{
+		Ancestor:   ref,
+		Conditions: []conditions.Condition{cond},
+	})
+}
+
 func attachPolicyToRoute(
 	policy *Policy,
+	ref PolicyTargetRef,
 	route *L7Route,
+	routes map[RouteKey]*L7Route,
 	validator validation.PolicyValidator,
 	ctlrName string,
 	logger logr.Logger,
@@ -372,6 +488,7 @@ func attachPolicyToRoute(
 
 	routeNsName := types.NamespacedName{Namespace: route.Source.GetNamespace(), Name: route.Source.GetName()}
 	ancestorRef := createParentReference(v1.GroupName, kind, routeNsName)
+	ancestorRef.SectionName = ref.SectionName
 
 	// Check ancestor limit
 	isFull := ngfPolicyAncestorsFull(policy, ctlrName)
@@ -396,11 +513,53 @@ func attachPolicyToRoute(
 		return
 	}
 
+	ruleIdx := -1
+	if ref.SectionName != nil {
+		ruleIdx = slices.IndexFunc(route.Spec.Rules, func(rule RouteRule) bool {
+			return rule.Name == string(*ref.SectionName)
+		})
+
+		if ruleIdx == -1 {
+			msg := fmt.Sprintf("The TargetRef sectionName %q does not match the name of any rule", *ref.SectionName)
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyTargetNotFound(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+
+		// NGINX only applies the rate limits of the first location that handles a request, which is the
+		// external location when the requests of the rule are routed via internal locations.
+		_, isRateLimitPolicy := policy.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
+		if isRateLimitPolicy && routedViaInternalLocations(route.Spec.Rules[ruleIdx].Matches) {
+			msg := fmt.Sprintf(
+				"RateLimitPolicy cannot target the rule %q, because the rule has several matches for the same path "+
+					"or matches on headers, query parameters or the method; target the Route instead",
+				*ref.SectionName,
+			)
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyInvalid(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+
+		// The requests of a rule are also routed via internal locations when another rule has a match for the same
+		// path on the same host, because NGINX merges the matches of both rules into a single location.
+		if isRateLimitPolicy && sharesPathWithOtherRules(route, ruleIdx, routes) {
+			msg := fmt.Sprintf(
+				"RateLimitPolicy cannot target the rule %q, because another rule of this or another Route has a match "+
+					"for the same path on the same host; target the Route instead",
+				*ref.SectionName,
+			)
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyInvalid(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+	}
+
 	for _, parentRef := range route.ParentRefs {
 		if parentRef.EffectiveNginxProxy != nil {
 			globalSettings := &policies.GlobalSettings{
-				TelemetryEnabled: telemetryEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
-				WAFEnabled:       WAFEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+				TelemetryEnabled:       telemetryEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+				WAFEnabled:             WAFEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+				MetricsExporterEnabled: metricsExporterEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
 			}
 
 			if conds := validator.ValidateGlobalSettings(policy.Source, globalSettings); len(conds) > 0 {
@@ -415,6 +574,178 @@ func attachPolicyToRoute(
 
 	policy.Ancestors = append(policy.Ancestors, ancestor)
 
+	// Only attach policy to route if it's effective for at least one gateway
+	if len(effectiveGateways) > 0 || len(policy.InvalidForGateways) < len(route.ParentRefs) {
+		if ruleIdx != -1 {
+			route.Spec.Rules[ruleIdx].Policies = append(route.Spec.Rules[ruleIdx].Policies, policy)
+		} else {
+			route.Policies = append(route.Policies, policy)
+		}
+	}
+}
+
+// routedViaInternalLocations returns true if NGINX routes the requests of a rule with the matches via internal
+// locations, because several matches share a path or a match has conditions other than the path.
+func routedViaInternalLocations(matches []v1.HTTPRouteMatch) bool {
+	paths := make(map[string]struct{}, len(matches))
+
+	for _, match := range matches {
+		if match.Method != nil || len(match.Headers) > 0 || len(match.QueryParams) > 0 {
+			return true
+		}
+
+		path := matchPath(match)
+		if _, exists := paths[path]; exists {
+			return true
+		}
+		paths[path] = struct{}{}
+	}
+
+	return false
+}
+
+// sharesPathWithOtherRules returns true if another rule of the route, or a rule of another route, has a match for
+// the same path as a match of the rule with the index, and both routes serve a common hostname of the same Gateway.
+func sharesPathWithOtherRules(route *L7Route, ruleIdx int, routes map[RouteKey]*L7Route) bool {
+	hosts := routeGatewayHosts(route)
+	if len(hosts) == 0 {
+		return false
+	}
+
+	paths := make(map[string]struct{}, len(route.Spec.Rules[ruleIdx].Matches))
+	for _, match := range route.Spec.Rules[ruleIdx].Matches {
+		paths[matchPath(match)] = struct{}{}
+	}
+
+	for _, other := range routes {
+		if !other.Valid || !other.Attachable {
+			continue
+		}
+
+		if !hostsOverlap(hosts, routeGatewayHosts(other)) {
+			continue
+		}
+
+		for idx, rule := range other.Spec.Rules {
+			if (other == route && idx == ruleIdx) || !rule.ValidMatches {
+				continue
+			}
+
+			for _, match := range rule.Matches {
+				if _, exists := paths[matchPath(match)]; exists {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// routeGatewayHosts returns the hostnames that the route serves, prefixed with the Gateway of the hostname.
+func routeGatewayHosts(route *L7Route) map[string]struct{} {
+	hosts := make(map[string]struct{})
+
+	for _, ref := range route.ParentRefs {
+		if ref.Attachment == nil || !ref.Attachment.Attached {
+			continue
+		}
+
+		for _, hostnames := range ref.Attachment.AcceptedHostnames {
+			for _, hostname := range hostnames {
+				hosts[ref.GatewayNsName.String()+"/"+hostname] = struct{}{}
+			}
+		}
+	}
+
+	return hosts
+}
+
+func hostsOverlap(hosts, otherHosts map[string]struct{}) bool {
+	for host := range otherHosts {
+		if _, exists := hosts[host]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPath returns the path type and value of the match, which identify the location of the match in NGINX.
+func matchPath(match v1.HTTPRouteMatch) string {
+	pathType, pathValue := v1.PathMatchPathPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+	}
+
+	return string(pathType) + ":" + pathValue
+}
+
+func attachPolicyToL4Route(
+	policy *Policy,
+	route *L4Route,
+	validator validation.PolicyValidator,
+	ctlrName string,
+	logger logr.Logger,
+) {
+	var effectiveGateways []types.NamespacedName
+
+	routeNsName := client.ObjectKeyFromObject(route.Source)
+	ancestorRef := createParentReference(v1.GroupName, kinds.TCPRoute, routeNsName)
+
+	// Check ancestor limit
+	isFull := ngfPolicyAncestorsFull(policy, ctlrName)
+	if isFull {
+		policyName := getPolicyName(policy.Source)
+		policyKind := getPolicyKind(policy.Source)
+		routeName := getAncestorName(ancestorRef)
+
+		route.Conditions = addPolicyAncestorLimitCondition(route.Conditions, policyName, policyKind)
+		logAncestorLimitReached(logger, policyName, policyKind, routeName)
+
+		return
+	}
+
+	ancestor := PolicyAncestor{
+		Ancestor: ancestorRef,
+	}
+
+	if !route.Valid || !route.Attachable || len(route.ParentRefs) == 0 {
+		ancestor.Conditions = []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	if msg := streamConnectionLimitKeyError(policy); msg != "" {
+		ancestor.Conditions = []conditions.Condition{conditions.NewPolicyInvalid(msg)}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	for _, parentRef := range route.ParentRefs {
+		if parentRef.EffectiveNginxProxy != nil {
+			globalSettings := &policies.GlobalSettings{
+				TelemetryEnabled:       telemetryEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+				WAFEnabled:             WAFEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+				MetricsExporterEnabled: metricsExporterEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+			}
+
+			if conds := validator.ValidateGlobalSettings(policy.Source, globalSettings); len(conds) > 0 {
+				policy.InvalidForGateways[parentRef.GatewayNsName] = struct{}{}
+				ancestor.Conditions = append(ancestor.Conditions, conds...)
+			} else {
+				effectiveGateways = append(effectiveGateways, parentRef.GatewayNsName)
+			}
+		}
+	}
+
+	policy.Ancestors = append(policy.Ancestors, ancestor)
+
 	// Only attach policy to route if it's effective for at least one gateway
 	if len(effectiveGateways) > 0 || len(policy.InvalidForGateways) < len(route.ParentRefs) {
 		route.Policies = append(route.Policies, policy)
@@ -431,6 +762,7 @@ func attachPolicyToGateway(
 	validator validation.PolicyValidator,
 ) {
 	ancestorRef := createParentReference(v1.GroupName, kinds.Gateway, ref.Nsname)
+	ancestorRef.SectionName = ref.SectionName
 	gw, exists := gateways[ref.Nsname]
 
 	if _, ok := policy.InvalidForGateways[ref.Nsname]; ok {
@@ -440,8 +772,7 @@ func attachPolicyToGateway(
 	if ancestorsContainsAncestorRef(policy.Ancestors, ancestorRef) {
 		// Ancestor already exists, but still attach policy to gateway if it's valid
 		if exists && gw != nil && gw.Valid && gw.Source != nil {
-			gw.Policies = append(gw.Policies, policy)
-			propagateSnippetsPolicyToRoutes(policy, gw, routes)
+			attachPolicyToGatewayOrListener(policy, ref, gw, routes)
 		}
 		return
 	}
@@ -482,9 +813,42 @@ func attachPolicyToGateway(
 		return
 	}
 
+	if ref.SectionName != nil {
+		l := findGatewayListener(gw, *ref.SectionName)
+		if l == nil {
+			msg := fmt.Sprintf("The TargetRef sectionName %q does not match the name of any listener", *ref.SectionName)
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyTargetNotFound(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+
+		// TLS listeners share a stream server per port that routes connections by SNI after the access phase,
+		// so a policy can't be scoped to a single TLS listener.
+		if l.Source.Protocol == v1.TLSProtocolType {
+			msg := fmt.Sprintf(
+				"The TargetRef sectionName %q names a TLS listener; policies are not supported on TLS listeners",
+				*ref.SectionName,
+			)
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyTargetNotFound(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+	}
+
+	// The policies of the Gateway also apply to the stream servers of its TCP, TLS and UDP listeners.
+	if gatewayHasStreamListeners(gw) {
+		if msg := streamConnectionLimitKeyError(policy); msg != "" {
+			policy.InvalidForGateways[ref.Nsname] = struct{}{}
+			ancestor.Conditions = []conditions.Condition{conditions.NewPolicyInvalid(msg)}
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+			return
+		}
+	}
+
 	globalSettings := &policies.GlobalSettings{
-		TelemetryEnabled: telemetryEnabledForNginxProxy(gw.EffectiveNginxProxy),
-		WAFEnabled:       WAFEnabledForNginxProxy(gw.EffectiveNginxProxy),
+		TelemetryEnabled:       telemetryEnabledForNginxProxy(gw.EffectiveNginxProxy),
+		WAFEnabled:             WAFEnabledForNginxProxy(gw.EffectiveNginxProxy),
+		MetricsExporterEnabled: metricsExporterEnabledForNginxProxy(gw.EffectiveNginxProxy),
 	}
 
 	// Policy is effective for this gateway (not adding to InvalidForGateways)
@@ -495,8 +859,71 @@ func attachPolicyToGateway(
 	}
 
 	policy.Ancestors = append(policy.Ancestors, ancestor)
-	gw.Policies = append(gw.Policies, policy)
-	propagateSnippetsPolicyToRoutes(policy, gw, routes)
+	attachPolicyToGatewayOrListener(policy, ref, gw, routes)
+}
+
+// gatewayHasStreamListeners returns true if the Gateway has a TCP, TLS or UDP listener.
+func gatewayHasStreamListeners(gw *Gateway) bool {
+	for _, l := range gw.Listeners {
+		switch l.Source.Protocol {
+		case v1.TCPProtocolType, v1.TLSProtocolType, v1.UDPProtocolType:
+			return true
+		}
+	}
+
+	return false
+}
+
+// streamConnectionLimitKeyError returns an error message if the policy is a ConnectionLimitPolicy with a key that
+// uses variables only available to HTTP traffic. NGINX fails to load a stream configuration that references them.
+func streamConnectionLimitKeyError(policy *Policy) string {
+	clp, ok := policy.Source.(*ngfAPIv1alpha1.ConnectionLimitPolicy)
+	if !ok || clp.Spec.ConnectionLimit == nil {
+		return ""
+	}
+
+	for _, rule := range clp.Spec.ConnectionLimit.Rules {
+		if rule.Key != nil && !shared.IsStreamSafe(*rule.Key) {
+			return fmt.Sprintf(
+				"The key %q uses variables that are only available to HTTP traffic, but the policy applies to "+
+					"TCP, TLS or UDP traffic",
+				*rule.Key,
+			)
+		}
+	}
+
+	return ""
+}
+
+// attachPolicyToGatewayOrListener attaches the policy to the Listener of the Gateway that is named by the
+// sectionName of the ref, or to the whole Gateway if the ref has no sectionName.
+func attachPolicyToGatewayOrListener(
+	policy *Policy,
+	ref PolicyTargetRef,
+	gw *Gateway,
+	routes map[RouteKey]*L7Route,
+) {
+	if ref.SectionName == nil {
+		gw.Policies = append(gw.Policies, policy)
+		propagateSnippetsPolicyToRoutes(policy, gw, routes)
+		return
+	}
+
+	if l := findGatewayListener(gw, *ref.SectionName); l != nil {
+		l.Policies = append(l.Policies, policy)
+	}
+}
+
+// findGatewayListener returns the Listener of the Gateway with the given name. Listeners that are
+// merged into the Gateway from ListenerSets are not considered, since they are not sections of the Gateway.
+func findGatewayListener(gw *Gateway, name v1.SectionName) *Listener {
+	for _, l := range gw.Listeners {
+		if l.ListenerSetName.Name == "" && l.Name == string(name) {
+			return l
+		}
+	}
+
+	return nil
 }
 
 func propagateSnippetsPolicyToRoutes(
@@ -550,6 +977,8 @@ type WAFProcessingInput struct {
 	PLMFetcher *s3fetch.Fetcher
 	// Secrets contains the Secrets from the cluster, used to resolve bundle auth credentials.
 	Secrets map[types.NamespacedName]*corev1.Secret
+	// ConfigMaps contains the ConfigMaps from the cluster, used to resolve bundle signature verification keys.
+	ConfigMaps map[types.NamespacedName]*corev1.ConfigMap
 	// PreviousBundles contains the bundles successfully fetched in the previous processing cycle.
 	// Used to keep the last-known-good bundle active when a re-fetch fails.
 	PreviousBundles map[WAFBundleKey]*WAFBundleData
@@ -574,9 +1003,12 @@ type WAFProcessingOutput struct {
 	ReferencedAPPolicies map[types.NamespacedName]*unstructured.Unstructured
 	// ReferencedAPLogConfs contains APLogConf resources referenced by WAFPolicy resources.
 	ReferencedAPLogConfs map[types.NamespacedName]*unstructured.Unstructured
-	// ReferencedWAFSecrets contains the Secrets referenced by WAFPolicy (auth and TLS CA).
+	// ReferencedWAFSecrets contains the Secrets referenced by WAFPolicy (auth, TLS CA, and signing keys).
 	// These must be watched by the change tracker.
 	ReferencedWAFSecrets map[types.NamespacedName]*corev1.Secret
+	// ReferencedWAFConfigMaps contains the ConfigMaps referenced by WAFPolicy (signing keys).
+	// These must be watched by the change tracker.
+	ReferencedWAFConfigMaps map[types.NamespacedName]*corev1.ConfigMap
 }
 
 func processPolicies(
@@ -585,6 +1017,7 @@ func processPolicies(
 	pols map[PolicyKey]policies.Policy,
 	validator validation.PolicyValidator,
 	routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
 	services map[types.NamespacedName]*ReferencedService,
 	gws map[types.NamespacedName]*Gateway,
 	wafInput *WAFProcessingInput,
@@ -598,10 +1031,11 @@ func processPolicies(
 	for key, policy := range pols {
 		var conds []conditions.Condition
 
-		targetRefs := make([]PolicyTargetRef, 0, len(policy.GetTargetRefs()))
+		policyRefs := policyTargetRefs(policy)
+		targetRefs := make([]PolicyTargetRef, 0, len(policyRefs))
 		targetedRoutes := make(map[types.NamespacedName]*L7Route)
 
-		for _, ref := range policy.GetTargetRefs() {
+		for _, ref := range policyRefs {
 			refNsName := types.NamespacedName{Name: string(ref.Name), Namespace: policy.GetNamespace()}
 
 			switch refGroupKind(ref.Group, ref.Kind) {
@@ -615,6 +1049,10 @@ func processPolicies(
 				} else {
 					continue
 				}
+			case tcpGroupKind:
+				if _, exists := l4Routes[l4RouteKeyForKind(ref.Kind, refNsName)]; !exists {
+					continue
+				}
 			case serviceGroupKind:
 				if _, exists := services[refNsName]; !exists {
 					continue
@@ -625,9 +1063,10 @@ func processPolicies(
 
 			targetRefs = append(targetRefs,
 				PolicyTargetRef{
-					Kind:   ref.Kind,
-					Group:  ref.Group,
-					Nsname: refNsName,
+					Kind:        ref.Kind,
+					Group:       ref.Group,
+					Nsname:      refNsName,
+					SectionName: ref.SectionName,
 				})
 		}
 
@@ -657,6 +1096,25 @@ func processPolicies(
 	return processedPolicies, wafOutput
 }
 
+// policyTargetRefs returns the targetRefs of the policy, including the sectionNames
+// for policies that support targeting a section of an object.
+func policyTargetRefs(policy policies.Policy) []v1.LocalPolicyTargetReferenceWithSectionName {
+	if sectionNamePolicy, ok := policy.(policies.SectionNamePolicy); ok {
+		return sectionNamePolicy.GetTargetRefsWithSectionName()
+	}
+
+	refs := policy.GetTargetRefs()
+	sectionNameRefs := make([]v1.LocalPolicyTargetReferenceWithSectionName, 0, len(refs))
+
+	for _, ref := range refs {
+		sectionNameRefs = append(sectionNameRefs, v1.LocalPolicyTargetReferenceWithSectionName{
+			LocalPolicyTargetReference: ref,
+		})
+	}
+
+	return sectionNameRefs
+}
+
 func checkTargetRoutesForOverlap(
 	targetedRoutes map[types.NamespacedName]*L7Route,
 	graphRoutes map[RouteKey]*L7Route,
@@ -767,25 +1225,32 @@ func buildGatewayHostPortPaths(route *L7Route) map[string]string {
 // Policies are sorted by timestamp and then alphabetically.
 func markConflictedPolicies(pols map[PolicyKey]*Policy, validator validation.PolicyValidator) {
 	// Policies can only conflict if they are the same policy type (gvk) and they target the same resource(s).
+	// Policies that target different sections of the same resource never apply to the same configuration,
+	// so they cannot conflict. A policy that targets the whole resource can conflict with any of them.
 	type key struct {
 		policyGVK schema.GroupVersionKind
 		PolicyTargetRef
 	}
 
-	possibles := make(map[key][]*Policy)
+	type possible struct {
+		policy      *Policy
+		sectionName *v1.SectionName
+	}
+
+	possibles := make(map[key][]possible)
 
 	for policyKey, policy := range pols {
 		// If a policy is invalid, it cannot conflict with another policy.
 		if policy.Valid {
 			for _, ref := range policy.TargetRefs {
+				sectionName := ref.SectionName
+				ref.SectionName = nil
+
 				ak := key{
 					PolicyTargetRef: ref,
 					policyGVK:       policyKey.GVK,
 				}
-				if possibles[ak] == nil {
-					possibles[ak] = make([]*Policy, 0)
-				}
-				possibles[ak] = append(possibles[ak], policy)
+				possibles[ak] = append(possibles[ak], possible{policy: policy, sectionName: sectionName})
 			}
 		}
 	}
@@ -800,13 +1265,13 @@ func markConflictedPolicies(pols map[PolicyKey]*Policy, validator validation.Pol
 		// This will put them in priority-order.
 		sort.Slice(
 			policyList, func(i, j int) bool {
-				return ngfsort.LessClientObject(policyList[i].Source, policyList[j].Source)
+				return ngfsort.LessClientObject(policyList[i].policy.Source, policyList[j].policy.Source)
 			},
 		)
 
 		// Second, we range over the policyList, starting with the highest priority policy.
 		for i := range policyList {
-			if !policyList[i].Valid {
+			if !policyList[i].policy.Valid {
 				// Ignore policy that has already been marked as invalid.
 				continue
 			}
@@ -821,13 +1286,18 @@ func markConflictedPolicies(pols map[PolicyKey]*Policy, validator validation.Pol
 			// i=C => j loop terminates.
 			// Results: A, and C are valid. B is invalid.
 			for j := i + 1; j < len(policyList); j++ {
-				if !policyList[j].Valid {
+				if !policyList[j].policy.Valid {
 					// Ignore policy that has already been marked as invalid.
 					continue
 				}
 
-				if validator.Conflicts(policyList[i].Source, policyList[j].Source) {
-					conflicted := policyList[j]
+				if policyList[i].policy == policyList[j].policy ||
+					!sectionNamesOverlap(policyList[i].sectionName, policyList[j].sectionName) {
+					continue
+				}
+
+				if validator.Conflicts(policyList[i].policy.Source, policyList[j].policy.Source) {
+					conflicted := policyList[j].policy
 					conflicted.Valid = false
 					conflicted.Conditions = append(conflicted.Conditions, conditions.NewPolicyConflicted(
 						fmt.Sprintf(
@@ -841,6 +1311,12 @@ func markConflictedPolicies(pols map[PolicyKey]*Policy, validator validation.Pol
 	}
 }
 
+// sectionNamesOverlap returns true if two targetRefs of the same object target overlapping sections of it.
+// A nil sectionName targets the whole object.
+func sectionNamesOverlap(a, b *v1.SectionName) bool {
+	return a == nil || b == nil || *a == *b
+}
+
 // refGroupKind formats the group and kind as a string.
 func refGroupKind(group v1.Group, kind v1.Kind) string {
 	if group == "" {
@@ -855,6 +1331,7 @@ func refGroupKind(group v1.Group, kind v1.Kind) string {
 func addPolicyAffectedStatusToTargetRefs(
 	processedPolicies map[PolicyKey]*Policy,
 	routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
 	gws map[types.NamespacedName]*Gateway,
 ) {
 	for policyKey, policy := range processedPolicies {
@@ -882,6 +1359,15 @@ func addPolicyAffectedStatusToTargetRefs(
 				// set the policy status on L7 routes.
 				policyKind := policyKey.GVK.Kind
 				addStatusToTargetRefs(policyKind, &l7route.Conditions)
+			case kinds.TCPRoute:
+				l4route, exists := l4Routes[l4RouteKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				// set the policy status on L4 routes.
+				policyKind := policyKey.GVK.Kind
+				addStatusToTargetRefs(policyKind, &l4route.Conditions)
 			default:
 				continue
 			}
@@ -919,6 +1405,21 @@ func addStatusToTargetRefs(policyKind string, conditionsList *[]conditions.Condi
 			return
 		}
 		*conditionsList = append(*conditionsList, conditions.NewRateLimitPolicyAffected())
+	case kinds.ConnectionLimitPolicy:
+		if conditions.HasMatchingCondition(*conditionsList, conditions.NewConnectionLimitPolicyAffected()) {
+			return
+		}
+		*conditionsList = append(*conditionsList, conditions.NewConnectionLimitPolicyAffected())
+	case kinds.AccessControlPolicy:
+		if conditions.HasMatchingCondition(*conditionsList, conditions.NewAccessControlPolicyAffected()) {
+			return
+		}
+		*conditionsList = append(*conditionsList, conditions.NewAccessControlPolicyAffected())
+	case kinds.CachePolicy:
+		if conditions.HasMatchingCondition(*conditionsList, conditions.NewCachePolicyAffected()) {
+			return
+		}
+		*conditionsList = append(*conditionsList, conditions.NewCachePolicyAffected())
 	case kinds.WAFPolicy:
 		if conditions.HasMatchingCondition(*conditionsList, conditions.NewWAFPolicyAffected()) {
 			return
@@ -939,10 +1440,11 @@ func processWAFPolicies(
 	}
 
 	output := &WAFProcessingOutput{
-		Bundles:              make(map[WAFBundleKey]*WAFBundleData),
-		ReferencedAPPolicies: make(map[types.NamespacedName]*unstructured.Unstructured),
-		ReferencedAPLogConfs: make(map[types.NamespacedName]*unstructured.Unstructured),
-		ReferencedWAFSecrets: make(map[types.NamespacedName]*corev1.Secret),
+		Bundles:                 make(map[WAFBundleKey]*WAFBundleData),
+		ReferencedAPPolicies:    make(map[types.NamespacedName]*unstructured.Unstructured),
+		ReferencedAPLogConfs:    make(map[types.NamespacedName]*unstructured.Unstructured),
+		ReferencedWAFSecrets:    make(map[types.NamespacedName]*corev1.Secret),
+		ReferencedWAFConfigMaps: make(map[types.NamespacedName]*corev1.ConfigMap),
 	}
 
 	for key, policy := range processedPolicies {
@@ -962,7 +1464,8 @@ func processWAFPolicies(
 		// Initialize the WAFBundles map on the policy to store fetched bundles.
 		// This allows each gateway to receive only the bundles for policies that target it.
 		policy.WAFState = &PolicyWAFState{
-			Bundles: make(map[WAFBundleKey]*WAFBundleData),
+			Bundles:             make(map[WAFBundleKey]*WAFBundleData),
+			ResolvedSigningKeys: make(map[WAFBundleKey][]byte),
 		}
 
 		if wgbPolicy.Spec.Type == ngfAPIv1alpha1.PolicySourceTypePLM {
@@ -977,14 +1480,16 @@ func processWAFPolicies(
 	return output
 }
 
-// BuildPolicyFetchRequest constructs a fetch.Request from a PolicySource, resolved auth, and TLS CA data.
+// BuildPolicyFetchRequest constructs a fetch.Request from a PolicySource, resolved auth, TLS CA data,
+// and signature verification key.
 //
-//nolint:gocyclo // complexity is inherent to handling HTTP/NIM/N1C source types with different field structures
+//nolint:gocyclo // complexity is inherent to handling HTTP/NIM/N1C/OCI source types with different field structures
 func BuildPolicyFetchRequest(
 	policySource *ngfAPIv1alpha1.PolicySource,
 	policyType ngfAPIv1alpha1.PolicySourceType,
 	auth *fetch.BundleAuth,
 	tlsCA []byte,
+	signingKey []byte,
 ) fetch.Request {
 	if policySource == nil {
 		return fetch.Request{}
@@ -995,6 +1500,7 @@ func BuildPolicyFetchRequest(
 		InsecureSkipVerify: policySource.InsecureSkipVerify,
 		VerifyChecksum:     policySource.Validation != nil && policySource.Validation.VerifyChecksum,
 		ExpectedChecksum:   expectedChecksum(policySource.Validation),
+		Signature:          signatureRequest(policySource.Validation, signingKey),
 		Timeout:            policySource.Timeout,
 		RetryAttempts:      retryAttempts(policySource.RetryAttempts),
 	}
@@ -1032,16 +1538,22 @@ func BuildPolicyFetchRequest(
 				req.Auth = &fetch.BundleAuth{APIToken: auth.BearerToken}
 			}
 		}
+	case ngfAPIv1alpha1.PolicySourceTypeOCI:
+		if policySource.OCISource != nil {
+			req.OCI.Reference = policySource.OCISource.Reference
+		}
 	}
 
 	return req
 }
 
-// BuildLogFetchRequest constructs a fetch.Request from a LogSource, resolved auth, and TLS CA data.
+// BuildLogFetchRequest constructs a fetch.Request from a LogSource, resolved auth, TLS CA data,
+// and signature verification key.
 func BuildLogFetchRequest(
 	logSource *ngfAPIv1alpha1.LogSource,
 	auth *fetch.BundleAuth,
 	tlsCA []byte,
+	signingKey []byte,
 ) fetch.Request {
 	if logSource == nil {
 		return fetch.Request{}
@@ -1052,6 +1564,7 @@ func BuildLogFetchRequest(
 		InsecureSkipVerify: logSource.InsecureSkipVerify,
 		VerifyChecksum:     logSource.Validation != nil && logSource.Validation.VerifyChecksum,
 		ExpectedChecksum:   expectedChecksum(logSource.Validation),
+		Signature:          signatureRequest(logSource.Validation, signingKey),
 		Timeout:            logSource.Timeout,
 		RetryAttempts:      retryAttempts(logSource.RetryAttempts),
 	}
@@ -1131,36 +1644,120 @@ func fetchPolicyBundle(
 		}
 	}
 
+	bundleKey := PolicyBundleKey(types.NamespacedName{Namespace: wafPolicy.Namespace, Name: wafPolicy.Name})
+
+	var signingKey []byte
+	if policySource.Validation != nil && policySource.Validation.Signature != nil {
+		var cond *conditions.Condition
+		signingKey, cond = resolveSigningKey(policySource.Validation.Signature, wafPolicy.Namespace, wafInput, output)
+		if cond != nil {
+			policy.Conditions = append(policy.Conditions, *cond)
+			policy.Valid = false
+			return
+		}
+		policy.WAFState.ResolvedSigningKeys[bundleKey] = signingKey
+	}
+
 	// Store resolved auth/TLS for use by the WAF polling manager.
 	policy.WAFState.ResolvedAuth = auth
 	policy.WAFState.ResolvedTLSCA = tlsCA
 
-	bundleKey := PolicyBundleKey(types.NamespacedName{Namespace: wafPolicy.Namespace, Name: wafPolicy.Name})
-
-	req := BuildPolicyFetchRequest(policySource, wafPolicy.Spec.Type, auth, tlsCA)
+	req := BuildPolicyFetchRequest(policySource, wafPolicy.Spec.Type, auth, tlsCA, signingKey)
 
 	result, err := wafInput.Fetcher.FetchPolicyBundle(ctx, req)
+	if err == nil {
+		err = fetch.VerifyBundleSignature(req, result)
+	}
 	if err != nil {
 		logger.Error(err, "Failed to fetch WAF policy bundle", "resource", wafPolicy.Name)
-		if prev, ok := wafInput.PreviousBundles[bundleKey]; ok {
-			cond := conditions.NewPolicyProgrammedStaleBundleWarning("policy bundle", err.Error())
-			policy.Conditions = append(policy.Conditions, cond)
+		prev, ok, prevErr := liveBundle(wafInput.PreviousBundles, bundleKey, req)
+		if ok {
+			policy.Conditions = append(policy.Conditions, StaleBundleCondition("policy bundle", err))
 			output.Bundles[bundleKey] = prev
 			policy.WAFState.Bundles[bundleKey] = prev
 			return
 		}
-		cond := conditions.NewPolicyNotProgrammedBundlePending(err.Error())
-		policy.Conditions = append(policy.Conditions, cond)
+		if prevErr != nil {
+			err = prevErr
+		}
+		policy.Conditions = append(policy.Conditions, pendingBundleCondition(err))
 		policy.WAFState.BundlePending = true
 		return
 	}
 
-	bundleData := &WAFBundleData{Data: result.Data, Checksum: result.Checksum}
+	if keepLiveBundle(logger, wafInput, output, policy, bundleKey, req, result.Checksum) {
+		return
+	}
+
+	bundleData := &WAFBundleData{Data: result.Data, Checksum: result.Checksum, Signature: result.Signature}
 	output.Bundles[bundleKey] = bundleData
 	policy.WAFState.Bundles[bundleKey] = bundleData
 }
 
-// fetchSecurityLogBundles fetches log profile bundles for each SecurityLog entry.
+// liveBundle returns a copy of the previous bundle for the key, without the RejectedChecksum set by the WAF
+// polling manager. ok is false if no previous bundle is available, including when a bundle was rolled back
+// before any bundle was deployed.
+// The previous bundle may have been restored from the bundle cache, so its signature is verified again if req
+// configures signature verification. An error is returned if the verification fails.
+func liveBundle(
+	previous map[WAFBundleKey]*WAFBundleData,
+	key WAFBundleKey,
+	req fetch.Request,
+) (*WAFBundleData, bool, error) {
+	prev, ok := previous[key]
+	if !ok || prev.Data == nil {
+		return nil, false, nil
+	}
+
+	if err := fetch.VerifyBundleSignature(req, fetch.Result{Data: prev.Data, Signature: prev.Signature}); err != nil {
+		return nil, false, fmt.Errorf("previously fetched bundle: %w", err)
+	}
+
+	return &WAFBundleData{Data: prev.Data, Checksum: prev.Checksum, Signature: prev.Signature}, true, nil
+}
+
+// keepLiveBundle keeps the live bundle for the key if the fetched bundle with the checksum was rolled back
+// during a canary rollout, so that rebuilding the graph doesn't redeploy the rejected bundle.
+// If no bundle was live before the rollback, the bundle is marked as pending.
+// It returns false if the fetched bundle wasn't rejected and should be used.
+func keepLiveBundle(
+	logger logr.Logger,
+	wafInput *WAFProcessingInput,
+	output *WAFProcessingOutput,
+	policy *Policy,
+	key WAFBundleKey,
+	req fetch.Request,
+	checksum string,
+) bool {
+	prev, ok := wafInput.PreviousBundles[key]
+	if !ok || prev.RejectedChecksum == "" || prev.RejectedChecksum != checksum {
+		return false
+	}
+
+	logger.Info(
+		"Fetched WAF bundle was rolled back, keeping the last good bundle",
+		"bundle", key,
+		"checksum", checksum,
+	)
+
+	live, ok, err := liveBundle(wafInput.PreviousBundles, key, req)
+	if !ok {
+		if err == nil {
+			err = fmt.Errorf("bundle with checksum %s was rolled back", checksum)
+		}
+		policy.Conditions = append(policy.Conditions, pendingBundleCondition(err))
+		policy.WAFState.BundlePending = true
+		return true
+	}
+
+	output.Bundles[key] = live
+	policy.WAFState.Bundles[key] = live
+
+	return true
+}
+
+// fetchSecurityLogBundles fetches log profile bundles for each SecurityLog entry, including the entries of
+// routeSecurityLogs.
 func fetchSecurityLogBundles(
 	ctx context.Context,
 	logger logr.Logger,
@@ -1169,7 +1766,7 @@ func fetchSecurityLogBundles(
 	wafInput *WAFProcessingInput,
 	output *WAFProcessingOutput,
 ) {
-	for _, secLog := range wafPolicy.Spec.SecurityLogs {
+	for _, secLog := range WAFSecurityLogs(wafPolicy.Spec) {
 		if secLog.LogSource == nil {
 			continue
 		}
@@ -1214,9 +1811,26 @@ func fetchSecurityLogBundles(
 			continue
 		}
 
-		req := BuildLogFetchRequest(secLog.LogSource, auth, tlsCA)
+		var signingKey []byte
+		if secLog.LogSource.Validation != nil && secLog.LogSource.Validation.Signature != nil {
+			var cond *conditions.Condition
+			signingKey, cond = resolveSigningKey(
+				secLog.LogSource.Validation.Signature, wafPolicy.Namespace, wafInput, output,
+			)
+			if cond != nil {
+				policy.Conditions = append(policy.Conditions, *cond)
+				policy.Valid = false
+				continue
+			}
+			policy.WAFState.ResolvedSigningKeys[bundleKey] = signingKey
+		}
+
+		req := BuildLogFetchRequest(secLog.LogSource, auth, tlsCA, signingKey)
 
 		result, err := wafInput.Fetcher.FetchLogProfileBundle(ctx, req)
+		if err == nil {
+			err = fetch.VerifyBundleSignature(req, result)
+		}
 		if err != nil {
 			logger.Error(
 				err,
@@ -1224,20 +1838,27 @@ func fetchSecurityLogBundles(
 				"resource",
 				wafPolicy.Name,
 			)
-			if prev, ok := wafInput.PreviousBundles[bundleKey]; ok {
-				cond := conditions.NewPolicyProgrammedStaleBundleWarning(LogBundleDescription(secLog.LogSource), err.Error())
+			prev, ok, prevErr := liveBundle(wafInput.PreviousBundles, bundleKey, req)
+			if ok {
+				cond := StaleBundleCondition(LogBundleDescription(secLog.LogSource), err)
 				policy.Conditions = append(policy.Conditions, cond)
 				output.Bundles[bundleKey] = prev
 				policy.WAFState.Bundles[bundleKey] = prev
 				continue
 			}
-			cond := conditions.NewPolicyNotProgrammedBundlePending(err.Error())
-			policy.Conditions = append(policy.Conditions, cond)
+			if prevErr != nil {
+				err = prevErr
+			}
+			policy.Conditions = append(policy.Conditions, pendingBundleCondition(err))
 			policy.WAFState.BundlePending = true
 			continue
 		}
 
-		bundleData := &WAFBundleData{Data: result.Data, Checksum: result.Checksum}
+		if keepLiveBundle(logger, wafInput, output, policy, bundleKey, req, result.Checksum) {
+			continue
+		}
+
+		bundleData := &WAFBundleData{Data: result.Data, Checksum: result.Checksum, Signature: result.Signature}
 		output.Bundles[bundleKey] = bundleData
 		policy.WAFState.Bundles[bundleKey] = bundleData
 	}
@@ -1251,6 +1872,44 @@ func expectedChecksum(v *ngfAPIv1alpha1.BundleValidation) string {
 	return *v.ExpectedChecksum
 }
 
+// signatureRequest returns the signature parameters of a fetch.Request for a BundleValidation and the
+// resolved signature verification key. The signature URL is left empty to use the default sidecar URL.
+func signatureRequest(v *ngfAPIv1alpha1.BundleValidation, signingKey []byte) fetch.SignatureRequest {
+	if v == nil || v.Signature == nil || len(signingKey) == 0 {
+		return fetch.SignatureRequest{}
+	}
+
+	sigReq := fetch.SignatureRequest{PublicKey: signingKey}
+	if v.Signature.URL != nil {
+		sigReq.URL = *v.Signature.URL
+	}
+
+	return sigReq
+}
+
+// StaleBundleCondition returns the condition reported when a bundle could not be fetched or failed
+// signature verification, and the previously fetched bundle is kept active.
+// bundleDescription is a human-readable label, e.g. "policy bundle" or "security log bundle (profile: default)".
+func StaleBundleCondition(bundleDescription string, err error) conditions.Condition {
+	var sigErr *fetch.SignatureError
+	if errors.As(err, &sigErr) {
+		return conditions.NewPolicyProgrammedSignatureVerificationFailed(bundleDescription, err.Error())
+	}
+
+	return conditions.NewPolicyProgrammedStaleBundleWarning(bundleDescription, err.Error())
+}
+
+// pendingBundleCondition returns the condition reported when a bundle could not be fetched or failed
+// signature verification, and no previously fetched bundle is available.
+func pendingBundleCondition(err error) conditions.Condition {
+	var sigErr *fetch.SignatureError
+	if errors.As(err, &sigErr) {
+		return conditions.NewPolicyNotProgrammedSignatureVerificationFailed(err.Error())
+	}
+
+	return conditions.NewPolicyNotProgrammedBundlePending(err.Error())
+}
+
 // resolveBundleAuth resolves a BundleAuth reference into fetch.BundleAuth credentials.
 // It looks up the referenced Secret from wafInput.Secrets and adds it to output.ReferencedWAFSecrets.
 // bundleAuth must not be nil.
@@ -1349,6 +2008,70 @@ func resolveTLSCA(
 	return caData, nil
 }
 
+// resolveSigningKey resolves the Secret or ConfigMap referenced by a BundleSignature into the PEM-encoded
+// signature verification key. It looks up the referenced object from wafInput and adds it to output.
+// Returns a non-nil *conditions.Condition on failure so callers can append it directly.
+func resolveSigningKey(
+	signature *ngfAPIv1alpha1.BundleSignature,
+	policyNamespace string,
+	wafInput *WAFProcessingInput,
+	output *WAFProcessingOutput,
+) ([]byte, *conditions.Condition) {
+	nsName := types.NamespacedName{
+		Namespace: policyNamespace,
+		Name:      signature.KeyRef.Name,
+	}
+
+	var keyData []byte
+	var objDesc string
+
+	if signature.KeyRef.Kind == ngfAPIv1alpha1.SigningKeyKindConfigMap {
+		objDesc = fmt.Sprintf("signing key ConfigMap %q", nsName)
+
+		cm, exists := wafInput.ConfigMaps[nsName]
+		// Track the ConfigMap even if it does not exist, so that a rebuild is triggered when it appears.
+		output.ReferencedWAFConfigMaps[nsName] = cm
+		if !exists {
+			cond := conditions.NewPolicyRefsNotResolved(fmt.Sprintf("%s not found", objDesc))
+			return nil, &cond
+		}
+
+		if data, ok := cm.Data[secrets.BundleSigningKeyKey]; ok {
+			keyData = []byte(data)
+		} else {
+			keyData = cm.BinaryData[secrets.BundleSigningKeyKey]
+		}
+	} else {
+		objDesc = fmt.Sprintf("signing key secret %q", nsName)
+
+		secret, exists := wafInput.Secrets[nsName]
+		// Track the Secret even if it does not exist, so that a rebuild is triggered when it appears.
+		output.ReferencedWAFSecrets[nsName] = secret
+		if !exists {
+			cond := conditions.NewPolicyRefsNotResolved(fmt.Sprintf("%s not found", objDesc))
+			return nil, &cond
+		}
+
+		keyData = secret.Data[secrets.BundleSigningKeyKey]
+	}
+
+	if len(bytes.TrimSpace(keyData)) == 0 {
+		cond := conditions.NewPolicyRefsNotResolved(
+			fmt.Sprintf("%s has missing or empty %q key", objDesc, secrets.BundleSigningKeyKey),
+		)
+		return nil, &cond
+	}
+
+	if _, err := fetch.ParseVerificationKey(keyData); err != nil {
+		cond := conditions.NewPolicyRefsNotResolved(
+			fmt.Sprintf("%s has invalid %q key: %s", objDesc, secrets.BundleSigningKeyKey, err),
+		)
+		return nil, &cond
+	}
+
+	return keyData, nil
+}
+
 // PLMPolicyBundleKey returns the WAFBundleKey for a PLM WAFPolicy's main policy bundle.
 func PLMPolicyBundleKey(policyNsName types.NamespacedName) WAFBundleKey {
 	return WAFBundleKey(fmt.Sprintf("%s_%s", policyNsName.Namespace, policyNsName.Name))
@@ -1539,7 +2262,7 @@ func fetchPLMSecurityLogBundles(
 ) {
 	policyNsName := types.NamespacedName{Namespace: wafPolicy.Namespace, Name: wafPolicy.Name}
 
-	for _, secLog := range wafPolicy.Spec.SecurityLogs {
+	for _, secLog := range WAFSecurityLogs(wafPolicy.Spec) {
 		if secLog.LogRef == nil || secLog.LogRef.APLogConfRef == nil {
 			// DefaultProfile or other source — no PLM bundle to fetch.
 			continue
@@ -1770,7 +2493,8 @@ func handlePLMBundleFetchError(
 	bundleDescription string,
 	err error,
 ) (*WAFBundleData, bool) {
-	if prev, ok := previousBundles[bundleKey]; ok {
+	// PLM bundles are not signed.
+	if prev, ok, _ := liveBundle(previousBundles, bundleKey, fetch.Request{}); ok {
 		cond := conditions.NewPolicyProgrammedStaleBundleWarning(bundleDescription, err.Error())
 		policy.Conditions = append(policy.Conditions, cond)
 		policy.WAFState.Bundles[bundleKey] = prev
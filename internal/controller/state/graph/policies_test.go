@@ -2,6 +2,9 @@ package graph
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"slices"
 	"strings"
@@ -274,6 +277,22 @@ func TestAttachPolicyToRoute(t *testing.T) {
 					Namespace: routeNsName.Namespace,
 				},
 			},
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{},
+					{Name: "rule-1"},
+					{
+						Name: "rule-2",
+						Matches: []v1.HTTPRouteMatch{
+							{Path: &v1.HTTPPathMatch{Value: helpers.GetPointer("/coffee")}},
+							{
+								Path:    &v1.HTTPPathMatch{Value: helpers.GetPointer("/coffee")},
+								Headers: []v1.HTTPHeaderMatch{{Name: "version", Value: "v2"}},
+							},
+						},
+					},
+				},
+			},
 			Valid:      valid,
 			Attachable: attachable,
 			RouteType:  routeType,
@@ -310,6 +329,60 @@ func TestAttachPolicyToRoute(t *testing.T) {
 		}
 	}
 
+	createExpRuleAncestor := func(sectionName v1.SectionName) v1.ParentReference {
+		ancestor := createExpAncestor(kinds.HTTPRoute)
+		ancestor.SectionName = &sectionName
+
+		return ancestor
+	}
+
+	// createRouteForPath returns a route with the rule "rule-1" that matches the path on the hostname foo.example.com.
+	createRouteForPath := func(name, path string) *L7Route {
+		return &L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: routeNsName.Namespace,
+				},
+			},
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{
+						Name:         "rule-1",
+						Matches:      []v1.HTTPRouteMatch{{Path: &v1.HTTPPathMatch{Value: helpers.GetPointer(path)}}},
+						ValidMatches: true,
+					},
+				},
+			},
+			Valid:      true,
+			Attachable: true,
+			RouteType:  RouteTypeHTTP,
+			ParentRefs: []ParentRef{
+				{
+					Kind:          kinds.Gateway,
+					GatewayNsName: types.NamespacedName{Namespace: testNs, Name: "gateway"},
+					Attachment: &ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"test/gateway/listener": {"foo.example.com"}},
+						Attached:          true,
+					},
+				},
+			},
+		}
+	}
+
+	createRoutes := func(route, otherRoute *L7Route) map[RouteKey]*L7Route {
+		return map[RouteKey]*L7Route{
+			{NamespacedName: routeNsName, RouteType: RouteTypeHTTP}: route,
+			{
+				NamespacedName: types.NamespacedName{Namespace: routeNsName.Namespace, Name: otherRoute.Source.GetName()},
+				RouteType:      RouteTypeHTTP,
+			}: otherRoute,
+		}
+	}
+
+	sharedPathRoute := createRouteForPath(routeNsName.Name, "/tea")
+	uniquePathRoute := createRouteForPath(routeNsName.Name, "/tea")
+
 	validatorError := &policiesfakes.FakeValidator{
 		ValidateGlobalSettingsStub: func(_ policies.Policy, gs *policies.GlobalSettings) []conditions.Condition {
 			if !gs.TelemetryEnabled {
@@ -322,12 +395,15 @@ func TestAttachPolicyToRoute(t *testing.T) {
 	}
 
 	tests := []struct {
-		route        *L7Route
-		policy       *Policy
-		validator    policies.Validator
-		name         string
-		expAncestors []PolicyAncestor
-		expAttached  bool
+		route           *L7Route
+		policy          *Policy
+		validator       policies.Validator
+		routes          map[RouteKey]*L7Route
+		name            string
+		ref             PolicyTargetRef
+		expAncestors    []PolicyAncestor
+		expAttached     bool
+		expRuleAttached bool
 	}{
 		{
 			name:      "policy attaches to http route",
@@ -349,6 +425,113 @@ func TestAttachPolicyToRoute(t *testing.T) {
 			},
 			expAttached: true,
 		},
+		{
+			name:      "policy attaches to named rule of http route",
+			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-1")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpRuleAncestor("rule-1")},
+			},
+			expAttached:     false,
+			expRuleAttached: true,
+		},
+		{
+			name:      "rate limit policy attaches to named rule of http route with a single match",
+			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-1")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &ngfAPIv1alpha1.RateLimitPolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpRuleAncestor("rule-1")},
+			},
+			expAttached:     false,
+			expRuleAttached: true,
+		},
+		{
+			name:      "no attachment; rate limit policy targets named rule with two matches",
+			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-2")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &ngfAPIv1alpha1.RateLimitPolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: createExpRuleAncestor("rule-2"),
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyInvalid(
+							"RateLimitPolicy cannot target the rule \"rule-2\", because the rule has several matches " +
+								"for the same path or matches on headers, query parameters or the method; " +
+								"target the Route instead",
+						),
+					},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:      "no attachment; rate limit policy targets named rule with a path of another route",
+			route:     sharedPathRoute,
+			routes:    createRoutes(sharedPathRoute, createRouteForPath("other-route", "/tea")),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-1")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &ngfAPIv1alpha1.RateLimitPolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: createExpRuleAncestor("rule-1"),
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyInvalid(
+							"RateLimitPolicy cannot target the rule \"rule-1\", because another rule of this or " +
+								"another Route has a match for the same path on the same host; target the Route instead",
+						),
+					},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:      "rate limit policy attaches to named rule with a path that other routes don't match",
+			route:     uniquePathRoute,
+			routes:    createRoutes(uniquePathRoute, createRouteForPath("other-route", "/coffee")),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-1")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &ngfAPIv1alpha1.RateLimitPolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpRuleAncestor("rule-1")},
+			},
+			expAttached:     false,
+			expRuleAttached: true,
+		},
+		{
+			name:      "other policy attaches to named rule with two matches",
+			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("rule-2")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpRuleAncestor("rule-2")},
+			},
+			expAttached:     false,
+			expRuleAttached: true,
+		},
+		{
+			name:      "no attachment; sectionName does not match a rule",
+			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			ref:       PolicyTargetRef{SectionName: helpers.GetPointer[v1.SectionName]("missing")},
+			validator: &policiesfakes.FakeValidator{},
+			policy:    &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: createExpRuleAncestor("missing"),
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyTargetNotFound(
+							"The TargetRef sectionName \"missing\" does not match the name of any rule",
+						),
+					},
+				},
+			},
+			expAttached: false,
+		},
 		{
 			name:      "attachment with existing ancestor",
 			route:     createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
@@ -509,7 +692,15 @@ func TestAttachPolicyToRoute(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToRoute(test.policy, test.route, test.validator, "nginx-gateway", logr.Discard())
+			attachPolicyToRoute(
+				test.policy,
+				test.ref,
+				test.route,
+				test.routes,
+				test.validator,
+				"nginx-gateway",
+				logr.Discard(),
+			)
 
 			if test.expAttached {
 				g.Expect(test.route.Policies).To(HaveLen(1))
@@ -517,11 +708,190 @@ func TestAttachPolicyToRoute(t *testing.T) {
 				g.Expect(test.route.Policies).To(BeEmpty())
 			}
 
+			var rulePolicies []*Policy
+			for _, rule := range test.route.Spec.Rules {
+				rulePolicies = append(rulePolicies, rule.Policies...)
+			}
+
+			if test.expRuleAttached {
+				g.Expect(rulePolicies).To(HaveLen(1))
+			} else {
+				g.Expect(rulePolicies).To(BeEmpty())
+			}
+
 			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
 		})
 	}
 }
 
+func TestRoutedViaInternalLocations(t *testing.T) {
+	t.Parallel()
+
+	pathMatch := func(pathType v1.PathMatchType, value string) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{Path: &v1.HTTPPathMatch{Type: &pathType, Value: &value}}
+	}
+
+	tests := []struct {
+		name    string
+		matches []v1.HTTPRouteMatch
+		exp     bool
+	}{
+		{
+			name: "no matches",
+			exp:  false,
+		},
+		{
+			name:    "matches with different paths",
+			matches: []v1.HTTPRouteMatch{pathMatch(v1.PathMatchPathPrefix, "/a"), pathMatch(v1.PathMatchExact, "/a")},
+			exp:     false,
+		},
+		{
+			name:    "matches with the same path",
+			matches: []v1.HTTPRouteMatch{pathMatch(v1.PathMatchExact, "/a"), pathMatch(v1.PathMatchExact, "/a")},
+			exp:     true,
+		},
+		{
+			name:    "match without path and match for the root path",
+			matches: []v1.HTTPRouteMatch{{}, pathMatch(v1.PathMatchPathPrefix, "/")},
+			exp:     true,
+		},
+		{
+			name:    "method match",
+			matches: []v1.HTTPRouteMatch{{Method: helpers.GetPointer(v1.HTTPMethodGet)}},
+			exp:     true,
+		},
+		{
+			name: "query param match",
+			matches: []v1.HTTPRouteMatch{
+				{QueryParams: []v1.HTTPQueryParamMatch{{Name: "version", Value: "v2"}}},
+			},
+			exp: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(routedViaInternalLocations(test.matches)).To(Equal(test.exp))
+		})
+	}
+}
+
+func TestAttachPolicyToL4Route(t *testing.T) {
+	t.Parallel()
+	routeNsName := types.NamespacedName{Namespace: testNs, Name: "tcp-route"}
+
+	createRoute := func(valid, attachable, parentRefs bool) *L4Route {
+		route := &L4Route{
+			Source: &v1.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      routeNsName.Name,
+					Namespace: routeNsName.Namespace,
+				},
+			},
+			Valid:      valid,
+			Attachable: attachable,
+			RouteType:  RouteTypeTCP,
+		}
+
+		if parentRefs {
+			route.ParentRefs = []ParentRef{
+				{
+					Kind:          kinds.Gateway,
+					GatewayNsName: types.NamespacedName{Namespace: testNs, Name: "gateway"},
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+					},
+					EffectiveNginxProxy: &EffectiveNginxProxy{},
+				},
+			}
+		}
+
+		return route
+	}
+
+	expAncestor := PolicyAncestor{
+		Ancestor: createParentReference(v1.GroupName, kinds.TCPRoute, routeNsName),
+	}
+
+	tests := []struct {
+		route        *L4Route
+		validator    *policiesfakes.FakeValidator
+		name         string
+		expAncestors []PolicyAncestor
+		expAttached  bool
+	}{
+		{
+			name:         "policy attaches to tcp route",
+			route:        createRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator:    &policiesfakes.FakeValidator{},
+			expAncestors: []PolicyAncestor{expAncestor},
+			expAttached:  true,
+		},
+		{
+			name:  "no attachment; invalid for the gateway",
+			route: createRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{
+				ValidateGlobalSettingsStub: func(_ policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+					return []conditions.Condition{conditions.NewPolicyNotAcceptedNginxProxyNotSet("test")}
+				},
+			},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   expAncestor.Ancestor,
+					Conditions: []conditions.Condition{conditions.NewPolicyNotAcceptedNginxProxyNotSet("test")},
+				},
+			},
+		},
+		{
+			name:      "no attachment; route is invalid",
+			route:     createRoute(false /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   expAncestor.Ancestor,
+					Conditions: []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")},
+				},
+			},
+		},
+		{
+			name:      "no attachment; route has no parent refs",
+			route:     createRoute(true /*valid*/, true /*attachable*/, false /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   expAncestor.Ancestor,
+					Conditions: []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &Policy{
+				Source:             createTestPolicyWithAncestors(0),
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			}
+
+			attachPolicyToL4Route(policy, test.route, test.validator, "nginx-gateway", logr.Discard())
+
+			if test.expAttached {
+				g.Expect(test.route.Policies).To(HaveLen(1))
+			} else {
+				g.Expect(test.route.Policies).To(BeEmpty())
+			}
+
+			g.Expect(policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+		})
+	}
+}
+
 func TestAttachPolicyToGateway(t *testing.T) {
 	t.Parallel()
 	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
@@ -772,6 +1142,327 @@ func TestAttachPolicyToGateway(t *testing.T) {
 	}
 }
 
+func TestAttachPolicyToGatewayListener(t *testing.T) {
+	t.Parallel()
+	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+
+	newGateway := func() *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      gatewayNsName.Name,
+					Namespace: gatewayNsName.Namespace,
+				},
+			},
+			Listeners: []*Listener{
+				{Name: "http"},
+				{
+					Name:   "tls",
+					Source: v1.Listener{Protocol: v1.TLSProtocolType},
+				},
+				{
+					Name:            "other",
+					ListenerSetName: types.NamespacedName{Namespace: testNs, Name: "listener-set"},
+				},
+			},
+			Valid:               true,
+			EffectiveNginxProxy: &EffectiveNginxProxy{},
+		}
+	}
+
+	validator := &policiesfakes.FakeValidator{}
+
+	tests := []struct {
+		sectionName         v1.SectionName
+		name                string
+		expAncestors        []PolicyAncestor
+		expListenerAttached bool
+	}{
+		{
+			name:        "attached to listener",
+			sectionName: "http",
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: v1.ParentReference{
+						Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:        helpers.GetPointer[v1.Kind](kinds.Gateway),
+						Namespace:   helpers.GetPointer(v1.Namespace(gatewayNsName.Namespace)),
+						Name:        v1.ObjectName(gatewayNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("http"),
+					},
+				},
+			},
+			expListenerAttached: true,
+		},
+		{
+			name:        "not attached; listener of a ListenerSet",
+			sectionName: "other",
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: v1.ParentReference{
+						Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:        helpers.GetPointer[v1.Kind](kinds.Gateway),
+						Namespace:   helpers.GetPointer(v1.Namespace(gatewayNsName.Namespace)),
+						Name:        v1.ObjectName(gatewayNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("other"),
+					},
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyTargetNotFound(
+							"The TargetRef sectionName \"other\" does not match the name of any listener",
+						),
+					},
+				},
+			},
+			expListenerAttached: false,
+		},
+		{
+			name:        "not attached; TLS listener",
+			sectionName: "tls",
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: v1.ParentReference{
+						Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:        helpers.GetPointer[v1.Kind](kinds.Gateway),
+						Namespace:   helpers.GetPointer(v1.Namespace(gatewayNsName.Namespace)),
+						Name:        v1.ObjectName(gatewayNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("tls"),
+					},
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyTargetNotFound(
+							"The TargetRef sectionName \"tls\" names a TLS listener; " +
+								"policies are not supported on TLS listeners",
+						),
+					},
+				},
+			},
+			expListenerAttached: false,
+		},
+		{
+			name:        "not attached; listener is not found",
+			sectionName: "missing",
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: v1.ParentReference{
+						Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:        helpers.GetPointer[v1.Kind](kinds.Gateway),
+						Namespace:   helpers.GetPointer(v1.Namespace(gatewayNsName.Namespace)),
+						Name:        v1.ObjectName(gatewayNsName.Name),
+						SectionName: helpers.GetPointer[v1.SectionName]("missing"),
+					},
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyTargetNotFound(
+							"The TargetRef sectionName \"missing\" does not match the name of any listener",
+						),
+					},
+				},
+			},
+			expListenerAttached: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gw := newGateway()
+			policy := &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				TargetRefs: []PolicyTargetRef{
+					{
+						Nsname:      gatewayNsName,
+						Kind:        kinds.Gateway,
+						SectionName: helpers.GetPointer(test.sectionName),
+					},
+				},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			}
+
+			attachPolicyToGateway(
+				policy,
+				policy.TargetRefs[0],
+				map[types.NamespacedName]*Gateway{gatewayNsName: gw},
+				nil,
+				"nginx-gateway",
+				logr.Discard(),
+				validator,
+			)
+
+			g.Expect(gw.Policies).To(BeEmpty())
+			for _, l := range gw.Listeners[1:] {
+				g.Expect(l.Policies).To(BeEmpty())
+			}
+			if test.expListenerAttached {
+				g.Expect(gw.Listeners[0].Policies).To(ConsistOf(policy))
+			} else {
+				g.Expect(gw.Listeners[0].Policies).To(BeEmpty())
+			}
+
+			g.Expect(policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+		})
+	}
+}
+
+func TestAttachConnectionLimitPolicyWithHTTPOnlyKey(t *testing.T) {
+	t.Parallel()
+	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: testNs, Name: "tcp-route"}
+
+	createPolicy := func(key string) *Policy {
+		return &Policy{
+			Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "clp", Namespace: testNs},
+				Spec: ngfAPIv1alpha1.ConnectionLimitPolicySpec{
+					ConnectionLimit: &ngfAPIv1alpha1.ConnectionLimit{
+						Rules: []ngfAPIv1alpha1.ConnectionLimitRule{
+							{Key: helpers.GetPointer("$binary_remote_addr")},
+							{Key: helpers.GetPointer(key)},
+						},
+					},
+				},
+			},
+			TargetRefs: []PolicyTargetRef{
+				{
+					Nsname: gatewayNsName,
+					Kind:   kinds.Gateway,
+				},
+			},
+			InvalidForGateways: map[types.NamespacedName]struct{}{},
+		}
+	}
+
+	createGateway := func(protocol v1.ProtocolType) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      gatewayNsName.Name,
+					Namespace: gatewayNsName.Namespace,
+				},
+			},
+			Listeners: []*Listener{
+				{
+					Name:   "http",
+					Source: v1.Listener{Protocol: v1.HTTPProtocolType},
+				},
+				{
+					Name:   "other",
+					Source: v1.Listener{Protocol: protocol},
+				},
+			},
+			Valid:               true,
+			EffectiveNginxProxy: &EffectiveNginxProxy{},
+		}
+	}
+
+	httpOnlyKeyCond := conditions.NewPolicyInvalid(
+		"The key \"$server_name\" uses variables that are only available to HTTP traffic, but the policy " +
+			"applies to TCP, TLS or UDP traffic",
+	)
+
+	gatewayTests := []struct {
+		name         string
+		key          string
+		protocol     v1.ProtocolType
+		expAncestors []PolicyAncestor
+		expAttached  bool
+	}{
+		{
+			name:     "not attached; http-only key and tcp listener",
+			key:      "$server_name",
+			protocol: v1.TCPProtocolType,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getGatewayParentRef(gatewayNsName),
+					Conditions: []conditions.Condition{httpOnlyKeyCond},
+				},
+			},
+		},
+		{
+			name:     "attached; http-only key and http listeners",
+			key:      "$server_name",
+			protocol: v1.HTTPSProtocolType,
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getGatewayParentRef(gatewayNsName)},
+			},
+			expAttached: true,
+		},
+		{
+			name:     "attached; stream-safe key and tcp listener",
+			key:      "$remote_addr",
+			protocol: v1.TCPProtocolType,
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getGatewayParentRef(gatewayNsName)},
+			},
+			expAttached: true,
+		},
+	}
+
+	for _, test := range gatewayTests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := createPolicy(test.key)
+			gws := map[types.NamespacedName]*Gateway{gatewayNsName: createGateway(test.protocol)}
+
+			attachPolicyToGateway(
+				policy,
+				policy.TargetRefs[0],
+				gws, nil,
+				"nginx-gateway",
+				logr.Discard(),
+				&policiesfakes.FakeValidator{},
+			)
+
+			if test.expAttached {
+				g.Expect(gws[gatewayNsName].Policies).To(ConsistOf(policy))
+				g.Expect(policy.InvalidForGateways).To(BeEmpty())
+			} else {
+				g.Expect(gws[gatewayNsName].Policies).To(BeEmpty())
+				g.Expect(policy.InvalidForGateways).To(HaveKey(gatewayNsName))
+			}
+
+			g.Expect(policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+		})
+	}
+
+	t.Run("not attached; http-only key and tcp route", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		route := &L4Route{
+			Source: &v1.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      routeNsName.Name,
+					Namespace: routeNsName.Namespace,
+				},
+			},
+			ParentRefs: []ParentRef{
+				{
+					Kind:                kinds.Gateway,
+					GatewayNsName:       gatewayNsName,
+					Attachment:          &ParentRefAttachmentStatus{Attached: true},
+					EffectiveNginxProxy: &EffectiveNginxProxy{},
+				},
+			},
+			Valid:      true,
+			Attachable: true,
+			RouteType:  RouteTypeTCP,
+		}
+		policy := createPolicy("$server_name")
+
+		attachPolicyToL4Route(policy, route, &policiesfakes.FakeValidator{}, "nginx-gateway", logr.Discard())
+
+		g.Expect(route.Policies).To(BeEmpty())
+		g.Expect(policy.Ancestors).To(BeEquivalentTo([]PolicyAncestor{
+			{
+				Ancestor:   createParentReference(v1.GroupName, kinds.TCPRoute, routeNsName),
+				Conditions: []conditions.Condition{httpOnlyKeyCond},
+			},
+		}))
+	})
+}
+
 func TestAttachPolicyToService(t *testing.T) {
 	t.Parallel()
 
@@ -792,6 +1483,28 @@ func TestAttachPolicyToService(t *testing.T) {
 		}
 	}
 
+	createHashPolicy := func(key string) *ngfAPIv1alpha1.UpstreamSettingsPolicy {
+		return &ngfAPIv1alpha1.UpstreamSettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "usp"},
+			Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+				LoadBalancingMethod: helpers.GetPointer(ngfAPIv1alpha1.LoadBalancingTypeHashConsistent),
+				HashMethodKey:       helpers.GetPointer(ngfAPIv1alpha1.HashMethodKey(key)),
+			},
+		}
+	}
+
+	l4Svc := func() *ReferencedService {
+		return &ReferencedService{
+			GatewayNsNames:   map[types.NamespacedName]struct{}{gwNsname: {}},
+			L4GatewayNsNames: map[types.NamespacedName]struct{}{gwNsname: {}},
+		}
+	}
+
+	httpOnlyHashKeyCond := conditions.NewPolicyInvalid(
+		"The hashMethodKey \"$request_uri\" uses variables that are only available to HTTP traffic, " +
+			"but the Service is a backend of a TCPRoute, TLSRoute or UDPRoute",
+	)
+
 	tests := []struct {
 		policy       *Policy
 		svc          *ReferencedService
@@ -925,6 +1638,75 @@ func TestAttachPolicyToService(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "attachment; Layer 4 Route backend with a hash key available to stream traffic",
+			policy: &Policy{
+				Source:             createHashPolicy("$remote_addr"),
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			svc:         l4Svc(),
+			gws:         getGateway(true /*valid*/),
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: getGatewayParentRef(gwNsname),
+				},
+			},
+		},
+		{
+			name: "no attachment; Layer 4 Route backend with a hash key only available to HTTP traffic",
+			policy: &Policy{
+				Source:             createHashPolicy("$request_uri"),
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			svc:         l4Svc(),
+			gws:         getGateway(true /*valid*/),
+			expAttached: false,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getGatewayParentRef(gwNsname),
+					Conditions: []conditions.Condition{httpOnlyHashKeyCond},
+				},
+			},
+		},
+		{
+			name: "no attachment; hash key only available to HTTP traffic; ancestor added by another Service",
+			policy: &Policy{
+				Source: createHashPolicy("$request_uri"),
+				Ancestors: []PolicyAncestor{
+					{
+						Ancestor: getGatewayParentRef(gwNsname),
+					},
+				},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			svc:         l4Svc(),
+			gws:         getGateway(true /*valid*/),
+			expAttached: false,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getGatewayParentRef(gwNsname),
+					Conditions: []conditions.Condition{httpOnlyHashKeyCond},
+				},
+			},
+		},
+		{
+			name: "attachment; HTTP Route backend with a hash key only available to HTTP traffic",
+			policy: &Policy{
+				Source:             createHashPolicy("$request_uri"),
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			svc: &ReferencedService{
+				GatewayNsNames: map[types.NamespacedName]struct{}{gwNsname: {}},
+			},
+			gws:         getGateway(true /*valid*/),
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: getGatewayParentRef(gwNsname),
+				},
+			},
+		},
 		{
 			name:   "no attachment; max ancestor",
 			policy: &Policy{Source: createTestPolicyWithAncestors(16), InvalidForGateways: map[types.NamespacedName]struct{}{}},
@@ -1005,6 +1787,7 @@ func TestProcessPolicies(t *testing.T) {
 	gatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
 	gatewayRef2 := createTestRef(kinds.Gateway, v1.GroupName, "gw2")
 	svcRef := createTestRef(kinds.Service, "core", "svc")
+	tcpRef := createTestRef(kinds.TCPRoute, v1.GroupName, "tcp")
 
 	// These refs reference objects that do not belong to NGF.
 	// Policies that contain these refs should NOT be processed.
@@ -1013,6 +1796,7 @@ func TestProcessPolicies(t *testing.T) {
 	gatewayWrongGroupRef := createTestRef(kinds.Gateway, "WrongGroup", "gw")
 	nonNGFGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "not-ours")
 	svcDoesNotExistRef := createTestRef(kinds.Service, "core", "dne")
+	tcpDoesNotExistRef := createTestRef(kinds.TCPRoute, v1.GroupName, "dne")
 
 	pol1, pol1Key := createTestPolicyAndKey(policyGVK, "pol1", hrRef)
 	pol2, pol2Key := createTestPolicyAndKey(policyGVK, "pol2", grpcRef)
@@ -1024,6 +1808,8 @@ func TestProcessPolicies(t *testing.T) {
 	pol8, pol8Key := createTestPolicyAndKey(policyGVK, "pol8", nonNGFGatewayRef)
 	pol9, pol9Key := createTestPolicyAndKey(policyGVK, "pol9", svcDoesNotExistRef)
 	pol10, pol10Key := createTestPolicyAndKey(policyGVK, "pol10", svcRef)
+	pol11, pol11Key := createTestPolicyAndKey(policyGVK, "pol11", tcpRef)
+	pol12, pol12Key := createTestPolicyAndKey(policyGVK, "pol12", tcpDoesNotExistRef)
 
 	pol1Conflict, pol1ConflictKey := createTestPolicyAndKey(policyGVK, "pol1-conflict", hrRef)
 
@@ -1053,6 +1839,8 @@ func TestProcessPolicies(t *testing.T) {
 				pol8Key:  pol8,
 				pol9Key:  pol9,
 				pol10Key: pol10,
+				pol11Key: pol11,
+				pol12Key: pol12,
 			},
 			expProcessedPolicies: map[PolicyKey]*Policy{
 				pol1Key: {
@@ -1120,6 +1908,19 @@ func TestProcessPolicies(t *testing.T) {
 					InvalidForGateways: map[types.NamespacedName]struct{}{},
 					Valid:              true,
 				},
+				pol11Key: {
+					Source: pol11,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "tcp"},
+							Kind:   kinds.TCPRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors:          []PolicyAncestor{},
+					InvalidForGateways: map[types.NamespacedName]struct{}{},
+					Valid:              true,
+				},
 			},
 		},
 		{
@@ -1254,6 +2055,17 @@ func TestProcessPolicies(t *testing.T) {
 		},
 	}
 
+	l4Routes := map[L4RouteKey]*L4Route{
+		{RouteType: RouteTypeTCP, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tcp"}}: {
+			Source: &v1.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tcp",
+					Namespace: testNs,
+				},
+			},
+		},
+	}
+
 	services := map[types.NamespacedName]*ReferencedService{
 		{Namespace: testNs, Name: "svc"}: {},
 	}
@@ -1269,6 +2081,7 @@ func TestProcessPolicies(t *testing.T) {
 				test.policies,
 				test.validator,
 				routes,
+				l4Routes,
 				services,
 				gateways,
 				nil,
@@ -1603,6 +2416,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 				test.validator,
 				test.routes,
 				nil,
+				nil,
 				gateways,
 				nil,
 			)
@@ -1625,6 +2439,12 @@ func TestMarkConflictedPolicies(t *testing.T) {
 		Nsname: types.NamespacedName{Namespace: testNs, Name: string(hrRef.Name)},
 	}
 
+	hrRule1TargetRef := hrTargetRef
+	hrRule1TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-1")
+
+	hrRule2TargetRef := hrTargetRef
+	hrRule2TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-2")
+
 	grpcRef := createTestRef(kinds.GRPCRoute, v1.GroupName, "grpc")
 	grpcTargetRef := PolicyTargetRef{
 		Kind:   grpcRef.Kind,
@@ -1676,6 +2496,35 @@ func TestMarkConflictedPolicies(t *testing.T) {
 			fakeValidator:         &policiesfakes.FakeValidator{},
 			expConflictToBeCalled: false,
 		},
+		{
+			name: "policies of the same type that target different rules of the same route can not conflict",
+			policies: map[PolicyKey]*Policy{
+				createTestPolicyKey(orangeGVK, "orange1"): {
+					Source:     createTestPolicy(orangeGVK, "orange1", hrRef),
+					TargetRefs: []PolicyTargetRef{hrRule1TargetRef},
+					Valid:      true,
+				},
+				createTestPolicyKey(orangeGVK, "orange2"): {
+					Source:     createTestPolicy(orangeGVK, "orange2", hrRef),
+					TargetRefs: []PolicyTargetRef{hrRule2TargetRef},
+					Valid:      true,
+				},
+			},
+			fakeValidator:         &policiesfakes.FakeValidator{},
+			expConflictToBeCalled: false,
+		},
+		{
+			name: "a policy that targets multiple rules of the same route can not conflict with itself",
+			policies: map[PolicyKey]*Policy{
+				createTestPolicyKey(orangeGVK, "orange1"): {
+					Source:     createTestPolicy(orangeGVK, "orange1", hrRef),
+					TargetRefs: []PolicyTargetRef{hrRule1TargetRef, hrRule2TargetRef},
+					Valid:      true,
+				},
+			},
+			fakeValidator:         &policiesfakes.FakeValidator{},
+			expConflictToBeCalled: false,
+		},
 		{
 			name: "invalid policies can not conflict",
 			policies: map[PolicyKey]*Policy{
@@ -1742,6 +2591,28 @@ func TestMarkConflictedPolicies(t *testing.T) {
 			conflictedNames:       []string{"orange3-conflicts-with-1", "orange5-conflicts-with-4"},
 			expConflictToBeCalled: true,
 		},
+		{
+			name: "a policy that targets a rule can conflict with a policy that targets the whole route",
+			policies: map[PolicyKey]*Policy{
+				createTestPolicyKey(orangeGVK, "orange1"): {
+					Source:     createTestPolicy(orangeGVK, "orange1", hrRef),
+					TargetRefs: []PolicyTargetRef{hrTargetRef},
+					Valid:      true,
+				},
+				createTestPolicyKey(orangeGVK, "orange2-rule"): {
+					Source:     createTestPolicy(orangeGVK, "orange2-rule", hrRef),
+					TargetRefs: []PolicyTargetRef{hrRule1TargetRef},
+					Valid:      true,
+				},
+			},
+			fakeValidator: &policiesfakes.FakeValidator{
+				ConflictsStub: func(_ policies.Policy, _ policies.Policy) bool {
+					return true
+				},
+			},
+			conflictedNames:       []string{"orange2-rule"},
+			expConflictToBeCalled: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -1771,6 +2642,48 @@ func TestMarkConflictedPolicies(t *testing.T) {
 	}
 }
 
+func TestPolicyTargetRefs(t *testing.T) {
+	t.Parallel()
+
+	hrRef := createTestRef(kinds.HTTPRoute, v1.GroupName, "hr")
+	hrRuleRef := v1.LocalPolicyTargetReferenceWithSectionName{
+		LocalPolicyTargetReference: hrRef,
+		SectionName:                helpers.GetPointer[v1.SectionName]("rule-1"),
+	}
+
+	tests := []struct {
+		policy  policies.Policy
+		name    string
+		expRefs []v1.LocalPolicyTargetReferenceWithSectionName
+	}{
+		{
+			name: "policy that supports sectionName",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{hrRuleRef},
+				},
+			},
+			expRefs: []v1.LocalPolicyTargetReferenceWithSectionName{hrRuleRef},
+		},
+		{
+			name:   "policy that does not support sectionName",
+			policy: createTestPolicy(schema.GroupVersionKind{Kind: "OrangePolicy"}, "orange", hrRef),
+			expRefs: []v1.LocalPolicyTargetReferenceWithSectionName{
+				{LocalPolicyTargetReference: hrRef},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(policyTargetRefs(test.policy)).To(Equal(test.expRefs))
+		})
+	}
+}
+
 func TestRefGroupKind(t *testing.T) {
 	t.Parallel()
 
@@ -2378,7 +3291,7 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			addPolicyAffectedStatusToTargetRefs(test.policies, test.routes, test.gws)
+			addPolicyAffectedStatusToTargetRefs(test.policies, test.routes, nil, test.gws)
 
 			for _, pols := range test.policies {
 				for _, targetRefs := range pols.TargetRefs {
@@ -2680,7 +3593,7 @@ func TestNGFPolicyAncestorLimitHandling(t *testing.T) {
 
 	// Process policies which should trigger ancestor limit handling
 	processedPolicies, _ := processPolicies(
-		t.Context(), logr.Discard(), testPolicies, validator, routes, referencedServices, gateways, nil,
+		t.Context(), logr.Discard(), testPolicies, validator, routes, nil, referencedServices, gateways, nil,
 	)
 
 	// Create a graph and attach policies to trigger ancestor limit handling
@@ -3068,11 +3981,42 @@ func TestProcessWAFPolicies(t *testing.T) {
 	// processedPolicies closure and the expBundles map of the multi-log test case.
 	multiLogURL1 := "https://example.com/log1.tgz"
 
+	signingKeyName := "signing-key"
+	signingKeyNsName := types.NamespacedName{Namespace: policyNs, Name: signingKeyName}
+
+	privateKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+	fetchedSignature := ed25519.Sign(privateKey, fetchedData)
+	oldData := []byte("old-data")
+	oldSignature := ed25519.Sign(privateKey, oldData)
+
+	signingKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: signingKeyName, Namespace: policyNs},
+		Data:       map[string][]byte{secrets.BundleSigningKeyKey: publicKeyPEM},
+	}
+
+	makeSignedWAFPolicy := func(kind ngfAPIv1alpha1.SigningKeyKind) *ngfAPIv1alpha1.WAFPolicy {
+		p := makeWAFPolicy(policyName, false, false, false)
+		p.Spec.PolicySource.Validation = &ngfAPIv1alpha1.BundleValidation{
+			Signature: &ngfAPIv1alpha1.BundleSignature{
+				KeyRef: ngfAPIv1alpha1.SigningKeyReference{Kind: kind, Name: signingKeyName},
+			},
+		}
+		return p
+	}
+
+	sigMismatchMsg := "signature verification failed: signature does not match the bundle"
+
 	tests := []struct {
 		processedPolicies func() map[PolicyKey]*Policy
 		wafInput          func() *WAFProcessingInput
 		expBundles        map[WAFBundleKey]*WAFBundleData
 		expSecrets        map[types.NamespacedName]*corev1.Secret
+		expConfigMaps     map[types.NamespacedName]*corev1.ConfigMap
 		expConditions     func(pol *Policy) []conditions.Condition
 		name              string
 		expValid          bool
@@ -3167,16 +4111,123 @@ func TestProcessWAFPolicies(t *testing.T) {
 					PreviousBundles: map[WAFBundleKey]*WAFBundleData{},
 				}
 			},
-			expBundles: map[WAFBundleKey]*WAFBundleData{},
-			expSecrets: map[types.NamespacedName]*corev1.Secret{},
-			expConditions: func(_ *Policy) []conditions.Condition {
-				return []conditions.Condition{conditions.NewPolicyNotProgrammedBundlePending("fetch failed")}
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{conditions.NewPolicyNotProgrammedBundlePending("fetch failed")}
+			},
+			expValid:         true,
+			expBundlePending: true,
+		},
+		{
+			name: "fetch error with previous bundle uses stale bundle and adds warning condition",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				wafPolicy := makeWAFPolicy(policyName, false, false, false)
+				key, pol := makePolicyEntry(wafPolicy, true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(fetch.Result{}, fmt.Errorf("fetch failed"))
+				fetcher.FetchLogProfileBundleReturns(fetch.Result{}, fmt.Errorf("fetch failed"))
+				prevData := &WAFBundleData{Data: []byte("old-data"), Checksum: "old-checksum"}
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: prevData,
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: []byte("old-data"), Checksum: "old-checksum"},
+			},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyProgrammedStaleBundleWarning("policy bundle", "fetch failed"),
+				}
+			},
+			expValid: true,
+		},
+		{
+			name: "rebuild after a rollback keeps the last good bundle",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				wafPolicy := makeWAFPolicy(policyName, false, false, false)
+				key, pol := makePolicyEntry(wafPolicy, true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(fetch.Result{Data: fetchedData, Checksum: fetchedChecksum}, nil)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {Data: []byte("old-data"), Checksum: "old-checksum", RejectedChecksum: fetchedChecksum},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: []byte("old-data"), Checksum: "old-checksum"},
+			},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expValid:   true,
+		},
+		{
+			name: "rebuild after a rollback with no last good bundle sets policy pending",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				wafPolicy := makeWAFPolicy(policyName, false, false, false)
+				key, pol := makePolicyEntry(wafPolicy, true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(fetch.Result{Data: fetchedData, Checksum: fetchedChecksum}, nil)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {RejectedChecksum: fetchedChecksum},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyNotProgrammedBundlePending("bundle with checksum abc123 was rolled back"),
+				}
+			},
+			expValid:         true,
+			expBundlePending: true,
+		},
+		{
+			name: "bundle newer than the rolled back bundle is used",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				wafPolicy := makeWAFPolicy(policyName, false, false, false)
+				key, pol := makePolicyEntry(wafPolicy, true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(fetch.Result{Data: fetchedData, Checksum: fetchedChecksum}, nil)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {Data: []byte("old-data"), Checksum: "old-checksum", RejectedChecksum: "bad-checksum"},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: fetchedData, Checksum: fetchedChecksum},
 			},
-			expValid:         true,
-			expBundlePending: true,
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expValid:   true,
 		},
 		{
-			name: "fetch error with previous bundle uses stale bundle and adds warning condition",
+			name: "fetch error after a rollback with no last good bundle sets policy pending",
 			processedPolicies: func() map[PolicyKey]*Policy {
 				wafPolicy := makeWAFPolicy(policyName, false, false, false)
 				key, pol := makePolicyEntry(wafPolicy, true)
@@ -3185,26 +4236,21 @@ func TestProcessWAFPolicies(t *testing.T) {
 			wafInput: func() *WAFProcessingInput {
 				fetcher := &fetchfakes.FakeFetcher{}
 				fetcher.FetchPolicyBundleReturns(fetch.Result{}, fmt.Errorf("fetch failed"))
-				fetcher.FetchLogProfileBundleReturns(fetch.Result{}, fmt.Errorf("fetch failed"))
-				prevData := &WAFBundleData{Data: []byte("old-data"), Checksum: "old-checksum"}
 				return &WAFProcessingInput{
 					Fetcher: fetcher,
 					Secrets: map[types.NamespacedName]*corev1.Secret{},
 					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
-						bundleKey: prevData,
+						bundleKey: {RejectedChecksum: "bad-checksum"},
 					},
 				}
 			},
-			expBundles: map[WAFBundleKey]*WAFBundleData{
-				bundleKey: {Data: []byte("old-data"), Checksum: "old-checksum"},
-			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
 			expSecrets: map[types.NamespacedName]*corev1.Secret{},
 			expConditions: func(_ *Policy) []conditions.Condition {
-				return []conditions.Condition{
-					conditions.NewPolicyProgrammedStaleBundleWarning("policy bundle", "fetch failed"),
-				}
+				return []conditions.Condition{conditions.NewPolicyNotProgrammedBundlePending("fetch failed")}
 			},
-			expValid: true,
+			expValid:         true,
+			expBundlePending: true,
 		},
 		{
 			name: "auth secret missing marks policy invalid",
@@ -3744,6 +4790,218 @@ func TestProcessWAFPolicies(t *testing.T) {
 			expSecrets: map[types.NamespacedName]*corev1.Secret{},
 			expValid:   true,
 		},
+		{
+			name: "bundle with valid signature is stored in output",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindSecret), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(
+					fetch.Result{Data: fetchedData, Checksum: fetchedChecksum, Signature: fetchedSignature}, nil,
+				)
+				return &WAFProcessingInput{
+					Fetcher:         fetcher,
+					Secrets:         map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: fetchedData, Checksum: fetchedChecksum, Signature: fetchedSignature},
+			},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+			expValid:   true,
+		},
+		{
+			name: "bundle with invalid signature and no previous bundle sets policy pending",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindConfigMap), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(
+					fetch.Result{Data: []byte("tampered"), Checksum: fetchedChecksum, Signature: fetchedSignature}, nil,
+				)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{},
+					ConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{
+						signingKeyNsName: {
+							ObjectMeta: metav1.ObjectMeta{Name: signingKeyName, Namespace: policyNs},
+							Data:       map[string]string{secrets.BundleSigningKeyKey: string(publicKeyPEM)},
+						},
+					},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{},
+			expConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{
+				signingKeyNsName: {
+					ObjectMeta: metav1.ObjectMeta{Name: signingKeyName, Namespace: policyNs},
+					Data:       map[string]string{secrets.BundleSigningKeyKey: string(publicKeyPEM)},
+				},
+			},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyNotProgrammedSignatureVerificationFailed(sigMismatchMsg),
+				}
+			},
+			expValid:         true,
+			expBundlePending: true,
+		},
+		{
+			name: "bundle with invalid signature keeps previous bundle",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindSecret), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(
+					fetch.Result{Data: fetchedData, Checksum: fetchedChecksum, Signature: []byte("invalid")}, nil,
+				)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {Data: oldData, Checksum: "old-checksum", Signature: oldSignature},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: oldData, Checksum: "old-checksum", Signature: oldSignature},
+			},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyProgrammedSignatureVerificationFailed("policy bundle", sigMismatchMsg),
+				}
+			},
+			expValid: true,
+		},
+		{
+			name: "rebuild after a rollback keeps the last good signed bundle",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindSecret), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(
+					fetch.Result{Data: fetchedData, Checksum: fetchedChecksum, Signature: fetchedSignature}, nil,
+				)
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {
+							Data:             oldData,
+							Checksum:         "old-checksum",
+							Signature:        oldSignature,
+							RejectedChecksum: fetchedChecksum,
+						},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{
+				bundleKey: {Data: oldData, Checksum: "old-checksum", Signature: oldSignature},
+			},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+			expValid:   true,
+		},
+		{
+			// The previous bundle may have been restored from the bundle cache, so its signature is verified.
+			name: "previous bundle with invalid signature is not used",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindSecret), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				fetcher := &fetchfakes.FakeFetcher{}
+				fetcher.FetchPolicyBundleReturns(fetch.Result{}, fmt.Errorf("fetch failed"))
+				return &WAFProcessingInput{
+					Fetcher: fetcher,
+					Secrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{
+						bundleKey: {Data: []byte("tampered"), Checksum: "old-checksum", Signature: oldSignature},
+					},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{signingKeyNsName: signingKeySecret},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyNotProgrammedSignatureVerificationFailed(
+						"previously fetched bundle: " + sigMismatchMsg,
+					),
+				}
+			},
+			expValid:         true,
+			expBundlePending: true,
+		},
+		{
+			name: "signing key ConfigMap missing marks policy invalid",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindConfigMap), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				return &WAFProcessingInput{
+					Fetcher:         &fetchfakes.FakeFetcher{},
+					Secrets:         map[types.NamespacedName]*corev1.Secret{},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{},
+				}
+			},
+			expBundles:    map[WAFBundleKey]*WAFBundleData{},
+			expSecrets:    map[types.NamespacedName]*corev1.Secret{},
+			expConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{signingKeyNsName: nil},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyRefsNotResolved(
+						fmt.Sprintf("signing key ConfigMap %q not found", signingKeyNsName),
+					),
+				}
+			},
+			expValid: false,
+		},
+		{
+			name: "signing key secret with invalid key marks policy invalid",
+			processedPolicies: func() map[PolicyKey]*Policy {
+				key, pol := makePolicyEntry(makeSignedWAFPolicy(ngfAPIv1alpha1.SigningKeyKindSecret), true)
+				return map[PolicyKey]*Policy{key: pol}
+			},
+			wafInput: func() *WAFProcessingInput {
+				return &WAFProcessingInput{
+					Fetcher: &fetchfakes.FakeFetcher{},
+					Secrets: map[types.NamespacedName]*corev1.Secret{
+						signingKeyNsName: {
+							ObjectMeta: metav1.ObjectMeta{Name: signingKeyName, Namespace: policyNs},
+							Data:       map[string][]byte{secrets.BundleSigningKeyKey: []byte("not-a-key")},
+						},
+					},
+					PreviousBundles: map[WAFBundleKey]*WAFBundleData{},
+				}
+			},
+			expBundles: map[WAFBundleKey]*WAFBundleData{},
+			expSecrets: map[types.NamespacedName]*corev1.Secret{
+				signingKeyNsName: {
+					ObjectMeta: metav1.ObjectMeta{Name: signingKeyName, Namespace: policyNs},
+					Data:       map[string][]byte{secrets.BundleSigningKeyKey: []byte("not-a-key")},
+				},
+			},
+			expConditions: func(_ *Policy) []conditions.Condition {
+				return []conditions.Condition{
+					conditions.NewPolicyRefsNotResolved(fmt.Sprintf(
+						"signing key secret %q has invalid %q key: no PEM-encoded public key or certificate found",
+						signingKeyNsName, secrets.BundleSigningKeyKey,
+					)),
+				}
+			},
+			expValid: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -3764,6 +5022,9 @@ func TestProcessWAFPolicies(t *testing.T) {
 			g.Expect(output).NotTo(BeNil())
 			g.Expect(output.Bundles).To(Equal(tc.expBundles))
 			g.Expect(output.ReferencedWAFSecrets).To(Equal(tc.expSecrets))
+			if tc.expConfigMaps != nil {
+				g.Expect(output.ReferencedWAFConfigMaps).To(Equal(tc.expConfigMaps))
+			}
 
 			for _, pol := range processedPolicies {
 				if pol.Source == nil {
@@ -4053,6 +5314,7 @@ func TestBuildPolicyFetchRequest(t *testing.T) {
 		name         string
 		policyType   ngfAPIv1alpha1.PolicySourceType
 		tlsCA        []byte
+		signingKey   []byte
 		expRequest   fetch.Request
 	}{
 		{
@@ -4193,6 +5455,23 @@ func TestBuildPolicyFetchRequest(t *testing.T) {
 				RetryAttempts: 3,
 			},
 		},
+		{
+			name:       "OCI type sets Reference and keeps BearerToken",
+			policyType: ngfAPIv1alpha1.PolicySourceTypeOCI,
+			policySource: &ngfAPIv1alpha1.PolicySource{
+				OCISource: &ngfAPIv1alpha1.OCIBundleSource{
+					Reference: "registry.example.com/security/waf-policy:v1",
+				},
+			},
+			auth: &fetch.BundleAuth{BearerToken: bearerToken},
+			expRequest: fetch.Request{
+				OCI: fetch.OCIRequest{
+					Reference: "registry.example.com/security/waf-policy:v1",
+				},
+				Auth:          &fetch.BundleAuth{BearerToken: bearerToken},
+				RetryAttempts: 3,
+			},
+		},
 		{
 			name:       "N1C type with nil auth does not panic",
 			policyType: ngfAPIv1alpha1.PolicySourceTypeN1C,
@@ -4251,6 +5530,46 @@ func TestBuildPolicyFetchRequest(t *testing.T) {
 				RetryAttempts: 0,
 			},
 		},
+		{
+			name:       "HTTP type with signature uses the default signature URL",
+			policyType: ngfAPIv1alpha1.PolicySourceTypeHTTP,
+			policySource: &ngfAPIv1alpha1.PolicySource{
+				HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: baseURL},
+				Validation: &ngfAPIv1alpha1.BundleValidation{
+					Signature: &ngfAPIv1alpha1.BundleSignature{
+						KeyRef: ngfAPIv1alpha1.SigningKeyReference{Name: "signing-key"},
+					},
+				},
+			},
+			signingKey: []byte("signing-key-data"),
+			expRequest: fetch.Request{
+				URL:           baseURL,
+				Signature:     fetch.SignatureRequest{PublicKey: []byte("signing-key-data")},
+				RetryAttempts: 3,
+			},
+		},
+		{
+			name:       "OCI type with signature URL",
+			policyType: ngfAPIv1alpha1.PolicySourceTypeOCI,
+			policySource: &ngfAPIv1alpha1.PolicySource{
+				OCISource: &ngfAPIv1alpha1.OCIBundleSource{Reference: "registry.example.com/waf/policy:v1"},
+				Validation: &ngfAPIv1alpha1.BundleValidation{
+					Signature: &ngfAPIv1alpha1.BundleSignature{
+						URL:    helpers.GetPointer("https://example.com/policy.sig"),
+						KeyRef: ngfAPIv1alpha1.SigningKeyReference{Name: "signing-key"},
+					},
+				},
+			},
+			signingKey: []byte("signing-key-data"),
+			expRequest: fetch.Request{
+				OCI: fetch.OCIRequest{Reference: "registry.example.com/waf/policy:v1"},
+				Signature: fetch.SignatureRequest{
+					URL:       "https://example.com/policy.sig",
+					PublicKey: []byte("signing-key-data"),
+				},
+				RetryAttempts: 3,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -4258,7 +5577,7 @@ func TestBuildPolicyFetchRequest(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			got := BuildPolicyFetchRequest(tc.policySource, tc.policyType, tc.auth, tc.tlsCA)
+			got := BuildPolicyFetchRequest(tc.policySource, tc.policyType, tc.auth, tc.tlsCA, tc.signingKey)
 			g.Expect(got).To(Equal(tc.expRequest))
 		})
 	}
@@ -4355,6 +5674,76 @@ func TestLogBundleKey(t *testing.T) {
 	}
 }
 
+func TestWAFSecurityLogs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	stderrLog := ngfAPIv1alpha1.WAFSecurityLog{
+		LogSource: &ngfAPIv1alpha1.LogSource{DefaultProfile: helpers.GetPointer(ngfAPIv1alpha1.DefaultLogProfileAll)},
+	}
+	coffeeLog := ngfAPIv1alpha1.WAFSecurityLog{
+		LogSource: &ngfAPIv1alpha1.LogSource{HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://coffee"}},
+	}
+	teaLog := ngfAPIv1alpha1.WAFSecurityLog{
+		LogSource: &ngfAPIv1alpha1.LogSource{HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://tea"}},
+	}
+
+	spec := ngfAPIv1alpha1.WAFPolicySpec{SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{stderrLog}}
+	g.Expect(WAFSecurityLogs(spec)).To(Equal([]ngfAPIv1alpha1.WAFSecurityLog{stderrLog}))
+
+	spec.RouteSecurityLogs = []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+		{Name: "coffee", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{coffeeLog}},
+		{Name: "tea", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{teaLog}},
+	}
+	g.Expect(WAFSecurityLogs(spec)).To(Equal([]ngfAPIv1alpha1.WAFSecurityLog{stderrLog, coffeeLog, teaLog}))
+	g.Expect(spec.SecurityLogs).To(HaveLen(1))
+}
+
+func TestWAFRouteSecurityLogs(t *testing.T) {
+	t.Parallel()
+
+	wp := &ngfAPIv1alpha1.WAFPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "waf"},
+		Spec: ngfAPIv1alpha1.WAFPolicySpec{
+			RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+				{Name: "coffee"},
+				{Name: "coffee", Namespace: helpers.GetPointer[v1.Namespace]("other")},
+				{Name: "coffee", Namespace: helpers.GetPointer[v1.Namespace]("test")},
+			},
+		},
+	}
+
+	tests := []struct {
+		expRouteLogs *ngfAPIv1alpha1.WAFRouteSecurityLogs
+		name         string
+		route        types.NamespacedName
+	}{
+		{
+			name:         "route in the namespace of the policy matches the first entry",
+			route:        types.NamespacedName{Namespace: "test", Name: "coffee"},
+			expRouteLogs: &wp.Spec.RouteSecurityLogs[0],
+		},
+		{
+			name:         "route in another namespace",
+			route:        types.NamespacedName{Namespace: "other", Name: "coffee"},
+			expRouteLogs: &wp.Spec.RouteSecurityLogs[1],
+		},
+		{
+			name:  "route without override",
+			route: types.NamespacedName{Namespace: "test", Name: "tea"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(WAFRouteSecurityLogs(wp, test.route)).To(BeIdenticalTo(test.expRouteLogs))
+		})
+	}
+}
+
 func TestBuildLogFetchRequest(t *testing.T) {
 	t.Parallel()
 
@@ -4366,6 +5755,7 @@ func TestBuildLogFetchRequest(t *testing.T) {
 		logSource  *ngfAPIv1alpha1.LogSource
 		name       string
 		tlsCA      []byte
+		signingKey []byte
 		expRequest fetch.Request
 	}{
 		{
@@ -4406,6 +5796,30 @@ func TestBuildLogFetchRequest(t *testing.T) {
 				RetryAttempts: 3,
 			},
 		},
+		{
+			name: "log fetch with signature",
+			logSource: &ngfAPIv1alpha1.LogSource{
+				HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: baseURL},
+				Validation: &ngfAPIv1alpha1.BundleValidation{
+					Signature: &ngfAPIv1alpha1.BundleSignature{
+						URL: helpers.GetPointer("https://example.com/log.tgz.asc"),
+						KeyRef: ngfAPIv1alpha1.SigningKeyReference{
+							Kind: ngfAPIv1alpha1.SigningKeyKindConfigMap,
+							Name: "signing-key",
+						},
+					},
+				},
+			},
+			signingKey: []byte("signing-key-data"),
+			expRequest: fetch.Request{
+				URL: baseURL,
+				Signature: fetch.SignatureRequest{
+					URL:       "https://example.com/log.tgz.asc",
+					PublicKey: []byte("signing-key-data"),
+				},
+				RetryAttempts: 3,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -4413,7 +5827,7 @@ func TestBuildLogFetchRequest(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			got := BuildLogFetchRequest(tc.logSource, tc.auth, tc.tlsCA)
+			got := BuildLogFetchRequest(tc.logSource, tc.auth, tc.tlsCA, tc.signingKey)
 			g.Expect(got).To(Equal(tc.expRequest))
 		})
 	}
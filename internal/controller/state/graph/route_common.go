@@ -111,6 +111,8 @@ type L4Route struct {
 	Conditions []conditions.Condition
 	// Spec is the L4RouteSpec of the Route
 	Spec L4RouteSpec
+	// Policies holds the policies that are attached to the Route.
+	Policies []*Policy
 	// Valid indicates if the Route is valid.
 	Valid bool
 	// Attachable indicates if the Route is attachable to any Listener.
@@ -167,18 +169,46 @@ type L7RouteSpec struct {
 }
 
 type RouteRule struct {
+	// Name is the name of the rule. Empty if the rule is not named.
+	Name string
 	// Matches define the predicate used to match requests to a given action.
 	Matches []v1.HTTPRouteMatch
 	// RouteBackendRefs are a wrapper for v1.BackendRef and any BackendRef filters from the HTTPRoute or GRPCRoute.
 	RouteBackendRefs []RouteBackendRef
 	// BackendRefs is an internal representation of a backendRef in a Route.
 	BackendRefs []BackendRef
+	// Timeouts holds the timeouts of the rule. Only HTTPRoute rules support timeouts.
+	Timeouts *RouteTimeouts
+	// Retry holds the retry configuration of the rule. Only HTTPRoute rules support retries.
+	Retry *RouteRetry
+	// Policies holds the policies that target this rule by name, using the sectionName of a targetRef.
+	// Policies that target the whole Route are stored in the L7Route.
+	Policies []*Policy
 	// Filters define processing steps that must be completed during the request or response lifecycle.
 	Filters RouteRuleFilters
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
 	ValidMatches bool
 }
 
+// RouteTimeouts holds the timeouts of a route rule, converted to the NGINX duration format.
+// An empty value means the timeout is not set, and a value of "0s" means the timeout is disabled.
+type RouteTimeouts struct {
+	// Request is the maximum duration for NGINX to respond to a request, including any retries.
+	Request string
+	// BackendRequest is the maximum duration of an individual request from NGINX to a backend.
+	BackendRequest string
+}
+
+// RouteRetry holds the retry configuration of a route rule.
+type RouteRetry struct {
+	// Attempts is the maximum number of times a request to a backend is retried.
+	// If nil, the number of attempts is only limited by the number of backend endpoints.
+	// Zero disables the retries.
+	Attempts *int
+	// Codes are the HTTP response status codes for which a request to a backend is retried.
+	Codes []int
+}
+
 // RouteBackendRef is a wrapper for v1.BackendRef and any BackendRef filters from the HTTPRoute or GRPCRoute.
 type RouteBackendRef struct {
 	v1.BackendRef
@@ -360,6 +390,8 @@ func buildRoutesForGateways(
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
 	authenticationFilters map[types.NamespacedName]*AuthenticationFilter,
+	canaryFilters map[types.NamespacedName]*CanaryFilter,
+	mirrorFilters map[types.NamespacedName]*MirrorFilter,
 	inferencePools map[types.NamespacedName]*inference.InferencePool,
 	featureFlags FeatureFlags,
 	listenerSets map[types.NamespacedName]*ListenerSet,
@@ -377,6 +409,8 @@ func buildRoutesForGateways(
 			gateways,
 			snippetsFilters,
 			authenticationFilters,
+			canaryFilters,
+			mirrorFilters,
 			inferencePools,
 			featureFlags,
 			listenerSets,
@@ -388,11 +422,21 @@ func buildRoutesForGateways(
 		routes[CreateRouteKey(route)] = r
 
 		// if this route has a RequestMirror filter, build a duplicate route for the mirror
-		buildHTTPMirrorRoutes(routes, r, route, gateways, snippetsFilters, featureFlags, listenerSets)
+		buildHTTPMirrorRoutes(routes, r, route, gateways, snippetsFilters, mirrorFilters, featureFlags, listenerSets)
 	}
 
 	for _, route := range grpcRoutes {
-		r := buildGRPCRoute(validator, route, gateways, snippetsFilters, authenticationFilters, featureFlags, listenerSets)
+		r := buildGRPCRoute(
+			validator,
+			route,
+			gateways,
+			snippetsFilters,
+			authenticationFilters,
+			canaryFilters,
+			mirrorFilters,
+			featureFlags,
+			listenerSets,
+		)
 		if r == nil {
 			continue
 		}
@@ -400,7 +444,7 @@ func buildRoutesForGateways(
 		routes[CreateRouteKey(route)] = r
 
 		// if this route has a RequestMirror filter, build a duplicate route for the mirror
-		buildGRPCMirrorRoutes(routes, r, route, gateways, snippetsFilters, featureFlags, listenerSets)
+		buildGRPCMirrorRoutes(routes, r, route, gateways, snippetsFilters, mirrorFilters, featureFlags, listenerSets)
 	}
 
 	return routes
@@ -1368,6 +1412,34 @@ func getSectionName(s *v1.SectionName) string {
 	return string(*s)
 }
 
+// validateRuleNames validates that the names of the rules of a Route are unique. The name of a rule that
+// duplicates the name of a previous rule is cleared, so that policies targeting that name only apply to the
+// first rule.
+func validateRuleNames(rules []RouteRule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]struct{}, len(rules))
+
+	for ruleIdx := range rules {
+		name := rules[ruleIdx].Name
+		if name == "" {
+			continue
+		}
+
+		if _, exists := names[name]; exists {
+			namePath := field.NewPath("spec").Child("rules").Index(ruleIdx).Child("name")
+			allErrs = append(allErrs, field.Duplicate(namePath, name))
+			rules[ruleIdx].Name = ""
+
+			continue
+		}
+
+		names[name] = struct{}{}
+	}
+
+	return allErrs
+}
+
 // separateGatewayAndListenerSetListeners takes a slice of listeners and separates them into
 // Gateway listeners (ListenerSetName.Name == "") and ListenerSet listeners (ListenerSetName.Name != "").
 func separateGatewayAndListenerSetListeners(listeners []*Listener) (
@@ -1453,6 +1525,22 @@ func routeKeyForKind(kind v1.Kind, nsname types.NamespacedName) RouteKey {
 	return key
 }
 
+func l4RouteKeyForKind(kind v1.Kind, nsname types.NamespacedName) L4RouteKey {
+	key := L4RouteKey{NamespacedName: nsname}
+	switch kind {
+	case kinds.TCPRoute:
+		key.RouteType = RouteTypeTCP
+	case kinds.TLSRoute:
+		key.RouteType = RouteTypeTLS
+	case kinds.UDPRoute:
+		key.RouteType = RouteTypeUDP
+	default:
+		panic(fmt.Sprintf("unsupported route kind: %s", kind))
+	}
+
+	return key
+}
+
 func getSessionPersistenceKey(ruleIdx int, routeNsName types.NamespacedName) string {
 	return fmt.Sprintf("%s_%s_%d", routeNsName.Name, routeNsName.Namespace, ruleIdx)
 }
@@ -2004,6 +2004,53 @@ func TestValidateHostnames(t *testing.T) {
 	}
 }
 
+func TestValidateRuleNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rules    []RouteRule
+		expNames []string
+		expErrs  field.ErrorList
+	}{
+		{
+			name:     "no names",
+			rules:    []RouteRule{{}, {}},
+			expNames: []string{"", ""},
+		},
+		{
+			name:     "unique names",
+			rules:    []RouteRule{{Name: "rule-1"}, {}, {Name: "rule-2"}},
+			expNames: []string{"rule-1", "", "rule-2"},
+		},
+		{
+			name:     "duplicate names",
+			rules:    []RouteRule{{Name: "rule-1"}, {Name: "rule-1"}, {Name: "rule-2"}, {Name: "rule-1"}},
+			expNames: []string{"rule-1", "", "rule-2", ""},
+			expErrs: field.ErrorList{
+				field.Duplicate(field.NewPath("spec").Child("rules").Index(1).Child("name"), "rule-1"),
+				field.Duplicate(field.NewPath("spec").Child("rules").Index(3).Child("name"), "rule-1"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			errs := validateRuleNames(test.rules)
+			g.Expect(errs).To(Equal(test.expErrs))
+
+			names := make([]string, 0, len(test.rules))
+			for _, rule := range test.rules {
+				names = append(names, rule.Name)
+			}
+			g.Expect(names).To(Equal(test.expNames))
+		})
+	}
+}
+
 func TestRouteKeyForKind(t *testing.T) {
 	t.Parallel()
 	nsname := types.NamespacedName{Namespace: testNs, Name: "route"}
@@ -14,6 +14,9 @@ import (
 type ReferencedService struct {
 	// GatewayNsNames are all the Gateways that this Service indirectly attaches to through a Route.
 	GatewayNsNames map[types.NamespacedName]struct{}
+	// L4GatewayNsNames are the Gateways that this Service indirectly attaches to through a TCPRoute,
+	// TLSRoute or UDPRoute, which proxy its traffic in the stream context.
+	L4GatewayNsNames map[types.NamespacedName]struct{}
 	// ExternalName holds the external service name for ExternalName type services.
 	ExternalName string
 	// ClusterIP is the ClusterIP of the Service, used when UseClusterIP is enabled.
@@ -134,7 +137,13 @@ func addServiceFromL4Route(
 		}
 
 		ensureReferencedService(svcNsName, referencedServices, services)
-		referencedServices[svcNsName].GatewayNsNames[gwNsName] = struct{}{}
+
+		svc := referencedServices[svcNsName]
+		svc.GatewayNsNames[gwNsName] = struct{}{}
+		if svc.L4GatewayNsNames == nil {
+			svc.L4GatewayNsNames = make(map[types.NamespacedName]struct{})
+		}
+		svc.L4GatewayNsNames[gwNsName] = struct{}{}
 	}
 }
 
@@ -248,6 +248,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -327,6 +331,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -343,6 +351,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -351,6 +363,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -396,6 +412,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -474,6 +494,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -545,6 +569,10 @@ func TestBuildReferencedServices(t *testing.T) {
 						{Namespace: "test", Name: "gwNsname"}:  {},
 						{Namespace: "test", Name: "gw2Nsname"}: {},
 					},
+					L4GatewayNsNames: map[types.NamespacedName]struct{}{
+						{Namespace: "test", Name: "gwNsname"}:  {},
+						{Namespace: "test", Name: "gw2Nsname"}: {},
+					},
 					IsExternalName: false,
 					ExternalName:   "",
 				},
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -64,8 +65,15 @@ const (
 	// BundleTokenKey is the Secret key for WAF bundle Bearer Token authentication.
 	BundleTokenKey = "token"
 
+	// BundleSigningKeyKey is the Secret or ConfigMap key for the PEM-encoded public key or certificate chain
+	// used to verify WAF bundle signatures.
+	BundleSigningKeyKey = "signing.pem"
+
 	// PLMS3Secret is the Secret data key for the PLM S3 storage secret access key.
 	PLMS3Secret = "seaweedfs_admin_secret"
+
+	// HeaderValueKey is the Secret key for the value of a header of the tracing exporter.
+	HeaderValueKey = "header-value"
 )
 
 // CertificateBundle is used to submit certificate data to nginx that is kubernetes aware.
@@ -105,6 +113,21 @@ func ValidateTLS(tlsCert, tlsPrivateKey []byte) error {
 	return nil
 }
 
+// ValidateHeaderValue validates the header-value entry of a Secret. The value is used in a double-quoted
+// NGINX parameter, so double quotes, backslashes and line breaks are not allowed.
+func ValidateHeaderValue(value []byte) error {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) == 0 {
+		return fmt.Errorf("the data field %q must not be empty", HeaderValueKey)
+	}
+
+	if bytes.ContainsAny(trimmed, "\"\\\r\n") {
+		return fmt.Errorf("the data field %q must not contain double quotes, backslashes or line breaks", HeaderValueKey)
+	}
+
+	return nil
+}
+
 // ValidateCA validates the ca.crt entry in the Certificate. If it is valid, the function returns nil.
 func ValidateCA(caData []byte) error {
 	data := make([]byte, base64.StdEncoding.DecodedLen(len(caData)))
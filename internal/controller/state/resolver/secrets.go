@@ -107,8 +107,11 @@ func (s *secretEntry) revalidate(opts *resolveOptions, obj client.Object) error
 
 func validateOpaqueSecretKey(secret *v1.Secret, key string) error {
 	if data, exists := secret.Data[key]; exists && len(data) > 0 {
-		if key == secrets.CAKey {
+		switch key {
+		case secrets.CAKey:
 			return secrets.ValidateCA(data)
+		case secrets.HeaderValueKey:
+			return secrets.ValidateHeaderValue(data)
 		}
 	} else {
 		return fmt.Errorf(
@@ -19,6 +19,17 @@ type FakeGenericValidator struct {
 	validateAccessLogFormatStringReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateCIDRStub        func(string) error
+	validateCIDRMutex       sync.RWMutex
+	validateCIDRArgsForCall []struct {
+		arg1 string
+	}
+	validateCIDRReturns struct {
+		result1 error
+	}
+	validateCIDRReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateEndpointStub        func(string) error
 	validateEndpointMutex       sync.RWMutex
 	validateEndpointArgsForCall []struct {
@@ -161,6 +172,67 @@ func (fake *FakeGenericValidator) ValidateAccessLogFormatStringReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateCIDR(arg1 string) error {
+	fake.validateCIDRMutex.Lock()
+	ret, specificReturn := fake.validateCIDRReturnsOnCall[len(fake.validateCIDRArgsForCall)]
+	fake.validateCIDRArgsForCall = append(fake.validateCIDRArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCIDRStub
+	fakeReturns := fake.validateCIDRReturns
+	fake.recordInvocation("ValidateCIDR", []interface{}{arg1})
+	fake.validateCIDRMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateCIDRCallCount() int {
+	fake.validateCIDRMutex.RLock()
+	defer fake.validateCIDRMutex.RUnlock()
+	return len(fake.validateCIDRArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateCIDRCalls(stub func(string) error) {
+	fake.validateCIDRMutex.Lock()
+	defer fake.validateCIDRMutex.Unlock()
+	fake.ValidateCIDRStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateCIDRArgsForCall(i int) string {
+	fake.validateCIDRMutex.RLock()
+	defer fake.validateCIDRMutex.RUnlock()
+	argsForCall := fake.validateCIDRArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateCIDRReturns(result1 error) {
+	fake.validateCIDRMutex.Lock()
+	defer fake.validateCIDRMutex.Unlock()
+	fake.ValidateCIDRStub = nil
+	fake.validateCIDRReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateCIDRReturnsOnCall(i int, result1 error) {
+	fake.validateCIDRMutex.Lock()
+	defer fake.validateCIDRMutex.Unlock()
+	fake.ValidateCIDRStub = nil
+	if fake.validateCIDRReturnsOnCall == nil {
+		fake.validateCIDRReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCIDRReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateEndpoint(arg1 string) error {
 	fake.validateEndpointMutex.Lock()
 	ret, specificReturn := fake.validateEndpointReturnsOnCall[len(fake.validateEndpointArgsForCall)]
@@ -178,6 +178,19 @@ type FakeHTTPFieldsValidator struct {
 		result1 bool
 		result2 []string
 	}
+	ValidateRetryStatusCodeStub        func(int) (bool, []string)
+	validateRetryStatusCodeMutex       sync.RWMutex
+	validateRetryStatusCodeArgsForCall []struct {
+		arg1 int
+	}
+	validateRetryStatusCodeReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateRetryStatusCodeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1098,6 +1111,70 @@ func (fake *FakeHTTPFieldsValidator) ValidateRedirectSchemeReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCode(arg1 int) (bool, []string) {
+	fake.validateRetryStatusCodeMutex.Lock()
+	ret, specificReturn := fake.validateRetryStatusCodeReturnsOnCall[len(fake.validateRetryStatusCodeArgsForCall)]
+	fake.validateRetryStatusCodeArgsForCall = append(fake.validateRetryStatusCodeArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ValidateRetryStatusCodeStub
+	fakeReturns := fake.validateRetryStatusCodeReturns
+	fake.recordInvocation("ValidateRetryStatusCode", []interface{}{arg1})
+	fake.validateRetryStatusCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCodeCallCount() int {
+	fake.validateRetryStatusCodeMutex.RLock()
+	defer fake.validateRetryStatusCodeMutex.RUnlock()
+	return len(fake.validateRetryStatusCodeArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCodeCalls(stub func(int) (bool, []string)) {
+	fake.validateRetryStatusCodeMutex.Lock()
+	defer fake.validateRetryStatusCodeMutex.Unlock()
+	fake.ValidateRetryStatusCodeStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCodeArgsForCall(i int) int {
+	fake.validateRetryStatusCodeMutex.RLock()
+	defer fake.validateRetryStatusCodeMutex.RUnlock()
+	argsForCall := fake.validateRetryStatusCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCodeReturns(result1 bool, result2 []string) {
+	fake.validateRetryStatusCodeMutex.Lock()
+	defer fake.validateRetryStatusCodeMutex.Unlock()
+	fake.ValidateRetryStatusCodeStub = nil
+	fake.validateRetryStatusCodeReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateRetryStatusCodeReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateRetryStatusCodeMutex.Lock()
+	defer fake.validateRetryStatusCodeMutex.Unlock()
+	fake.ValidateRetryStatusCodeStub = nil
+	if fake.validateRetryStatusCodeReturnsOnCall == nil {
+		fake.validateRetryStatusCodeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateRetryStatusCodeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
 func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -38,6 +38,7 @@ type HTTPFieldsValidator interface {
 	ValidateFilterHeaderValue(value string) error
 	ValidatePath(path string) error
 	ValidateDuration(duration string) (string, error)
+	ValidateRetryStatusCode(code int) (valid bool, supportedValues []string)
 }
 
 // GenericValidator validates any generic values from NGF API resources from the perspective of a data-plane.
@@ -53,6 +54,7 @@ type GenericValidator interface {
 	ValidateNginxVariableName(name string) error
 	ValidateServerTokensValue(value string) error
 	ValidateAccessLogFormatString(value string) error
+	ValidateCIDR(value string) error
 }
 
 // AuthFieldsValidator validates authentication-related fields from NGF API resources.
@@ -102,3 +104,4 @@ func (SkipValidator) ValidateFilterHeaderName(string) error          { return ni
 func (SkipValidator) ValidateFilterHeaderValue(string) error         { return nil }
 func (SkipValidator) ValidatePath(string) error                      { return nil }
 func (SkipValidator) ValidateDuration(string) (string, error)        { return "", nil }
+func (SkipValidator) ValidateRetryStatusCode(int) (bool, []string)   { return true, nil }
@@ -37,6 +37,9 @@ func supportedFeatures() []gatewayv1.SupportedFeature {
 		features.SupportGatewayFrontendClientCertificateValidationInsecureFallback,
 		features.SupportListenerSet,
 
+		// GRPCRoute extended
+		features.SupportGRPCRouteNamedRouteRule,
+
 		// HTTPRoute extended
 		features.SupportHTTPRouteBackendProtocolWebSocket,
 		features.SupportHTTPRouteBackendProtocolH2C,
@@ -57,6 +60,10 @@ func supportedFeatures() []gatewayv1.SupportedFeature {
 		features.SupportHTTPRoute307RedirectStatusCode,
 		features.SupportHTTPRoute308RedirectStatusCode,
 		features.SupportHTTPRouteCORS,
+		features.SupportHTTPRouteRequestTimeout,
+		features.SupportHTTPRouteBackendTimeout,
+		features.SupportHTTPRouteRetry,
+		features.SupportHTTPRouteNamedRouteRule,
 
 		// TCPRoute
 		features.SupportTCPRoute,
@@ -46,6 +46,11 @@ func TestSupportedFeatures(t *testing.T) {
 		gatewayv1.FeatureName(features.SupportTLSRouteModeTerminate),
 		gatewayv1.FeatureName(features.SupportTLSRouteModeMixed),
 		gatewayv1.FeatureName(features.SupportHTTPRouteCORS),
+		gatewayv1.FeatureName(features.SupportHTTPRouteRequestTimeout),
+		gatewayv1.FeatureName(features.SupportHTTPRouteBackendTimeout),
+		gatewayv1.FeatureName(features.SupportHTTPRouteRetry),
+		gatewayv1.FeatureName(features.SupportHTTPRouteNamedRouteRule),
+		gatewayv1.FeatureName(features.SupportGRPCRouteNamedRouteRule),
 		gatewayv1.FeatureName(features.SupportGatewayHTTPSListenerDetectMisdirectedRequests),
 		gatewayv1.FeatureName(features.SupportGatewayFrontendClientCertificateValidation),
 		gatewayv1.FeatureName(features.SupportGatewayFrontendClientCertificateValidationInsecureFallback),
@@ -11,6 +11,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 )
 
 // We only use one resource type in this test - GatewayClass.
@@ -46,7 +48,7 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 		)
 
 		BeforeAll(func() {
-			updater = NewLeaderAwareGroupUpdater(NewUpdater(k8sClient, logr.Discard()))
+			updater = NewLeaderAwareGroupUpdater(NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector()))
 
 			for _, name := range allGCNames {
 				gc := createGC(name)
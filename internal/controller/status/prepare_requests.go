@@ -415,8 +415,12 @@ func prepareGatewayRequest(
 // settingsPolicyKinds are the NGF custom policy kinds that report a GEP-713 "Programmed" condition
 // indicating whether their settings have been programmed into the NGINX data plane.
 var settingsPolicyKinds = map[string]struct{}{
+	kinds.AccessControlPolicy:    {},
+	kinds.CachePolicy:            {},
 	kinds.ClientSettingsPolicy:   {},
+	kinds.ConnectionLimitPolicy:  {},
 	kinds.UpstreamSettingsPolicy: {},
+	kinds.HealthCheckPolicy:      {},
 	kinds.ObservabilityPolicy:    {},
 	kinds.ProxySettingsPolicy:    {},
 	kinds.RateLimitPolicy:        {},
@@ -645,6 +649,74 @@ func PrepareAuthenticationFilterRequests(
 	return reqs
 }
 
+// PrepareCanaryFilterRequests prepares status UpdateRequests for the given CanaryFilters.
+func PrepareCanaryFilterRequests(
+	canaryFilters map[types.NamespacedName]*graph.CanaryFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []UpdateRequest {
+	reqs := make([]UpdateRequest, 0, len(canaryFilters))
+
+	for nsname, canaryFilter := range canaryFilters {
+		allConds := make([]conditions.Condition, 0, len(canaryFilter.Conditions)+1)
+		allConds = append(allConds, conditions.NewCanaryFilterAccepted())
+		allConds = append(allConds, canaryFilter.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, canaryFilter.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.CanaryFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, UpdateRequest{
+			NsName:       nsname,
+			ResourceType: canaryFilter.Source,
+			Setter:       newCanaryFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareMirrorFilterRequests prepares status UpdateRequests for the given MirrorFilters.
+func PrepareMirrorFilterRequests(
+	mirrorFilters map[types.NamespacedName]*graph.MirrorFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []UpdateRequest {
+	reqs := make([]UpdateRequest, 0, len(mirrorFilters))
+
+	for nsname, mirrorFilter := range mirrorFilters {
+		allConds := make([]conditions.Condition, 0, len(mirrorFilter.Conditions)+1)
+		allConds = append(allConds, conditions.NewMirrorFilterAccepted())
+		allConds = append(allConds, mirrorFilter.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, mirrorFilter.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.MirrorFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, UpdateRequest{
+			NsName:       nsname,
+			ResourceType: mirrorFilter.Source,
+			Setter:       newMirrorFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
 // PrepareExternalLoadBalancerRequests prepares status UpdateRequests for the given ExternalLoadBalancer resources.
 func PrepareExternalLoadBalancerRequests(
 	externalLoadBalancers map[types.NamespacedName]*graph.ExternalLoadBalancer,
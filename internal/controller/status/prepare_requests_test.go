@@ -18,6 +18,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -590,7 +591,7 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareRouteRequests(
 		map[graph.L4RouteKey]*graph.L4Route{},
@@ -668,7 +669,7 @@ func TestBuildGRPCRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareRouteRequests(
 		map[graph.L4RouteKey]*graph.L4Route{},
@@ -744,7 +745,7 @@ func TestBuildTLSRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareRouteRequests(
 		routes,
@@ -1011,7 +1012,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 				expectedTotalReqs++
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareGatewayClassRequests(test.gc, test.ignoredClasses, transitionTime)
 
@@ -1976,7 +1977,7 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				expectedTotalReqs++
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareGatewayRequests(
 				test.gateway,
@@ -2239,7 +2240,7 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareBackendTLSPolicyRequests(test.backendTLSPolicies, transitionTime, gatewayCtlrName)
 
@@ -2333,7 +2334,7 @@ func TestBuildNginxGatewayStatus(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			req := PrepareNginxGatewayStatus(test.nginxGateway, transitionTime, test.cpUpdateResult)
 
@@ -2659,7 +2660,7 @@ func TestBuildNGFPolicyStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareNGFPolicyRequests(test.policies, transitionTime, gatewayCtlrName)
 
@@ -2767,7 +2768,7 @@ func TestBuildNGFPolicyStatusesProgrammedCondition(t *testing.T) {
 					reqs := PrepareNGFPolicyRequests(policies, transitionTime, gatewayCtlrName)
 					g.Expect(reqs).To(HaveLen(1))
 
-					NewUpdater(k8sClient, logr.Discard()).Update(t.Context(), reqs...)
+					NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector()).Update(t.Context(), reqs...)
 
 					var pol ngfAPI.ClientSettingsPolicy
 					g.Expect(k8sClient.Get(t.Context(), nsname, &pol)).To(Succeed())
@@ -3014,7 +3015,7 @@ func TestBuildWAFPolicyStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareNGFPolicyRequests(test.policies, transitionTime, gatewayCtlrName)
 
@@ -3146,7 +3147,7 @@ func TestBuildSnippetsFilterStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareSnippetsFilterRequests(test.snippetsFilters, transitionTime, gatewayCtlrName)
 
@@ -3281,7 +3282,7 @@ func TestPrepareExternalLoadBalancerRequests(t *testing.T) {
 				g.Expect(k8sClient.Create(t.Context(), elb.Source)).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareExternalLoadBalancerRequests(test.externalLoadBalancers, transitionTime, gatewayCtlrName)
 
@@ -3403,7 +3404,7 @@ func TestBuildAuthenticationFilterStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			reqs := PrepareAuthenticationFilterRequests(test.authenticationFilters, transitionTime, gatewayCtlrName)
 
@@ -3422,6 +3423,194 @@ func TestBuildAuthenticationFilterStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildCanaryFilterStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	validCanaryFilter := &graph.CanaryFilter{
+		Source: &ngfAPI.CanaryFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "valid-canary",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Valid: true,
+	}
+
+	invalidCanaryFilter := &graph.CanaryFilter{
+		Source: &ngfAPI.CanaryFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "invalid-canary",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Conditions: []conditions.Condition{conditions.NewCanaryFilterInvalid("Invalid CanaryFilter")},
+		Valid:      false,
+	}
+
+	canaryFilters := map[types.NamespacedName]*graph.CanaryFilter{
+		{Namespace: "test", Name: "valid-canary"}:   validCanaryFilter,
+		{Namespace: "test", Name: "invalid-canary"}: invalidCanaryFilter,
+	}
+
+	expected := map[types.NamespacedName]ngfAPI.CanaryFilterStatus{
+		{Namespace: "test", Name: "valid-canary"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.CanaryFilterConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.CanaryFilterConditionReasonAccepted),
+							Message:            "The CanaryFilter is accepted",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+		{Namespace: "test", Name: "invalid-canary"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.CanaryFilterConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.CanaryFilterConditionReasonInvalid),
+							Message:            "Invalid CanaryFilter",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.CanaryFilter{})
+
+	for _, cf := range canaryFilters {
+		err := k8sClient.Create(t.Context(), cf.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
+
+	reqs := PrepareCanaryFilterRequests(canaryFilters, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(t.Context(), reqs...)
+
+	for nsname, exp := range expected {
+		var canaryFilter ngfAPI.CanaryFilter
+
+		err := k8sClient.Get(t.Context(), nsname, &canaryFilter)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, canaryFilter.Status)).To(BeEmpty())
+	}
+}
+
+func TestBuildMirrorFilterStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	validMirrorFilter := &graph.MirrorFilter{
+		Source: &ngfAPI.MirrorFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "valid-mirror",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Valid: true,
+	}
+
+	invalidMirrorFilter := &graph.MirrorFilter{
+		Source: &ngfAPI.MirrorFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "invalid-mirror",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Conditions: []conditions.Condition{conditions.NewMirrorFilterInvalid("Invalid MirrorFilter")},
+		Valid:      false,
+	}
+
+	mirrorFilters := map[types.NamespacedName]*graph.MirrorFilter{
+		{Namespace: "test", Name: "valid-mirror"}:   validMirrorFilter,
+		{Namespace: "test", Name: "invalid-mirror"}: invalidMirrorFilter,
+	}
+
+	expected := map[types.NamespacedName]ngfAPI.MirrorFilterStatus{
+		{Namespace: "test", Name: "valid-mirror"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.MirrorFilterConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.MirrorFilterConditionReasonAccepted),
+							Message:            "The MirrorFilter is accepted",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+		{Namespace: "test", Name: "invalid-mirror"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.MirrorFilterConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.MirrorFilterConditionReasonInvalid),
+							Message:            "Invalid MirrorFilter",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.MirrorFilter{})
+
+	for _, mf := range mirrorFilters {
+		err := k8sClient.Create(t.Context(), mf.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
+
+	reqs := PrepareMirrorFilterRequests(mirrorFilters, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(t.Context(), reqs...)
+
+	for nsname, exp := range expected {
+		var mirrorFilter ngfAPI.MirrorFilter
+
+		err := k8sClient.Get(t.Context(), nsname, &mirrorFilter)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, mirrorFilter.Status)).To(BeEmpty())
+	}
+}
+
 func TestBuildInferencePoolStatuses(t *testing.T) {
 	t.Parallel()
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
@@ -3716,7 +3905,7 @@ func TestBuildInferencePoolStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := NewUpdater(k8sClient, logr.Discard())
+			updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 			reqs := PrepareInferencePoolRequests(
 				test.referencedInferencePool,
 				&test.clusterInferencePools,
@@ -3791,7 +3980,7 @@ func TestBuildTCPRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareRouteRequests(
 		routes,
@@ -3867,7 +4056,7 @@ func TestBuildUDPRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareRouteRequests(
 		routes,
@@ -4339,7 +4528,7 @@ func TestBuildListenerSetStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := NewUpdater(k8sClient, logr.Discard())
+	updater := NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 	reqs := PrepareListenerSetRequests(
 		listenerSets,
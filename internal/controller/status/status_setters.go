@@ -536,6 +536,58 @@ func authenticationStatusEqual(status1, status2 ngfAPI.ControllerStatus) bool {
 	return ConditionsEqual(status1.Conditions, status2.Conditions)
 }
 
+func newCanaryFilterStatusSetter(cfStatus ngfAPI.CanaryFilterStatus, gatewayCtlrName string) Setter {
+	return func(obj client.Object) (wasSet bool) {
+		cf := helpers.MustCastObject[*ngfAPI.CanaryFilter](obj)
+
+		maxControllerStatus := 1 + len(cf.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range cf.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, cfStatus.Controllers...)
+		cfStatus.Controllers = controllerStatuses
+
+		// the controller statuses have the same shape as the ones of the AuthenticationFilter
+		if authenticationFilterStatusEqual(gatewayCtlrName, cfStatus.Controllers, cf.Status.Controllers) {
+			return false
+		}
+
+		cf.Status = cfStatus
+		return true
+	}
+}
+
+func newMirrorFilterStatusSetter(mfStatus ngfAPI.MirrorFilterStatus, gatewayCtlrName string) Setter {
+	return func(obj client.Object) (wasSet bool) {
+		mf := helpers.MustCastObject[*ngfAPI.MirrorFilter](obj)
+
+		maxControllerStatus := 1 + len(mf.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range mf.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, mfStatus.Controllers...)
+		mfStatus.Controllers = controllerStatuses
+
+		// the controller statuses have the same shape as the ones of the AuthenticationFilter
+		if authenticationFilterStatusEqual(gatewayCtlrName, mfStatus.Controllers, mf.Status.Controllers) {
+			return false
+		}
+
+		mf.Status = mfStatus
+		return true
+	}
+}
+
 func newExternalLoadBalancerStatusSetter(
 	elbStatus ngfAPI.ExternalLoadBalancerStatus,
 	gatewayCtlrName string,
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
@@ -50,17 +51,25 @@ type Setter func(client.Object) (wasSet bool)
 // result of processing some other new change to a resource(s).
 // FIXME(pleshakov): https://github.com/nginx/nginx-gateway-fabric/issues/1813
 type Updater struct {
-	client client.Client
-	logger logr.Logger
+	client           client.Client
+	metricsCollector MetricsCollector
+	logger           logr.Logger
+}
+
+// MetricsCollector collects metrics for the Updater.
+type MetricsCollector interface {
+	ObserveStatusUpdateTime(kind string, duration time.Duration)
+	IncrementStatusUpdateFailures(kind string)
 }
 
 var ErrFailedAssert = errors.New("type assertion failed")
 
 // NewUpdater creates a new Updater.
-func NewUpdater(c client.Client, logger logr.Logger) *Updater {
+func NewUpdater(c client.Client, logger logr.Logger, metricsCollector MetricsCollector) *Updater {
 	return &Updater{
-		client: c,
-		logger: logger,
+		client:           c,
+		logger:           logger,
+		metricsCollector: metricsCollector,
 	}
 }
 
@@ -77,7 +86,7 @@ func (u *Updater) Update(ctx context.Context, reqs ...UpdateRequest) {
 			"Updating status for resource",
 			"namespace", r.NsName.Namespace,
 			"name", r.NsName.Name,
-			"kind", r.ResourceType.GetObjectKind().GroupVersionKind().Kind,
+			"kind", kinds.ObjectKind(r.ResourceType),
 		)
 
 		u.writeStatuses(ctx, r.NsName, r.ResourceType, r.Setter)
@@ -96,6 +105,9 @@ func (u *Updater) writeStatuses(
 		panic(fmt.Errorf("object is not a client.Object: %w", ErrFailedAssert))
 	}
 
+	kind := kinds.ObjectKind(resourceType)
+	start := time.Now()
+
 	err := wait.ExponentialBackoffWithContext(
 		ctx,
 		wait.Backoff{
@@ -108,13 +120,16 @@ func (u *Updater) writeStatuses(
 		// Function returns true if the condition is satisfied, or an error if the loop should be aborted.
 		NewRetryUpdateFunc(u.client, u.client.Status(), nsname, obj, u.logger, statusSetter),
 	)
+	u.metricsCollector.ObserveStatusUpdateTime(kind, time.Since(start))
+
 	if err != nil && !errors.Is(err, context.Canceled) {
+		u.metricsCollector.IncrementStatusUpdateFailures(kind)
 		u.logger.Error(
 			err,
 			"Failed to update status",
 			"namespace", nsname.Namespace,
 			"name", nsname.Name,
-			"kind", resourceType.GetObjectKind().GroupVersionKind().Kind)
+			"kind", kind)
 	}
 }
 
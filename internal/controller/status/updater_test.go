@@ -2,6 +2,7 @@ package status
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
@@ -60,6 +62,17 @@ func createConditions(
 	}
 }
 
+// kindRecordingCollector records the kind labels of the observed status updates.
+type kindRecordingCollector struct {
+	observedKinds []string
+}
+
+func (c *kindRecordingCollector) ObserveStatusUpdateTime(kind string, _ time.Duration) {
+	c.observedKinds = append(c.observedKinds, kind)
+}
+
+func (c *kindRecordingCollector) IncrementStatusUpdateFailures(_ string) {}
+
 const (
 	updateNeeded    = true
 	updateNotNeeded = false
@@ -116,7 +129,7 @@ var _ = Describe("Updater", func() {
 		)
 
 		BeforeAll(func() {
-			updater = NewUpdater(k8sClient, logr.Discard())
+			updater = NewUpdater(k8sClient, logr.Discard(), collectors.NewStatusUpdaterNoopCollector())
 
 			for _, name := range gcNames {
 				gc := createGC(name)
@@ -201,5 +214,15 @@ var _ = Describe("Updater", func() {
 				}
 			})
 		})
+
+		It("should record the status update metrics with the kind of the resource", func() {
+			metricsCollector := &kindRecordingCollector{}
+			metricsUpdater := NewUpdater(k8sClient, logr.Discard(), metricsCollector)
+
+			metricsUpdater.Update(context.Background(), prepareReq(gcNames[0], "TestMetrics", updateNeeded))
+
+			testStatus(gcNames[0], "TestMetrics")
+			Expect(metricsCollector.observedKinds).To(Equal([]string{kinds.GatewayClass}))
+		})
 	})
 })
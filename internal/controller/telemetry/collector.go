@@ -151,10 +151,14 @@ type NGFResourceCounts struct {
 	NIMWAFPolicyCount int64
 	// N1CWAFPolicyCount is the number of WAFPolicies with N1C source type.
 	N1CWAFPolicyCount int64
+	// OCIWAFPolicyCount is the number of WAFPolicies with OCI source type.
+	OCIWAFPolicyCount int64
 	// PLMWAFPolicyCount is the number of WAFPolicies with PLM source type.
 	PLMWAFPolicyCount int64
 	// ListenerSetCount is the number of relevant ListenerSets.
 	ListenerSetCount int64
+	// HealthCheckPolicyCount is the number of HealthCheckPolicies.
+	HealthCheckPolicyCount int64
 }
 
 func (rc *NGFResourceCounts) CountPolicies(g *graph.Graph) {
@@ -176,6 +180,8 @@ func (rc *NGFResourceCounts) CountPolicies(g *graph.Graph) {
 			rc.ObservabilityPolicyCount++
 		case kinds.UpstreamSettingsPolicy:
 			rc.UpstreamSettingsPolicyCount++
+		case kinds.HealthCheckPolicy:
+			rc.HealthCheckPolicyCount++
 		case kinds.WAFPolicy:
 			gatewayCount, routeCount := countPolicyTargetRefs(policy)
 			rc.GatewayAttachedWAFPolicyCount += gatewayCount
@@ -188,6 +194,8 @@ func (rc *NGFResourceCounts) CountPolicies(g *graph.Graph) {
 					rc.NIMWAFPolicyCount++
 				case ngfAPIv1alpha1.PolicySourceTypeN1C:
 					rc.N1CWAFPolicyCount++
+				case ngfAPIv1alpha1.PolicySourceTypeOCI:
+					rc.OCIWAFPolicyCount++
 				case ngfAPIv1alpha1.PolicySourceTypePLM:
 					rc.PLMWAFPolicyCount++
 				}
@@ -396,6 +396,10 @@ var _ = Describe("Collector", Ordered, func() {
 							NsName: types.NamespacedName{Namespace: "test", Name: "UpstreamSettingsPolicy-1"},
 							GVK:    schema.GroupVersionKind{Kind: kinds.UpstreamSettingsPolicy},
 						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "HealthCheckPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.HealthCheckPolicy},
+						}: {},
 						{
 							NsName: types.NamespacedName{Namespace: "test", Name: "ProxySettingsPolicy-1"},
 							GVK:    schema.GroupVersionKind{Kind: kinds.ProxySettingsPolicy},
@@ -713,6 +717,7 @@ var _ = Describe("Collector", Ordered, func() {
 					HTTPWAFPolicyCount:                       1,
 					NIMWAFPolicyCount:                        1,
 					ListenerSetCount:                         3,
+					HealthCheckPolicyCount:                   1,
 				}
 				expData.ClusterVersion = "1.29.2"
 				expData.ClusterPlatform = "kind"
@@ -934,6 +939,10 @@ var _ = Describe("Collector", Ordered, func() {
 						NsName: types.NamespacedName{Namespace: "test", Name: "UpstreamSettingsPolicy-1"},
 						GVK:    schema.GroupVersionKind{Kind: kinds.UpstreamSettingsPolicy},
 					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "HealthCheckPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.HealthCheckPolicy},
+					}: {},
 					{
 						NsName: types.NamespacedName{Namespace: "test", Name: "ProxySettingsPolicy-1"},
 						GVK:    schema.GroupVersionKind{Kind: kinds.ProxySettingsPolicy},
@@ -1015,6 +1024,16 @@ var _ = Describe("Collector", Ordered, func() {
 							{Kind: kinds.Gateway},
 						},
 					},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "WAFPolicy-5"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.WAFPolicy},
+					}: {
+						Source: &ngfAPI.WAFPolicy{
+							Spec: ngfAPI.WAFPolicySpec{
+								Type: ngfAPI.PolicySourceTypeOCI,
+							},
+						},
+					},
 				},
 				ReferencedNginxProxies: map[types.NamespacedName]*graph.NginxProxy{
 					{Namespace: "test", Name: "NginxProxy-1"}: {Valid: true},
@@ -1131,8 +1150,10 @@ var _ = Describe("Collector", Ordered, func() {
 					HTTPWAFPolicyCount:                       1,
 					NIMWAFPolicyCount:                        1,
 					N1CWAFPolicyCount:                        1,
+					OCIWAFPolicyCount:                        1,
 					PLMWAFPolicyCount:                        1,
 					ListenerSetCount:                         1,
+					HealthCheckPolicyCount:                   1,
 				}
 				expData.NginxPodCount = 1
 
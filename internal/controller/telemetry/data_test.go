@@ -56,8 +56,10 @@ func TestDataAttributes(t *testing.T) {
 			HTTPWAFPolicyCount:                       28,
 			NIMWAFPolicyCount:                        29,
 			N1CWAFPolicyCount:                        30,
-			PLMWAFPolicyCount:                        31,
-			ListenerSetCount:                         32,
+			OCIWAFPolicyCount:                        31,
+			PLMWAFPolicyCount:                        32,
+			ListenerSetCount:                         33,
+			HealthCheckPolicyCount:                   34,
 		},
 		SnippetsFiltersDirectives:       []string{"main-three-count", "http-two-count", "server-one-count"},
 		SnippetsFiltersDirectivesCount:  []int64{3, 2, 1},
@@ -122,8 +124,10 @@ func TestDataAttributes(t *testing.T) {
 		attribute.Int64("HTTPWAFPolicyCount", 28),
 		attribute.Int64("NIMWAFPolicyCount", 29),
 		attribute.Int64("N1CWAFPolicyCount", 30),
-		attribute.Int64("PLMWAFPolicyCount", 31),
-		attribute.Int64("ListenerSetCount", 32),
+		attribute.Int64("OCIWAFPolicyCount", 31),
+		attribute.Int64("PLMWAFPolicyCount", 32),
+		attribute.Int64("ListenerSetCount", 33),
+		attribute.Int64("HealthCheckPolicyCount", 34),
 
 		// Top level attributes
 		attribute.Int64("NginxPodCount", 3),
@@ -198,8 +202,10 @@ func TestDataAttributesWithEmptyData(t *testing.T) {
 		attribute.Int64("HTTPWAFPolicyCount", 0),
 		attribute.Int64("NIMWAFPolicyCount", 0),
 		attribute.Int64("N1CWAFPolicyCount", 0),
+		attribute.Int64("OCIWAFPolicyCount", 0),
 		attribute.Int64("PLMWAFPolicyCount", 0),
 		attribute.Int64("ListenerSetCount", 0),
+		attribute.Int64("HealthCheckPolicyCount", 0),
 
 		// Top level attributes
 		attribute.Int64("NginxPodCount", 0),
@@ -43,8 +43,10 @@ func (d *NGFResourceCounts) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, attribute.Int64("HTTPWAFPolicyCount", d.HTTPWAFPolicyCount))
 	attrs = append(attrs, attribute.Int64("NIMWAFPolicyCount", d.NIMWAFPolicyCount))
 	attrs = append(attrs, attribute.Int64("N1CWAFPolicyCount", d.N1CWAFPolicyCount))
+	attrs = append(attrs, attribute.Int64("OCIWAFPolicyCount", d.OCIWAFPolicyCount))
 	attrs = append(attrs, attribute.Int64("PLMWAFPolicyCount", d.PLMWAFPolicyCount))
 	attrs = append(attrs, attribute.Int64("ListenerSetCount", d.ListenerSetCount))
+	attrs = append(attrs, attribute.Int64("HealthCheckPolicyCount", d.HealthCheckPolicyCount))
 
 	return attrs
 }
@@ -26,6 +26,8 @@ var (
 		secrets.BundlePasswordKey,
 		secrets.BundleTokenKey,
 		secrets.PLMS3Secret,
+		// Tracing exporter header values
+		secrets.HeaderValueKey,
 	}
 
 	configMapKeys = []string{
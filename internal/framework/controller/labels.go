@@ -1,5 +1,7 @@
 package controller
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // The following labels are added to each nginx resource created by the control plane.
 const (
 	GatewayLabel      = "gateway.networking.k8s.io/gateway-name"
@@ -8,5 +10,15 @@ const (
 	AppManagedByLabel = "app.kubernetes.io/managed-by"
 )
 
+// ZoneSyncLabel is added to the headless Service that the nginx replicas of a Gateway use to discover each other
+// for zone synchronization.
+const ZoneSyncLabel = "gateway.nginx.org/zone-sync"
+
+// IsZoneSyncService returns whether the object is a zone synchronization Service.
+func IsZoneSyncService(obj metav1.Object) bool {
+	_, ok := obj.GetLabels()[ZoneSyncLabel]
+	return ok
+}
+
 // RestartedAnnotation is added to a Deployment or DaemonSet's PodSpec to trigger a rolling restart.
 const RestartedAnnotation = "kubectl.kubernetes.io/restartedAt"
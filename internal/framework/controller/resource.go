@@ -9,8 +9,11 @@ import (
 const (
 	// inferencePoolServiceSuffix is the suffix of the headless Service name for an InferencePool.
 	inferencePoolServiceSuffix = "pool-svc"
-	MaxServiceNameLen          = 63
-	hashLen                    = 8
+	// zoneSyncServiceSuffix is the suffix of the headless Service name used by nginx replicas
+	// to discover each other for zone synchronization.
+	zoneSyncServiceSuffix = "zone-sync"
+	MaxServiceNameLen     = 63
+	hashLen               = 8
 )
 
 // CreateNginxResourceName creates the base resource name for all nginx resources
@@ -25,6 +28,12 @@ func CreateInferencePoolServiceName(name string) string {
 	return truncateAndHashName(name, inferencePoolServiceSuffix)
 }
 
+// CreateZoneSyncServiceName creates the name for the headless Service that
+// the nginx replicas of a Deployment use to discover each other for zone synchronization.
+func CreateZoneSyncServiceName(deploymentName string) string {
+	return truncateAndHashName(deploymentName, zoneSyncServiceSuffix)
+}
+
 // truncateAndHashName truncates the input name to fit within maxLen,
 // appending a hash for uniqueness if needed.
 func truncateAndHashName(name string, suffix string) string {
@@ -2,6 +2,7 @@ package kinds
 
 import (
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -125,12 +126,24 @@ const (
 	SnippetsPolicy = "SnippetsPolicy"
 	// AuthenticationFilter is the AuthenticationFilter kind.
 	AuthenticationFilter = "AuthenticationFilter"
+	// CanaryFilter is the CanaryFilter kind.
+	CanaryFilter = "CanaryFilter"
+	// MirrorFilter is the MirrorFilter kind.
+	MirrorFilter = "MirrorFilter"
 	// UpstreamSettingsPolicy is the UpstreamSettingsPolicy kind.
 	UpstreamSettingsPolicy = "UpstreamSettingsPolicy"
 	// RateLimitPolicy is the RateLimitPolicy kind.
 	RateLimitPolicy = "RateLimitPolicy"
+	// ConnectionLimitPolicy is the ConnectionLimitPolicy kind.
+	ConnectionLimitPolicy = "ConnectionLimitPolicy"
+	// AccessControlPolicy is the AccessControlPolicy kind.
+	AccessControlPolicy = "AccessControlPolicy"
+	// CachePolicy is the CachePolicy kind.
+	CachePolicy = "CachePolicy"
 	// WAFPolicy is the WAFPolicy kind.
 	WAFPolicy = "WAFPolicy"
+	// HealthCheckPolicy is the HealthCheckPolicy kind.
+	HealthCheckPolicy = "HealthCheckPolicy"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.
@@ -148,3 +161,13 @@ func NewMustExtractGKV(scheme *runtime.Scheme) MustExtractGVK {
 		return gvk
 	}
 }
+
+// ObjectKind returns the kind of the object. Typed objects, such as the ones from the cache, usually have an empty
+// TypeMeta, so it falls back to the name of the Go type, which matches the kind for all resources that NGF watches.
+func ObjectKind(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
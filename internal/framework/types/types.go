@@ -15,4 +15,6 @@ const (
 	// GoShimPort is the default port for the Go EPP shim server to listen on. If collisions become a problem,
 	// we can make this configurable via the NginxProxy resource.
 	GoShimPort = 54800 // why 54800? Sum "nginx" in ASCII and multiply by 100.
+	// GoShimMetricsPort is the default port for the Go EPP shim server to expose its Prometheus metrics on.
+	GoShimMetricsPort = 54801
 )
@@ -0,0 +1,222 @@
+// Package bundlecache persists fetched WAF bundles so that they survive controller restarts.
+package bundlecache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+)
+
+//go:generate go tool counterfeiter -generate
+
+// Store persists WAF bundles keyed by bundle key. A Store holds at most one bundle per key.
+//
+//counterfeiter:generate . Store
+type Store interface {
+	// Load returns all persisted bundles. Bundles that fail verification are skipped and reported in the
+	// returned error, together with the bundles that passed verification.
+	Load() (map[graph.WAFBundleKey]*graph.WAFBundleData, error)
+	// Save persists the bundle for the key, replacing any bundle previously persisted for it.
+	Save(key graph.WAFBundleKey, bundle *graph.WAFBundleData) error
+	// Delete removes the bundle persisted for the key. Deleting a key without a bundle is not an error.
+	Delete(key graph.WAFBundleKey) error
+}
+
+// DirStore is a Store that persists bundles in a directory, typically backed by a PersistentVolume.
+// Every bundle key has its own subdirectory, which holds a single file named after the checksum of
+// the bundle. The file starts with a header line holding the SHA-256 digest of the bundle data and the
+// base64 encoded signature of the bundle, separated by a space, followed by the bundle data.
+// The digest is verified on Load. The signature is verified by the graph, which knows the verification keys,
+// before a restored bundle is used.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a DirStore that persists bundles in dir, creating dir if it does not exist.
+func NewDirStore(dir string) (*DirStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create WAF bundle cache directory: %w", err)
+	}
+
+	return &DirStore{dir: dir}, nil
+}
+
+// Load returns all bundles persisted in the directory.
+// Entries that cannot be decoded, e.g. left behind by an older version, are skipped. Bundles that don't
+// match their digest are skipped and reported in the returned error.
+func (s *DirStore) Load() (map[graph.WAFBundleKey]*graph.WAFBundleData, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAF bundle cache directory: %w", err)
+	}
+
+	var errs []error
+	bundles := make(map[graph.WAFBundleKey]*graph.WAFBundleData, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		key, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		bundle, err := s.loadBundle(filepath.Join(s.dir, entry.Name()))
+		if errors.Is(err, errCorruptBundle) {
+			errs = append(errs, fmt.Errorf("cached WAF bundle %s: %w", key, err))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if bundle != nil {
+			bundles[graph.WAFBundleKey(key)] = bundle
+		}
+	}
+
+	return bundles, errors.Join(errs...)
+}
+
+// errCorruptBundle is returned by loadBundle if the bundle data doesn't match its digest.
+var errCorruptBundle = errors.New("bundle data does not match its digest")
+
+// loadBundle reads the bundle from the key directory. It returns nil if the directory holds no bundle.
+func (s *DirStore) loadBundle(keyDir string) (*graph.WAFBundleData, error) {
+	files, err := os.ReadDir(keyDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAF bundle cache directory: %w", err)
+	}
+
+	for _, file := range files {
+		// Skip temporary files left behind by a Save that did not complete.
+		if !file.Type().IsRegular() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		checksum, err := url.PathUnescape(file.Name())
+		if err != nil || checksum == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(keyDir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cached WAF bundle: %w", err)
+		}
+
+		digest, signature, data, ok := decodeBundle(content)
+		if !ok {
+			continue
+		}
+		if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != digest {
+			return nil, errCorruptBundle
+		}
+
+		return &graph.WAFBundleData{Checksum: checksum, Data: data, Signature: signature}, nil
+	}
+
+	return nil, nil //nolint:nilnil // an empty key directory is not an error
+}
+
+// encodeBundleHeader returns the header line of a bundle file.
+func encodeBundleHeader(bundle *graph.WAFBundleData) []byte {
+	sum := sha256.Sum256(bundle.Data)
+	return fmt.Appendf(nil, "%s %s\n", hex.EncodeToString(sum[:]), base64.StdEncoding.EncodeToString(bundle.Signature))
+}
+
+// decodeBundle splits the content of a bundle file into the digest, signature and data of the bundle.
+// ok is false if the content doesn't start with a valid header.
+func decodeBundle(content []byte) (digest string, signature, data []byte, ok bool) {
+	header, data, found := bytes.Cut(content, []byte("\n"))
+	if !found {
+		return "", nil, nil, false
+	}
+
+	digestField, signatureField, found := strings.Cut(string(header), " ")
+	if !found || len(digestField) != hex.EncodedLen(sha256.Size) {
+		return "", nil, nil, false
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(signatureField)
+	if err != nil {
+		return "", nil, nil, false
+	}
+	if len(signature) == 0 {
+		signature = nil
+	}
+
+	return digestField, signature, data, true
+}
+
+// Save persists the bundle for the key. The bundle is written to a temporary file first and then
+// renamed, so that a crash while saving never leaves a partially written bundle behind.
+func (s *DirStore) Save(key graph.WAFBundleKey, bundle *graph.WAFBundleData) error {
+	if bundle.Checksum == "" {
+		return errors.New("cannot cache a WAF bundle without a checksum")
+	}
+
+	keyDir := filepath.Join(s.dir, url.PathEscape(string(key)))
+	if err := os.MkdirAll(keyDir, 0o750); err != nil {
+		return fmt.Errorf("failed to create WAF bundle cache directory: %w", err)
+	}
+
+	// Temporary files start with a "." so that they are never loaded as a bundle.
+	tmp, err := os.CreateTemp(keyDir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cached WAF bundle: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // the file no longer exists after a successful rename
+
+	if _, err := tmp.Write(encodeBundleHeader(bundle)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cached WAF bundle: %w", err)
+	}
+	if _, err := tmp.Write(bundle.Data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cached WAF bundle: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cached WAF bundle: %w", err)
+	}
+
+	name := url.PathEscape(bundle.Checksum)
+	if err := os.Rename(tmp.Name(), filepath.Join(keyDir, name)); err != nil {
+		return fmt.Errorf("failed to write cached WAF bundle: %w", err)
+	}
+
+	// Remove the bundles previously persisted for the key.
+	files, err := os.ReadDir(keyDir)
+	if err != nil {
+		return fmt.Errorf("failed to read WAF bundle cache directory: %w", err)
+	}
+	for _, file := range files {
+		if file.Name() == name {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(keyDir, file.Name())); err != nil {
+			return fmt.Errorf("failed to remove previously cached WAF bundle: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the bundle persisted for the key.
+func (s *DirStore) Delete(key graph.WAFBundleKey) error {
+	err := os.RemoveAll(filepath.Join(s.dir, url.PathEscape(string(key))))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove cached WAF bundle: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package bundlecache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/bundlecache"
+)
+
+func TestNewDirStore(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := filepath.Join(t.TempDir(), "cache", "waf")
+
+	store, err := bundlecache.NewDirStore(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(store).ToNot(BeNil())
+	g.Expect(dir).To(BeADirectory())
+
+	bundles, err := store.Load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundles).To(BeEmpty())
+}
+
+func TestDirStore(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	store, err := bundlecache.NewDirStore(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	policyKey := graph.WAFBundleKey("default_policy")
+	// Keys and checksums may contain characters that are not valid in file names.
+	logKey := graph.WAFBundleKey("default_policy_log_https://nim.example.com/profiles/default")
+	policyBundle := &graph.WAFBundleData{
+		Data:      []byte("policy bundle"),
+		Checksum:  "sha256:abc123",
+		Signature: []byte("signature"),
+	}
+	logBundle := &graph.WAFBundleData{Data: []byte("log bundle"), Checksum: "def/456"}
+
+	g.Expect(store.Save(policyKey, policyBundle)).To(Succeed())
+	g.Expect(store.Save(logKey, logBundle)).To(Succeed())
+
+	// A new store on the same directory, as after a controller restart, loads the saved bundles.
+	restarted, err := bundlecache.NewDirStore(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	bundles, err := restarted.Load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundles).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		policyKey: policyBundle,
+		logKey:    logBundle,
+	}))
+
+	// Saving a new bundle for a key replaces the previous bundle.
+	updated := &graph.WAFBundleData{Data: []byte("updated policy bundle"), Checksum: "sha256:ghi789"}
+	g.Expect(store.Save(policyKey, updated)).To(Succeed())
+
+	bundles, err = store.Load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundles).To(HaveKeyWithValue(policyKey, updated))
+
+	entries, err := os.ReadDir(filepath.Join(dir, "default_policy"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(HaveLen(1))
+
+	g.Expect(store.Delete(logKey)).To(Succeed())
+	// Deleting a key without a bundle is not an error.
+	g.Expect(store.Delete("default_missing")).To(Succeed())
+
+	bundles, err = store.Load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundles).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{policyKey: updated}))
+}
+
+func TestDirStoreLoadSkipsIncompleteBundles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	store, err := bundlecache.NewDirStore(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	bundle := &graph.WAFBundleData{Data: []byte("bundle"), Checksum: "abc123"}
+	g.Expect(store.Save("default_policy", bundle)).To(Succeed())
+
+	// A temporary file left behind by an interrupted save, a key directory without a bundle and a
+	// stray file are ignored.
+	g.Expect(os.WriteFile(filepath.Join(dir, "default_policy", ".tmp-123"), []byte("partial"), 0o600)).To(Succeed())
+	g.Expect(os.Mkdir(filepath.Join(dir, "default_empty"), 0o750)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "stray"), []byte("stray"), 0o600)).To(Succeed())
+
+	bundles, err := store.Load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundles).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{"default_policy": bundle}))
+}
+
+func TestDirStoreLoadVerifiesBundles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	store, err := bundlecache.NewDirStore(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	bundle := &graph.WAFBundleData{Data: []byte("bundle"), Checksum: "abc123"}
+	g.Expect(store.Save("default_policy", bundle)).To(Succeed())
+	g.Expect(store.Save("default_corrupt", bundle)).To(Succeed())
+	g.Expect(store.Save("default_old", bundle)).To(Succeed())
+
+	// A bundle whose data was modified after it was saved.
+	corruptFile := filepath.Join(dir, "default_corrupt", "abc123")
+	content, err := os.ReadFile(corruptFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	content[len(content)-1] = 'X'
+	g.Expect(os.WriteFile(corruptFile, content, 0o600)).To(Succeed())
+
+	// A bundle saved without a header by an older version.
+	g.Expect(os.WriteFile(filepath.Join(dir, "default_old", "abc123"), []byte("bundle"), 0o600)).To(Succeed())
+
+	bundles, err := store.Load()
+	g.Expect(err).To(MatchError(ContainSubstring("cached WAF bundle default_corrupt")))
+	g.Expect(bundles).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{"default_policy": bundle}))
+}
+
+func TestDirStoreSaveRequiresChecksum(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store, err := bundlecache.NewDirStore(t.TempDir())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = store.Save("default_policy", &graph.WAFBundleData{Data: []byte("bundle")})
+	g.Expect(err).To(MatchError(ContainSubstring("without a checksum")))
+}
@@ -73,7 +73,10 @@ type Result struct {
 	ETag         string
 	LastModified string
 	Data         []byte
-	Unchanged    bool
+	// Signature is the detached signature of the bundle, downloaded when req.Signature.URL is set.
+	// It is not verified by the fetcher; use VerifyBundleSignature before deploying the bundle.
+	Signature []byte
+	Unchanged bool
 }
 
 // BundleAuth holds authentication credentials for bundle fetching.
@@ -102,21 +105,26 @@ type Request struct {
 	PolicyName string
 	// NIM holds the NIM specific request details.
 	NIM NIMRequest
+	// OCI holds the OCI specific request details.
+	OCI OCIRequest
+	// Signature holds the details of the detached bundle signature.
+	Signature SignatureRequest
 	// URL is the base URL of the bundle source.
 	URL string
 	// ExpectedChecksum is the hex-encoded SHA-256 checksum the downloaded bundle must match.
 	// When set, NGF verifies the bundle after download and rejects it if the checksum differs.
-	// Mutually exclusive with VerifyChecksum. Supported for all source types.
+	// Mutually exclusive with VerifyChecksum. Supported for all source types. For OCI sources it is
+	// compared against the bundle layer, since the manifest digest is reported as the checksum.
 	ExpectedChecksum string
 	// ETag is the value of the ETag response header from the previous successful HTTP fetch.
 	// When set, it is sent as If-None-Match on the next request so the server can respond
 	// with 304 Not Modified instead of retransmitting the bundle. Takes precedence over LastModified.
-	// Only used for plain HTTP sources; ignored for NIM and N1C.
+	// Only used for plain HTTP sources; ignored for NIM, N1C, and OCI.
 	ETag string
 	// LastModified is the value of the Last-Modified response header from the previous successful
 	// HTTP fetch. When set, it is sent as If-Modified-Since on the next request. Only used when
 	// ETag is empty, since ETag is the preferred validator. Only used for plain HTTP sources; ignored
-	// for NIM and N1C.
+	// for NIM, N1C, and OCI.
 	LastModified string
 	// TLSCAData is the PEM-encoded CA certificate used to verify the bundle server's TLS certificate.
 	// When nil, the system certificate pool is used.
@@ -129,8 +137,8 @@ type Request struct {
 	// InsecureSkipVerify disables TLS certificate verification. Not recommended for production use.
 	InsecureSkipVerify bool
 	// VerifyChecksum enables checksum verification by fetching a companion <url>.sha256 file.
-	// Only supported for plain HTTP fetches; not supported for NIM or N1C policy or log-profile
-	// sources (PolicyName, NIM.PolicyUID, N1C.Namespace, or LogProfileName set).
+	// Only supported for plain HTTP fetches; not supported for NIM, N1C, or OCI policy or log-profile
+	// sources (PolicyName, NIM.PolicyUID, N1C.Namespace, OCI.Reference, or LogProfileName set).
 	// Mutually exclusive with ExpectedChecksum.
 	VerifyChecksum bool
 }
@@ -160,6 +168,14 @@ type NIMRequest struct {
 	PolicyUID string
 }
 
+// OCIRequest carries all the OCI specific parameters to fetch a single bundle.
+type OCIRequest struct {
+	// Reference is the OCI artifact reference, including the registry host and either a tag or a digest
+	// (e.g. "registry.example.com/security/waf-policy:v1" or "registry.example.com/waf@sha256:<digest>").
+	// When set, the bundle is pulled from the registry and URL is ignored.
+	Reference string
+}
+
 // Fetcher fetches WAF policy bundles and log profile bundles from remote sources.
 //
 //counterfeiter:generate . Fetcher
@@ -171,14 +187,19 @@ type Fetcher interface {
 	// req.URL+".sha256" to verify integrity. VerifyChecksum is not supported for NIM/N1C.
 	// For NIM sources: calls the NIM bundles API and base64-decodes items[0].content.
 	// For N1C sources: resolves the policy via the N1C API and downloads the compiled bundle.
+	// For OCI sources: resolves the manifest of req.OCI.Reference and downloads the bundle layer;
+	// the manifest digest is returned as Result.Checksum.
+	// For all sources, when req.Signature.PublicKey is set, the detached signature is downloaded into
+	// Result.Signature; it is verified by the caller with VerifyBundleSignature.
 	FetchPolicyBundle(ctx context.Context, req Request) (Result, error)
 	// FetchLogProfileBundle retrieves the log profile bundle described by req.
 	// For HTTP sources: same conditional-request behavior as FetchPolicyBundle.
 	// For NIM sources: calls the NIM log profile bundles API and base64-decodes the compiledBundle field.
 	// For N1C sources: resolves the log profile via the N1C API and downloads the compiled bundle.
+	// The detached signature is downloaded as for FetchPolicyBundle.
 	FetchLogProfileBundle(ctx context.Context, req Request) (Result, error)
 	// FetchPolicyBundleChecksum retrieves only the checksum of the remote policy bundle without
-	// downloading the full bundle content. Only supported for NIM and N1C sources; returns an error
+	// downloading the full bundle content. Only supported for NIM, N1C, and OCI sources; returns an error
 	// for plain HTTP sources (use FetchPolicyBundle with ETag/LastModified instead).
 	FetchPolicyBundleChecksum(ctx context.Context, req Request) (checksum string, err error)
 	// FetchLogProfileBundleChecksum retrieves only the checksum of the remote log profile bundle without
@@ -215,13 +236,19 @@ func (f *HTTPFetcher) WithN1CCompilePollDelay(d time.Duration) *HTTPFetcher {
 // validateAndNormalizeRequest checks mutual-exclusion rules and normalises
 // ExpectedChecksum to lowercase. It returns the updated Request or an error.
 func validateAndNormalizeRequest(req Request) (Request, error) {
-	if req.VerifyChecksum &&
-		(req.N1C.Namespace != "" || req.PolicyName != "" || req.NIM.PolicyUID != "" || req.LogProfileName != "") {
+	if req.VerifyChecksum && !req.isPlainHTTP() {
 		return Request{}, fmt.Errorf(
-			"verifyChecksum is only supported for plain HTTP fetches; use expectedChecksum for NIM/N1C sources",
+			"verifyChecksum is only supported for plain HTTP fetches; use expectedChecksum for NIM/N1C/OCI sources",
 		)
 	}
 
+	if len(req.Signature.PublicKey) > 0 && req.Signature.URL == "" {
+		if !req.isPlainHTTP() {
+			return Request{}, fmt.Errorf("a signature URL is required to verify bundles from NIM/N1C/OCI sources")
+		}
+		req.Signature.URL = req.URL + ".sig"
+	}
+
 	if req.ExpectedChecksum != "" {
 		normalized := strings.ToLower(req.ExpectedChecksum)
 		if _, err := hex.DecodeString(normalized); err != nil || len(normalized) != 64 {
@@ -243,13 +270,24 @@ type nonTransientError struct {
 func (e *nonTransientError) Error() string { return e.err.Error() }
 func (e *nonTransientError) Unwrap() error { return e.err }
 
+// SignatureRequest carries the parameters to fetch and verify the detached signature of a bundle.
+type SignatureRequest struct {
+	// URL is the URL of the detached signature. When empty and PublicKey is set, it defaults to
+	// <URL>.sig for plain HTTP sources; it is required for NIM, N1C, and OCI sources.
+	URL string
+	// PublicKey is the PEM-encoded public key or certificate chain used to verify the signature.
+	// When empty, no signature is fetched or verified.
+	PublicKey []byte
+}
+
 // FetchPolicyBundle retrieves policy bundle bytes.
+// When req.OCI.Reference is set, pulls the bundle from the OCI registry.
 // When req.N1C.Namespace is set, uses N1C fetch logic (APIToken auth, N1C API path).
 // When req.PolicyName or req.NIM.PolicyUID is set (and N1C.Namespace is empty), uses NIM fetch logic.
 // Otherwise performs a plain GET to req.URL, optionally verifying the checksum.
 // For plain HTTP sources, a conditional GET is issued when req.ETag or req.LastModified is set.
 func (f *HTTPFetcher) FetchPolicyBundle(ctx context.Context, req Request) (Result, error) {
-	return f.fetch(ctx, req, f.dispatch)
+	return f.fetch(ctx, req, withSignature(f.dispatch))
 }
 
 // FetchLogProfileBundle retrieves log profile bundle bytes.
@@ -258,10 +296,10 @@ func (f *HTTPFetcher) FetchPolicyBundle(ctx context.Context, req Request) (Resul
 // Otherwise performs a plain GET to req.URL.
 // For plain HTTP sources, a conditional GET is issued when req.ETag or req.LastModified is set.
 func (f *HTTPFetcher) FetchLogProfileBundle(ctx context.Context, req Request) (Result, error) {
-	return f.fetch(ctx, req, f.logProfileDispatch)
+	return f.fetch(ctx, req, withSignature(f.logProfileDispatch))
 }
 
-// FetchPolicyBundleChecksum returns only the checksum of the remote policy bundle for NIM and N1C
+// FetchPolicyBundleChecksum returns only the checksum of the remote policy bundle for NIM, N1C, and OCI
 // sources without downloading the full bundle content. Returns an error for plain HTTP sources.
 func (f *HTTPFetcher) FetchPolicyBundleChecksum(ctx context.Context, req Request) (string, error) {
 	result, err := f.fetch(ctx, req, f.dispatchChecksum)
@@ -281,6 +319,9 @@ func (f *HTTPFetcher) dispatchChecksum(
 	req Request,
 ) (Result, error) {
 	switch {
+	case req.OCI.Reference != "":
+		checksum, err := fetchOCIChecksum(ctx, client, req)
+		return Result{Checksum: checksum}, err
 	case req.N1C.Namespace != "":
 		checksum, err := fetchN1CChecksum(ctx, client, req, f.n1cCompilePollDelay, f.logger)
 		return Result{Checksum: checksum}, err
@@ -313,10 +354,13 @@ func (f *HTTPFetcher) logProfileDispatchChecksum(
 	}
 }
 
+// dispatchFunc fetches a bundle, or its checksum, for the given request using client.
+type dispatchFunc func(ctx context.Context, client *http.Client, req Request) (Result, error)
+
 func (f *HTTPFetcher) fetch(
 	ctx context.Context,
 	req Request,
-	dispatch func(ctx context.Context, client *http.Client, req Request) (Result, error),
+	dispatch dispatchFunc,
 ) (Result, error) {
 	var err error
 	if req, err = validateAndNormalizeRequest(req); err != nil {
@@ -368,7 +412,10 @@ func (f *HTTPFetcher) fetch(
 		return Result{}, err
 	}
 
-	if !result.Unchanged && req.ExpectedChecksum != "" && result.Checksum != req.ExpectedChecksum {
+	// OCI sources report the manifest digest as the checksum and verify ExpectedChecksum against
+	// the bundle layer themselves.
+	if !result.Unchanged && req.ExpectedChecksum != "" && req.OCI.Reference == "" &&
+		result.Checksum != req.ExpectedChecksum {
 		return Result{}, fmt.Errorf(
 			"bundle checksum mismatch: expected %s, got %s", req.ExpectedChecksum, result.Checksum,
 		)
@@ -379,6 +426,8 @@ func (f *HTTPFetcher) fetch(
 
 func (f *HTTPFetcher) dispatch(ctx context.Context, client *http.Client, req Request) (Result, error) {
 	switch {
+	case req.OCI.Reference != "":
+		return fetchOCI(ctx, client, req, f.logger)
 	case req.N1C.Namespace != "":
 		return fetchN1C(ctx, client, req, f.n1cCompilePollDelay, f.logger)
 	case req.PolicyName != "" || req.NIM.PolicyUID != "":
@@ -1389,6 +1438,7 @@ func buildNIMBundlesURL(baseURL, policyName, policyUID string, includeBundleCont
 // This is true for:
 //   - NIM policy bundles (metadata hash endpoint)
 //   - N1C policy and log-profile bundles (compile-status hash endpoint)
+//   - OCI policy bundles (manifest digest)
 //
 // NIM log profile bundles have no metadata-only endpoint and require a full download to compute a
 // checksum, so they return false.
@@ -1398,7 +1448,13 @@ func (r Request) SupportsChecksumOnlyFetch() bool {
 	if r.LogProfileName != "" && r.N1C.Namespace == "" {
 		return false
 	}
-	return r.N1C.Namespace != "" || r.PolicyName != "" || r.NIM.PolicyUID != ""
+	return r.N1C.Namespace != "" || r.PolicyName != "" || r.NIM.PolicyUID != "" || r.OCI.Reference != ""
+}
+
+// isPlainHTTP reports whether the request is a plain GET to r.URL rather than a NIM, N1C, or OCI fetch.
+func (r Request) isPlainHTTP() bool {
+	return r.N1C.Namespace == "" && r.PolicyName == "" && r.NIM.PolicyUID == "" &&
+		r.OCI.Reference == "" && r.LogProfileName == ""
 }
 
 // doGet performs a GET request and returns the response body.
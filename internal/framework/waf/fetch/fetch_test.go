@@ -349,6 +349,15 @@ func TestHTTPFetcherVerifyChecksumRejectedForNIMAndN1C(t *testing.T) {
 				VerifyChecksum: true,
 			},
 		},
+		{
+			name: "OCI",
+			req: fetch.Request{
+				OCI: fetch.OCIRequest{
+					Reference: "registry.example.com/waf/policy:v1",
+				},
+				VerifyChecksum: true,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1618,6 +1627,11 @@ func TestRequestSupportsChecksumOnlyFetch(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:     "OCI policy",
+			req:      fetch.Request{OCI: fetch.OCIRequest{Reference: "registry.example.com/waf/policy:v1"}},
+			expected: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,470 @@
+package fetch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// ociManifestAccept lists the manifest media types accepted when resolving an OCI reference.
+	ociManifestAccept = "application/vnd.oci.image.manifest.v1+json, " +
+		"application/vnd.docker.distribution.manifest.v2+json"
+	// headerDockerContentDigest is the registry response header carrying the digest of a manifest.
+	headerDockerContentDigest = "Docker-Content-Digest"
+	// headerWWWAuthenticate is the registry response header carrying the authentication challenge.
+	headerWWWAuthenticate = "WWW-Authenticate"
+	// ociTitleAnnotation is the annotation used by OCI artifact tools to record the file name of a layer.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	// sha256DigestPrefix is the algorithm prefix of a SHA-256 OCI digest.
+	sha256DigestPrefix = "sha256:"
+	// ociMaxManifestSize is the maximum size of an OCI manifest. Registries are not required to accept
+	// larger manifests.
+	ociMaxManifestSize = 4 * 1024 * 1024
+	// ociMaxBlobSize is the maximum size of the OCI layer holding a policy bundle.
+	ociMaxBlobSize = 1024 * 1024 * 1024
+)
+
+// ociDigestRegexp matches a SHA-256 OCI digest. Other digest algorithms are not supported.
+var ociDigestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ociReference is a parsed OCI artifact reference.
+type ociReference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+// manifestReference returns the tag or digest used to resolve the manifest. The digest takes precedence.
+func (r ociReference) manifestReference() string {
+	if r.digest != "" {
+		return r.digest
+	}
+	return r.tag
+}
+
+// ociManifest is the subset of an OCI image manifest needed to locate the bundle layer.
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// ociDescriptor is a single content descriptor of an OCI image manifest.
+type ociDescriptor struct {
+	Annotations map[string]string `json:"annotations"`
+	Digest      string            `json:"digest"`
+}
+
+// ociTokenResponse is the response of a registry token endpoint.
+// Registries return the token in either field.
+type ociTokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// parseOCIReference parses a reference of the form <registry>/<repository>[:<tag>][@<digest>].
+// The registry host is required; there is no default registry.
+func parseOCIReference(ref string) (ociReference, error) {
+	var parsed ociReference
+
+	name := ref
+	if before, digest, found := strings.Cut(ref, "@"); found {
+		if !ociDigestRegexp.MatchString(digest) {
+			return ociReference{}, fmt.Errorf("invalid OCI reference %q: unsupported digest %q", ref, digest)
+		}
+		name, parsed.digest = before, digest
+	}
+
+	registry, repository, found := strings.Cut(name, "/")
+	if !found || registry == "" || repository == "" {
+		return ociReference{}, fmt.Errorf("invalid OCI reference %q: must include the registry host", ref)
+	}
+
+	if idx := strings.LastIndex(repository, ":"); idx > strings.LastIndex(repository, "/") {
+		repository, parsed.tag = repository[:idx], repository[idx+1:]
+	}
+
+	if repository == "" {
+		return ociReference{}, fmt.Errorf("invalid OCI reference %q: missing repository", ref)
+	}
+	if parsed.tag == "" && parsed.digest == "" {
+		return ociReference{}, fmt.Errorf("invalid OCI reference %q: must include a tag or a digest", ref)
+	}
+
+	parsed.registry = registry
+	parsed.repository = repository
+
+	return parsed, nil
+}
+
+// ociSession performs requests against the registry of a single OCI reference.
+// It answers the first authentication challenge of the registry and reuses the resulting
+// Authorization header for all subsequent requests.
+type ociSession struct {
+	client        *http.Client
+	auth          *BundleAuth
+	authorization string
+	ref           ociReference
+	challenged    bool
+}
+
+func newOCISession(client *http.Client, req Request) (*ociSession, error) {
+	ref, err := parseOCIReference(req.OCI.Reference)
+	if err != nil {
+		return nil, &nonTransientError{err: err}
+	}
+
+	s := &ociSession{
+		client: client,
+		auth:   req.Auth,
+		ref:    ref,
+	}
+
+	// A token from the auth Secret is a pre-issued registry token and is sent as-is.
+	if req.Auth != nil && req.Auth.BearerToken != "" {
+		s.authorization = "Bearer " + req.Auth.BearerToken
+	}
+
+	return s, nil
+}
+
+// fetchOCI pulls the bundle referenced by req.OCI.Reference.
+// The manifest is resolved first, then the bundle layer is downloaded and verified against its digest.
+// The manifest digest is returned as the checksum, so that an unchanged tag is detected without
+// downloading the bundle again.
+func fetchOCI(ctx context.Context, client *http.Client, req Request, logger logr.Logger) (Result, error) {
+	s, err := newOCISession(client, req)
+	if err != nil {
+		return Result{}, err
+	}
+
+	manifestDigest, body, err := s.getManifest(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return Result{}, &nonTransientError{err: fmt.Errorf("failed to parse OCI manifest: %w", err)}
+	}
+
+	layer, err := bundleLayer(manifest)
+	if err != nil {
+		return Result{}, &nonTransientError{
+			err: fmt.Errorf("invalid OCI artifact %q: %w", req.OCI.Reference, err),
+		}
+	}
+
+	data, err := s.getBlob(ctx, layer.Digest)
+	if err != nil {
+		return Result{}, err
+	}
+
+	bundleChecksum := ComputeChecksum(data)
+	if req.ExpectedChecksum != "" && bundleChecksum != req.ExpectedChecksum {
+		return Result{}, &nonTransientError{
+			err: fmt.Errorf("bundle checksum mismatch: expected %s, got %s", req.ExpectedChecksum, bundleChecksum),
+		}
+	}
+
+	logger.V(1).Info(
+		"Fetched OCI policy bundle",
+		"reference", req.OCI.Reference,
+		"manifestDigest", manifestDigest,
+		"layerDigest", layer.Digest,
+		"size", len(data),
+	)
+
+	return Result{Data: data, Checksum: manifestDigest}, nil
+}
+
+// fetchOCIChecksum returns the manifest digest of req.OCI.Reference without downloading the bundle.
+// A reference pinned to a digest is immutable, so its digest is returned without contacting the registry.
+func fetchOCIChecksum(ctx context.Context, client *http.Client, req Request) (string, error) {
+	s, err := newOCISession(client, req)
+	if err != nil {
+		return "", err
+	}
+
+	if s.ref.digest != "" {
+		return s.ref.digest, nil
+	}
+
+	resp, err := s.do(ctx, http.MethodHead, "/manifests/"+s.ref.tag, ociManifestAccept)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve OCI manifest: %w", err)
+	}
+	resp.Body.Close()
+
+	if digest := resp.Header.Get(headerDockerContentDigest); digest != "" {
+		return digest, nil
+	}
+
+	// Not all registries return the digest header; fall back to computing it from the manifest.
+	digest, _, err := s.getManifest(ctx)
+	return digest, err
+}
+
+// getManifest downloads the manifest of the reference and returns its digest and content.
+// For references pinned to a digest, the content is verified against the digest.
+func (s *ociSession) getManifest(ctx context.Context) (string, []byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, "/manifests/"+s.ref.manifestReference(), ociManifestAccept)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch OCI manifest: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := readAllLimited(resp.Body, ociMaxManifestSize)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read OCI manifest: %w", err)
+	}
+
+	computed := sha256DigestPrefix + ComputeChecksum(body)
+	if s.ref.digest != "" && computed != s.ref.digest {
+		return "", nil, &nonTransientError{
+			err: fmt.Errorf("OCI manifest digest mismatch: expected %s, got %s", s.ref.digest, computed),
+		}
+	}
+
+	digest := resp.Header.Get(headerDockerContentDigest)
+	if digest == "" {
+		digest = computed
+	}
+
+	return digest, body, nil
+}
+
+// getBlob downloads the blob with the given digest and verifies its content against the digest.
+func (s *ociSession) getBlob(ctx context.Context, digest string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, "/blobs/"+digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OCI bundle layer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := readAllLimited(resp.Body, ociMaxBlobSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI bundle layer: %w", err)
+	}
+
+	if computed := sha256DigestPrefix + ComputeChecksum(data); computed != digest {
+		return nil, &nonTransientError{
+			err: fmt.Errorf("OCI bundle layer digest mismatch: expected %s, got %s", digest, computed),
+		}
+	}
+
+	return data, nil
+}
+
+// readAllLimited reads from r until EOF and returns the data. It fails with a non-transient error
+// if the data is larger than limit bytes, without reading more than limit+1 bytes.
+func readAllLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, &nonTransientError{err: fmt.Errorf("size exceeds the maximum of %d bytes", limit)}
+	}
+
+	return data, nil
+}
+
+// do sends a request for the given path of the repository API and returns the response on 200 OK.
+// The caller must close the response body. On the first 401 response the authentication challenge
+// is answered and the request is retried once.
+func (s *ociSession) do(ctx context.Context, method, path, accept string) (*http.Response, error) {
+	rawURL := (&url.URL{
+		Scheme: "https",
+		Host:   s.ref.registry,
+		Path:   "/v2/" + s.ref.repository + path,
+	}).String()
+
+	resp, err := s.send(ctx, method, rawURL, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && !s.challenged && s.authorization == "" {
+		s.challenged = true
+		challenge := resp.Header.Get(headerWWWAuthenticate)
+		resp.Body.Close()
+
+		if err := s.authorize(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		if resp, err = s.send(ctx, method, rawURL, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err := fmt.Errorf("unexpected status %d from %s", resp.StatusCode, rawURL)
+		if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError {
+			return nil, &nonTransientError{err: err}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ociSession) send(ctx context.Context, method, rawURL, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", rawURL, err)
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if s.authorization != "" {
+		req.Header.Set("Authorization", s.authorization)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", rawURL, err)
+	}
+
+	return resp, nil
+}
+
+// authorize answers a registry authentication challenge.
+// For the Basic scheme the username and password are used directly. For the Bearer scheme a token
+// with pull access to the repository is requested from the realm of the challenge, using the
+// username and password when set and anonymous access otherwise.
+func (s *ociSession) authorize(ctx context.Context, challenge string) error {
+	scheme, rawParams, _ := strings.Cut(challenge, " ")
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if s.auth == nil || s.auth.Username == "" {
+			return &nonTransientError{err: fmt.Errorf("registry %s requires credentials", s.ref.registry)}
+		}
+		credentials := base64.StdEncoding.EncodeToString([]byte(s.auth.Username + ":" + s.auth.Password))
+		s.authorization = "Basic " + credentials
+	case "bearer":
+		token, err := s.requestToken(ctx, parseChallengeParams(rawParams))
+		if err != nil {
+			return err
+		}
+		s.authorization = "Bearer " + token
+	default:
+		return &nonTransientError{
+			err: fmt.Errorf("registry %s returned unsupported authentication challenge %q", s.ref.registry, challenge),
+		}
+	}
+
+	return nil
+}
+
+func (s *ociSession) requestToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", &nonTransientError{
+			err: fmt.Errorf("registry %s returned invalid token realm %q", s.ref.registry, params["realm"]),
+		}
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + s.ref.repository + ":pull"
+	}
+
+	q := realm.Query()
+	q.Set("scope", scope)
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	realm.RawQuery = q.Encode()
+
+	var auth *BundleAuth
+	if s.auth != nil && s.auth.Username != "" {
+		auth = &BundleAuth{Username: s.auth.Username, Password: s.auth.Password}
+	}
+
+	body, err := doGet(ctx, s.client, realm.String(), auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to request registry token: %w", err)
+	}
+
+	var resp ociTokenResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("failed to parse registry token response: %w", err)
+	}
+
+	token := resp.Token
+	if token == "" {
+		token = resp.AccessToken
+	}
+	if token == "" {
+		return "", &nonTransientError{err: fmt.Errorf("registry token response contains no token")}
+	}
+
+	return token, nil
+}
+
+// parseChallengeParams parses the comma-separated key="value" parameters of a WWW-Authenticate challenge.
+// Quoted values may contain commas, e.g. scope="repository:a:pull,push".
+func parseChallengeParams(raw string) map[string]string {
+	params := make(map[string]string)
+
+	for raw = strings.TrimSpace(raw); raw != ""; raw = strings.TrimSpace(raw) {
+		key, rest, found := strings.Cut(raw, "=")
+		if !found {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				params[key] = rest[1:]
+				break
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		params[key] = strings.TrimSpace(value)
+		raw = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+
+	return params
+}
+
+// bundleLayer returns the layer of the manifest holding the policy bundle: the only layer, or
+// otherwise the layer whose title annotation names a .tgz file.
+func bundleLayer(manifest ociManifest) (ociDescriptor, error) {
+	switch len(manifest.Layers) {
+	case 0:
+		return ociDescriptor{}, fmt.Errorf("manifest has no layers")
+	case 1:
+		return manifest.Layers[0], nil
+	}
+
+	for _, layer := range manifest.Layers {
+		if strings.HasSuffix(layer.Annotations[ociTitleAnnotation], ".tgz") {
+			return layer, nil
+		}
+	}
+
+	return ociDescriptor{}, fmt.Errorf(
+		"manifest has %d layers and none is annotated with a %q ending in .tgz",
+		len(manifest.Layers), ociTitleAnnotation,
+	)
+}
@@ -0,0 +1,351 @@
+package fetch_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
+)
+
+const (
+	ociTestRepository = "waf/policy"
+	ociTestTag        = "v1"
+	ociTestToken      = "registry-token"
+)
+
+// ociRegistry is a fake OCI registry serving a single artifact with one bundle layer.
+type ociRegistry struct {
+	// auth, when set, requires requests to be authorized: a pre-issued token is accepted as a Bearer token,
+	// and a username and password are exchanged for ociTestToken at the token endpoint.
+	auth *fetch.BundleAuth
+	// servedBlob, when set, is served instead of the bundle, to simulate a corrupted layer.
+	servedBlob       []byte
+	manifest         []byte
+	bundle           []byte
+	manifestDigest   string
+	bundleDigest     string
+	manifestRequests atomic.Int32
+	blobRequests     atomic.Int32
+	omitDigestHeader bool
+}
+
+func newOCIRegistry(bundle []byte, layers int) *ociRegistry {
+	reg := &ociRegistry{
+		bundle:       bundle,
+		bundleDigest: "sha256:" + fetch.ComputeChecksum(bundle),
+	}
+
+	layerList := make([]map[string]any, 0, layers)
+	for i := range layers {
+		layerList = append(layerList, map[string]any{
+			"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip",
+			"digest":    reg.bundleDigest,
+			"size":      len(bundle),
+			"annotations": map[string]string{
+				"org.opencontainers.image.title": fmt.Sprintf("file-%d.txt", i),
+			},
+		})
+	}
+
+	reg.manifest, _ = json.Marshal(map[string]any{ //nolint:errchkjson
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers":        layerList,
+	})
+	reg.manifestDigest = "sha256:" + fetch.ComputeChecksum(reg.manifest)
+
+	return reg
+}
+
+// start starts a TLS server for the registry and returns the reference of the artifact with the given tag
+// or digest suffix (e.g. ":v1").
+func (reg *ociRegistry) start(t *testing.T) (*httptest.Server, func(suffix string) string) {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(nil)
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			u, p, ok := r.BasicAuth()
+			if reg.auth == nil || !ok || u != reg.auth.Username || p != reg.auth.Password {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"token": ociTestToken}) //nolint:errcheck,errchkjson
+			return
+		}
+
+		if !reg.authorized(r) {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				`Bearer realm="%s/token",service="registry.test",scope="repository:%s:pull"`,
+				srv.URL, ociTestRepository,
+			))
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		repoPrefix := "/v2/" + ociTestRepository
+		switch {
+		case strings.HasPrefix(r.URL.Path, repoPrefix+"/manifests/"):
+			reg.manifestRequests.Add(1)
+			ref := strings.TrimPrefix(r.URL.Path, repoPrefix+"/manifests/")
+			if ref != ociTestTag && ref != reg.manifestDigest {
+				http.Error(w, "manifest unknown", http.StatusNotFound)
+				return
+			}
+			if !reg.omitDigestHeader {
+				w.Header().Set("Docker-Content-Digest", reg.manifestDigest)
+			}
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			if r.Method == http.MethodHead {
+				return
+			}
+			w.Write(reg.manifest) //nolint:errcheck
+		case r.URL.Path == repoPrefix+"/blobs/"+reg.bundleDigest:
+			reg.blobRequests.Add(1)
+			if reg.servedBlob != nil {
+				w.Write(reg.servedBlob) //nolint:errcheck
+				return
+			}
+			w.Write(reg.bundle) //nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	host := strings.TrimPrefix(srv.URL, "https://")
+	return srv, func(suffix string) string {
+		return host + "/" + ociTestRepository + suffix
+	}
+}
+
+func (reg *ociRegistry) authorized(r *http.Request) bool {
+	if reg.auth == nil {
+		return true
+	}
+
+	authz := r.Header.Get("Authorization")
+	if reg.auth.BearerToken != "" {
+		return authz == "Bearer "+reg.auth.BearerToken
+	}
+
+	return authz == "Bearer "+ociTestToken
+}
+
+func TestHTTPFetcherFetchOCI(t *testing.T) {
+	t.Parallel()
+
+	bundle := []byte("oci-bundle-content")
+
+	tests := []struct {
+		registryAuth *fetch.BundleAuth
+		requestAuth  *fetch.BundleAuth
+		name         string
+		suffix       func(reg *ociRegistry) string
+	}{
+		{
+			name:   "anonymous pull by tag",
+			suffix: func(*ociRegistry) string { return ":" + ociTestTag },
+		},
+		{
+			name:   "anonymous pull by digest",
+			suffix: func(reg *ociRegistry) string { return "@" + reg.manifestDigest },
+		},
+		{
+			name:         "username and password are exchanged for a token",
+			registryAuth: &fetch.BundleAuth{Username: "user", Password: "pass"},
+			requestAuth:  &fetch.BundleAuth{Username: "user", Password: "pass"},
+			suffix:       func(*ociRegistry) string { return ":" + ociTestTag },
+		},
+		{
+			name:         "pre-issued token is sent as Bearer token",
+			registryAuth: &fetch.BundleAuth{BearerToken: "pre-issued"},
+			requestAuth:  &fetch.BundleAuth{BearerToken: "pre-issued"},
+			suffix:       func(*ociRegistry) string { return ":" + ociTestTag },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			reg := newOCIRegistry(bundle, 1)
+			reg.auth = tc.registryAuth
+			_, reference := reg.start(t)
+
+			f := fetch.NewHTTPFetcher(logr.Discard())
+			result, err := f.FetchPolicyBundle(t.Context(), fetch.Request{
+				OCI:                fetch.OCIRequest{Reference: reference(tc.suffix(reg))},
+				Auth:               tc.requestAuth,
+				InsecureSkipVerify: true,
+				ExpectedChecksum:   fetch.ComputeChecksum(bundle),
+			})
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Data).To(Equal(bundle))
+			g.Expect(result.Checksum).To(Equal(reg.manifestDigest))
+		})
+	}
+}
+
+func TestHTTPFetcherFetchOCIErrors(t *testing.T) {
+	t.Parallel()
+
+	bundle := []byte("oci-bundle-content")
+
+	tests := []struct {
+		setup     func(reg *ociRegistry, req *fetch.Request)
+		name      string
+		expectErr string
+		layers    int
+	}{
+		{
+			name:      "reference without registry host",
+			layers:    1,
+			setup:     func(_ *ociRegistry, req *fetch.Request) { req.OCI.Reference = "policy:v1" },
+			expectErr: "must include the registry host",
+		},
+		{
+			name:   "reference without tag or digest",
+			layers: 1,
+			setup: func(_ *ociRegistry, req *fetch.Request) {
+				req.OCI.Reference = strings.TrimSuffix(req.OCI.Reference, ":"+ociTestTag)
+			},
+			expectErr: "must include a tag or a digest",
+		},
+		{
+			name:   "unknown tag",
+			layers: 1,
+			setup: func(_ *ociRegistry, req *fetch.Request) {
+				req.OCI.Reference = strings.TrimSuffix(req.OCI.Reference, ociTestTag) + "v2"
+			},
+			expectErr: "unexpected status 404",
+		},
+		{
+			name:   "invalid credentials",
+			layers: 1,
+			setup: func(reg *ociRegistry, req *fetch.Request) {
+				reg.auth = &fetch.BundleAuth{Username: "user", Password: "pass"}
+				req.Auth = &fetch.BundleAuth{Username: "user", Password: "wrong"}
+			},
+			expectErr: "failed to request registry token",
+		},
+		{
+			name:   "oversized manifest",
+			layers: 1,
+			setup: func(reg *ociRegistry, _ *fetch.Request) {
+				reg.manifest = []byte(strings.Repeat(" ", 4*1024*1024+1))
+			},
+			expectErr: "size exceeds the maximum of 4194304 bytes",
+		},
+		{
+			name:   "corrupted bundle layer",
+			layers: 1,
+			setup: func(reg *ociRegistry, _ *fetch.Request) {
+				reg.servedBlob = []byte("tampered")
+			},
+			expectErr: "OCI bundle layer digest mismatch",
+		},
+		{
+			name:   "expected checksum mismatch",
+			layers: 1,
+			setup: func(_ *ociRegistry, req *fetch.Request) {
+				req.ExpectedChecksum = strings.Repeat("a", 64)
+			},
+			expectErr: "bundle checksum mismatch",
+		},
+		{
+			name:      "multiple layers without a bundle title",
+			layers:    2,
+			setup:     func(*ociRegistry, *fetch.Request) {},
+			expectErr: "none is annotated",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			reg := newOCIRegistry(bundle, tc.layers)
+			_, reference := reg.start(t)
+
+			req := fetch.Request{
+				OCI:                fetch.OCIRequest{Reference: reference(":" + ociTestTag)},
+				InsecureSkipVerify: true,
+				// Non-transient errors must not be retried.
+				RetryAttempts: 3,
+			}
+			tc.setup(reg, &req)
+
+			f := fetch.NewHTTPFetcher(logr.Discard())
+			_, err := f.FetchPolicyBundle(t.Context(), req)
+
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.expectErr))
+			g.Expect(reg.manifestRequests.Load()).To(BeNumerically("<=", 1))
+		})
+	}
+}
+
+func TestFetchPolicyBundleChecksumOCI(t *testing.T) {
+	t.Parallel()
+
+	bundle := []byte("oci-bundle-content")
+
+	tests := []struct {
+		name                string
+		suffix              func(reg *ociRegistry) string
+		omitDigestHeader    bool
+		expManifestRequests int32
+	}{
+		{
+			name:                "tag is resolved with a HEAD request",
+			suffix:              func(*ociRegistry) string { return ":" + ociTestTag },
+			expManifestRequests: 1,
+		},
+		{
+			name:                "digest is computed from the manifest when the registry omits the header",
+			suffix:              func(*ociRegistry) string { return ":" + ociTestTag },
+			omitDigestHeader:    true,
+			expManifestRequests: 2,
+		},
+		{
+			name:                "digest reference is returned without contacting the registry",
+			suffix:              func(reg *ociRegistry) string { return "@" + reg.manifestDigest },
+			expManifestRequests: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			reg := newOCIRegistry(bundle, 1)
+			reg.omitDigestHeader = tc.omitDigestHeader
+			_, reference := reg.start(t)
+
+			f := fetch.NewHTTPFetcher(logr.Discard())
+			checksum, err := f.FetchPolicyBundleChecksum(t.Context(), fetch.Request{
+				OCI:                fetch.OCIRequest{Reference: reference(tc.suffix(reg))},
+				InsecureSkipVerify: true,
+			})
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(checksum).To(Equal(reg.manifestDigest))
+			g.Expect(reg.manifestRequests.Load()).To(Equal(tc.expManifestRequests))
+			g.Expect(reg.blobRequests.Load()).To(BeZero())
+		})
+	}
+}
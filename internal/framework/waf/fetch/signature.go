@@ -0,0 +1,190 @@
+package fetch
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SignatureError is returned by VerifyBundleSignature when a bundle cannot be verified against its
+// detached signature. A bundle that fails verification must not be deployed.
+type SignatureError struct {
+	err error
+}
+
+func (e *SignatureError) Error() string { return "signature verification failed: " + e.err.Error() }
+func (e *SignatureError) Unwrap() error { return e.err }
+
+// withSignature wraps dispatch so that the detached signature of the bundle is downloaded together with
+// the bundle when req.Signature.URL is set. The signature is not downloaded when the bundle is unchanged.
+func withSignature(dispatch dispatchFunc) dispatchFunc {
+	return func(ctx context.Context, client *http.Client, req Request) (Result, error) {
+		result, err := dispatch(ctx, client, req)
+		if err != nil || result.Unchanged || req.Signature.URL == "" {
+			return result, err
+		}
+
+		sig, err := doGet(ctx, client, req.Signature.URL, signatureAuth(req))
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to fetch bundle signature: %w", err)
+		}
+		result.Signature = sig
+
+		return result, nil
+	}
+}
+
+// signatureAuth returns the credentials to send with the signature request. The bundle credentials are
+// only sent when the signature is served from the same host as the bundle, so that they are not leaked to
+// a different server.
+func signatureAuth(req Request) *BundleAuth {
+	if req.Auth == nil || req.URL == "" {
+		return nil
+	}
+
+	bundleURL, err := url.Parse(req.URL)
+	if err != nil {
+		return nil
+	}
+	sigURL, err := url.Parse(req.Signature.URL)
+	if err != nil {
+		return nil
+	}
+
+	if !strings.EqualFold(bundleURL.Host, sigURL.Host) {
+		return nil
+	}
+
+	return req.Auth
+}
+
+// VerifyBundleSignature verifies result.Data against result.Signature using the verification key of
+// req.Signature. It returns nil when req does not configure signature verification, and a *SignatureError
+// when the bundle does not match its signature.
+func VerifyBundleSignature(req Request, result Result) error {
+	if len(req.Signature.PublicKey) == 0 {
+		return nil
+	}
+
+	key, err := ParseVerificationKey(req.Signature.PublicKey)
+	if err != nil {
+		return &SignatureError{err: err}
+	}
+
+	if len(result.Signature) == 0 {
+		return &SignatureError{err: errors.New("signature is empty")}
+	}
+
+	if verifySignature(key, result.Data, result.Signature) {
+		return nil
+	}
+
+	// Signature files commonly hold the base64 encoding of the signature.
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(result.Signature)))
+	if err == nil && verifySignature(key, result.Data, decoded) {
+		return nil
+	}
+
+	return &SignatureError{err: errors.New("signature does not match the bundle")}
+}
+
+// verifySignature reports whether sig is a valid signature of data for key.
+func verifySignature(key crypto.PublicKey, data, sig []byte) bool {
+	digest := sha256.Sum256(data)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil {
+			return true
+		}
+		return rsa.VerifyPSS(k, crypto.SHA256, digest[:], sig, nil) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, data, sig)
+	default:
+		return false
+	}
+}
+
+// ParseVerificationKey parses a PEM-encoded public key or certificate chain and returns the signature
+// verification key. For a certificate chain, the key of the first certificate is returned after verifying
+// that the certificate chains up to the last certificate, which is used as the root of trust.
+// A single certificate is trusted as is.
+func ParseVerificationKey(data []byte) (crypto.PublicKey, error) {
+	var certs []*x509.Certificate
+
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key: %w", err)
+			}
+			return checkKeyType(key)
+		case "RSA PUBLIC KEY":
+			key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+			}
+			return key, nil
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+			certs = append(certs, cert)
+		}
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded public key or certificate found")
+	}
+
+	if len(certs) > 1 {
+		roots := x509.NewCertPool()
+		roots.AddCert(certs[len(certs)-1])
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1 : len(certs)-1] {
+			intermediates.AddCert(cert)
+		}
+
+		if _, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to verify certificate chain: %w", err)
+		}
+	}
+
+	return checkKeyType(certs[0].PublicKey)
+}
+
+// checkKeyType returns key if it is of a type supported for signature verification.
+func checkKeyType(key crypto.PublicKey) (crypto.PublicKey, error) {
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -0,0 +1,334 @@
+package fetch_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
+)
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// newCertificate creates a certificate for key, signed by parent and parentKey.
+// When parent is nil, the certificate is self-signed.
+func newCertificate(
+	t *testing.T,
+	cn string,
+	key *ecdsa.PrivateKey,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, []byte) {
+	t.Helper()
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestVerifyBundleSignature(t *testing.T) {
+	t.Parallel()
+
+	bundle := []byte("signed-bundle-content")
+	digest := sha256.Sum256(bundle)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPSSSig, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, digest[:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPKCS1PEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey),
+	})
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edSig := ed25519.Sign(edKey, bundle)
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCert, rootPEM := newCertificate(t, "root", rootKey, nil, nil)
+	_, leafPEM := newCertificate(t, "leaf", ecKey, rootCert, rootKey)
+
+	otherRootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherRootPEM := newCertificate(t, "other-root", otherRootKey, nil, nil)
+
+	tests := []struct {
+		name      string
+		expectErr string
+		key       []byte
+		signature []byte
+	}{
+		{
+			name:      "ECDSA public key",
+			key:       publicKeyPEM(t, &ecKey.PublicKey),
+			signature: ecSig,
+		},
+		{
+			name:      "RSA PKCS #1 v1.5 signature",
+			key:       publicKeyPEM(t, &rsaKey.PublicKey),
+			signature: rsaSig,
+		},
+		{
+			name:      "RSA PSS signature with PKCS #1 public key",
+			key:       rsaPKCS1PEM,
+			signature: rsaPSSSig,
+		},
+		{
+			name:      "Ed25519 public key",
+			key:       publicKeyPEM(t, edKey.Public()),
+			signature: edSig,
+		},
+		{
+			name:      "base64-encoded signature",
+			key:       publicKeyPEM(t, edKey.Public()),
+			signature: []byte(base64.StdEncoding.EncodeToString(edSig) + "\n"),
+		},
+		{
+			name:      "certificate chain",
+			key:       append(append([]byte{}, leafPEM...), rootPEM...),
+			signature: ecSig,
+		},
+		{
+			name:      "single certificate",
+			key:       leafPEM,
+			signature: ecSig,
+		},
+		{
+			name:      "signature of a different bundle",
+			key:       publicKeyPEM(t, edKey.Public()),
+			signature: ed25519.Sign(edKey, []byte("other-bundle")),
+			expectErr: "signature does not match the bundle",
+		},
+		{
+			name:      "signature made with a different key",
+			key:       publicKeyPEM(t, &rsaKey.PublicKey),
+			signature: ecSig,
+			expectErr: "signature does not match the bundle",
+		},
+		{
+			name:      "empty signature",
+			key:       publicKeyPEM(t, &ecKey.PublicKey),
+			expectErr: "signature is empty",
+		},
+		{
+			name:      "certificate chain with untrusted root",
+			key:       append(append([]byte{}, leafPEM...), otherRootPEM...),
+			signature: ecSig,
+			expectErr: "failed to verify certificate chain",
+		},
+		{
+			name:      "invalid key",
+			key:       []byte("not-a-key"),
+			signature: ecSig,
+			expectErr: "no PEM-encoded public key or certificate found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := fetch.VerifyBundleSignature(
+				fetch.Request{Signature: fetch.SignatureRequest{PublicKey: tc.key}},
+				fetch.Result{Data: bundle, Signature: tc.signature},
+			)
+
+			if tc.expectErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.expectErr))
+
+			var sigErr *fetch.SignatureError
+			g.Expect(errors.As(err, &sigErr)).To(BeTrue())
+		})
+	}
+}
+
+func TestVerifyBundleSignatureNotConfigured(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	err := fetch.VerifyBundleSignature(fetch.Request{}, fetch.Result{Data: []byte("bundle")})
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestHTTPFetcherFetchSignature(t *testing.T) {
+	t.Parallel()
+
+	bundle := []byte("signed-bundle-content")
+	signature := []byte("bundle-signature")
+	auth := &fetch.BundleAuth{BearerToken: "my-token"}
+
+	bundleSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+auth.BearerToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/bundle.tgz":
+			w.Write(bundle) //nolint:errcheck
+		case "/bundle.tgz.sig", "/custom.sig":
+			w.Write(signature) //nolint:errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(bundleSrv.Close)
+
+	// The signature server rejects authenticated requests to check that credentials are not leaked.
+	sigSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			http.Error(w, "unexpected credentials", http.StatusBadRequest)
+			return
+		}
+		w.Write(signature) //nolint:errcheck
+	}))
+	t.Cleanup(sigSrv.Close)
+
+	tests := []struct {
+		name         string
+		expectErr    string
+		signatureURL string
+		expSignature []byte
+		publicKey    []byte
+	}{
+		{
+			name: "no signature is fetched when no key is configured",
+		},
+		{
+			name:         "default sidecar signature URL",
+			publicKey:    []byte("key"),
+			expSignature: signature,
+		},
+		{
+			name:         "custom signature URL on the same host",
+			publicKey:    []byte("key"),
+			signatureURL: bundleSrv.URL + "/custom.sig",
+			expSignature: signature,
+		},
+		{
+			name:         "signature on a different host is fetched without credentials",
+			publicKey:    []byte("key"),
+			signatureURL: sigSrv.URL + "/bundle.sig",
+			expSignature: signature,
+		},
+		{
+			name:         "missing signature",
+			publicKey:    []byte("key"),
+			signatureURL: bundleSrv.URL + "/missing.sig",
+			expectErr:    "failed to fetch bundle signature",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			f := fetch.NewHTTPFetcher(logr.Discard())
+			result, err := f.FetchPolicyBundle(t.Context(), fetch.Request{
+				URL:       bundleSrv.URL + "/bundle.tgz",
+				Auth:      auth,
+				Signature: fetch.SignatureRequest{URL: tc.signatureURL, PublicKey: tc.publicKey},
+			})
+
+			if tc.expectErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectErr))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.Data).To(Equal(bundle))
+			g.Expect(result.Signature).To(Equal(tc.expSignature))
+		})
+	}
+}
+
+func TestHTTPFetcherSignatureURLRequiredForManagedSources(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	f := fetch.NewHTTPFetcher(logr.Discard())
+	_, err := f.FetchPolicyBundle(t.Context(), fetch.Request{
+		OCI:       fetch.OCIRequest{Reference: "registry.example.com/waf/policy:v1"},
+		Signature: fetch.SignatureRequest{PublicKey: []byte("key")},
+	})
+
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("a signature URL is required"))
+}
@@ -2,6 +2,7 @@ package poller
 
 import (
 	"context"
+	"errors"
 	"maps"
 	"sync"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/bundlecache"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
 )
 
@@ -28,7 +30,8 @@ type PollError struct {
 }
 
 // BundleUpdate records the most recent poll cycle in which a changed bundle was detected and
-// dispatched to target deployments. It does not confirm that any deployment applied the update.
+// dispatched to target deployments. It does not confirm that any deployment applied the update,
+// unless the bundle was deployed with the Canary rollout strategy.
 type BundleUpdate struct {
 	UpdatedAt metav1.Time
 	// BundleKey is the internal identifier of the bundle that was updated.
@@ -52,7 +55,16 @@ type Manager interface {
 	// GetLatestBundles returns a copy of all bundles that have been successfully fetched by pollers.
 	// These represent the freshest known bundle data and should take precedence over
 	// graph-cached bundles when constructing stale-bundle fallback state.
+	// Until PersistBundles is first called, it also returns the bundles restored from the bundle cache.
+	// For a bundle that was rolled back, it returns the last good bundle with RejectedChecksum set.
 	GetLatestBundles() map[graph.WAFBundleKey]*graph.WAFBundleData
+	// PersistBundles saves the given graph bundles, together with the bundles fetched by pollers, to the
+	// bundle cache and removes all other bundles from it. It does nothing if no bundle cache is configured.
+	// Bundles are only written once EnableBundleCache has been called.
+	PersistBundles(bundles map[graph.WAFBundleKey]*graph.WAFBundleData)
+	// EnableBundleCache is called when the Pod becomes leader. Until then, bundles are restored from the
+	// bundle cache but never written to it, since the bundle cache may be shared by all replicas.
+	EnableBundleCache(ctx context.Context)
 	// StopPoller stops the poller for a WAFPolicy.
 	StopPoller(policyNsName types.NamespacedName)
 	// StopPollersNotIn stops all pollers whose policy namespace/name is not in the given set.
@@ -70,6 +82,23 @@ type pollerManager struct {
 	pollErrors    map[types.NamespacedName]*PollError
 	bundleUpdates map[types.NamespacedName]BundleUpdate
 	bundleCache   map[graph.WAFBundleKey]*graph.WAFBundleData
+	// initialBundles holds the bundles each poller was started with. Together with bundleCache, it is
+	// used to find the last good bundle that a failed canary rollout is reverted to.
+	initialBundles map[graph.WAFBundleKey]*graph.WAFBundleData
+	// store persists bundles across controller restarts. It is nil if no bundle cache is configured.
+	store bundlecache.Store
+	// restoredBundles holds the keys of the bundles in bundleCache that were restored from the store on
+	// startup rather than fetched by a poller.
+	restoredBundles map[graph.WAFBundleKey]struct{}
+	// rejectedChecksums maps each bundle key to the checksum of a bundle that failed to apply during a canary
+	// rollout and was rolled back. An entry is removed once a newer bundle is deployed.
+	rejectedChecksums map[graph.WAFBundleKey]string
+	// persistedChecksums maps each bundle key in the store to the checksum of the persisted bundle.
+	// Guarded by storeMu.
+	persistedChecksums map[graph.WAFBundleKey]string
+	// storeEnabled is set when the Pod becomes leader. Only the leader writes to the store.
+	// Guarded by storeMu.
+	storeEnabled bool
 	// bundleKeyToPolicy maps each bundle key to the policy that owns it.
 	// Used to look up the policy namespace/name when injecting a WAFBundleReconcileEvent.
 	bundleKeyToPolicy map[graph.WAFBundleKey]types.NamespacedName
@@ -86,6 +115,8 @@ type pollerManager struct {
 	ctx    context.Context
 	logger logr.Logger
 	mu     sync.RWMutex
+	// storeMu serializes writes to the store. When both locks are needed, storeMu is acquired before mu.
+	storeMu sync.Mutex
 }
 
 // pollerEntry holds a poller and its cancellation function.
@@ -100,6 +131,10 @@ type ManagerConfig struct {
 	Deployments    agent.DeploymentStorer
 	StatusCallback func(targets []types.NamespacedName)
 	EventCh        chan<- any
+	// BundleCache persists bundles across controller restarts. Optional.
+	// The manager is warmed from it on creation, so that gateways can be configured with the cached
+	// bundles when the bundle sources are unavailable after a restart.
+	BundleCache bundlecache.Store
 	// Ctx is the root context for the manager lifetime.
 	// It is used to cancel goroutines that inject events into the event loop on shutdown.
 	Ctx    context.Context
@@ -113,7 +148,7 @@ func NewManager(cfg ManagerConfig) Manager {
 	if cfg.EventCh != nil && cfg.Ctx == nil {
 		panic("waf.ManagerConfig: Ctx must be set when EventCh is set")
 	}
-	return &pollerManager{
+	m := &pollerManager{
 		logger:                 cfg.Logger,
 		fetcher:                cfg.Fetcher,
 		deployments:            cfg.Deployments,
@@ -121,17 +156,52 @@ func NewManager(cfg ManagerConfig) Manager {
 		pollErrors:             make(map[types.NamespacedName]*PollError),
 		bundleUpdates:          make(map[types.NamespacedName]BundleUpdate),
 		bundleCache:            make(map[graph.WAFBundleKey]*graph.WAFBundleData),
+		initialBundles:         make(map[graph.WAFBundleKey]*graph.WAFBundleData),
+		store:                  cfg.BundleCache,
+		restoredBundles:        make(map[graph.WAFBundleKey]struct{}),
+		rejectedChecksums:      make(map[graph.WAFBundleKey]string),
+		persistedChecksums:     make(map[graph.WAFBundleKey]string),
 		bundleKeyToPolicy:      make(map[graph.WAFBundleKey]types.NamespacedName),
 		bundleKeyToDescription: make(map[graph.WAFBundleKey]string),
 		statusCallback:         cfg.StatusCallback,
 		eventCh:                cfg.EventCh,
 		ctx:                    cfg.Ctx,
 	}
+
+	m.restoreBundles()
+
+	return m
+}
+
+// restoreBundles warms the bundle cache with the bundles persisted in the store.
+func (m *pollerManager) restoreBundles() {
+	if m.store == nil {
+		return
+	}
+
+	// Bundles that fail verification are reported in err, while the remaining bundles are still restored.
+	bundles, err := m.store.Load()
+	if err != nil {
+		m.logger.Error(err, "Failed to load cached WAF bundles")
+	}
+
+	for key, bundle := range bundles {
+		m.bundleCache[key] = bundle
+		m.restoredBundles[key] = struct{}{}
+		m.persistedChecksums[key] = bundle.Checksum
+	}
+
+	if len(bundles) > 0 {
+		m.logger.Info("Restored cached WAF bundles", "count", len(bundles))
+	}
 }
 
 // Config contains configuration for reconciling a poller.
 type Config struct {
-	InitialChecksums  map[graph.WAFBundleKey]string
+	InitialChecksums map[graph.WAFBundleKey]string
+	// InitialBundles are the bundles currently deployed for the policy. A failed canary rollout is
+	// reverted to them until the poller deploys a newer bundle.
+	InitialBundles    map[graph.WAFBundleKey]*graph.WAFBundleData
 	PolicyNsName      types.NamespacedName
 	Sources           []BundleSource
 	TargetDeployments []types.NamespacedName
@@ -210,6 +280,9 @@ func (m *pollerManager) startPoller(ctx context.Context, cfg Config) {
 			desc = "WAF bundle"
 		}
 		m.bundleKeyToDescription[src.BundleKey] = desc
+		if bundle := cfg.InitialBundles[src.BundleKey]; bundle != nil {
+			m.initialBundles[src.BundleKey] = bundle
+		}
 	}
 
 	poller = newPoller(pollerConfig{
@@ -222,6 +295,7 @@ func (m *pollerManager) startPoller(ctx context.Context, cfg Config) {
 		initialChecksums:     cfg.InitialChecksums,
 		statusCallback:       wrappedCallback,
 		bundleUpdateCallback: m.cacheBundleUpdate,
+		lastGoodBundle:       m.lastGoodBundle,
 	})
 
 	m.pollers[cfg.PolicyNsName] = &pollerEntry{
@@ -276,6 +350,11 @@ func (m *pollerManager) recordPollResult(
 			BundleDescription: bundleDescription,
 			Err:               err,
 		}
+
+		var rolloutErr *RolloutError
+		if errors.As(err, &rolloutErr) {
+			m.rejectedChecksums[bundleKey] = rolloutErr.FailedChecksum
+		}
 	}
 }
 
@@ -302,15 +381,25 @@ func (m *pollerManager) GetAllBundleUpdates() map[types.NamespacedName]BundleUpd
 // cache — not only when the policy was previously in BundlePending state. A spurious reconcile
 // event in that case is harmless: it triggers an unnecessary graph rebuild but causes no
 // incorrect behavior.
-func (m *pollerManager) cacheBundleUpdate(bundleKey graph.WAFBundleKey, data []byte, checksum string) {
+func (m *pollerManager) cacheBundleUpdate(
+	bundleKey graph.WAFBundleKey,
+	data []byte,
+	checksum string,
+	signature []byte,
+) {
 	m.mu.Lock()
 
 	_, alreadyCached := m.bundleCache[bundleKey]
-
-	m.bundleCache[bundleKey] = &graph.WAFBundleData{
-		Data:     data,
-		Checksum: checksum,
+	// The fetched bundle supersedes a bundle restored from the store and any rolled back bundle.
+	delete(m.restoredBundles, bundleKey)
+	delete(m.rejectedChecksums, bundleKey)
+
+	bundle := &graph.WAFBundleData{
+		Data:      data,
+		Checksum:  checksum,
+		Signature: signature,
 	}
+	m.bundleCache[bundleKey] = bundle
 
 	// Capture event details while holding the lock, then release before sending.
 	var event *events.WAFBundleReconcileEvent
@@ -322,6 +411,8 @@ func (m *pollerManager) cacheBundleUpdate(bundleKey graph.WAFBundleKey, data []b
 
 	m.mu.Unlock()
 
+	m.persistBundle(bundleKey, bundle)
+
 	// Send the reconcile event after releasing the lock so other manager operations are not
 	// blocked on the mutex while waiting for the event loop. The manager's root context is
 	// used as a cancellation escape hatch: on shutdown, the event loop exits before the
@@ -335,6 +426,111 @@ func (m *pollerManager) cacheBundleUpdate(bundleKey graph.WAFBundleKey, data []b
 	}
 }
 
+// persistBundle saves the bundle to the store, unless the store already holds a bundle with the same checksum.
+// Must not be called while m.mu is held.
+func (m *pollerManager) persistBundle(bundleKey graph.WAFBundleKey, bundle *graph.WAFBundleData) {
+	if m.store == nil {
+		return
+	}
+
+	m.storeMu.Lock()
+	defer m.storeMu.Unlock()
+
+	m.persistBundleLocked(bundleKey, bundle)
+}
+
+// persistBundleLocked saves the bundle to the store, unless the store already holds a bundle with the
+// same checksum. Must be called while m.storeMu is held.
+func (m *pollerManager) persistBundleLocked(bundleKey graph.WAFBundleKey, bundle *graph.WAFBundleData) {
+	if !m.storeEnabled {
+		return
+	}
+
+	if checksum, ok := m.persistedChecksums[bundleKey]; ok && checksum == bundle.Checksum {
+		return
+	}
+
+	if err := m.store.Save(bundleKey, bundle); err != nil {
+		m.logger.Error(err, "Failed to cache WAF bundle", "bundle", bundleKey)
+		return
+	}
+	m.persistedChecksums[bundleKey] = bundle.Checksum
+}
+
+// PersistBundles saves the given graph bundles, together with the bundles fetched by pollers, to the
+// store and removes all other bundles from it. Bundles fetched by pollers take precedence, as they are
+// fresher than the graph bundles.
+// The bundles restored from the store on startup are dropped from the cache, as the graph has either
+// picked them up or no longer references them.
+func (m *pollerManager) PersistBundles(bundles map[graph.WAFBundleKey]*graph.WAFBundleData) {
+	if m.store == nil {
+		return
+	}
+
+	m.storeMu.Lock()
+	defer m.storeMu.Unlock()
+
+	m.mu.Lock()
+	for key := range m.restoredBundles {
+		delete(m.bundleCache, key)
+	}
+	clear(m.restoredBundles)
+
+	keep := make(map[graph.WAFBundleKey]*graph.WAFBundleData, len(bundles)+len(m.bundleCache))
+	for key, bundle := range bundles {
+		if bundle != nil && bundle.Checksum != "" {
+			keep[key] = bundle
+		}
+	}
+	maps.Copy(keep, m.bundleCache)
+	m.mu.Unlock()
+
+	if !m.storeEnabled {
+		return
+	}
+
+	for key, bundle := range keep {
+		m.persistBundleLocked(key, bundle)
+	}
+
+	for key := range m.persistedChecksums {
+		if _, ok := keep[key]; ok {
+			continue
+		}
+		if err := m.store.Delete(key); err != nil {
+			m.logger.Error(err, "Failed to remove cached WAF bundle", "bundle", key)
+			continue
+		}
+		delete(m.persistedChecksums, key)
+	}
+}
+
+// EnableBundleCache allows the manager to write to the store. It is called when the Pod becomes leader;
+// the bundles in use are persisted on the next call to PersistBundles.
+func (m *pollerManager) EnableBundleCache(_ context.Context) {
+	m.storeMu.Lock()
+	defer m.storeMu.Unlock()
+
+	m.storeEnabled = true
+}
+
+// lastGoodBundle returns the most recently deployed bundle for the bundle key: the latest bundle
+// cached from a poller, or else the bundle the poller was started with. It returns nil if neither exists.
+func (m *pollerManager) lastGoodBundle(bundleKey graph.WAFBundleKey) *graph.WAFBundleData {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lastGoodBundleLocked(bundleKey)
+}
+
+// lastGoodBundleLocked is lastGoodBundle for callers that hold m.mu.
+func (m *pollerManager) lastGoodBundleLocked(bundleKey graph.WAFBundleKey) *graph.WAFBundleData {
+	if bundle, ok := m.bundleCache[bundleKey]; ok {
+		return bundle
+	}
+	return m.initialBundles[bundleKey]
+}
+
 // GetAllPollErrors returns a deep copy of all current poll errors.
 func (m *pollerManager) GetAllPollErrors() map[types.NamespacedName]PollError {
 	m.mu.RLock()
@@ -354,16 +550,30 @@ func (m *pollerManager) GetAllPollErrors() map[types.NamespacedName]PollError {
 // GetLatestBundles returns a copy of all bundles that have been successfully fetched by pollers.
 // These represent the freshest known bundle data and should take precedence over
 // graph-cached bundles when constructing stale-bundle fallback state.
+// Until PersistBundles is first called, it also returns the bundles restored from the store.
+// For a bundle that was rolled back, it returns the last good bundle with RejectedChecksum set, so that
+// rebuilding the graph doesn't redeploy the rejected bundle.
 func (m *pollerManager) GetLatestBundles() map[graph.WAFBundleKey]*graph.WAFBundleData {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	if len(m.bundleCache) == 0 {
+	if len(m.bundleCache) == 0 && len(m.rejectedChecksums) == 0 {
 		return nil
 	}
 
-	result := make(map[graph.WAFBundleKey]*graph.WAFBundleData, len(m.bundleCache))
+	result := make(map[graph.WAFBundleKey]*graph.WAFBundleData, len(m.bundleCache)+len(m.rejectedChecksums))
 	maps.Copy(result, m.bundleCache)
+
+	for key, checksum := range m.rejectedChecksums {
+		bundle := &graph.WAFBundleData{RejectedChecksum: checksum}
+		if lastGood := m.lastGoodBundleLocked(key); lastGood != nil {
+			bundle.Data = lastGood.Data
+			bundle.Checksum = lastGood.Checksum
+			bundle.Signature = lastGood.Signature
+		}
+		result[key] = bundle
+	}
+
 	return result
 }
 
@@ -396,6 +606,9 @@ func (m *pollerManager) stopAll() {
 	m.pollErrors = make(map[types.NamespacedName]*PollError)
 	m.bundleUpdates = make(map[types.NamespacedName]BundleUpdate)
 	m.bundleCache = make(map[graph.WAFBundleKey]*graph.WAFBundleData)
+	m.initialBundles = make(map[graph.WAFBundleKey]*graph.WAFBundleData)
+	m.restoredBundles = make(map[graph.WAFBundleKey]struct{})
+	m.rejectedChecksums = make(map[graph.WAFBundleKey]string)
 	m.bundleKeyToPolicy = make(map[graph.WAFBundleKey]types.NamespacedName)
 	m.bundleKeyToDescription = make(map[graph.WAFBundleKey]string)
 	m.mu.Unlock()
@@ -412,6 +625,9 @@ func (m *pollerManager) stopAll() {
 func (m *pollerManager) clearBundleCacheLocked(p *poller) {
 	for _, src := range p.getSources() {
 		delete(m.bundleCache, src.BundleKey)
+		delete(m.initialBundles, src.BundleKey)
+		delete(m.restoredBundles, src.BundleKey)
+		delete(m.rejectedChecksums, src.BundleKey)
 		delete(m.bundleKeyToPolicy, src.BundleKey)
 		delete(m.bundleKeyToDescription, src.BundleKey)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/agentfakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/bundlecache/bundlecachefakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch/fetchfakes"
 )
@@ -526,7 +527,7 @@ func TestManager_stopPollerClearsBundleCache(t *testing.T) {
 	})
 
 	// Simulate a cached bundle.
-	mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum")
+	mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum", nil)
 	g.Expect(mgr.GetLatestBundles()).To(HaveKey(bundleKey))
 
 	// StopPoller should clear the cached bundle.
@@ -572,8 +573,8 @@ func TestManager_stopPollersNotInClearsBundleCache(t *testing.T) {
 		TargetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
 	})
 
-	mgr.cacheBundleUpdate(bundleKeyA, []byte("data-a"), "checksum-a")
-	mgr.cacheBundleUpdate(bundleKeyB, []byte("data-b"), "checksum-b")
+	mgr.cacheBundleUpdate(bundleKeyA, []byte("data-a"), "checksum-a", nil)
+	mgr.cacheBundleUpdate(bundleKeyB, []byte("data-b"), "checksum-b", nil)
 	g.Expect(mgr.GetLatestBundles()).To(HaveLen(2))
 
 	// Keep only policyA active — policyB's cached bundle should be cleared.
@@ -615,7 +616,7 @@ func TestManager_GetLatestBundles(t *testing.T) {
 		bundleData := []byte("bundle content")
 		checksum := "abc123"
 
-		mgr.cacheBundleUpdate(bundleKey, bundleData, checksum)
+		mgr.cacheBundleUpdate(bundleKey, bundleData, checksum, nil)
 
 		bundles := mgr.GetLatestBundles()
 		g.Expect(bundles).To(HaveLen(1))
@@ -636,8 +637,8 @@ func TestManager_GetLatestBundles(t *testing.T) {
 		})
 
 		bundleKey := graph.WAFBundleKey("default_my-policy")
-		mgr.cacheBundleUpdate(bundleKey, []byte("old"), "old-checksum")
-		mgr.cacheBundleUpdate(bundleKey, []byte("new"), "new-checksum")
+		mgr.cacheBundleUpdate(bundleKey, []byte("old"), "old-checksum", nil)
+		mgr.cacheBundleUpdate(bundleKey, []byte("new"), "new-checksum", nil)
 
 		bundles := mgr.GetLatestBundles()
 		g.Expect(bundles).To(HaveLen(1))
@@ -656,7 +657,7 @@ func TestManager_GetLatestBundles(t *testing.T) {
 		})
 
 		bundleKey := graph.WAFBundleKey("default_my-policy")
-		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum", nil)
 
 		copy1 := mgr.GetLatestBundles()
 		copy2 := mgr.GetLatestBundles()
@@ -666,6 +667,51 @@ func TestManager_GetLatestBundles(t *testing.T) {
 		g.Expect(copy2).To(HaveLen(1))
 	})
 
+	t.Run("returns the last good bundle for a rolled back bundle", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		mgr := newTestManager(ManagerConfig{
+			Logger:      logr.Discard(),
+			Fetcher:     &fetchfakes.FakeFetcher{},
+			Deployments: &agentfakes.FakeDeploymentStorer{},
+		})
+
+		policyNsName := types.NamespacedName{Namespace: "default", Name: "my-policy"}
+		bundleKey := graph.WAFBundleKey("default_my-policy")
+		otherKey := graph.WAFBundleKey("default_other-policy")
+		rolloutErr := &RolloutError{Err: errors.New("apply failed"), FailedChecksum: "bad-checksum"}
+
+		// No bundle was deployed before the rollback.
+		mgr.recordPollResult(policyNsName, otherKey, "policy bundle", "", rolloutErr)
+		g.Expect(mgr.GetLatestBundles()).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{
+			otherKey: {RejectedChecksum: "bad-checksum"},
+		}))
+
+		mgr.cacheBundleUpdate(bundleKey, []byte("good"), "good-checksum", []byte("good-signature"))
+		mgr.recordPollResult(policyNsName, bundleKey, "policy bundle", "", rolloutErr)
+
+		bundles := mgr.GetLatestBundles()
+		g.Expect(bundles).To(HaveLen(2))
+		g.Expect(bundles[bundleKey]).To(Equal(&graph.WAFBundleData{
+			Data:             []byte("good"),
+			Checksum:         "good-checksum",
+			Signature:        []byte("good-signature"),
+			RejectedChecksum: "bad-checksum",
+		}))
+
+		// Other poll errors don't reject a bundle.
+		mgr.recordPollResult(policyNsName, bundleKey, "policy bundle", "", errors.New("fetch failed"))
+		g.Expect(mgr.GetLatestBundles()[bundleKey].RejectedChecksum).To(Equal("bad-checksum"))
+
+		// Deploying a newer bundle clears the rejection.
+		mgr.cacheBundleUpdate(bundleKey, []byte("newer"), "newer-checksum", nil)
+		g.Expect(mgr.GetLatestBundles()[bundleKey]).To(Equal(&graph.WAFBundleData{
+			Data:     []byte("newer"),
+			Checksum: "newer-checksum",
+		}))
+	})
+
 	t.Run("stopAll clears cache", func(t *testing.T) {
 		t.Parallel()
 		g := NewWithT(t)
@@ -676,7 +722,7 @@ func TestManager_GetLatestBundles(t *testing.T) {
 			Deployments: &agentfakes.FakeDeploymentStorer{},
 		})
 
-		mgr.cacheBundleUpdate(graph.WAFBundleKey("default_policy"), []byte("data"), "checksum")
+		mgr.cacheBundleUpdate(graph.WAFBundleKey("default_policy"), []byte("data"), "checksum", nil)
 		g.Expect(mgr.GetLatestBundles()).To(HaveLen(1))
 
 		mgr.stopAll()
@@ -684,6 +730,278 @@ func TestManager_GetLatestBundles(t *testing.T) {
 	})
 }
 
+func TestManager_lastGoodBundle(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mgr := newTestManager(ManagerConfig{
+		Logger:      logr.Discard(),
+		Fetcher:     &fetchfakes.FakeFetcher{},
+		Deployments: &agentfakes.FakeDeploymentStorer{},
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	policyNsName := types.NamespacedName{Namespace: "default", Name: "test-policy"}
+	bundleKey := graph.WAFBundleKey("test_policy")
+	initialBundle := &graph.WAFBundleData{Data: []byte("initial"), Checksum: "initial-checksum"}
+
+	g.Expect(mgr.lastGoodBundle(bundleKey)).To(BeNil())
+
+	mgr.ReconcilePoller(ctx, Config{
+		PolicyNsName: policyNsName,
+		Sources: []BundleSource{
+			{
+				BundleKey: bundleKey,
+				Request:   fetch.Request{URL: "http://example.com/bundle.tgz"},
+				Interval:  1 * time.Hour,
+			},
+		},
+		TargetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
+		InitialChecksums:  map[graph.WAFBundleKey]string{bundleKey: initialBundle.Checksum},
+		InitialBundles:    map[graph.WAFBundleKey]*graph.WAFBundleData{bundleKey: initialBundle},
+	})
+
+	// The bundle the poller was started with is used until a newer bundle is cached.
+	g.Expect(mgr.lastGoodBundle(bundleKey)).To(Equal(initialBundle))
+
+	mgr.cacheBundleUpdate(bundleKey, []byte("polled"), "polled-checksum", nil)
+	g.Expect(mgr.lastGoodBundle(bundleKey)).To(Equal(&graph.WAFBundleData{
+		Data:     []byte("polled"),
+		Checksum: "polled-checksum",
+	}))
+
+	mgr.StopPoller(policyNsName)
+	g.Expect(mgr.lastGoodBundle(bundleKey)).To(BeNil())
+}
+
+func TestManager_restoresBundlesFromBundleCache(t *testing.T) {
+	t.Parallel()
+
+	restored := map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_policy": {Data: []byte("policy"), Checksum: "policy-checksum"},
+		"default_log":    {Data: []byte("log"), Checksum: "log-checksum"},
+	}
+
+	t.Run("warms the latest bundles", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		store := &bundlecachefakes.FakeStore{}
+		store.LoadReturns(restored, nil)
+
+		mgr := newTestManager(ManagerConfig{
+			Logger:      logr.Discard(),
+			Fetcher:     &fetchfakes.FakeFetcher{},
+			Deployments: &agentfakes.FakeDeploymentStorer{},
+			BundleCache: store,
+		})
+
+		g.Expect(store.LoadCallCount()).To(Equal(1))
+		g.Expect(mgr.GetLatestBundles()).To(Equal(restored))
+	})
+
+	t.Run("bundles that pass verification are restored despite a load error", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		restored := map[graph.WAFBundleKey]*graph.WAFBundleData{
+			"default_policy": {Data: []byte("restored"), Checksum: "restored-checksum"},
+		}
+		store := &bundlecachefakes.FakeStore{}
+		store.LoadReturns(restored, errors.New("cached WAF bundle default_corrupt: digest mismatch"))
+
+		mgr := newTestManager(ManagerConfig{
+			Logger:      logr.Discard(),
+			Fetcher:     &fetchfakes.FakeFetcher{},
+			Deployments: &agentfakes.FakeDeploymentStorer{},
+			BundleCache: store,
+		})
+
+		g.Expect(mgr.GetLatestBundles()).To(Equal(restored))
+	})
+
+	t.Run("load error leaves the cache empty", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		store := &bundlecachefakes.FakeStore{}
+		store.LoadReturns(nil, errors.New("read error"))
+
+		mgr := newTestManager(ManagerConfig{
+			Logger:      logr.Discard(),
+			Fetcher:     &fetchfakes.FakeFetcher{},
+			Deployments: &agentfakes.FakeDeploymentStorer{},
+			BundleCache: store,
+		})
+
+		g.Expect(mgr.GetLatestBundles()).To(BeNil())
+	})
+}
+
+func TestManager_cacheBundleUpdatePersistsBundle(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	bundleKey := graph.WAFBundleKey("default_policy")
+
+	store := &bundlecachefakes.FakeStore{}
+	store.LoadReturns(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		bundleKey: {Data: []byte("restored"), Checksum: "restored-checksum"},
+	}, nil)
+
+	mgr := newTestManager(ManagerConfig{
+		Logger:      logr.Discard(),
+		Fetcher:     &fetchfakes.FakeFetcher{},
+		Deployments: &agentfakes.FakeDeploymentStorer{},
+		BundleCache: store,
+	})
+
+	// Bundles are not persisted until the Pod becomes leader.
+	mgr.cacheBundleUpdate(bundleKey, []byte("follower"), "follower-checksum", nil)
+	g.Expect(store.SaveCallCount()).To(BeZero())
+
+	mgr.EnableBundleCache(t.Context())
+
+	// A bundle with the checksum of the persisted bundle is not saved again.
+	mgr.cacheBundleUpdate(bundleKey, []byte("restored"), "restored-checksum", nil)
+	g.Expect(store.SaveCallCount()).To(BeZero())
+
+	mgr.cacheBundleUpdate(bundleKey, []byte("polled"), "polled-checksum", []byte("signature"))
+	g.Expect(store.SaveCallCount()).To(Equal(1))
+
+	key, bundle := store.SaveArgsForCall(0)
+	g.Expect(key).To(Equal(bundleKey))
+	g.Expect(bundle).To(Equal(&graph.WAFBundleData{
+		Data:      []byte("polled"),
+		Checksum:  "polled-checksum",
+		Signature: []byte("signature"),
+	}))
+
+	// A failed save is retried with the next update.
+	store.SaveReturns(errors.New("disk full"))
+	mgr.cacheBundleUpdate(bundleKey, []byte("newer"), "newer-checksum", nil)
+	store.SaveReturns(nil)
+	mgr.cacheBundleUpdate(bundleKey, []byte("newer"), "newer-checksum", nil)
+	g.Expect(store.SaveCallCount()).To(Equal(3))
+}
+
+func TestManager_PersistBundles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := &bundlecachefakes.FakeStore{}
+	store.LoadReturns(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_unchanged": {Data: []byte("unchanged"), Checksum: "unchanged-checksum"},
+		"default_changed":   {Data: []byte("old"), Checksum: "old-checksum"},
+		"default_deleted":   {Data: []byte("deleted"), Checksum: "deleted-checksum"},
+	}, nil)
+
+	mgr := newTestManager(ManagerConfig{
+		Logger:      logr.Discard(),
+		Fetcher:     &fetchfakes.FakeFetcher{},
+		Deployments: &agentfakes.FakeDeploymentStorer{},
+		BundleCache: store,
+	})
+	mgr.EnableBundleCache(t.Context())
+
+	polled := &graph.WAFBundleData{Data: []byte("polled"), Checksum: "polled-checksum"}
+	mgr.cacheBundleUpdate("default_polled", polled.Data, polled.Checksum, nil)
+	g.Expect(store.SaveCallCount()).To(Equal(1))
+
+	changed := &graph.WAFBundleData{Data: []byte("new"), Checksum: "new-checksum"}
+	added := &graph.WAFBundleData{Data: []byte("added"), Checksum: "added-checksum"}
+
+	mgr.PersistBundles(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_unchanged": {Data: []byte("unchanged"), Checksum: "unchanged-checksum"},
+		"default_changed":   changed,
+		"default_added":     added,
+		// The bundle fetched by the poller takes precedence over the graph bundle.
+		"default_polled": {Data: []byte("stale"), Checksum: "stale-checksum"},
+	})
+
+	saved := make(map[graph.WAFBundleKey]*graph.WAFBundleData)
+	for i := 1; i < store.SaveCallCount(); i++ {
+		key, bundle := store.SaveArgsForCall(i)
+		saved[key] = bundle
+	}
+	g.Expect(saved).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_changed": changed,
+		"default_added":   added,
+	}))
+
+	g.Expect(store.DeleteCallCount()).To(Equal(1))
+	g.Expect(store.DeleteArgsForCall(0)).To(Equal(graph.WAFBundleKey("default_deleted")))
+
+	// The restored bundles are superseded by the graph bundles.
+	g.Expect(mgr.GetLatestBundles()).To(Equal(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_polled": polled,
+	}))
+
+	// Nothing is written when the bundles are unchanged.
+	mgr.PersistBundles(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_unchanged": {Data: []byte("unchanged"), Checksum: "unchanged-checksum"},
+		"default_changed":   changed,
+		"default_added":     added,
+	})
+	g.Expect(store.SaveCallCount()).To(Equal(3))
+	g.Expect(store.DeleteCallCount()).To(Equal(1))
+}
+
+func TestManager_PersistBundlesBeforeLeader(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := &bundlecachefakes.FakeStore{}
+	store.LoadReturns(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_restored": {Data: []byte("restored"), Checksum: "restored-checksum"},
+	}, nil)
+
+	mgr := newTestManager(ManagerConfig{
+		Logger:      logr.Discard(),
+		Fetcher:     &fetchfakes.FakeFetcher{},
+		Deployments: &agentfakes.FakeDeploymentStorer{},
+		BundleCache: store,
+	})
+
+	// Only the leader writes to the store, which may be shared by all replicas.
+	mgr.PersistBundles(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_policy": {Data: []byte("policy"), Checksum: "policy-checksum"},
+	})
+	g.Expect(store.SaveCallCount()).To(BeZero())
+	g.Expect(store.DeleteCallCount()).To(BeZero())
+
+	// The restored bundles are still superseded by the graph bundles.
+	g.Expect(mgr.GetLatestBundles()).To(BeNil())
+
+	mgr.EnableBundleCache(t.Context())
+	mgr.PersistBundles(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_policy": {Data: []byte("policy"), Checksum: "policy-checksum"},
+	})
+	g.Expect(store.SaveCallCount()).To(Equal(1))
+	g.Expect(store.DeleteCallCount()).To(Equal(1))
+	g.Expect(store.DeleteArgsForCall(0)).To(Equal(graph.WAFBundleKey("default_restored")))
+}
+
+func TestManager_PersistBundlesWithoutBundleCache(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mgr := newTestManager(ManagerConfig{
+		Logger:      logr.Discard(),
+		Fetcher:     &fetchfakes.FakeFetcher{},
+		Deployments: &agentfakes.FakeDeploymentStorer{},
+	})
+
+	mgr.cacheBundleUpdate("default_polled", []byte("polled"), "polled-checksum", nil)
+	mgr.PersistBundles(map[graph.WAFBundleKey]*graph.WAFBundleData{
+		"default_policy": {Data: []byte("policy"), Checksum: "policy-checksum"},
+	})
+
+	g.Expect(mgr.GetLatestBundles()).To(HaveLen(1))
+}
+
 func TestManager_cacheBundleUpdateInjectsReconcileEvent(t *testing.T) {
 	t.Parallel()
 
@@ -705,7 +1023,7 @@ func TestManager_cacheBundleUpdateInjectsReconcileEvent(t *testing.T) {
 
 		mgr.bundleKeyToPolicy[bundleKey] = policyNsName
 
-		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum", nil)
 
 		g.Expect(eventCh).To(Receive(Equal(events.WAFBundleReconcileEvent{PolicyNsName: policyNsName})))
 	})
@@ -725,10 +1043,10 @@ func TestManager_cacheBundleUpdateInjectsReconcileEvent(t *testing.T) {
 
 		mgr.bundleKeyToPolicy[bundleKey] = policyNsName
 
-		mgr.cacheBundleUpdate(bundleKey, []byte("data-v1"), "checksum-v1")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data-v1"), "checksum-v1", nil)
 		// Consume the first event, then verify no second event is sent.
 		g.Expect(eventCh).To(Receive())
-		mgr.cacheBundleUpdate(bundleKey, []byte("data-v2"), "checksum-v2")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data-v2"), "checksum-v2", nil)
 		g.Consistently(eventCh).ShouldNot(Receive())
 	})
 
@@ -744,7 +1062,7 @@ func TestManager_cacheBundleUpdateInjectsReconcileEvent(t *testing.T) {
 		mgr.bundleKeyToPolicy[bundleKey] = policyNsName
 
 		// Must not panic.
-		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum", nil)
 	})
 
 	t.Run("panics when EventCh is set without Ctx", func(t *testing.T) {
@@ -775,7 +1093,7 @@ func TestManager_cacheBundleUpdateInjectsReconcileEvent(t *testing.T) {
 		})
 
 		// No entry in bundleKeyToPolicy.
-		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum")
+		mgr.cacheBundleUpdate(bundleKey, []byte("data"), "checksum", nil)
 
 		g.Expect(eventCh).To(BeEmpty())
 	})
@@ -41,14 +41,21 @@ type BundleSource struct {
 	Type BundleType
 	// Interval is the polling interval for this source.
 	Interval time.Duration
+	// RolloutStrategy is the strategy used to deploy a changed bundle. An empty value deploys the bundle
+	// to all target deployments at once.
+	RolloutStrategy ngfAPIv1alpha1.BundleRolloutStrategy
 }
 
 // bundleState tracks the last known state of a fetched bundle, including the checksum and any
 // conditional-request validators (ETag or Last-Modified) for use on subsequent HTTP polls.
 type bundleState struct {
+	// rejectedErr is the error of the last rollout that was rolled back.
+	rejectedErr  error
 	checksum     string
 	eTag         string
 	lastModified string
+	// rejectedChecksum is the checksum of the last bundle that was rolled back. It is not rolled out again.
+	rejectedChecksum string
 }
 
 // poller handles periodic re-fetching of WAF bundles for a single WAFPolicy.
@@ -64,12 +71,15 @@ type poller struct {
 		newChecksum string,
 		err error,
 	)
-	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string)
-	policyNsName         types.NamespacedName
-	logger               logr.Logger
-	sources              []BundleSource
-	targetMu             sync.RWMutex
-	stateMu              sync.RWMutex
+	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string, signature []byte)
+	// lastGoodBundle returns the last bundle that was deployed successfully, which a failed rollout is
+	// reverted to.
+	lastGoodBundle func(bundleKey graph.WAFBundleKey) *graph.WAFBundleData
+	policyNsName   types.NamespacedName
+	logger         logr.Logger
+	sources        []BundleSource
+	targetMu       sync.RWMutex
+	stateMu        sync.RWMutex
 }
 
 // pollerConfig contains the configuration for creating a new poller.
@@ -83,7 +93,8 @@ type pollerConfig struct {
 		newChecksum string,
 		err error,
 	)
-	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string)
+	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string, signature []byte)
+	lastGoodBundle       func(bundleKey graph.WAFBundleKey) *graph.WAFBundleData
 	policyNsName         types.NamespacedName
 	logger               logr.Logger
 	sources              []BundleSource
@@ -112,6 +123,7 @@ func newPoller(cfg pollerConfig) *poller {
 		bundleStates:         states,
 		statusCallback:       cfg.statusCallback,
 		bundleUpdateCallback: cfg.bundleUpdateCallback,
+		lastGoodBundle:       cfg.lastGoodBundle,
 	}
 }
 
@@ -205,7 +217,7 @@ func sourcesEqual(a, b []BundleSource) bool {
 
 // pollSource fetches a single bundle source and pushes it to deployments if changed.
 //
-// For NIM and N1C sources a two-phase approach is used: first only the remote checksum is
+// For NIM, N1C, and OCI sources a two-phase approach is used: first only the remote checksum is
 // retrieved, and the full bundle is downloaded only when the checksum differs from the last
 // known value.
 //
@@ -220,7 +232,7 @@ func (p *poller) pollSource(ctx context.Context, src BundleSource) {
 	p.stateMu.RUnlock()
 
 	if src.Request.SupportsChecksumOnlyFetch() {
-		if skip := p.skipIfChecksumUnchanged(ctx, src, last.checksum); skip {
+		if skip := p.skipIfChecksumUnchanged(ctx, src, last); skip {
 			return
 		}
 	}
@@ -249,21 +261,42 @@ func (p *poller) pollSource(ctx context.Context, src BundleSource) {
 		return
 	}
 
+	// A bundle that fails signature verification is never pushed. Its state is not saved either, so that
+	// it is downloaded and verified again on the next poll.
+	if err := fetch.VerifyBundleSignature(src.Request, result); err != nil {
+		p.logger.Error(err, "Bundle failed signature verification, not pushing to deployments", "bundle", src.BundleKey)
+		p.reportStatus(src.BundleKey, "", err)
+		return
+	}
+
+	if p.skipIfRejected(src.BundleKey, result.Checksum, last) {
+		return
+	}
+
 	p.logger.Info("Bundle changed, pushing to deployments", "bundle", src.BundleKey, "newChecksum", result.Checksum)
-	p.pushBundleToDeployments(src.BundleKey, result.Data)
+	if src.RolloutStrategy == ngfAPIv1alpha1.BundleRolloutStrategyCanary {
+		if err := p.rolloutBundle(src.BundleKey, result, p.getLastGoodBundle(src.BundleKey)); err != nil {
+			p.logger.Error(err, "Bundle rollout failed, reverted deployments to the last good bundle", "bundle", src.BundleKey)
+			p.rejectBundle(src.BundleKey, result.Checksum, err)
+			p.reportStatus(src.BundleKey, "", err)
+			return
+		}
+	} else {
+		p.pushBundleToDeployments(src.BundleKey, result.Data)
+	}
 	p.saveBundleState(src.BundleKey, result)
 
 	if p.bundleUpdateCallback != nil {
-		p.bundleUpdateCallback(src.BundleKey, result.Data, result.Checksum)
+		p.bundleUpdateCallback(src.BundleKey, result.Data, result.Checksum, result.Signature)
 	}
 	p.reportStatus(src.BundleKey, result.Checksum, nil)
 }
 
-// skipIfChecksumUnchanged fetches only the remote checksum for a NIM or N1C source.
+// skipIfChecksumUnchanged fetches only the remote checksum for a NIM, N1C, or OCI source.
 // It reports whether polling should skip the full download (true = skip). When skipping due to
-// an error or an unchanged checksum the appropriate status callback is fired.
-func (p *poller) skipIfChecksumUnchanged(ctx context.Context, src BundleSource, lastChecksum string) bool {
-	changed, checksum, err := p.checksumChanged(ctx, src, lastChecksum)
+// an error, an unchanged checksum, or a previously rolled back bundle the appropriate status callback is fired.
+func (p *poller) skipIfChecksumUnchanged(ctx context.Context, src BundleSource, last bundleState) bool {
+	changed, checksum, err := p.checksumChanged(ctx, src, last.checksum)
 	if err != nil {
 		p.logger.Error(err, "Failed to fetch bundle checksum during poll", "bundle", src.BundleKey)
 		p.reportStatus(src.BundleKey, "", err)
@@ -274,6 +307,9 @@ func (p *poller) skipIfChecksumUnchanged(ctx context.Context, src BundleSource,
 		p.reportStatus(src.BundleKey, "", nil)
 		return true
 	}
+	if p.skipIfRejected(src.BundleKey, checksum, last) {
+		return true
+	}
 	p.logger.Info("Bundle checksum changed, downloading full bundle", "bundle", src.BundleKey, "newChecksum", checksum)
 	return false
 }
@@ -309,6 +345,11 @@ func (p *poller) saveBundleState(bundleKey graph.WAFBundleKey, result fetch.Resu
 	defer p.stateMu.Unlock()
 
 	state := p.bundleStates[bundleKey]
+	if state.checksum != result.Checksum {
+		// A new bundle was deployed, so a bundle that was rolled back before may be rolled out again.
+		state.rejectedChecksum = ""
+		state.rejectedErr = nil
+	}
 	state.checksum = result.Checksum
 	if result.ETag != "" {
 		state.eTag = result.ETag
@@ -321,6 +362,38 @@ func (p *poller) saveBundleState(bundleKey graph.WAFBundleKey, result fetch.Resu
 	p.bundleStates[bundleKey] = state
 }
 
+// rejectBundle records that the bundle with the given checksum was rolled back, so that it is not rolled
+// out again on subsequent polls. The checksum of the last good bundle and any conditional token are kept.
+func (p *poller) rejectBundle(bundleKey graph.WAFBundleKey, checksum string, err error) {
+	p.stateMu.Lock()
+	defer p.stateMu.Unlock()
+
+	state := p.bundleStates[bundleKey]
+	state.rejectedChecksum = checksum
+	state.rejectedErr = err
+	p.bundleStates[bundleKey] = state
+}
+
+// skipIfRejected reports whether checksum belongs to a bundle that was rolled back. In that case the
+// rollout error is reported again so that the policy status keeps reflecting the rolled back bundle.
+func (p *poller) skipIfRejected(bundleKey graph.WAFBundleKey, checksum string, last bundleState) bool {
+	if last.rejectedChecksum == "" || checksum != last.rejectedChecksum {
+		return false
+	}
+
+	p.logger.V(1).Info("Bundle was rolled back before, skipping rollout", "bundle", bundleKey, "checksum", checksum)
+	p.reportStatus(bundleKey, "", last.rejectedErr)
+	return true
+}
+
+// getLastGoodBundle returns the last bundle that was deployed successfully, or nil if it is unknown.
+func (p *poller) getLastGoodBundle(bundleKey graph.WAFBundleKey) *graph.WAFBundleData {
+	if p.lastGoodBundle == nil {
+		return nil
+	}
+	return p.lastGoodBundle(bundleKey)
+}
+
 // reportStatus fires the status callback if one is registered.
 // newChecksum is non-empty when the bundle was successfully updated; empty for unchanged or error cases.
 func (p *poller) reportStatus(bundleKey graph.WAFBundleKey, newChecksum string, err error) {
@@ -329,7 +402,7 @@ func (p *poller) reportStatus(bundleKey graph.WAFBundleKey, newChecksum string,
 	}
 }
 
-// checksumChanged fetches only the remote checksum for a NIM or N1C source and reports whether
+// checksumChanged fetches only the remote checksum for a NIM, N1C, or OCI source and reports whether
 // it differs from lastChecksum. Returns (changed, remoteChecksum, error).
 func (p *poller) checksumChanged(ctx context.Context, src BundleSource, lastChecksum string) (bool, string, error) {
 	var checksum string
@@ -370,33 +443,55 @@ func (p *poller) pushBundleToDeployments(bundleKey graph.WAFBundleKey, data []by
 			continue
 		}
 
-		deployment.FileLock.Lock()
-		msg := deployment.UpdateWAFBundle(bundlePath, data)
-		if msg != nil {
-			applied := deployment.GetBroadcaster().Send(*msg)
-			if applied {
-				p.logger.Info(
-					"Pushed updated WAF bundle to deployment",
-					"deployment", depName,
-				)
-			} else {
-				p.logger.V(1).Info(
-					"No subscribers for deployment, bundle stored but not pushed",
-					"deployment", depName,
-				)
-			}
+		if _, err := p.sendBundle(depName, deployment, bundlePath, data); err != nil {
+			p.logger.Error(err, "WAF bundle failed to apply on deployment", "deployment", depName)
 		}
-		deployment.FileLock.Unlock()
 	}
 }
 
+// sendBundle stores the bundle on the deployment and sends it to the deployment's agents.
+// It reports whether at least one agent received the bundle and responded, and returns the errors
+// the agents responded with. Errors from earlier config applies are not included.
+func (p *poller) sendBundle(
+	depName types.NamespacedName,
+	deployment *agent.Deployment,
+	bundlePath string,
+	data []byte,
+) (bool, error) {
+	deployment.FileLock.Lock()
+	defer deployment.FileLock.Unlock()
+
+	msg := deployment.UpdateWAFBundle(bundlePath, data)
+	if msg == nil {
+		return false, nil
+	}
+
+	statusVersion := deployment.GetPodStatusVersion()
+	applied := deployment.GetBroadcaster().Send(*msg)
+	if applied {
+		p.logger.Info(
+			"Pushed updated WAF bundle to deployment",
+			"deployment", depName,
+		)
+	} else {
+		p.logger.V(1).Info(
+			"No subscribers for deployment, bundle stored but not pushed",
+			"deployment", depName,
+		)
+	}
+
+	return applied, deployment.GetConfigurationStatusSince(statusVersion)
+}
+
 // BuildBundleSources constructs BundleSource entries from a WAFPolicy spec.
 // It returns only sources that have polling enabled.
+// signingKeys holds the resolved signature verification keys of the bundles, keyed by bundle key.
 func BuildBundleSources(
 	policyNsName types.NamespacedName,
 	spec ngfAPIv1alpha1.WAFPolicySpec,
 	auth *fetch.BundleAuth,
 	tlsCA []byte,
+	signingKeys map[graph.WAFBundleKey][]byte,
 ) []BundleSource {
 	var sources []BundleSource
 
@@ -407,17 +502,24 @@ func BuildBundleSources(
 			interval = spec.PolicySource.Polling.Interval.Duration
 		}
 
+		bundleKey := graph.PolicyBundleKey(policyNsName)
+
 		sources = append(sources, BundleSource{
-			Type:        PolicyBundle,
-			BundleKey:   graph.PolicyBundleKey(policyNsName),
-			Request:     graph.BuildPolicyFetchRequest(spec.PolicySource, spec.Type, auth, tlsCA),
-			Description: "policy bundle",
-			Interval:    interval,
+			Type:      PolicyBundle,
+			BundleKey: bundleKey,
+			Request: graph.BuildPolicyFetchRequest(
+				spec.PolicySource, spec.Type, auth, tlsCA, signingKeys[bundleKey],
+			),
+			Description:     "policy bundle",
+			Interval:        interval,
+			RolloutStrategy: rolloutStrategy(spec.PolicySource.Polling),
 		})
 	}
 
-	// Check each logSource for polling.
-	for _, secLog := range spec.SecurityLogs {
+	// Check each logSource for polling. Entries of routeSecurityLogs may reference the same bundle as other
+	// entries, in which case the bundle is polled once.
+	polledLogBundles := make(map[graph.WAFBundleKey]struct{})
+	for _, secLog := range graph.WAFSecurityLogs(spec) {
 		if secLog.LogSource == nil {
 			continue
 		}
@@ -433,14 +535,29 @@ func BuildBundleSources(
 			interval = secLog.LogSource.Polling.Interval.Duration
 		}
 
+		bundleKey := graph.LogBundleKey(policyNsName, secLog.LogSource)
+		if _, polled := polledLogBundles[bundleKey]; polled {
+			continue
+		}
+		polledLogBundles[bundleKey] = struct{}{}
+
 		sources = append(sources, BundleSource{
-			Type:        LogProfileBundle,
-			BundleKey:   graph.LogBundleKey(policyNsName, secLog.LogSource),
-			Request:     graph.BuildLogFetchRequest(secLog.LogSource, auth, tlsCA),
-			Description: graph.LogBundleDescription(secLog.LogSource),
-			Interval:    interval,
+			Type:            LogProfileBundle,
+			BundleKey:       bundleKey,
+			Request:         graph.BuildLogFetchRequest(secLog.LogSource, auth, tlsCA, signingKeys[bundleKey]),
+			Description:     graph.LogBundleDescription(secLog.LogSource),
+			Interval:        interval,
+			RolloutStrategy: rolloutStrategy(secLog.LogSource.Polling),
 		})
 	}
 
 	return sources
 }
+
+// rolloutStrategy returns the rollout strategy configured for polling, or an empty strategy if none is set.
+func rolloutStrategy(polling *ngfAPIv1alpha1.BundlePolling) ngfAPIv1alpha1.BundleRolloutStrategy {
+	if polling.Rollout == nil {
+		return ""
+	}
+	return polling.Rollout.Strategy
+}
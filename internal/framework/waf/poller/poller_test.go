@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/agentfakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
@@ -348,7 +349,7 @@ func Test_poller_pollSourceSuccessWithCallback(t *testing.T) {
 	newChecksum := "def456"
 
 	// Create a real deployment store so we can return a real deployment.
-	connTracker := agentgrpc.NewConnectionsTracker()
+	connTracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
 	realStore := agent.NewDeploymentStore(connTracker)
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 	fakeBroadcaster.SendReturns(true) // Simulate active subscribers.
@@ -438,7 +439,7 @@ func Test_poller_bundleUpdateCallbackOnChange(t *testing.T) {
 		deployments:       deployments,
 		targetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
 		initialChecksums:  map[graph.WAFBundleKey]string{bundleKey: oldChecksum},
-		bundleUpdateCallback: func(key graph.WAFBundleKey, data []byte, checksum string) {
+		bundleUpdateCallback: func(key graph.WAFBundleKey, data []byte, checksum string, _ []byte) {
 			callbackCalled = true
 			callbackKey = key
 			callbackData = data
@@ -485,7 +486,7 @@ func Test_poller_bundleUpdateCallbackNotCalledOnUnchanged(t *testing.T) {
 		deployments:       deployments,
 		targetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
 		initialChecksums:  map[graph.WAFBundleKey]string{bundleKey: checksum},
-		bundleUpdateCallback: func(_ graph.WAFBundleKey, _ []byte, _ string) {
+		bundleUpdateCallback: func(_ graph.WAFBundleKey, _ []byte, _ string, _ []byte) {
 			callbackCalled = true
 		},
 	})
@@ -524,7 +525,7 @@ func Test_poller_bundleUpdateCallbackNotCalledOnError(t *testing.T) {
 		fetcher:           fetcher,
 		deployments:       deployments,
 		targetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
-		bundleUpdateCallback: func(_ graph.WAFBundleKey, _ []byte, _ string) {
+		bundleUpdateCallback: func(_ graph.WAFBundleKey, _ []byte, _ string, _ []byte) {
 			callbackCalled = true
 		},
 	})
@@ -547,7 +548,7 @@ func Test_poller_pushBundleNoSubscribers(t *testing.T) {
 	newChecksum := "def456"
 
 	// Create a real deployment store so we can return a real deployment.
-	connTracker := agentgrpc.NewConnectionsTracker()
+	connTracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
 	realStore := agent.NewDeploymentStore(connTracker)
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 	fakeBroadcaster.SendReturns(false) // Simulate no subscribers.
@@ -812,6 +813,64 @@ func Test_sourcesEqual(t *testing.T) {
 	}
 }
 
+func Test_poller_pollSourceSignatureVerificationFailed(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fetcher := &fetchfakes.FakeFetcher{}
+	deployments := &agentfakes.FakeDeploymentStorer{}
+	logger := logr.Discard()
+
+	bundleKey := graph.WAFBundleKey("default_test")
+	oldChecksum := "abc123"
+
+	// Fetcher returns a changed bundle whose signature cannot be verified with the configured key.
+	fetcher.FetchPolicyBundleReturns(
+		fetch.Result{Data: []byte("new bundle data"), Checksum: "def456", Signature: []byte("signature")},
+		nil,
+	)
+
+	var callbackErr error
+	var updateCalled bool
+	poller := newPoller(pollerConfig{
+		logger:       logger,
+		policyNsName: types.NamespacedName{Namespace: "default", Name: "test"},
+		sources: []BundleSource{
+			{
+				BundleKey: bundleKey,
+				Request: fetch.Request{
+					URL:       "http://example.com/bundle.tgz",
+					Signature: fetch.SignatureRequest{PublicKey: []byte("invalid key")},
+				},
+				Interval: 5 * time.Minute,
+			},
+		},
+		fetcher:           fetcher,
+		deployments:       deployments,
+		targetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
+		initialChecksums:  map[graph.WAFBundleKey]string{bundleKey: oldChecksum},
+		statusCallback: func(_ types.NamespacedName, _ graph.WAFBundleKey, _ string, err error) {
+			callbackErr = err
+		},
+		bundleUpdateCallback: func(graph.WAFBundleKey, []byte, string, []byte) {
+			updateCalled = true
+		},
+	})
+
+	src := poller.sources[0]
+	poller.pollSource(t.Context(), src)
+
+	g.Expect(fetcher.FetchPolicyBundleCallCount()).To(Equal(1))
+	// The bundle must not be pushed to deployments or cached.
+	g.Expect(deployments.GetCallCount()).To(Equal(0))
+	g.Expect(updateCalled).To(BeFalse())
+	// Checksum should NOT be updated so that the bundle is verified again on the next poll.
+	g.Expect(poller.bundleStates[bundleKey].checksum).To(Equal(oldChecksum))
+	// Status callback should report the signature error.
+	var sigErr *fetch.SignatureError
+	g.Expect(errors.As(callbackErr, &sigErr)).To(BeTrue())
+}
+
 func TestBuildBundleSources(t *testing.T) {
 	t.Parallel()
 
@@ -820,6 +879,7 @@ func TestBuildBundleSources(t *testing.T) {
 	tests := []struct {
 		auth            *fetch.BundleAuth
 		validateSources func(g Gomega, sources []BundleSource)
+		signingKeys     map[graph.WAFBundleKey][]byte
 		name            string
 		spec            ngfAPIv1alpha1.WAFPolicySpec
 		tlsCA           []byte
@@ -943,6 +1003,47 @@ func TestBuildBundleSources(t *testing.T) {
 				g.Expect(sources[0].Request.URL).To(Equal("http://example.com/log-profile.tgz"))
 			},
 		},
+		{
+			name: "route security log sources are polled once per bundle",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				Type: ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/policy.tgz"},
+				},
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+					{
+						LogSource: &ngfAPIv1alpha1.LogSource{
+							HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/log-profile.tgz"},
+							Polling:    &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+						},
+					},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{
+						Name: "coffee",
+						SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+							{
+								LogSource: &ngfAPIv1alpha1.LogSource{
+									HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/log-profile.tgz"},
+									Polling:    &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+								},
+							},
+							{
+								LogSource: &ngfAPIv1alpha1.LogSource{
+									HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/coffee-log.tgz"},
+									Polling:    &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSources: 2,
+			validateSources: func(g Gomega, sources []BundleSource) {
+				g.Expect(sources[0].Request.URL).To(Equal("http://example.com/log-profile.tgz"))
+				g.Expect(sources[1].Request.URL).To(Equal("http://example.com/coffee-log.tgz"))
+			},
+		},
 		{
 			name: "log source with default profile (no URL)",
 			spec: ngfAPIv1alpha1.WAFPolicySpec{
@@ -1099,6 +1200,63 @@ func TestBuildBundleSources(t *testing.T) {
 				g.Expect(sources[0].Interval).To(Equal(defaultPollingInterval))
 			},
 		},
+		{
+			name: "rollout strategy is passed to the sources",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				Type: ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/policy.tgz"},
+					Polling: &ngfAPIv1alpha1.BundlePolling{
+						Enabled: true,
+						Rollout: &ngfAPIv1alpha1.BundleRollout{Strategy: ngfAPIv1alpha1.BundleRolloutStrategyCanary},
+					},
+				},
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+					{
+						LogSource: &ngfAPIv1alpha1.LogSource{
+							HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/log.tgz"},
+							Polling:    &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+						},
+					},
+				},
+			},
+			expectedSources: 2,
+			validateSources: func(g Gomega, sources []BundleSource) {
+				g.Expect(sources[0].RolloutStrategy).To(Equal(ngfAPIv1alpha1.BundleRolloutStrategyCanary))
+				g.Expect(sources[1].RolloutStrategy).To(BeEmpty())
+			},
+		},
+		{
+			name: "signing keys are passed to the matching sources",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				Type: ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/policy.tgz"},
+					Validation: &ngfAPIv1alpha1.BundleValidation{
+						Signature: &ngfAPIv1alpha1.BundleSignature{
+							KeyRef: ngfAPIv1alpha1.SigningKeyReference{Name: "signing-key"},
+						},
+					},
+					Polling: &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+				},
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{
+					{
+						LogSource: &ngfAPIv1alpha1.LogSource{
+							HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "http://example.com/log.tgz"},
+							Polling:    &ngfAPIv1alpha1.BundlePolling{Enabled: true},
+						},
+					},
+				},
+			},
+			signingKeys: map[graph.WAFBundleKey][]byte{
+				graph.PolicyBundleKey(types.NamespacedName{Namespace: "default", Name: "test-policy"}): []byte("key"),
+			},
+			expectedSources: 2,
+			validateSources: func(g Gomega, sources []BundleSource) {
+				g.Expect(sources[0].Request.Signature).To(Equal(fetch.SignatureRequest{PublicKey: []byte("key")}))
+				g.Expect(sources[1].Request.Signature).To(Equal(fetch.SignatureRequest{}))
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1107,7 +1265,7 @@ func TestBuildBundleSources(t *testing.T) {
 			g := NewWithT(t)
 
 			policyNsName := types.NamespacedName{Namespace: "default", Name: "test-policy"}
-			sources := BuildBundleSources(policyNsName, tc.spec, tc.auth, tc.tlsCA)
+			sources := BuildBundleSources(policyNsName, tc.spec, tc.auth, tc.tlsCA, tc.signingKeys)
 
 			g.Expect(sources).To(HaveLen(tc.expectedSources))
 
@@ -11,6 +11,11 @@ import (
 )
 
 type FakeManager struct {
+	EnableBundleCacheStub        func(context.Context)
+	enableBundleCacheMutex       sync.RWMutex
+	enableBundleCacheArgsForCall []struct {
+		arg1 context.Context
+	}
 	GetAllBundleUpdatesStub        func() map[types.NamespacedName]poller.BundleUpdate
 	getAllBundleUpdatesMutex       sync.RWMutex
 	getAllBundleUpdatesArgsForCall []struct {
@@ -52,6 +57,11 @@ type FakeManager struct {
 	hasPollerReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PersistBundlesStub        func(map[graph.WAFBundleKey]*graph.WAFBundleData)
+	persistBundlesMutex       sync.RWMutex
+	persistBundlesArgsForCall []struct {
+		arg1 map[graph.WAFBundleKey]*graph.WAFBundleData
+	}
 	ReconcilePollerStub        func(context.Context, poller.Config)
 	reconcilePollerMutex       sync.RWMutex
 	reconcilePollerArgsForCall []struct {
@@ -72,6 +82,38 @@ type FakeManager struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeManager) EnableBundleCache(arg1 context.Context) {
+	fake.enableBundleCacheMutex.Lock()
+	fake.enableBundleCacheArgsForCall = append(fake.enableBundleCacheArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.EnableBundleCacheStub
+	fake.recordInvocation("EnableBundleCache", []interface{}{arg1})
+	fake.enableBundleCacheMutex.Unlock()
+	if stub != nil {
+		fake.EnableBundleCacheStub(arg1)
+	}
+}
+
+func (fake *FakeManager) EnableBundleCacheCallCount() int {
+	fake.enableBundleCacheMutex.RLock()
+	defer fake.enableBundleCacheMutex.RUnlock()
+	return len(fake.enableBundleCacheArgsForCall)
+}
+
+func (fake *FakeManager) EnableBundleCacheCalls(stub func(context.Context)) {
+	fake.enableBundleCacheMutex.Lock()
+	defer fake.enableBundleCacheMutex.Unlock()
+	fake.EnableBundleCacheStub = stub
+}
+
+func (fake *FakeManager) EnableBundleCacheArgsForCall(i int) context.Context {
+	fake.enableBundleCacheMutex.RLock()
+	defer fake.enableBundleCacheMutex.RUnlock()
+	argsForCall := fake.enableBundleCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeManager) GetAllBundleUpdates() map[types.NamespacedName]poller.BundleUpdate {
 	fake.getAllBundleUpdatesMutex.Lock()
 	ret, specificReturn := fake.getAllBundleUpdatesReturnsOnCall[len(fake.getAllBundleUpdatesArgsForCall)]
@@ -292,6 +334,38 @@ func (fake *FakeManager) HasPollerReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeManager) PersistBundles(arg1 map[graph.WAFBundleKey]*graph.WAFBundleData) {
+	fake.persistBundlesMutex.Lock()
+	fake.persistBundlesArgsForCall = append(fake.persistBundlesArgsForCall, struct {
+		arg1 map[graph.WAFBundleKey]*graph.WAFBundleData
+	}{arg1})
+	stub := fake.PersistBundlesStub
+	fake.recordInvocation("PersistBundles", []interface{}{arg1})
+	fake.persistBundlesMutex.Unlock()
+	if stub != nil {
+		fake.PersistBundlesStub(arg1)
+	}
+}
+
+func (fake *FakeManager) PersistBundlesCallCount() int {
+	fake.persistBundlesMutex.RLock()
+	defer fake.persistBundlesMutex.RUnlock()
+	return len(fake.persistBundlesArgsForCall)
+}
+
+func (fake *FakeManager) PersistBundlesCalls(stub func(map[graph.WAFBundleKey]*graph.WAFBundleData)) {
+	fake.persistBundlesMutex.Lock()
+	defer fake.persistBundlesMutex.Unlock()
+	fake.PersistBundlesStub = stub
+}
+
+func (fake *FakeManager) PersistBundlesArgsForCall(i int) map[graph.WAFBundleKey]*graph.WAFBundleData {
+	fake.persistBundlesMutex.RLock()
+	defer fake.persistBundlesMutex.RUnlock()
+	argsForCall := fake.persistBundlesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeManager) ReconcilePoller(arg1 context.Context, arg2 poller.Config) {
 	fake.reconcilePollerMutex.Lock()
 	fake.reconcilePollerArgsForCall = append(fake.reconcilePollerArgsForCall, struct {
@@ -11,6 +11,11 @@ import (
 )
 
 type FakePollerManager struct {
+	EnableBundleCacheStub        func(context.Context)
+	enableBundleCacheMutex       sync.RWMutex
+	enableBundleCacheArgsForCall []struct {
+		arg1 context.Context
+	}
 	GetAllBundleUpdatesStub        func() map[types.NamespacedName]poller.BundleUpdate
 	getAllBundleUpdatesMutex       sync.RWMutex
 	getAllBundleUpdatesArgsForCall []struct {
@@ -41,12 +46,6 @@ type FakePollerManager struct {
 	getLatestBundlesReturnsOnCall map[int]struct {
 		result1 map[graph.WAFBundleKey]*graph.WAFBundleData
 	}
-	ReconcilePollerStub        func(context.Context, poller.Config)
-	reconcilePollerMutex       sync.RWMutex
-	reconcilePollerArgsForCall []struct {
-		arg1 context.Context
-		arg2 poller.Config
-	}
 	HasPollerStub        func(types.NamespacedName) bool
 	hasPollerMutex       sync.RWMutex
 	hasPollerArgsForCall []struct {
@@ -58,6 +57,17 @@ type FakePollerManager struct {
 	hasPollerReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PersistBundlesStub        func(map[graph.WAFBundleKey]*graph.WAFBundleData)
+	persistBundlesMutex       sync.RWMutex
+	persistBundlesArgsForCall []struct {
+		arg1 map[graph.WAFBundleKey]*graph.WAFBundleData
+	}
+	ReconcilePollerStub        func(context.Context, poller.Config)
+	reconcilePollerMutex       sync.RWMutex
+	reconcilePollerArgsForCall []struct {
+		arg1 context.Context
+		arg2 poller.Config
+	}
 	StopPollerStub        func(types.NamespacedName)
 	stopPollerMutex       sync.RWMutex
 	stopPollerArgsForCall []struct {
@@ -72,6 +82,38 @@ type FakePollerManager struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePollerManager) EnableBundleCache(arg1 context.Context) {
+	fake.enableBundleCacheMutex.Lock()
+	fake.enableBundleCacheArgsForCall = append(fake.enableBundleCacheArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.EnableBundleCacheStub
+	fake.recordInvocation("EnableBundleCache", []interface{}{arg1})
+	fake.enableBundleCacheMutex.Unlock()
+	if stub != nil {
+		fake.EnableBundleCacheStub(arg1)
+	}
+}
+
+func (fake *FakePollerManager) EnableBundleCacheCallCount() int {
+	fake.enableBundleCacheMutex.RLock()
+	defer fake.enableBundleCacheMutex.RUnlock()
+	return len(fake.enableBundleCacheArgsForCall)
+}
+
+func (fake *FakePollerManager) EnableBundleCacheCalls(stub func(context.Context)) {
+	fake.enableBundleCacheMutex.Lock()
+	defer fake.enableBundleCacheMutex.Unlock()
+	fake.EnableBundleCacheStub = stub
+}
+
+func (fake *FakePollerManager) EnableBundleCacheArgsForCall(i int) context.Context {
+	fake.enableBundleCacheMutex.RLock()
+	defer fake.enableBundleCacheMutex.RUnlock()
+	argsForCall := fake.enableBundleCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakePollerManager) GetAllBundleUpdates() map[types.NamespacedName]poller.BundleUpdate {
 	fake.getAllBundleUpdatesMutex.Lock()
 	ret, specificReturn := fake.getAllBundleUpdatesReturnsOnCall[len(fake.getAllBundleUpdatesArgsForCall)]
@@ -231,39 +273,6 @@ func (fake *FakePollerManager) GetLatestBundlesReturnsOnCall(i int, result1 map[
 	}{result1}
 }
 
-func (fake *FakePollerManager) ReconcilePoller(arg1 context.Context, arg2 poller.Config) {
-	fake.reconcilePollerMutex.Lock()
-	fake.reconcilePollerArgsForCall = append(fake.reconcilePollerArgsForCall, struct {
-		arg1 context.Context
-		arg2 poller.Config
-	}{arg1, arg2})
-	stub := fake.ReconcilePollerStub
-	fake.recordInvocation("ReconcilePoller", []interface{}{arg1, arg2})
-	fake.reconcilePollerMutex.Unlock()
-	if stub != nil {
-		fake.ReconcilePollerStub(arg1, arg2)
-	}
-}
-
-func (fake *FakePollerManager) ReconcilePollerCallCount() int {
-	fake.reconcilePollerMutex.RLock()
-	defer fake.reconcilePollerMutex.RUnlock()
-	return len(fake.reconcilePollerArgsForCall)
-}
-
-func (fake *FakePollerManager) ReconcilePollerCalls(stub func(context.Context, poller.Config)) {
-	fake.reconcilePollerMutex.Lock()
-	defer fake.reconcilePollerMutex.Unlock()
-	fake.ReconcilePollerStub = stub
-}
-
-func (fake *FakePollerManager) ReconcilePollerArgsForCall(i int) (context.Context, poller.Config) {
-	fake.reconcilePollerMutex.RLock()
-	defer fake.reconcilePollerMutex.RUnlock()
-	argsForCall := fake.reconcilePollerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
 func (fake *FakePollerManager) HasPoller(arg1 types.NamespacedName) bool {
 	fake.hasPollerMutex.Lock()
 	ret, specificReturn := fake.hasPollerReturnsOnCall[len(fake.hasPollerArgsForCall)]
@@ -325,6 +334,71 @@ func (fake *FakePollerManager) HasPollerReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePollerManager) PersistBundles(arg1 map[graph.WAFBundleKey]*graph.WAFBundleData) {
+	fake.persistBundlesMutex.Lock()
+	fake.persistBundlesArgsForCall = append(fake.persistBundlesArgsForCall, struct {
+		arg1 map[graph.WAFBundleKey]*graph.WAFBundleData
+	}{arg1})
+	stub := fake.PersistBundlesStub
+	fake.recordInvocation("PersistBundles", []interface{}{arg1})
+	fake.persistBundlesMutex.Unlock()
+	if stub != nil {
+		fake.PersistBundlesStub(arg1)
+	}
+}
+
+func (fake *FakePollerManager) PersistBundlesCallCount() int {
+	fake.persistBundlesMutex.RLock()
+	defer fake.persistBundlesMutex.RUnlock()
+	return len(fake.persistBundlesArgsForCall)
+}
+
+func (fake *FakePollerManager) PersistBundlesCalls(stub func(map[graph.WAFBundleKey]*graph.WAFBundleData)) {
+	fake.persistBundlesMutex.Lock()
+	defer fake.persistBundlesMutex.Unlock()
+	fake.PersistBundlesStub = stub
+}
+
+func (fake *FakePollerManager) PersistBundlesArgsForCall(i int) map[graph.WAFBundleKey]*graph.WAFBundleData {
+	fake.persistBundlesMutex.RLock()
+	defer fake.persistBundlesMutex.RUnlock()
+	argsForCall := fake.persistBundlesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePollerManager) ReconcilePoller(arg1 context.Context, arg2 poller.Config) {
+	fake.reconcilePollerMutex.Lock()
+	fake.reconcilePollerArgsForCall = append(fake.reconcilePollerArgsForCall, struct {
+		arg1 context.Context
+		arg2 poller.Config
+	}{arg1, arg2})
+	stub := fake.ReconcilePollerStub
+	fake.recordInvocation("ReconcilePoller", []interface{}{arg1, arg2})
+	fake.reconcilePollerMutex.Unlock()
+	if stub != nil {
+		fake.ReconcilePollerStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePollerManager) ReconcilePollerCallCount() int {
+	fake.reconcilePollerMutex.RLock()
+	defer fake.reconcilePollerMutex.RUnlock()
+	return len(fake.reconcilePollerArgsForCall)
+}
+
+func (fake *FakePollerManager) ReconcilePollerCalls(stub func(context.Context, poller.Config)) {
+	fake.reconcilePollerMutex.Lock()
+	defer fake.reconcilePollerMutex.Unlock()
+	fake.ReconcilePollerStub = stub
+}
+
+func (fake *FakePollerManager) ReconcilePollerArgsForCall(i int) (context.Context, poller.Config) {
+	fake.reconcilePollerMutex.RLock()
+	defer fake.reconcilePollerMutex.RUnlock()
+	argsForCall := fake.reconcilePollerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakePollerManager) StopPoller(arg1 types.NamespacedName) {
 	fake.stopPollerMutex.Lock()
 	fake.stopPollerArgsForCall = append(fake.stopPollerArgsForCall, struct {
@@ -0,0 +1,119 @@
+package poller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
+)
+
+// RolloutError is reported when a changed bundle failed to apply on a deployment during a canary rollout.
+// Every deployment that received the bundle was reverted to the last good bundle.
+type RolloutError struct {
+	Err error
+	// Deployment is the deployment on which the bundle failed to apply.
+	Deployment types.NamespacedName
+	// FailedChecksum is the checksum of the bundle that was rolled back.
+	FailedChecksum string
+	// LiveChecksum is the checksum of the bundle that is live after the rollback.
+	// It is empty if no bundle was deployed before, in which case the failed bundle was removed.
+	LiveChecksum string
+}
+
+func (e *RolloutError) Error() string {
+	return fmt.Sprintf("bundle failed to apply on deployment %s: %v", e.Deployment, e.Err)
+}
+
+func (e *RolloutError) Unwrap() error { return e.Err }
+
+// rolloutBundle deploys a changed bundle to the target deployments one at a time. The first deployment
+// with connected agents acts as the canary: the bundle only proceeds to the remaining deployments once the
+// agents of the canary have applied it successfully. If the bundle fails to apply on any deployment, every
+// deployment that received it is reverted to lastGood and a *RolloutError is returned.
+// lastGood may be nil, in which case the bundle is removed from the deployments on failure.
+func (p *poller) rolloutBundle(
+	bundleKey graph.WAFBundleKey,
+	result fetch.Result,
+	lastGood *graph.WAFBundleData,
+) error {
+	p.targetMu.RLock()
+	defer p.targetMu.RUnlock()
+
+	bundlePath := config.GenerateWAFBundleFileName(dataplane.WAFBundleID(bundleKey))
+
+	// Deployments are visited in a stable order so that the same canary is used for every rollout.
+	targets := make([]types.NamespacedName, 0, len(p.targetDeployments))
+	for t := range p.targetDeployments {
+		targets = append(targets, t)
+	}
+	slices.SortFunc(targets, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	pushed := make([]*agent.Deployment, 0, len(targets))
+	for _, depName := range targets {
+		deployment := p.deployments.Get(depName)
+		if deployment == nil {
+			p.logger.V(1).Info("Deployment not found, skipping bundle push", "deployment", depName)
+			continue
+		}
+
+		applied, err := p.sendBundle(depName, deployment, bundlePath, result.Data)
+		pushed = append(pushed, deployment)
+		if !applied {
+			// Without connected agents the bundle cannot be verified on this deployment; it is applied
+			// when the agents connect.
+			continue
+		}
+
+		if err != nil {
+			p.revertBundle(pushed, bundlePath, lastGood)
+
+			rolloutErr := &RolloutError{
+				Err:            err,
+				Deployment:     depName,
+				FailedChecksum: result.Checksum,
+			}
+			if lastGood != nil {
+				rolloutErr.LiveChecksum = lastGood.Checksum
+			}
+
+			return rolloutErr
+		}
+
+		p.logger.V(1).Info("WAF bundle applied on deployment", "bundle", bundleKey, "deployment", depName)
+	}
+
+	return nil
+}
+
+// revertBundle restores lastGood on the given deployments. If lastGood is nil, the bundle is removed instead.
+func (p *poller) revertBundle(deployments []*agent.Deployment, bundlePath string, lastGood *graph.WAFBundleData) {
+	for _, deployment := range deployments {
+		deployment.FileLock.Lock()
+
+		var msg *broadcast.NginxAgentMessage
+		if lastGood != nil {
+			msg = deployment.UpdateWAFBundle(bundlePath, lastGood.Data)
+		} else {
+			msg = deployment.RemoveWAFBundle(bundlePath)
+		}
+
+		if msg != nil && !deployment.GetBroadcaster().Send(*msg) {
+			p.logger.V(1).Info(
+				"No subscribers for deployment, reverted bundle stored but not pushed",
+				"gateway", deployment.GetGatewayName(),
+			)
+		}
+
+		deployment.FileLock.Unlock()
+	}
+}
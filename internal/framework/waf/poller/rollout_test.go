@@ -0,0 +1,290 @@
+package poller
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	filesHelper "github.com/nginx/agent/v3/pkg/files"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/agentfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/waf/fetch/fetchfakes"
+)
+
+// bundleHash returns the hash of the bundle stored on the deployment, or an empty string if the
+// deployment does not hold the bundle.
+func bundleHash(dep *agent.Deployment, bundleKey graph.WAFBundleKey) string {
+	dep.FileLock.RLock()
+	defer dep.FileLock.RUnlock()
+
+	_, hash, _ := dep.GetFile(config.GenerateWAFBundleFileName(dataplane.WAFBundleID(bundleKey)), "")
+	return hash
+}
+
+func Test_poller_pollSourceCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	bundleKey := graph.WAFBundleKey("default_test")
+	oldBundle := &graph.WAFBundleData{Data: []byte("old bundle data"), Checksum: "abc123"}
+	newData := []byte("new bundle data")
+	newChecksum := "def456"
+	applyErr := errors.New("nginx reload failed")
+
+	canaryName := types.NamespacedName{Namespace: "nginx-gateway", Name: "a"}
+	otherName := types.NamespacedName{Namespace: "nginx-gateway", Name: "b"}
+
+	tests := []struct {
+		lastGood *graph.WAFBundleData
+		// failOn is the deployment whose first apply fails.
+		failOn         *types.NamespacedName
+		name           string
+		expSendCounts  map[types.NamespacedName]int
+		expBundleData  []byte
+		expLive        string
+		expRolledBack  bool
+		expBundleFound bool
+		// staleErr sets an error from an earlier config apply on a pod that doesn't respond to the push.
+		staleErr bool
+	}{
+		{
+			name:           "bundle is deployed to the canary first and then to the remaining deployments",
+			lastGood:       oldBundle,
+			expSendCounts:  map[types.NamespacedName]int{canaryName: 1, otherName: 1},
+			expBundleData:  newData,
+			expBundleFound: true,
+		},
+		{
+			name:           "errors from earlier config applies don't fail the rollout",
+			lastGood:       oldBundle,
+			staleErr:       true,
+			expSendCounts:  map[types.NamespacedName]int{canaryName: 1, otherName: 1},
+			expBundleData:  newData,
+			expBundleFound: true,
+		},
+		{
+			name:     "failed canary is reverted and the bundle is not deployed further",
+			lastGood: oldBundle,
+			failOn:   &canaryName,
+			// The canary receives the bundle and the revert.
+			expSendCounts:  map[types.NamespacedName]int{canaryName: 2, otherName: 0},
+			expBundleData:  oldBundle.Data,
+			expBundleFound: true,
+			expRolledBack:  true,
+			expLive:        oldBundle.Checksum,
+		},
+		{
+			name:           "failure after the canary reverts every deployment that received the bundle",
+			lastGood:       oldBundle,
+			failOn:         &otherName,
+			expSendCounts:  map[types.NamespacedName]int{canaryName: 2, otherName: 2},
+			expBundleData:  oldBundle.Data,
+			expBundleFound: true,
+			expRolledBack:  true,
+			expLive:        oldBundle.Checksum,
+		},
+		{
+			name:          "bundle is removed when there is no last good bundle",
+			failOn:        &canaryName,
+			expSendCounts: map[types.NamespacedName]int{canaryName: 2, otherName: 0},
+			expRolledBack: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			connTracker := agentgrpc.NewConnectionsTracker(collectors.NewAgentConnectionsNoopCollector())
+			store := agent.NewDeploymentStore(connTracker)
+
+			var mu sync.Mutex
+			var sendOrder []types.NamespacedName
+			broadcasters := make(map[types.NamespacedName]*broadcastfakes.FakeBroadcaster)
+			deps := make(map[types.NamespacedName]*agent.Deployment)
+
+			for _, name := range []types.NamespacedName{otherName, canaryName} {
+				broadcaster := &broadcastfakes.FakeBroadcaster{}
+				dep := store.StoreWithBroadcaster(name, broadcaster, name.Name)
+				if tc.lastGood != nil {
+					dep.FileLock.Lock()
+					dep.UpdateWAFBundle(
+						config.GenerateWAFBundleFileName(dataplane.WAFBundleID(bundleKey)),
+						tc.lastGood.Data,
+					)
+					dep.FileLock.Unlock()
+				}
+				if tc.staleErr {
+					dep.SetPodErrorStatus("stale-pod", errors.New("earlier config apply failed"))
+				}
+
+				// Simulate the DataPlaneResponse of the agent: the first apply fails on the failing
+				// deployment, the revert succeeds.
+				broadcaster.SendStub = func(broadcast.NginxAgentMessage) bool {
+					mu.Lock()
+					sendOrder = append(sendOrder, name)
+					mu.Unlock()
+
+					var err error
+					if tc.failOn != nil && *tc.failOn == name && broadcaster.SendCallCount() == 1 {
+						err = applyErr
+					}
+					dep.SetPodErrorStatus("pod", err)
+					return true
+				}
+
+				broadcasters[name] = broadcaster
+				deps[name] = dep
+			}
+
+			fakeDeployments := &agentfakes.FakeDeploymentStorer{}
+			fakeDeployments.GetStub = func(nsName types.NamespacedName) *agent.Deployment {
+				return deps[nsName]
+			}
+
+			fetcher := &fetchfakes.FakeFetcher{}
+			fetcher.FetchPolicyBundleReturns(fetch.Result{Data: newData, Checksum: newChecksum}, nil)
+
+			var callbackErr error
+			var updateCalled bool
+			poller := newPoller(pollerConfig{
+				logger:       logr.Discard(),
+				policyNsName: types.NamespacedName{Namespace: "default", Name: "test"},
+				sources: []BundleSource{
+					{
+						BundleKey:       bundleKey,
+						Request:         fetch.Request{URL: "http://example.com/bundle.tgz"},
+						Interval:        5 * time.Minute,
+						RolloutStrategy: ngfAPIv1alpha1.BundleRolloutStrategyCanary,
+					},
+				},
+				fetcher:           fetcher,
+				deployments:       fakeDeployments,
+				targetDeployments: []types.NamespacedName{otherName, canaryName},
+				initialChecksums:  map[graph.WAFBundleKey]string{bundleKey: oldBundle.Checksum},
+				statusCallback: func(_ types.NamespacedName, _ graph.WAFBundleKey, _ string, err error) {
+					callbackErr = err
+				},
+				bundleUpdateCallback: func(graph.WAFBundleKey, []byte, string, []byte) {
+					updateCalled = true
+				},
+				lastGoodBundle: func(graph.WAFBundleKey) *graph.WAFBundleData {
+					return tc.lastGood
+				},
+			})
+
+			src := poller.sources[0]
+			poller.pollSource(t.Context(), src)
+
+			// The canary is chosen in a stable order.
+			g.Expect(sendOrder[0]).To(Equal(canaryName))
+			for name, count := range tc.expSendCounts {
+				g.Expect(broadcasters[name].SendCallCount()).To(Equal(count), name.String())
+			}
+
+			for name, dep := range deps {
+				if broadcasters[name].SendCallCount() == 0 {
+					continue
+				}
+				hash := bundleHash(dep, bundleKey)
+				if !tc.expBundleFound {
+					g.Expect(hash).To(BeEmpty())
+					continue
+				}
+				g.Expect(hash).To(Equal(filesHelper.GenerateHash(tc.expBundleData)))
+			}
+
+			if !tc.expRolledBack {
+				g.Expect(callbackErr).ToNot(HaveOccurred())
+				g.Expect(updateCalled).To(BeTrue())
+				g.Expect(poller.bundleStates[bundleKey].checksum).To(Equal(newChecksum))
+				return
+			}
+
+			var rolloutErr *RolloutError
+			g.Expect(errors.As(callbackErr, &rolloutErr)).To(BeTrue())
+			g.Expect(rolloutErr.Deployment).To(Equal(*tc.failOn))
+			g.Expect(rolloutErr.FailedChecksum).To(Equal(newChecksum))
+			g.Expect(rolloutErr.LiveChecksum).To(Equal(tc.expLive))
+			g.Expect(errors.Is(callbackErr, applyErr)).To(BeTrue())
+
+			g.Expect(updateCalled).To(BeFalse())
+			g.Expect(poller.bundleStates[bundleKey].checksum).To(Equal(oldBundle.Checksum))
+
+			// Polling the same bundle again does not roll it out again, but keeps reporting the rollback.
+			callbackErr = nil
+			poller.pollSource(t.Context(), src)
+
+			g.Expect(fetcher.FetchPolicyBundleCallCount()).To(Equal(2))
+			for name, count := range tc.expSendCounts {
+				g.Expect(broadcasters[name].SendCallCount()).To(Equal(count), name.String())
+			}
+			g.Expect(errors.As(callbackErr, &rolloutErr)).To(BeTrue())
+		})
+	}
+}
+
+func Test_poller_pollSourceCanaryRolloutRejectedChecksumOnly(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	bundleKey := graph.WAFBundleKey("default_test")
+	rolloutErr := &RolloutError{Err: errors.New("nginx reload failed"), FailedChecksum: "def456"}
+
+	fetcher := &fetchfakes.FakeFetcher{}
+	fetcher.FetchPolicyBundleChecksumReturns("def456", nil)
+
+	var callbackErr error
+	poller := newPoller(pollerConfig{
+		logger:       logr.Discard(),
+		policyNsName: types.NamespacedName{Namespace: "default", Name: "test"},
+		sources: []BundleSource{
+			{
+				BundleKey:       bundleKey,
+				Request:         fetch.Request{OCI: fetch.OCIRequest{Reference: "registry.example.com/waf/policy:v1"}},
+				Interval:        5 * time.Minute,
+				RolloutStrategy: ngfAPIv1alpha1.BundleRolloutStrategyCanary,
+			},
+		},
+		fetcher:           fetcher,
+		deployments:       &agentfakes.FakeDeploymentStorer{},
+		targetDeployments: []types.NamespacedName{{Namespace: "nginx-gateway", Name: "nginx"}},
+		initialChecksums:  map[graph.WAFBundleKey]string{bundleKey: "abc123"},
+		statusCallback: func(_ types.NamespacedName, _ graph.WAFBundleKey, _ string, err error) {
+			callbackErr = err
+		},
+	})
+	poller.rejectBundle(bundleKey, "def456", rolloutErr)
+
+	poller.pollSource(t.Context(), poller.sources[0])
+
+	// A rolled back bundle is not downloaded again while its checksum is unchanged.
+	g.Expect(fetcher.FetchPolicyBundleChecksumCallCount()).To(Equal(1))
+	g.Expect(fetcher.FetchPolicyBundleCallCount()).To(Equal(0))
+	g.Expect(callbackErr).To(Equal(rolloutErr))
+
+	// A new bundle is rolled out and clears the rejected checksum.
+	fetcher.FetchPolicyBundleChecksumReturns("ghi789", nil)
+	fetcher.FetchPolicyBundleReturns(fetch.Result{Data: []byte("fixed bundle"), Checksum: "ghi789"}, nil)
+
+	poller.pollSource(t.Context(), poller.sources[0])
+
+	g.Expect(fetcher.FetchPolicyBundleCallCount()).To(Equal(1))
+	g.Expect(callbackErr).ToNot(HaveOccurred())
+	g.Expect(poller.bundleStates[bundleKey].checksum).To(Equal("ghi789"))
+	g.Expect(poller.bundleStates[bundleKey].rejectedChecksum).To(BeEmpty())
+}
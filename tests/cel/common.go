@@ -62,8 +62,14 @@ const (
 	expectedTargetRefGroupCoreError = "TargetRefs Group must be core"
 
 	// Name uniqueness validation errors.
-	expectedTargetRefNameUniqueError              = "TargetRef Name must be unique"
-	expectedTargetRefKindAndNameComboMustBeUnique = "TargetRef Kind and Name combination must be unique"
+	expectedTargetRefNameUniqueError                         = "TargetRef Name must be unique"
+	expectedTargetRefKindAndNameComboMustBeUnique            = "TargetRef Kind and Name combination must be unique"
+	expectedTargetRefKindNameAndSectionNameComboMustBeUnique = "TargetRef Kind, Name and SectionName " +
+		"combination must be unique"
+
+	// SectionName validation errors.
+	expectedSectionNameKindError     = "SectionName can only be set for HTTPRoute or GRPCRoute kinds"
+	expectedRouteAndNamedRuleTargets = "Cannot target a Route and a named rule of the same Route in targetRefs"
 
 	// Header validation error.
 	expectedHeaderWithoutServerError = "header can only be specified if server is specified"
@@ -97,6 +103,13 @@ const (
 	// AccessLog format validation error.
 	expectedAccessLogFormatPatternError = `format in body should match`
 
+	// Tracing exporter TLS validation error.
+	expectedTracingExporterTLSError = "tls.clientCertificateRef and tls.serverName are not supported by the " +
+		"tracing exporter"
+
+	// RateLimitPolicy key validation error.
+	expectedRateLimitKeyPatternError = `key in body should match`
+
 	// ExtraAuthArgs validation error.
 	expectedExtraAuthArgsKeyError = "extraAuthArgs keys must contain only alphanumeric characters, hyphens, " +
 		"underscores, or dots"
@@ -108,6 +121,9 @@ const (
 	expectedHashKeyLoadBalancingTypeError = `hashMethodKey is required when loadBalancingMethod ` +
 		`is 'hash' or 'hash consistent'`
 
+	// HealthCheckPolicy validation error.
+	expectedHealthCheckMatchEmptyError = "at least one of status or body must be specified"
+
 	// WAFPolicy errors.
 	expectedWAFFileIfAndOnlyIfFileTypeError     = "destination.file must be set if and only if type is file"
 	expectedWAFSyslogIfAndOnlyIfSyslogType      = "destination.syslog must be set if and only if type is syslog"
@@ -116,7 +132,7 @@ const (
 	expectedWAFPolicySourceTypeMatchError       = "type must match the configured policy source"
 	expectedWAFPolicyRefRequiredForPLMError     = "policyRef.apPolicyRef is required when type is PLM"
 	expectedWAFPolicySourceMutualExclusionError = "exactly one of httpSource, nimSource, " +
-		"or n1cSource must be set"
+		"n1cSource, or ociSource must be set"
 	expectedWAFLogSourceOrLogRefError        = "exactly one of logSource or logRef must be set"
 	expectedWAFLogSourceMutualExclusionError = "exactly one of defaultProfile, httpSource, " +
 		"nimSource, or n1cSource must be set"
@@ -131,6 +147,16 @@ const (
 	expectedWAFNIMPolicyUIDPatternError          = `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`
 	expectedWAFN1CPolicyObjectIDPatternError     = `^pol_[A-Za-z0-9_-]+$`
 	expectedWAFN1CPolicyVersionIDPatternError    = `^pv_[A-Za-z0-9_-]+$`
+	expectedWAFSignatureURLRequiredError         = "policySource.validation.signature.url is required " +
+		"when type is not HTTP"
+	expectedWAFLogSignatureURLRequiredError = "validation.signature.url is required when httpSource is not set"
+	expectedWAFRoutePLMLogSourceTypeError   = "routeSecurityLogs[*].securityLogs[*].logRef.apLogConfRef " +
+		"is only allowed when type is PLM"
+	expectedWAFRouteSecurityLogsGRPCRouteError = "routeSecurityLogs is not supported when targetRefs are GRPCRoutes"
+	expectedWAFRouteSecurityLogsNameError      = "routeSecurityLogs[*].name must match the name of a targetRef " +
+		"when targetRefs are HTTPRoutes"
+	expectedWAFRouteSecurityLogsNamespaceError = "routeSecurityLogs[*].namespace is only allowed when " +
+		"targetRefs are Gateways"
 
 	// ExternalLoadBalancer validation errors.
 	expectedELBBackendRequiredError                         = "exactly one external load balancer backend must be set"
@@ -0,0 +1,142 @@
+package cel
+
+import (
+	"testing"
+
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func TestHealthCheckPolicyTargetRefKind(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	tests := []struct {
+		spec       ngfAPIv1alpha1.HealthCheckPolicySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "Validate TargetRef of kind Service is allowed",
+			spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{
+						Kind:  serviceKind,
+						Group: coreGroup,
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate TargetRef of kind Gateway is not allowed",
+			wantErrors: []string{expectedTargetRefKindServiceError},
+			spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{
+						Kind:  gatewayKind,
+						Group: coreGroup,
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate TargetRef of kind HTTPRoute is not allowed",
+			wantErrors: []string{expectedTargetRefKindServiceError},
+			spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{
+						Kind:  httpRouteKind,
+						Group: coreGroup,
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate TargetRef group must be core",
+			wantErrors: []string{expectedTargetRefGroupCoreError},
+			spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{
+						Kind:  serviceKind,
+						Group: invalidGroup,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for i := range tt.spec.TargetRefs {
+				tt.spec.TargetRefs[i].Name = gatewayv1.ObjectName(uniqueResourceName(testTargetRefName))
+			}
+
+			healthCheckPolicy := &ngfAPIv1alpha1.HealthCheckPolicy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      uniqueResourceName(testResourceName),
+					Namespace: defaultNamespace,
+				},
+				Spec: tt.spec,
+			}
+			validateCrd(t, tt.wantErrors, healthCheckPolicy, k8sClient)
+		})
+	}
+}
+
+func TestHealthCheckPolicyMatch(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	tests := []struct {
+		match      *ngfAPIv1alpha1.HealthCheckMatch
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "Validate match with status is allowed",
+			match: &ngfAPIv1alpha1.HealthCheckMatch{
+				Status: helpers.GetPointer[ngfAPIv1alpha1.HealthCheckStatusMatch]("200-399"),
+			},
+		},
+		{
+			name: "Validate match with body is allowed",
+			match: &ngfAPIv1alpha1.HealthCheckMatch{
+				Body: helpers.GetPointer("ok"),
+			},
+		},
+		{
+			name:       "Validate empty match is not allowed",
+			wantErrors: []string{expectedHealthCheckMatchEmptyError},
+			match:      &ngfAPIv1alpha1.HealthCheckMatch{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			healthCheckPolicy := &ngfAPIv1alpha1.HealthCheckPolicy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      uniqueResourceName(testResourceName),
+					Namespace: defaultNamespace,
+				},
+				Spec: ngfAPIv1alpha1.HealthCheckPolicySpec{
+					TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+						{
+							Kind:  serviceKind,
+							Group: coreGroup,
+							Name:  gatewayv1.ObjectName(uniqueResourceName(testTargetRefName)),
+						},
+					},
+					Match: tt.match,
+				},
+			}
+			validateCrd(t, tt.wantErrors, healthCheckPolicy, k8sClient)
+		})
+	}
+}
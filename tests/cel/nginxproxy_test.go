@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
@@ -605,3 +606,63 @@ func TestNginxProxyServerTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestNginxProxyTelemetryExporterTLS(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	createSpec := func(tls *ngfAPIv1alpha2.ExporterTLS) ngfAPIv1alpha2.NginxProxySpec {
+		return ngfAPIv1alpha2.NginxProxySpec{
+			Telemetry: &ngfAPIv1alpha2.Telemetry{
+				Exporter: &ngfAPIv1alpha2.TelemetryExporter{
+					Endpoint: helpers.GetPointer("otel-collector:4317"),
+					TLS:      tls,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		spec       ngfAPIv1alpha2.NginxProxySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "Validate tracing exporter with a CA certificate is valid",
+			spec: createSpec(&ngfAPIv1alpha2.ExporterTLS{
+				CACertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: "otel-ca"},
+			}),
+		},
+		{
+			name:       "Validate tracing exporter with a client certificate is invalid",
+			wantErrors: []string{expectedTracingExporterTLSError},
+			spec: createSpec(&ngfAPIv1alpha2.ExporterTLS{
+				ClientCertificateRef: &ngfAPIv1alpha1.LocalObjectReference{Name: "otel-client"},
+			}),
+		},
+		{
+			name:       "Validate tracing exporter with a server name is invalid",
+			wantErrors: []string{expectedTracingExporterTLSError},
+			spec: createSpec(&ngfAPIv1alpha2.ExporterTLS{
+				ServerName: helpers.GetPointer("otel.example.com"),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			spec := tt.spec
+			resourceName := uniqueResourceName(testResourceName)
+
+			nginxProxy := &ngfAPIv1alpha2.NginxProxy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      resourceName,
+					Namespace: defaultNamespace,
+				},
+				Spec: spec,
+			}
+			validateCrd(t, tt.wantErrors, nginxProxy, k8sClient)
+		})
+	}
+}
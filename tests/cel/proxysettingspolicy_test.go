@@ -7,6 +7,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
 func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
@@ -21,10 +22,12 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind Gateway is allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -32,10 +35,12 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind HTTPRoute is allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -43,10 +48,12 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind GRPCRoute is allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -54,14 +61,18 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRefs of kind GRPCRoute and HTTPRoute are allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -70,14 +81,18 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs of kind Gateway and HTTPRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -86,14 +101,18 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs of kind Gateway and GRPCRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -102,18 +121,24 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs with Gateway, HTTPRoute, and GRPCRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -122,10 +147,12 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate invalid TargetRef Kind is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -134,10 +161,12 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TCPRoute TargetRef Kind is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  tcpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  tcpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -146,14 +175,18 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate valid and invalid TargetRefs Kinds is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -162,14 +195,18 @@ func TestProxySettingsPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate more than one invalid TargetRefs Kinds are not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -207,10 +244,12 @@ func TestProxySettingsPolicyTargetRefsGroup(t *testing.T) {
 		{
 			name: "Validate gateway.networking.k8s.io TargetRef Group is allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -219,10 +258,12 @@ func TestProxySettingsPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate invalid.networking.k8s.io TargetRef Group is not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 				},
 			},
@@ -231,14 +272,18 @@ func TestProxySettingsPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate valid and invalid TargetRef Group are not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -247,14 +292,18 @@ func TestProxySettingsPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate more than one invalid TargetRef Group are not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 					{
-						Kind:  gatewayKind,
-						Group: discoveryGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: discoveryGroup,
+						},
 					},
 				},
 			},
@@ -291,10 +340,12 @@ func TestProxySettingsPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate single TargetRef with unique name is allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -302,14 +353,18 @@ func TestProxySettingsPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate multiple TargetRefs with unique names are allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -317,85 +372,107 @@ func TestProxySettingsPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate multiple name duplicates for different TargetRefs Kind are allowed",
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate duplicate TargetRef names are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate three TargetRefs with one duplicate name are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
-						Name:  "unique-service-1",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "unique-service-1",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate multiple duplicates are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-a",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-a",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-a", // Duplicate of first
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-a", // Duplicate of first
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-b",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-b",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-b", // Duplicate of third
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-b", // Duplicate of third
+						},
 					},
 				},
 			},
@@ -421,3 +498,115 @@ func TestProxySettingsPolicyTargetRefsNameUniqueness(t *testing.T) {
 		})
 	}
 }
+
+func TestProxySettingsPolicyTargetRefsSectionName(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	tests := []struct {
+		spec       ngfAPIv1alpha1.ProxySettingsPolicySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "Validate TargetRefs to different rules of the same route are allowed",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-2"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate duplicate TargetRef sectionNames are not allowed",
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate sectionName is not allowed for Gateway kind",
+			wantErrors: []string{expectedSectionNameKindError},
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+							Name:  "gateway",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("listener"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate TargetRefs to a route and a rule of the same route are not allowed",
+			wantErrors: []string{expectedRouteAndNamedRuleTargets},
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			psp := &ngfAPIv1alpha1.ProxySettingsPolicy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      uniqueResourceName(testResourceName),
+					Namespace: defaultNamespace,
+				},
+				Spec: tt.spec,
+			}
+			validateCrd(t, tt.wantErrors, psp, k8sClient)
+		})
+	}
+}
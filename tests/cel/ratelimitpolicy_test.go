@@ -22,10 +22,12 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind Gateway is allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -33,10 +35,12 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind HTTPRoute is allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -44,10 +48,12 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRef of kind GRPCRoute is allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -55,14 +61,18 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 		{
 			name: "Validate TargetRefs of kind GRPCRoute and HTTPRoute are allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -71,14 +81,18 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs of kind Gateway and HTTPRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -87,14 +101,18 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs of kind Gateway and GRPCRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -103,18 +121,24 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TargetRefs with Gateway, HTTPRoute, and GRPCRoute are not allowed",
 			wantErrors: []string{"Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs"},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -123,10 +147,12 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate invalid TargetRef Kind is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -135,10 +161,12 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate TCPRoute TargetRef Kind is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  tcpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  tcpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -147,14 +175,18 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate valid and invalid TargetRefs Kinds is not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -163,14 +195,18 @@ func TestRateLimitPolicyTargetRefsKind(t *testing.T) {
 			name:       "Validate more than one invalid TargetRefs Kinds are not allowed",
 			wantErrors: []string{expectedTargetRefKindMustBeGatewayOrHTTPRouteOrGrpcRouteError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  invalidKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  invalidKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -208,10 +244,12 @@ func TestRateLimitPolicyTargetRefsGroup(t *testing.T) {
 		{
 			name: "Validate gateway.networking.k8s.io TargetRef Group is allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -220,10 +258,12 @@ func TestRateLimitPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate invalid.networking.k8s.io TargetRef Group is not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 				},
 			},
@@ -232,14 +272,18 @@ func TestRateLimitPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate valid and invalid TargetRef Group are not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -248,14 +292,18 @@ func TestRateLimitPolicyTargetRefsGroup(t *testing.T) {
 			name:       "Validate more than one invalid TargetRef Group are not allowed",
 			wantErrors: []string{expectedTargetRefGroupError},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: invalidGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: invalidGroup,
+						},
 					},
 					{
-						Kind:  gatewayKind,
-						Group: discoveryGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: discoveryGroup,
+						},
 					},
 				},
 			},
@@ -292,10 +340,12 @@ func TestRateLimitPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate single TargetRef with unique name is allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -303,14 +353,18 @@ func TestRateLimitPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate multiple TargetRefs with unique names are allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 			},
@@ -318,85 +372,107 @@ func TestRateLimitPolicyTargetRefsNameUniqueness(t *testing.T) {
 		{
 			name: "Validate multiple name duplicates for different TargetRefs Kind are allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate duplicate TargetRef names are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate three TargetRefs with one duplicate name are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  grpcRouteKind,
-						Group: gatewayGroup,
-						Name:  "unique-service-1",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "unique-service-1",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "duplicate-service-name", // Same name as above
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "duplicate-service-name", // Same name as above
+						},
 					},
 				},
 			},
 		},
 		{
 			name:       "Validate multiple duplicates are not allowed",
-			wantErrors: []string{expectedTargetRefKindAndNameComboMustBeUnique},
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-a",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-a",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-a", // Duplicate of first
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-a", // Duplicate of first
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-b",
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-b",
+						},
 					},
 					{
-						Kind:  httpRouteKind,
-						Group: gatewayGroup,
-						Name:  "service-b", // Duplicate of third
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "service-b", // Duplicate of third
+						},
 					},
 				},
 			},
@@ -423,6 +499,118 @@ func TestRateLimitPolicyTargetRefsNameUniqueness(t *testing.T) {
 	}
 }
 
+func TestRateLimitPolicyTargetRefsSectionName(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	tests := []struct {
+		spec       ngfAPIv1alpha1.RateLimitPolicySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "Validate TargetRefs to different rules of the same route are allowed",
+			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-2"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate duplicate TargetRef sectionNames are not allowed",
+			wantErrors: []string{expectedTargetRefKindNameAndSectionNameComboMustBeUnique},
+			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  httpRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate sectionName is not allowed for Gateway kind",
+			wantErrors: []string{expectedSectionNameKindError},
+			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+							Name:  "gateway",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("listener"),
+					},
+				},
+			},
+		},
+		{
+			name:       "Validate TargetRefs to a route and a rule of the same route are not allowed",
+			wantErrors: []string{expectedRouteAndNamedRuleTargets},
+			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+					},
+					{
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  grpcRouteKind,
+							Group: gatewayGroup,
+							Name:  "route",
+						},
+						SectionName: helpers.GetPointer[gatewayv1.SectionName]("rule-1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      uniqueResourceName(testResourceName),
+					Namespace: defaultNamespace,
+				},
+				Spec: tt.spec,
+			}
+			validateCrd(t, tt.wantErrors, rlp, k8sClient)
+		})
+	}
+}
+
 func TestRateLimitPolicyDelayAndNoDelay(t *testing.T) {
 	t.Parallel()
 	k8sClient := getKubernetesClient(t)
@@ -435,10 +623,12 @@ func TestRateLimitPolicyDelayAndNoDelay(t *testing.T) {
 		{
 			name: "Validate NoDelay and Delay cannot be set together",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 				RateLimit: &ngfAPIv1alpha1.RateLimit{
@@ -459,10 +649,12 @@ func TestRateLimitPolicyDelayAndNoDelay(t *testing.T) {
 		{
 			name: "Validate two separate rules with Delay and NoDelay are allowed",
 			spec: ngfAPIv1alpha1.RateLimitPolicySpec{
-				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
 					{
-						Kind:  gatewayKind,
-						Group: gatewayGroup,
+						LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+							Kind:  gatewayKind,
+							Group: gatewayGroup,
+						},
 					},
 				},
 				RateLimit: &ngfAPIv1alpha1.RateLimit{
@@ -504,3 +696,63 @@ func TestRateLimitPolicyDelayAndNoDelay(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitPolicyKey(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	tests := []struct {
+		name       string
+		key        string
+		wantErrors []string
+	}{
+		{
+			name: "Validate key with variables and text is allowed",
+			key:  "$binary_remote_addr:$request_uri",
+		},
+		{
+			name:       "Validate key with a double quote is not allowed",
+			key:        `$binary_remote_addr"`,
+			wantErrors: []string{expectedRateLimitKeyPatternError},
+		},
+		{
+			name:       "Validate key with a backslash is not allowed",
+			key:        `$binary_remote_addr\`,
+			wantErrors: []string{expectedRateLimitKeyPatternError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      uniqueResourceName(testResourceName),
+					Namespace: defaultNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+								Kind:  gatewayKind,
+								Group: gatewayGroup,
+								Name:  gatewayv1.ObjectName(uniqueResourceName(testTargetRefName)),
+							},
+						},
+					},
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Local: &ngfAPIv1alpha1.LocalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Key:  tt.key,
+									Rate: "5r/s",
+								},
+							},
+						},
+					},
+				},
+			}
+			validateCrd(t, tt.wantErrors, rlp, k8sClient)
+		})
+	}
+}
@@ -1014,6 +1014,40 @@ func TestWAFPolicyPolicySource(t *testing.T) {
 				Type:       ngfAPIv1alpha1.PolicySourceTypeN1C,
 			},
 		},
+		{
+			name:       "OCI type without ociSource is invalid",
+			wantErrors: []string{expectedWAFPolicySourceTypeMatchError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeOCI,
+			},
+		},
+		{
+			name: "OCI type with ociSource is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeOCI,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					OCISource: &ngfAPIv1alpha1.OCIBundleSource{
+						Reference: "registry.example.com/security/waf-policy:v1.2.0",
+					},
+				},
+			},
+		},
+		{
+			name:       "ociSource set with HTTP type is invalid",
+			wantErrors: []string{expectedWAFPolicySourceMutualExclusionError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://example.com/policy.tgz"},
+					OCISource: &ngfAPIv1alpha1.OCIBundleSource{
+						Reference: "registry.example.com/security/waf-policy:v1.2.0",
+					},
+				},
+			},
+		},
 		{
 			name:       "PLM type without apPolicyRef is invalid",
 			wantErrors: []string{expectedWAFPolicyRefRequiredForPLMError},
@@ -1278,6 +1312,109 @@ func TestWAFPolicyVerifyChecksumHTTPOnly(t *testing.T) {
 	}
 }
 
+func TestWAFPolicySignatureURL(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	nimSource := &ngfAPIv1alpha1.NIMBundleSource{
+		URL:        "https://nim.example.com",
+		PolicyName: helpers.GetPointer("my-policy"),
+	}
+	signature := &ngfAPIv1alpha1.BundleSignature{
+		KeyRef: ngfAPIv1alpha1.SigningKeyReference{Name: "signing-key"},
+	}
+	signatureWithURL := &ngfAPIv1alpha1.BundleSignature{
+		URL:    helpers.GetPointer("https://example.com/policy.tgz.sig"),
+		KeyRef: ngfAPIv1alpha1.SigningKeyReference{Kind: ngfAPIv1alpha1.SigningKeyKindConfigMap, Name: "signing-key"},
+	}
+	httpPolicySource := &ngfAPIv1alpha1.PolicySource{
+		HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://example.com/policy.tgz"},
+	}
+	nimLogSecurityLog := func(sig *ngfAPIv1alpha1.BundleSignature) ngfAPIv1alpha1.WAFSecurityLog {
+		secLog := baseSecurityLog()
+		secLog.LogSource = &ngfAPIv1alpha1.LogSource{
+			NIMSource: &ngfAPIv1alpha1.NIMLogProfileBundleSource{
+				URL:         "https://nim.example.com",
+				ProfileName: "my-profile",
+			},
+			Validation: &ngfAPIv1alpha1.BundleValidation{Signature: sig},
+		}
+		return secLog
+	}
+
+	tests := []struct {
+		spec       ngfAPIv1alpha1.WAFPolicySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "signature without url with HTTP type is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					HTTPSource: &ngfAPIv1alpha1.HTTPBundleSource{URL: "https://example.com/policy.tgz"},
+					Validation: &ngfAPIv1alpha1.BundleValidation{Signature: signature},
+				},
+			},
+		},
+		{
+			name:       "signature without url with NIM type is invalid",
+			wantErrors: []string{expectedWAFSignatureURLRequiredError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeNIM,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					NIMSource:  nimSource,
+					Validation: &ngfAPIv1alpha1.BundleValidation{Signature: signature},
+				},
+			},
+		},
+		{
+			name: "signature with url with NIM type is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypeNIM,
+				PolicySource: &ngfAPIv1alpha1.PolicySource{
+					NIMSource:  nimSource,
+					Validation: &ngfAPIv1alpha1.BundleValidation{Signature: signatureWithURL},
+				},
+			},
+		},
+		{
+			name:       "log profile signature without url with NIM source is invalid",
+			wantErrors: []string{expectedWAFLogSignatureURLRequiredError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs:   []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:         ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: httpPolicySource,
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{nimLogSecurityLog(signature)},
+			},
+		},
+		{
+			name: "log profile signature with url with NIM source is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs:   []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:         ngfAPIv1alpha1.PolicySourceTypeHTTP,
+				PolicySource: httpPolicySource,
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{nimLogSecurityLog(signatureWithURL)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for i := range tt.spec.TargetRefs {
+				if tt.spec.TargetRefs[i].Name == "" {
+					tt.spec.TargetRefs[i].Name = gatewayv1.ObjectName(uniqueResourceName(testTargetRefName))
+				}
+			}
+			validateCrd(t, tt.wantErrors, newWAFPolicy(t, tt.spec), k8sClient)
+		})
+	}
+}
+
 func TestWAFPolicyNIMPolicyUID(t *testing.T) {
 	t.Parallel()
 	k8sClient := getKubernetesClient(t)
@@ -1647,3 +1784,133 @@ func TestWAFPolicyN1CLogProfileNameOrObjectID(t *testing.T) {
 		})
 	}
 }
+
+func TestWAFPolicyRouteSecurityLogs(t *testing.T) {
+	t.Parallel()
+	k8sClient := getKubernetesClient(t)
+
+	otlpSecurityLog := ngfAPIv1alpha1.WAFSecurityLog{
+		LogSource:   baseLogSource(),
+		Destination: ngfAPIv1alpha1.SecurityLogDestination{Type: ngfAPIv1alpha1.SecurityLogDestinationTypeOTLP},
+	}
+	apLogConfSecurityLog := ngfAPIv1alpha1.WAFSecurityLog{
+		LogRef: &ngfAPIv1alpha1.LogRef{
+			APLogConfRef: &ngfAPIv1alpha1.APLogConfReference{Name: "my-log-conf"},
+		},
+		Destination: ngfAPIv1alpha1.SecurityLogDestination{Type: ngfAPIv1alpha1.SecurityLogDestinationTypeStderr},
+	}
+
+	tests := []struct {
+		spec       ngfAPIv1alpha1.WAFPolicySpec
+		name       string
+		wantErrors []string
+	}{
+		{
+			name: "otlp destination is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs:   []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{otlpSecurityLog},
+			},
+		},
+		{
+			name: "routeSecurityLogs with a Gateway target and a route namespace is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{
+						Name:         "coffee",
+						Namespace:    helpers.GetPointer[gatewayv1.Namespace]("cafe"),
+						SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{otlpSecurityLog},
+					},
+				},
+			},
+		},
+		{
+			name: "routeSecurityLogs naming a targeted HTTPRoute is valid",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{Kind: httpRouteKind, Group: gatewayGroup, Name: "coffee"},
+					{Kind: httpRouteKind, Group: gatewayGroup, Name: "tea"},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{Name: "tea", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{baseSecurityLog()}},
+				},
+			},
+		},
+		{
+			name:       "routeSecurityLogs naming an HTTPRoute that is not targeted is invalid",
+			wantErrors: []string{expectedWAFRouteSecurityLogsNameError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{Kind: httpRouteKind, Group: gatewayGroup, Name: "coffee"},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{Name: "tea", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{baseSecurityLog()}},
+				},
+			},
+		},
+		{
+			name:       "routeSecurityLogs namespace with HTTPRoute targets is invalid",
+			wantErrors: []string{expectedWAFRouteSecurityLogsNamespaceError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{Kind: httpRouteKind, Group: gatewayGroup, Name: "coffee"},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{
+						Name:         "coffee",
+						Namespace:    helpers.GetPointer[gatewayv1.Namespace]("cafe"),
+						SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{baseSecurityLog()},
+					},
+				},
+			},
+		},
+		{
+			name:       "routeSecurityLogs with GRPCRoute targets is invalid",
+			wantErrors: []string{expectedWAFRouteSecurityLogsGRPCRouteError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{
+					{Kind: grpcRouteKind, Group: gatewayGroup, Name: "coffee"},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{Name: "coffee", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{baseSecurityLog()}},
+				},
+			},
+		},
+		{
+			name: "routeSecurityLogs apLogConfRef is valid for PLM",
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				Type:       ngfAPIv1alpha1.PolicySourceTypePLM,
+				PolicyRef: &ngfAPIv1alpha1.PolicyRef{
+					APPolicyRef: &ngfAPIv1alpha1.APPolicyReference{Name: "my-ap-policy"},
+				},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{Name: "coffee", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{apLogConfSecurityLog}},
+				},
+			},
+		},
+		{
+			name:       "routeSecurityLogs apLogConfRef with non-PLM type is invalid",
+			wantErrors: []string{expectedWAFRoutePLMLogSourceTypeError},
+			spec: ngfAPIv1alpha1.WAFPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReference{{Kind: gatewayKind, Group: gatewayGroup}},
+				RouteSecurityLogs: []ngfAPIv1alpha1.WAFRouteSecurityLogs{
+					{Name: "coffee", SecurityLogs: []ngfAPIv1alpha1.WAFSecurityLog{apLogConfSecurityLog}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for i := range tt.spec.TargetRefs {
+				if tt.spec.TargetRefs[i].Name == "" {
+					tt.spec.TargetRefs[i].Name = gatewayv1.ObjectName(uniqueResourceName(testTargetRefName))
+				}
+			}
+			validateCrd(t, tt.wantErrors, newWAFPolicy(t, tt.spec), k8sClient)
+		})
+	}
+}
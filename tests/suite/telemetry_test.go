@@ -108,8 +108,10 @@ var _ = Describe("Telemetry test with OTel collector", Label("telemetry"), func(
 				"HTTPWAFPolicyCount: Int(0)",
 				"NIMWAFPolicyCount: Int(0)",
 				"N1CWAFPolicyCount: Int(0)",
+				"OCIWAFPolicyCount: Int(0)",
 				"PLMWAFPolicyCount: Int(0)",
 				"ListenerSetCount: Int(0)",
+				"HealthCheckPolicyCount: Int(0)",
 				"NginxPodCount: Int(0)",
 				"ControlPlanePodCount: Int(1)",
 				"NginxOneConnectionEnabled: Bool(false)",